@@ -344,6 +344,39 @@ func main() {
 	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/pipelines/{pipelineID=*}/image", middleware.HandleProfileImage(service, repository)); err != nil {
 		logger.Fatal(err.Error())
 	}
+	if err := publicServeMux.HandlePath("DELETE", "/v1beta/*/{namespaceID=*}/pipelines/{pipelineID=*}/cache", middleware.HandlePurgePipelineCache(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("POST", "/v1beta/operations/{operationID=*}:cancel", middleware.HandleCancelOperation(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/approvals", middleware.HandleListApprovals(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}", middleware.HandleGetApproval(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("POST", "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}:approve", middleware.HandleReviewApproval(publicServeMux, service, true)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("POST", "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}:reject", middleware.HandleReviewApproval(publicServeMux, service, false)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/pipelines/{pipelineID=*}/runs", middleware.HandleListPipelineRuns(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/pipeline-runs", middleware.HandleListNamespaceRuns(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("GET", "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}", middleware.HandleGetNamespaceRun(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("POST", "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}:replay", middleware.HandleReplayNamespaceRun(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := publicServeMux.HandlePath("POST", "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}:resume", middleware.HandleResumeNamespaceRun(publicServeMux, service)); err != nil {
+		logger.Fatal(err.Error())
+	}
 
 	privateHTTPServer := &http.Server{
 		Addr:      fmt.Sprintf(":%v", config.Config.Server.PrivatePort),
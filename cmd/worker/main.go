@@ -156,11 +156,26 @@ func main() {
 	w.RegisterWorkflow(cw.TriggerPipelineWorkflow)
 	w.RegisterWorkflow(cw.SchedulePipelineWorkflow)
 	w.RegisterActivity(cw.ComponentActivity)
+	w.RegisterActivity(cw.ComponentErrorActivity)
 	w.RegisterActivity(cw.PreIteratorActivity)
 	w.RegisterActivity(cw.PostIteratorActivity)
+	w.RegisterActivity(cw.PreSwitchActivity)
+	w.RegisterActivity(cw.PostSwitchActivity)
+	w.RegisterActivity(cw.PreSubPipelineActivity)
+	w.RegisterActivity(cw.PostSubPipelineActivity)
+	w.RegisterActivity(cw.PublishStreamEventActivity)
+	w.RegisterActivity(cw.PreApprovalActivity)
+	w.RegisterActivity(cw.PostApprovalActivity)
 	w.RegisterActivity(cw.IncreasePipelineTriggerCountActivity)
+	w.RegisterActivity(cw.CreatePipelineRunActivity)
+	w.RegisterActivity(cw.CompletePipelineRunActivity)
+	w.RegisterActivity(cw.PurgeMemoryActivity)
+	w.RegisterActivity(cw.RetainMemoryActivity)
+	w.RegisterActivity(cw.StoreTriggerResultActivity)
 	w.RegisterActivity(cw.SchedulePipelineLoaderActivity)
 
+	go cw.ClearExpiredRunData(ctx)
+
 	span.End()
 	err = w.Run(worker.InterruptCh())
 	if err != nil {
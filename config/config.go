@@ -74,6 +74,20 @@ type ServerConfig struct {
 		MaxWorkflowRetry   int32 `koanf:"maxworkflowretry"`
 		MaxActivityRetry   int32 `koanf:"maxactivityretry"`
 	}
+	PipelineRun struct {
+		RecordData    bool          `koanf:"recorddata"`    // store the inputs and outputs of the runs
+		DataRetention time.Duration `koanf:"dataretention"` // the inputs and outputs are kept if 0
+		MaxDataSize   int           `koanf:"maxdatasize"`   // in MB, larger inputs or outputs aren't stored
+	}
+	Resume struct {
+		Retention time.Duration `koanf:"retention"` // the memory of failed runs isn't kept if 0
+	}
+	AsyncResult struct {
+		Retention time.Duration `koanf:"retention"` // the results stay in the trigger memory if 0
+		// NamespaceRetention overrides the retention of the namespaces, keyed
+		// by namespace UID.
+		NamespaceRetention map[string]time.Duration `koanf:"namespaceretention"`
+	}
 	InstanceID         string `koanf:"instanceid"`
 	DataChanBufferSize int    `koanf:"datachanbuffersize"`
 	InstillCoreHost    string `koanf:"instillcorehost"`
@@ -30,6 +30,15 @@ const (
 
 	HeaderInstillCodeKey  = "Instill-Share-Code"
 	HeaderReturnTracesKey = "Instill-Return-Traces"
+	// HeaderIsolateBatchItemsKey makes each item of a batch trigger succeed
+	// or fail on its own. The failed items have an empty output and their
+	// errors are reported in the traces of the trigger metadata.
+	HeaderIsolateBatchItemsKey = "Instill-Isolate-Batch-Items"
+	// HeaderDryRunKey makes a trigger skip the component executions. The
+	// components output the mocks of HeaderDryRunMockKey, a JSON object keyed
+	// by component ID, or outputs synthesized from their task schema.
+	HeaderDryRunKey     = "Instill-Dry-Run"
+	HeaderDryRunMockKey = "Instill-Dry-Run-Mock"
 )
 
 // GlobalSecretKey can be used to reference a global secret in the
@@ -21,6 +21,30 @@ import (
 
 const Iterator = "iterator"
 
+// Switch is the type of the components that run one of several nested
+// component groups, depending on which case matches.
+const Switch = "switch"
+
+// SubPipeline is the type of the components that trigger another pipeline or
+// pipeline release.
+const SubPipeline = "pipeline"
+
+// Approval is the type of the components that pause the pipeline until a
+// reviewer approves or rejects their input.
+const Approval = "approval"
+
+// The actions applied when nobody reviews an approval in time.
+const (
+	ApprovalTimeoutApprove = "approve"
+	ApprovalTimeoutReject  = "reject"
+	ApprovalTimeoutFail    = "fail"
+)
+
+// IteratorErrorsOutput is the output of an iterator that continues on error
+// holding the error message of each element, or null for the elements that
+// didn't fail.
+const IteratorErrorsOutput = "errors"
+
 // BaseDynamicHardDelete contains common columns for all tables with static UUID as primary key
 type BaseDynamicHardDelete struct {
 	UID        uuid.UUID `gorm:"type:uuid;primary_key;<-:create"` // allow read and create
@@ -286,6 +310,19 @@ type Variable struct {
 	InstillUIOrder     int32    `json:"instillUiOrder,omitempty" yaml:"instill-ui-order,omitempty"`
 	InstillUIMultiline bool     `json:"instillUiMultiline,omitempty" yaml:"instill-ui-multiline,omitempty"`
 	Listen             []string `json:"listen,omitempty" yaml:"listen,omitempty"`
+
+	// The following fields are JSON Schema keywords that constrain the value
+	// of the variable at trigger time. Default is filled in when the variable
+	// isn't provided.
+	Default   any      `json:"default,omitempty" yaml:"default,omitempty"`
+	Enum      []any    `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	Pattern   string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MinLength *int     `json:"minLength,omitempty" yaml:"min-length,omitempty"`
+	// Variables are optional by default. A required variable without a
+	// default must be provided in every trigger.
+	Required bool `json:"required,omitempty" yaml:"required,omitempty"`
 }
 
 type Output struct {
@@ -324,11 +361,125 @@ type Component struct {
 	// Fields for regular components
 	Setup      map[string]any `json:"setup,omitempty" yaml:"setup,omitempty"`
 	Definition *Definition    `json:"definition,omitempty" yaml:"-"`
+	// Retry and Timeout override the default execution policy of the
+	// component.
+	Retry   *RetryPolicy   `json:"retry,omitempty" yaml:"retry,omitempty"`
+	Timeout *TimeoutPolicy `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// OnError defines what happens when the component fails after its
+	// retries. By default, the pipeline fails.
+	OnError *OnErrorPolicy `json:"onError,omitempty" yaml:"on-error,omitempty"`
+	// Cache reuses the output of previous executions with the same input.
+	Cache *CachePolicy `json:"cache,omitempty" yaml:"cache,omitempty"`
 
 	// Fields for iterators
 	Component         ComponentMap          `json:"component" yaml:"component,omitempty"`
 	OutputElements    map[string]string     `json:"outputElements,omitempty" yaml:"output-elements,omitempty"`
 	DataSpecification *pb.DataSpecification `json:"dataSpecification,omitempty" yaml:"-"`
+	// BatchSize is the number of elements processed by each execution of the
+	// nested components. By default, all the elements are processed at once.
+	BatchSize int `json:"batchSize,omitempty" yaml:"batch-size,omitempty"`
+	// MaxConcurrency bounds the number of element batches that run at the
+	// same time. By default, all the batches run concurrently.
+	MaxConcurrency int `json:"maxConcurrency,omitempty" yaml:"max-concurrency,omitempty"`
+	// ContinueOnError keeps the iterator running when some elements fail.
+	// The output elements of a failed element are null and its error is
+	// reported in the `errors` output.
+	ContinueOnError bool `json:"continueOnError,omitempty" yaml:"continue-on-error,omitempty"`
+
+	// Fields for switches
+	Cases []*SwitchCase `json:"cases,omitempty" yaml:"cases,omitempty"`
+
+	// Fields for sub-pipelines
+	// Pipeline references the called pipeline as
+	// `namespace/pipeline[@release]`. The input of the component is mapped
+	// onto the callee variables and its output is the callee output.
+	Pipeline string `json:"pipeline,omitempty" yaml:"pipeline,omitempty"`
+	// Callee is resolved when the pipeline is triggered.
+	Callee *Callee `json:"callee,omitempty" yaml:"-"`
+
+	// Fields for approvals
+	// ApprovalTimeout is how long an approval waits for a review, e.g. "24h".
+	// By default, it waits until the trigger times out.
+	ApprovalTimeout string `json:"approvalTimeout,omitempty" yaml:"approval-timeout,omitempty"`
+	// TimeoutAction is applied when nobody reviews the input in time. It's
+	// one of approve, reject or fail, the default.
+	TimeoutAction string `json:"timeoutAction,omitempty" yaml:"timeout-action,omitempty"`
+}
+
+// RetryPolicy defines how the execution of a component is retried when it
+// fails. The intervals are durations such as "500ms" or "1m30s". The unset
+// fields keep the default policy of the pipeline backend.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of executions, including the first
+	// one.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"max-attempts,omitempty"`
+	// InitialInterval is the delay before the first retry.
+	InitialInterval string `json:"initialInterval,omitempty" yaml:"initial-interval,omitempty"`
+	// BackoffCoefficient multiplies the delay after each retry.
+	BackoffCoefficient float64 `json:"backoffCoefficient,omitempty" yaml:"backoff-coefficient,omitempty"`
+	// NonRetryableErrorTypes lists the error types that aren't retried, e.g.
+	// "ComponentActivityError".
+	NonRetryableErrorTypes []string `json:"nonRetryableErrorTypes,omitempty" yaml:"non-retryable-error-types,omitempty"`
+}
+
+// TimeoutPolicy bounds the execution time of a component. The timeouts are
+// durations such as "30s" or "5m".
+type TimeoutPolicy struct {
+	// StartToClose bounds each execution attempt.
+	StartToClose string `json:"startToClose,omitempty" yaml:"start-to-close,omitempty"`
+	// ScheduleToClose bounds the execution including its retries.
+	ScheduleToClose string `json:"scheduleToClose,omitempty" yaml:"schedule-to-close,omitempty"`
+}
+
+// The actions of an on-error policy.
+const (
+	// OnErrorFail fails the pipeline.
+	OnErrorFail = "fail"
+	// OnErrorContinue marks the component as errored and skips its
+	// downstream components.
+	OnErrorContinue = "continue"
+	// OnErrorFallback marks the component as errored and uses the fallback
+	// output as its output, so the downstream components still run.
+	OnErrorFallback = "fallback"
+)
+
+// OnErrorPolicy defines how a component failure is handled.
+type OnErrorPolicy struct {
+	Action string `json:"action" yaml:"action"`
+	// Output is the fallback output. Its values can reference the variables
+	// and the upstream components.
+	Output map[string]any `json:"output,omitempty" yaml:"output,omitempty"`
+}
+
+// CachePolicy defines how long the outputs of a component are cached and
+// which input fields identify them.
+type CachePolicy struct {
+	TTL string `json:"ttl" yaml:"ttl"`
+	// Key holds the input fields that identify an output. By default, the
+	// whole input is used.
+	Key []string `json:"key,omitempty" yaml:"key,omitempty"`
+}
+
+// Callee is the pipeline or release called by a sub-pipeline component.
+type Callee struct {
+	OwnerPermalink string    `json:"ownerPermalink"`
+	OwnerType      string    `json:"ownerType"`
+	OwnerUID       uuid.UUID `json:"ownerUID"`
+	PipelineID     string    `json:"pipelineID"`
+	PipelineUID    uuid.UUID `json:"pipelineUID"`
+	ReleaseID      string    `json:"releaseID,omitempty"`
+	ReleaseUID     uuid.UUID `json:"releaseUID,omitempty"`
+	Recipe         *Recipe   `json:"recipe"`
+}
+
+// SwitchCase is a branch of a switch component. The cases are evaluated in
+// order and the components of the first case whose condition is true are
+// executed. A case without condition always matches. The output of the
+// switch is the output of the matching case.
+type SwitchCase struct {
+	Condition string            `json:"condition,omitempty" yaml:"condition,omitempty"`
+	Component ComponentMap      `json:"component" yaml:"component,omitempty"`
+	Output    map[string]string `json:"output,omitempty" yaml:"output,omitempty"`
 }
 
 type Definition struct {
@@ -592,3 +743,77 @@ type Secret struct {
 	NamespaceID   string `gorm:"type:namespace_id"`
 	NamespaceType string `gorm:"type:namespace_type"`
 }
+
+// RunStatus is the status of a pipeline run.
+type RunStatus string
+
+// The statuses of a pipeline run.
+const (
+	RunStatusProcessing RunStatus = "RUN_STATUS_PROCESSING"
+	RunStatusCompleted  RunStatus = "RUN_STATUS_COMPLETED"
+	RunStatusFailed     RunStatus = "RUN_STATUS_FAILED"
+	RunStatusCancelled  RunStatus = "RUN_STATUS_CANCELLED"
+)
+
+// PipelineRun is the record of a pipeline trigger. The inputs and outputs
+// hold one entry per batch item and are only recorded when the server is
+// configured to. They're cleared at DataExpireTime.
+type PipelineRun struct {
+	BaseDynamicHardDelete
+	PipelineTriggerID  string
+	PipelineUID        uuid.UUID
+	PipelineID         string
+	PipelineReleaseUID uuid.NullUUID
+	PipelineReleaseID  string
+	Owner              string
+	RequesterUID       uuid.NullUUID
+	TriggerMode        string
+	Status             RunStatus
+	StartedTime        time.Time
+	CompletedTime      *time.Time
+	// TotalDuration is in milliseconds.
+	TotalDuration  *int64
+	Error          *string
+	Inputs         datatypes.JSON `gorm:"type:jsonb"`
+	Outputs        datatypes.JSON `gorm:"type:jsonb"`
+	DataExpireTime *time.Time
+	// SourcePipelineTriggerID is the run this one was started from, e.g. by
+	// a replay.
+	SourcePipelineTriggerID string
+}
+
+// ComponentRun is the record of the execution of a component for a batch item
+// of a pipeline run. The components nested in iterators and switches are
+// identified by their path in the trigger traces.
+type ComponentRun struct {
+	BaseDynamicHardDelete
+	PipelineTriggerID string
+	ComponentID       string
+	BatchIndex        int
+	Status            RunStatus
+	StartedTime       time.Time
+	CompletedTime     *time.Time
+	// TotalDuration is in milliseconds.
+	TotalDuration *int64
+	Attempts      int32
+	ErrorType     *string
+	Error         *string
+	// The sizes of the input and the output are the length of their JSON
+	// representation.
+	InputSize  int64
+	OutputSize int64
+	// CacheHit is set when the output was read from the component cache.
+	CacheHit bool
+}
+
+// PipelineTriggerResult holds the outputs of a completed asynchronous
+// trigger, one entry per batch item, and its metadata with the component
+// traces, until they expire.
+type PipelineTriggerResult struct {
+	BaseDynamicHardDelete
+	PipelineTriggerID string
+	Owner             string
+	Outputs           datatypes.JSON `gorm:"type:jsonb"`
+	Metadata          datatypes.JSON `gorm:"type:jsonb"`
+	ExpireTime        time.Time
+}
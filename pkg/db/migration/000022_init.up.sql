@@ -0,0 +1,32 @@
+BEGIN;
+
+-- `pipeline_run` records every pipeline trigger, so the run history outlives
+-- the trigger memory in Redis.
+CREATE TABLE IF NOT EXISTS public.pipeline_run (
+  uid UUID NOT NULL PRIMARY KEY,
+  pipeline_trigger_id VARCHAR(255) NOT NULL,
+  pipeline_uid UUID NOT NULL,
+  pipeline_id VARCHAR(255) NOT NULL,
+  pipeline_release_uid UUID NULL,
+  pipeline_release_id VARCHAR(255) DEFAULT '' NOT NULL,
+  owner VARCHAR(255) NOT NULL,
+  requester_uid UUID NULL,
+  trigger_mode VARCHAR(255) NOT NULL,
+  status VARCHAR(255) NOT NULL,
+  started_time TIMESTAMPTZ NOT NULL,
+  completed_time TIMESTAMPTZ NULL,
+  total_duration BIGINT NULL,
+  error TEXT NULL,
+  inputs JSONB NULL,
+  outputs JSONB NULL,
+  data_expire_time TIMESTAMPTZ NULL,
+  create_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  update_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+CREATE UNIQUE INDEX pipeline_run_unique_trigger_id ON public.pipeline_run (pipeline_trigger_id);
+CREATE INDEX pipeline_run_pipeline_uid_create_time ON public.pipeline_run (pipeline_uid, create_time);
+CREATE INDEX pipeline_run_owner_create_time ON public.pipeline_run (owner, create_time);
+-- The inputs and outputs of the runs are cleared once they expire.
+CREATE INDEX pipeline_run_data_expire_time ON public.pipeline_run (data_expire_time) WHERE data_expire_time IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+-- `component_run` records the executions of the components of a pipeline run,
+-- one row per component and batch item. The components nested in iterators
+-- and switches are identified by their trace path, e.g. `iter[0].comp`.
+CREATE TABLE IF NOT EXISTS public.component_run (
+  uid UUID NOT NULL PRIMARY KEY,
+  pipeline_trigger_id VARCHAR(255) NOT NULL,
+  component_id VARCHAR(255) NOT NULL,
+  batch_index INTEGER NOT NULL,
+  status VARCHAR(255) NOT NULL,
+  started_time TIMESTAMPTZ NOT NULL,
+  completed_time TIMESTAMPTZ NULL,
+  total_duration BIGINT NULL,
+  attempts INTEGER DEFAULT 1 NOT NULL,
+  error_type VARCHAR(255) NULL,
+  error TEXT NULL,
+  input_size BIGINT DEFAULT 0 NOT NULL,
+  output_size BIGINT DEFAULT 0 NOT NULL,
+  cache_hit BOOLEAN DEFAULT FALSE NOT NULL,
+  create_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  update_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+CREATE UNIQUE INDEX component_run_unique_trigger_id_component_id_batch_index ON public.component_run (pipeline_trigger_id, component_id, batch_index);
+
+COMMIT;
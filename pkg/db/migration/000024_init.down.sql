@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE public.pipeline_run DROP COLUMN IF EXISTS source_pipeline_trigger_id;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- A run started from another one, e.g. a replay, is linked to it.
+ALTER TABLE public.pipeline_run ADD COLUMN IF NOT EXISTS source_pipeline_trigger_id VARCHAR(255) DEFAULT '' NOT NULL;
+
+COMMIT;
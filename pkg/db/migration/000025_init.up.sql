@@ -0,0 +1,18 @@
+BEGIN;
+
+-- `pipeline_trigger_result` keeps the outputs and traces of the asynchronous
+-- triggers once they complete, until they expire.
+CREATE TABLE IF NOT EXISTS public.pipeline_trigger_result (
+  uid UUID NOT NULL PRIMARY KEY,
+  pipeline_trigger_id VARCHAR(255) NOT NULL,
+  owner VARCHAR(255) NOT NULL,
+  outputs JSONB NOT NULL,
+  metadata JSONB,
+  expire_time TIMESTAMPTZ NOT NULL,
+  create_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  update_time TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+CREATE UNIQUE INDEX pipeline_trigger_result_unique_trigger_id ON public.pipeline_trigger_result (pipeline_trigger_id);
+CREATE INDEX pipeline_trigger_result_expire_time ON public.pipeline_trigger_result (expire_time);
+
+COMMIT;
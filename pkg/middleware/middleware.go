@@ -8,14 +8,21 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
 	"github.com/instill-ai/pipeline-backend/config"
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
 	"github.com/instill-ai/pipeline-backend/pkg/handler"
 	"github.com/instill-ai/pipeline-backend/pkg/repository"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
 	"github.com/instill-ai/pipeline-backend/pkg/service"
 	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
 )
@@ -190,3 +197,366 @@ func HandleProfileImage(srv service.Service, repo repository.Repository) runtime
 		}
 	})
 }
+
+// HandlePurgePipelineCache removes the cached component outputs of a
+// pipeline. It responds with 204 No Content on success.
+func HandlePurgePipelineCache(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipelines/{pipelineID=*}/cache")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		if err := srv.PurgeNamespacePipelineCacheByID(ctx, ns, pathParams["pipelineID"]); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleCancelOperation cancels a running pipeline trigger, identified by its
+// operation ID. It responds with 204 No Content on success.
+func HandleCancelOperation(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/operations/{operationID=*}:cancel")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		if err := srv.CancelOperation(ctx, pathParams["operationID"]); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// incomingContext forwards the headers of an HTTP request, e.g. the
+// authentication headers, as incoming metadata, as they would be for a gRPC
+// request.
+func incomingContext(mux *runtime.ServeMux, r *http.Request, pathPattern string) (context.Context, error) {
+	annotatedContext, err := runtime.AnnotateContext(r.Context(), mux, r, "", runtime.WithHTTPPathPattern(pathPattern))
+	if err != nil {
+		return nil, err
+	}
+	md, _ := metadata.FromOutgoingContext(annotatedContext)
+	return metadata.NewIncomingContext(r.Context(), md), nil
+}
+
+// HandleListApprovals lists the pending approval requests of a namespace.
+func HandleListApprovals(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/approvals")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		approvals, err := srv.ListNamespaceApprovals(ctx, ns)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		writeJSON(w, map[string]any{"approvals": approvals})
+	})
+}
+
+// HandleGetApproval returns a pending approval request of a namespace.
+func HandleGetApproval(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		approval, err := srv.GetNamespaceApprovalByID(ctx, ns, pathParams["approvalID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		writeJSON(w, approval)
+	})
+}
+
+// HandleReviewApproval approves or rejects a pending approval request. The
+// request body may hold an edited payload and a comment. It responds with 204
+// No Content on success.
+func HandleReviewApproval(mux *runtime.ServeMux, srv service.Service, approved bool) runtime.HandlerFunc {
+
+	pathPattern := "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}:reject"
+	if approved {
+		pathPattern = "/v1beta/*/{namespaceID=*}/approvals/{approvalID=*}:approve"
+	}
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, pathPattern)
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var body struct {
+			Payload map[string]any `json:"payload"`
+			Comment string         `json:"comment"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		if err := srv.ReviewNamespaceApprovalByID(ctx, ns, pathParams["approvalID"], approved, body.Payload, body.Comment); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// runListQuery reads the pagination and filter query parameters of a run
+// list.
+func runListQuery(r *http.Request) (pageSize int32, pageToken, filter string, err error) {
+	q := r.URL.Query()
+	if ps := q.Get("pageSize"); ps != "" {
+		n, err := strconv.ParseInt(ps, 10, 32)
+		if err != nil {
+			return 0, "", "", status.Errorf(codes.InvalidArgument, "invalid page size: %s", err)
+		}
+		pageSize = int32(n)
+	}
+	return pageSize, q.Get("pageToken"), q.Get("filter"), nil
+}
+
+// HandleListPipelineRuns lists the runs of a pipeline.
+func HandleListPipelineRuns(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipelines/{pipelineID=*}/runs")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		pageSize, pageToken, filter, err := runListQuery(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		runs, totalSize, nextPageToken, err := srv.ListNamespacePipelineRuns(ctx, ns, pathParams["pipelineID"], pageSize, pageToken, filter)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		writeJSON(w, map[string]any{
+			"pipelineRuns":  runs,
+			"nextPageToken": nextPageToken,
+			"totalSize":     totalSize,
+		})
+	})
+}
+
+// HandleListNamespaceRuns lists the runs of the pipelines of a namespace.
+func HandleListNamespaceRuns(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipeline-runs")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		pageSize, pageToken, filter, err := runListQuery(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		runs, totalSize, nextPageToken, err := srv.ListNamespaceRuns(ctx, ns, pageSize, pageToken, filter)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		writeJSON(w, map[string]any{
+			"pipelineRuns":  runs,
+			"nextPageToken": nextPageToken,
+			"totalSize":     totalSize,
+		})
+	})
+}
+
+// HandleGetNamespaceRun returns the run of a pipeline trigger.
+func HandleGetNamespaceRun(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		run, err := srv.GetNamespaceRunByID(ctx, ns, pathParams["pipelineTriggerID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		writeJSON(w, run)
+	})
+}
+
+// HandleReplayNamespaceRun triggers a pipeline again with the inputs of a
+// previous run. The request body may choose the release to trigger. It
+// responds with the operation of the new trigger.
+func HandleReplayNamespaceRun(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}:replay")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var body struct {
+			ReleaseID string `json:"releaseId"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		returnTraces := resource.GetRequestSingleHeader(ctx, constant.HeaderReturnTracesKey) == "true"
+		operation, err := srv.ReplayNamespaceRun(ctx, ns, pathParams["pipelineTriggerID"], body.ReleaseID, returnTraces)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, &pb.TriggerAsyncNamespacePipelineResponse{Operation: operation})
+	})
+}
+
+// HandleResumeNamespaceRun triggers a failed run again from its failed
+// component. It responds with the operation of the new trigger.
+func HandleResumeNamespaceRun(mux *runtime.ServeMux, srv service.Service) runtime.HandlerFunc {
+
+	return runtime.HandlerFunc(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := incomingContext(mux, r, "/v1beta/*/{namespaceID=*}/pipeline-runs/{pipelineTriggerID=*}:resume")
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		ns, err := srv.GetRscNamespace(ctx, pathParams["namespaceID"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		returnTraces := resource.GetRequestSingleHeader(ctx, constant.HeaderReturnTracesKey) == "true"
+		operation, err := srv.ResumeNamespaceRun(ctx, ns, pathParams["pipelineTriggerID"], returnTraces)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, AsGRPCError(err))
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, &pb.TriggerAsyncNamespacePipelineResponse{Operation: operation})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
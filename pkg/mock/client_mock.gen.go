@@ -0,0 +1,10501 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.13). DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	mm_client "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// ClientMock implements client.Client
+type ClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCancelWorkflow          func(ctx context.Context, workflowID string, runID string) (err error)
+	inspectFuncCancelWorkflow   func(ctx context.Context, workflowID string, runID string)
+	afterCancelWorkflowCounter  uint64
+	beforeCancelWorkflowCounter uint64
+	CancelWorkflowMock          mClientMockCancelWorkflow
+
+	funcCheckHealth          func(ctx context.Context, request *mm_client.CheckHealthRequest) (cp1 *mm_client.CheckHealthResponse, err error)
+	inspectFuncCheckHealth   func(ctx context.Context, request *mm_client.CheckHealthRequest)
+	afterCheckHealthCounter  uint64
+	beforeCheckHealthCounter uint64
+	CheckHealthMock          mClientMockCheckHealth
+
+	funcClose          func()
+	inspectFuncClose   func()
+	afterCloseCounter  uint64
+	beforeCloseCounter uint64
+	CloseMock          mClientMockClose
+
+	funcCompleteActivity          func(ctx context.Context, taskToken []byte, result interface{}, e1 error) (err error)
+	inspectFuncCompleteActivity   func(ctx context.Context, taskToken []byte, result interface{}, e1 error)
+	afterCompleteActivityCounter  uint64
+	beforeCompleteActivityCounter uint64
+	CompleteActivityMock          mClientMockCompleteActivity
+
+	funcCompleteActivityByID          func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error) (err error)
+	inspectFuncCompleteActivityByID   func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error)
+	afterCompleteActivityByIDCounter  uint64
+	beforeCompleteActivityByIDCounter uint64
+	CompleteActivityByIDMock          mClientMockCompleteActivityByID
+
+	funcCountWorkflow          func(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) (cp1 *workflowservice.CountWorkflowExecutionsResponse, err error)
+	inspectFuncCountWorkflow   func(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest)
+	afterCountWorkflowCounter  uint64
+	beforeCountWorkflowCounter uint64
+	CountWorkflowMock          mClientMockCountWorkflow
+
+	funcDescribeTaskQueue          func(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType) (dp1 *workflowservice.DescribeTaskQueueResponse, err error)
+	inspectFuncDescribeTaskQueue   func(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType)
+	afterDescribeTaskQueueCounter  uint64
+	beforeDescribeTaskQueueCounter uint64
+	DescribeTaskQueueMock          mClientMockDescribeTaskQueue
+
+	funcDescribeWorkflowExecution          func(ctx context.Context, workflowID string, runID string) (dp1 *workflowservice.DescribeWorkflowExecutionResponse, err error)
+	inspectFuncDescribeWorkflowExecution   func(ctx context.Context, workflowID string, runID string)
+	afterDescribeWorkflowExecutionCounter  uint64
+	beforeDescribeWorkflowExecutionCounter uint64
+	DescribeWorkflowExecutionMock          mClientMockDescribeWorkflowExecution
+
+	funcExecuteWorkflow          func(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (w1 mm_client.WorkflowRun, err error)
+	inspectFuncExecuteWorkflow   func(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{})
+	afterExecuteWorkflowCounter  uint64
+	beforeExecuteWorkflowCounter uint64
+	ExecuteWorkflowMock          mClientMockExecuteWorkflow
+
+	funcGetSearchAttributes          func(ctx context.Context) (gp1 *workflowservice.GetSearchAttributesResponse, err error)
+	inspectFuncGetSearchAttributes   func(ctx context.Context)
+	afterGetSearchAttributesCounter  uint64
+	beforeGetSearchAttributesCounter uint64
+	GetSearchAttributesMock          mClientMockGetSearchAttributes
+
+	funcGetWorkflow          func(ctx context.Context, workflowID string, runID string) (w1 mm_client.WorkflowRun)
+	inspectFuncGetWorkflow   func(ctx context.Context, workflowID string, runID string)
+	afterGetWorkflowCounter  uint64
+	beforeGetWorkflowCounter uint64
+	GetWorkflowMock          mClientMockGetWorkflow
+
+	funcGetWorkflowHistory          func(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType) (h1 mm_client.HistoryEventIterator)
+	inspectFuncGetWorkflowHistory   func(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType)
+	afterGetWorkflowHistoryCounter  uint64
+	beforeGetWorkflowHistoryCounter uint64
+	GetWorkflowHistoryMock          mClientMockGetWorkflowHistory
+
+	funcListArchivedWorkflow          func(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) (lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse, err error)
+	inspectFuncListArchivedWorkflow   func(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest)
+	afterListArchivedWorkflowCounter  uint64
+	beforeListArchivedWorkflowCounter uint64
+	ListArchivedWorkflowMock          mClientMockListArchivedWorkflow
+
+	funcListClosedWorkflow          func(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest) (lp1 *workflowservice.ListClosedWorkflowExecutionsResponse, err error)
+	inspectFuncListClosedWorkflow   func(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest)
+	afterListClosedWorkflowCounter  uint64
+	beforeListClosedWorkflowCounter uint64
+	ListClosedWorkflowMock          mClientMockListClosedWorkflow
+
+	funcListOpenWorkflow          func(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) (lp1 *workflowservice.ListOpenWorkflowExecutionsResponse, err error)
+	inspectFuncListOpenWorkflow   func(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest)
+	afterListOpenWorkflowCounter  uint64
+	beforeListOpenWorkflowCounter uint64
+	ListOpenWorkflowMock          mClientMockListOpenWorkflow
+
+	funcListWorkflow          func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (lp1 *workflowservice.ListWorkflowExecutionsResponse, err error)
+	inspectFuncListWorkflow   func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest)
+	afterListWorkflowCounter  uint64
+	beforeListWorkflowCounter uint64
+	ListWorkflowMock          mClientMockListWorkflow
+
+	funcOperatorService          func() (o1 operatorservice.OperatorServiceClient)
+	inspectFuncOperatorService   func()
+	afterOperatorServiceCounter  uint64
+	beforeOperatorServiceCounter uint64
+	OperatorServiceMock          mClientMockOperatorService
+
+	funcQueryWorkflow          func(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) (e1 converter.EncodedValue, err error)
+	inspectFuncQueryWorkflow   func(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{})
+	afterQueryWorkflowCounter  uint64
+	beforeQueryWorkflowCounter uint64
+	QueryWorkflowMock          mClientMockQueryWorkflow
+
+	funcQueryWorkflowWithOptions          func(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest) (qp1 *mm_client.QueryWorkflowWithOptionsResponse, err error)
+	inspectFuncQueryWorkflowWithOptions   func(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest)
+	afterQueryWorkflowWithOptionsCounter  uint64
+	beforeQueryWorkflowWithOptionsCounter uint64
+	QueryWorkflowWithOptionsMock          mClientMockQueryWorkflowWithOptions
+
+	funcRecordActivityHeartbeat          func(ctx context.Context, taskToken []byte, details ...interface{}) (err error)
+	inspectFuncRecordActivityHeartbeat   func(ctx context.Context, taskToken []byte, details ...interface{})
+	afterRecordActivityHeartbeatCounter  uint64
+	beforeRecordActivityHeartbeatCounter uint64
+	RecordActivityHeartbeatMock          mClientMockRecordActivityHeartbeat
+
+	funcRecordActivityHeartbeatByID          func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{}) (err error)
+	inspectFuncRecordActivityHeartbeatByID   func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{})
+	afterRecordActivityHeartbeatByIDCounter  uint64
+	beforeRecordActivityHeartbeatByIDCounter uint64
+	RecordActivityHeartbeatByIDMock          mClientMockRecordActivityHeartbeatByID
+
+	funcResetWorkflowExecution          func(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest) (rp1 *workflowservice.ResetWorkflowExecutionResponse, err error)
+	inspectFuncResetWorkflowExecution   func(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest)
+	afterResetWorkflowExecutionCounter  uint64
+	beforeResetWorkflowExecutionCounter uint64
+	ResetWorkflowExecutionMock          mClientMockResetWorkflowExecution
+
+	funcScanWorkflow          func(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest) (sp1 *workflowservice.ScanWorkflowExecutionsResponse, err error)
+	inspectFuncScanWorkflow   func(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest)
+	afterScanWorkflowCounter  uint64
+	beforeScanWorkflowCounter uint64
+	ScanWorkflowMock          mClientMockScanWorkflow
+
+	funcScheduleClient          func() (s1 mm_client.ScheduleClient)
+	inspectFuncScheduleClient   func()
+	afterScheduleClientCounter  uint64
+	beforeScheduleClientCounter uint64
+	ScheduleClientMock          mClientMockScheduleClient
+
+	funcSignalWithStartWorkflow          func(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) (w1 mm_client.WorkflowRun, err error)
+	inspectFuncSignalWithStartWorkflow   func(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{})
+	afterSignalWithStartWorkflowCounter  uint64
+	beforeSignalWithStartWorkflowCounter uint64
+	SignalWithStartWorkflowMock          mClientMockSignalWithStartWorkflow
+
+	funcSignalWorkflow          func(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) (err error)
+	inspectFuncSignalWorkflow   func(ctx context.Context, workflowID string, runID string, signalName string, arg interface{})
+	afterSignalWorkflowCounter  uint64
+	beforeSignalWorkflowCounter uint64
+	SignalWorkflowMock          mClientMockSignalWorkflow
+
+	funcTerminateWorkflow          func(ctx context.Context, workflowID string, runID string, reason string, details ...interface{}) (err error)
+	inspectFuncTerminateWorkflow   func(ctx context.Context, workflowID string, runID string, reason string, details ...interface{})
+	afterTerminateWorkflowCounter  uint64
+	beforeTerminateWorkflowCounter uint64
+	TerminateWorkflowMock          mClientMockTerminateWorkflow
+
+	funcUpdateWorkflow          func(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{}) (w1 mm_client.WorkflowUpdateHandle, err error)
+	inspectFuncUpdateWorkflow   func(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{})
+	afterUpdateWorkflowCounter  uint64
+	beforeUpdateWorkflowCounter uint64
+	UpdateWorkflowMock          mClientMockUpdateWorkflow
+
+	funcUpdateWorkflowWithOptions          func(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest) (w1 mm_client.WorkflowUpdateHandle, err error)
+	inspectFuncUpdateWorkflowWithOptions   func(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest)
+	afterUpdateWorkflowWithOptionsCounter  uint64
+	beforeUpdateWorkflowWithOptionsCounter uint64
+	UpdateWorkflowWithOptionsMock          mClientMockUpdateWorkflowWithOptions
+
+	funcWorkflowService          func() (w1 workflowservice.WorkflowServiceClient)
+	inspectFuncWorkflowService   func()
+	afterWorkflowServiceCounter  uint64
+	beforeWorkflowServiceCounter uint64
+	WorkflowServiceMock          mClientMockWorkflowService
+}
+
+// NewClientMock returns a mock for client.Client
+func NewClientMock(t minimock.Tester) *ClientMock {
+	m := &ClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CancelWorkflowMock = mClientMockCancelWorkflow{mock: m}
+	m.CancelWorkflowMock.callArgs = []*ClientMockCancelWorkflowParams{}
+
+	m.CheckHealthMock = mClientMockCheckHealth{mock: m}
+	m.CheckHealthMock.callArgs = []*ClientMockCheckHealthParams{}
+
+	m.CloseMock = mClientMockClose{mock: m}
+
+	m.CompleteActivityMock = mClientMockCompleteActivity{mock: m}
+	m.CompleteActivityMock.callArgs = []*ClientMockCompleteActivityParams{}
+
+	m.CompleteActivityByIDMock = mClientMockCompleteActivityByID{mock: m}
+	m.CompleteActivityByIDMock.callArgs = []*ClientMockCompleteActivityByIDParams{}
+
+	m.CountWorkflowMock = mClientMockCountWorkflow{mock: m}
+	m.CountWorkflowMock.callArgs = []*ClientMockCountWorkflowParams{}
+
+	m.DescribeTaskQueueMock = mClientMockDescribeTaskQueue{mock: m}
+	m.DescribeTaskQueueMock.callArgs = []*ClientMockDescribeTaskQueueParams{}
+
+	m.DescribeWorkflowExecutionMock = mClientMockDescribeWorkflowExecution{mock: m}
+	m.DescribeWorkflowExecutionMock.callArgs = []*ClientMockDescribeWorkflowExecutionParams{}
+
+	m.ExecuteWorkflowMock = mClientMockExecuteWorkflow{mock: m}
+	m.ExecuteWorkflowMock.callArgs = []*ClientMockExecuteWorkflowParams{}
+
+	m.GetSearchAttributesMock = mClientMockGetSearchAttributes{mock: m}
+	m.GetSearchAttributesMock.callArgs = []*ClientMockGetSearchAttributesParams{}
+
+	m.GetWorkflowMock = mClientMockGetWorkflow{mock: m}
+	m.GetWorkflowMock.callArgs = []*ClientMockGetWorkflowParams{}
+
+	m.GetWorkflowHistoryMock = mClientMockGetWorkflowHistory{mock: m}
+	m.GetWorkflowHistoryMock.callArgs = []*ClientMockGetWorkflowHistoryParams{}
+
+	m.ListArchivedWorkflowMock = mClientMockListArchivedWorkflow{mock: m}
+	m.ListArchivedWorkflowMock.callArgs = []*ClientMockListArchivedWorkflowParams{}
+
+	m.ListClosedWorkflowMock = mClientMockListClosedWorkflow{mock: m}
+	m.ListClosedWorkflowMock.callArgs = []*ClientMockListClosedWorkflowParams{}
+
+	m.ListOpenWorkflowMock = mClientMockListOpenWorkflow{mock: m}
+	m.ListOpenWorkflowMock.callArgs = []*ClientMockListOpenWorkflowParams{}
+
+	m.ListWorkflowMock = mClientMockListWorkflow{mock: m}
+	m.ListWorkflowMock.callArgs = []*ClientMockListWorkflowParams{}
+
+	m.OperatorServiceMock = mClientMockOperatorService{mock: m}
+
+	m.QueryWorkflowMock = mClientMockQueryWorkflow{mock: m}
+	m.QueryWorkflowMock.callArgs = []*ClientMockQueryWorkflowParams{}
+
+	m.QueryWorkflowWithOptionsMock = mClientMockQueryWorkflowWithOptions{mock: m}
+	m.QueryWorkflowWithOptionsMock.callArgs = []*ClientMockQueryWorkflowWithOptionsParams{}
+
+	m.RecordActivityHeartbeatMock = mClientMockRecordActivityHeartbeat{mock: m}
+	m.RecordActivityHeartbeatMock.callArgs = []*ClientMockRecordActivityHeartbeatParams{}
+
+	m.RecordActivityHeartbeatByIDMock = mClientMockRecordActivityHeartbeatByID{mock: m}
+	m.RecordActivityHeartbeatByIDMock.callArgs = []*ClientMockRecordActivityHeartbeatByIDParams{}
+
+	m.ResetWorkflowExecutionMock = mClientMockResetWorkflowExecution{mock: m}
+	m.ResetWorkflowExecutionMock.callArgs = []*ClientMockResetWorkflowExecutionParams{}
+
+	m.ScanWorkflowMock = mClientMockScanWorkflow{mock: m}
+	m.ScanWorkflowMock.callArgs = []*ClientMockScanWorkflowParams{}
+
+	m.ScheduleClientMock = mClientMockScheduleClient{mock: m}
+
+	m.SignalWithStartWorkflowMock = mClientMockSignalWithStartWorkflow{mock: m}
+	m.SignalWithStartWorkflowMock.callArgs = []*ClientMockSignalWithStartWorkflowParams{}
+
+	m.SignalWorkflowMock = mClientMockSignalWorkflow{mock: m}
+	m.SignalWorkflowMock.callArgs = []*ClientMockSignalWorkflowParams{}
+
+	m.TerminateWorkflowMock = mClientMockTerminateWorkflow{mock: m}
+	m.TerminateWorkflowMock.callArgs = []*ClientMockTerminateWorkflowParams{}
+
+	m.UpdateWorkflowMock = mClientMockUpdateWorkflow{mock: m}
+	m.UpdateWorkflowMock.callArgs = []*ClientMockUpdateWorkflowParams{}
+
+	m.UpdateWorkflowWithOptionsMock = mClientMockUpdateWorkflowWithOptions{mock: m}
+	m.UpdateWorkflowWithOptionsMock.callArgs = []*ClientMockUpdateWorkflowWithOptionsParams{}
+
+	m.WorkflowServiceMock = mClientMockWorkflowService{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mClientMockCancelWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCancelWorkflowExpectation
+	expectations       []*ClientMockCancelWorkflowExpectation
+
+	callArgs []*ClientMockCancelWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockCancelWorkflowExpectation specifies expectation struct of the Client.CancelWorkflow
+type ClientMockCancelWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockCancelWorkflowParams
+	paramPtrs *ClientMockCancelWorkflowParamPtrs
+	results   *ClientMockCancelWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockCancelWorkflowParams contains parameters of the Client.CancelWorkflow
+type ClientMockCancelWorkflowParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+}
+
+// ClientMockCancelWorkflowParamPtrs contains pointers to parameters of the Client.CancelWorkflow
+type ClientMockCancelWorkflowParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+}
+
+// ClientMockCancelWorkflowResults contains results of the Client.CancelWorkflow
+type ClientMockCancelWorkflowResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Optional() *mClientMockCancelWorkflow {
+	mmCancelWorkflow.optional = true
+	return mmCancelWorkflow
+}
+
+// Expect sets up expected params for Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Expect(ctx context.Context, workflowID string, runID string) *mClientMockCancelWorkflow {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	if mmCancelWorkflow.defaultExpectation == nil {
+		mmCancelWorkflow.defaultExpectation = &ClientMockCancelWorkflowExpectation{}
+	}
+
+	if mmCancelWorkflow.defaultExpectation.paramPtrs != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmCancelWorkflow.defaultExpectation.params = &ClientMockCancelWorkflowParams{ctx, workflowID, runID}
+	for _, e := range mmCancelWorkflow.expectations {
+		if minimock.Equal(e.params, mmCancelWorkflow.defaultExpectation.params) {
+			mmCancelWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockCancelWorkflow {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	if mmCancelWorkflow.defaultExpectation == nil {
+		mmCancelWorkflow.defaultExpectation = &ClientMockCancelWorkflowExpectation{}
+	}
+
+	if mmCancelWorkflow.defaultExpectation.params != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Expect")
+	}
+
+	if mmCancelWorkflow.defaultExpectation.paramPtrs == nil {
+		mmCancelWorkflow.defaultExpectation.paramPtrs = &ClientMockCancelWorkflowParamPtrs{}
+	}
+	mmCancelWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCancelWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockCancelWorkflow {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	if mmCancelWorkflow.defaultExpectation == nil {
+		mmCancelWorkflow.defaultExpectation = &ClientMockCancelWorkflowExpectation{}
+	}
+
+	if mmCancelWorkflow.defaultExpectation.params != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Expect")
+	}
+
+	if mmCancelWorkflow.defaultExpectation.paramPtrs == nil {
+		mmCancelWorkflow.defaultExpectation.paramPtrs = &ClientMockCancelWorkflowParamPtrs{}
+	}
+	mmCancelWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmCancelWorkflow
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) ExpectRunIDParam3(runID string) *mClientMockCancelWorkflow {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	if mmCancelWorkflow.defaultExpectation == nil {
+		mmCancelWorkflow.defaultExpectation = &ClientMockCancelWorkflowExpectation{}
+	}
+
+	if mmCancelWorkflow.defaultExpectation.params != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Expect")
+	}
+
+	if mmCancelWorkflow.defaultExpectation.paramPtrs == nil {
+		mmCancelWorkflow.defaultExpectation.paramPtrs = &ClientMockCancelWorkflowParamPtrs{}
+	}
+	mmCancelWorkflow.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmCancelWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Inspect(f func(ctx context.Context, workflowID string, runID string)) *mClientMockCancelWorkflow {
+	if mmCancelWorkflow.mock.inspectFuncCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.CancelWorkflow")
+	}
+
+	mmCancelWorkflow.mock.inspectFuncCancelWorkflow = f
+
+	return mmCancelWorkflow
+}
+
+// Return sets up results that will be returned by Client.CancelWorkflow
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Return(err error) *ClientMock {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	if mmCancelWorkflow.defaultExpectation == nil {
+		mmCancelWorkflow.defaultExpectation = &ClientMockCancelWorkflowExpectation{mock: mmCancelWorkflow.mock}
+	}
+	mmCancelWorkflow.defaultExpectation.results = &ClientMockCancelWorkflowResults{err}
+	return mmCancelWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.CancelWorkflow method
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Set(f func(ctx context.Context, workflowID string, runID string) (err error)) *ClientMock {
+	if mmCancelWorkflow.defaultExpectation != nil {
+		mmCancelWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.CancelWorkflow method")
+	}
+
+	if len(mmCancelWorkflow.expectations) > 0 {
+		mmCancelWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.CancelWorkflow method")
+	}
+
+	mmCancelWorkflow.mock.funcCancelWorkflow = f
+	return mmCancelWorkflow.mock
+}
+
+// When sets expectation for the Client.CancelWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmCancelWorkflow *mClientMockCancelWorkflow) When(ctx context.Context, workflowID string, runID string) *ClientMockCancelWorkflowExpectation {
+	if mmCancelWorkflow.mock.funcCancelWorkflow != nil {
+		mmCancelWorkflow.mock.t.Fatalf("ClientMock.CancelWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockCancelWorkflowExpectation{
+		mock:   mmCancelWorkflow.mock,
+		params: &ClientMockCancelWorkflowParams{ctx, workflowID, runID},
+	}
+	mmCancelWorkflow.expectations = append(mmCancelWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.CancelWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockCancelWorkflowExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockCancelWorkflowResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.CancelWorkflow should be invoked
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Times(n uint64) *mClientMockCancelWorkflow {
+	if n == 0 {
+		mmCancelWorkflow.mock.t.Fatalf("Times of ClientMock.CancelWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelWorkflow.expectedInvocations, n)
+	return mmCancelWorkflow
+}
+
+func (mmCancelWorkflow *mClientMockCancelWorkflow) invocationsDone() bool {
+	if len(mmCancelWorkflow.expectations) == 0 && mmCancelWorkflow.defaultExpectation == nil && mmCancelWorkflow.mock.funcCancelWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelWorkflow.mock.afterCancelWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelWorkflow implements client.Client
+func (mmCancelWorkflow *ClientMock) CancelWorkflow(ctx context.Context, workflowID string, runID string) (err error) {
+	mm_atomic.AddUint64(&mmCancelWorkflow.beforeCancelWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelWorkflow.afterCancelWorkflowCounter, 1)
+
+	if mmCancelWorkflow.inspectFuncCancelWorkflow != nil {
+		mmCancelWorkflow.inspectFuncCancelWorkflow(ctx, workflowID, runID)
+	}
+
+	mm_params := ClientMockCancelWorkflowParams{ctx, workflowID, runID}
+
+	// Record call args
+	mmCancelWorkflow.CancelWorkflowMock.mutex.Lock()
+	mmCancelWorkflow.CancelWorkflowMock.callArgs = append(mmCancelWorkflow.CancelWorkflowMock.callArgs, &mm_params)
+	mmCancelWorkflow.CancelWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmCancelWorkflow.CancelWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelWorkflow.CancelWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelWorkflow.CancelWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelWorkflow.CancelWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelWorkflow.CancelWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockCancelWorkflowParams{ctx, workflowID, runID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelWorkflow.t.Errorf("ClientMock.CancelWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmCancelWorkflow.t.Errorf("ClientMock.CancelWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmCancelWorkflow.t.Errorf("ClientMock.CancelWorkflow got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelWorkflow.t.Errorf("ClientMock.CancelWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelWorkflow.CancelWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelWorkflow.t.Fatal("No results are set for the ClientMock.CancelWorkflow")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelWorkflow.funcCancelWorkflow != nil {
+		return mmCancelWorkflow.funcCancelWorkflow(ctx, workflowID, runID)
+	}
+	mmCancelWorkflow.t.Fatalf("Unexpected call to ClientMock.CancelWorkflow. %v %v %v", ctx, workflowID, runID)
+	return
+}
+
+// CancelWorkflowAfterCounter returns a count of finished ClientMock.CancelWorkflow invocations
+func (mmCancelWorkflow *ClientMock) CancelWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelWorkflow.afterCancelWorkflowCounter)
+}
+
+// CancelWorkflowBeforeCounter returns a count of ClientMock.CancelWorkflow invocations
+func (mmCancelWorkflow *ClientMock) CancelWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelWorkflow.beforeCancelWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.CancelWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelWorkflow *mClientMockCancelWorkflow) Calls() []*ClientMockCancelWorkflowParams {
+	mmCancelWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockCancelWorkflowParams, len(mmCancelWorkflow.callArgs))
+	copy(argCopy, mmCancelWorkflow.callArgs)
+
+	mmCancelWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelWorkflowDone returns true if the count of the CancelWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCancelWorkflowDone() bool {
+	if m.CancelWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelWorkflowMock.invocationsDone()
+}
+
+// MinimockCancelWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockCancelWorkflowInspect() {
+	for _, e := range m.CancelWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.CancelWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterCancelWorkflowCounter := mm_atomic.LoadUint64(&m.afterCancelWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelWorkflowMock.defaultExpectation != nil && afterCancelWorkflowCounter < 1 {
+		if m.CancelWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.CancelWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.CancelWorkflow with params: %#v", *m.CancelWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelWorkflow != nil && afterCancelWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.CancelWorkflow")
+	}
+
+	if !m.CancelWorkflowMock.invocationsDone() && afterCancelWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.CancelWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelWorkflowMock.expectedInvocations), afterCancelWorkflowCounter)
+	}
+}
+
+type mClientMockCheckHealth struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCheckHealthExpectation
+	expectations       []*ClientMockCheckHealthExpectation
+
+	callArgs []*ClientMockCheckHealthParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockCheckHealthExpectation specifies expectation struct of the Client.CheckHealth
+type ClientMockCheckHealthExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockCheckHealthParams
+	paramPtrs *ClientMockCheckHealthParamPtrs
+	results   *ClientMockCheckHealthResults
+	Counter   uint64
+}
+
+// ClientMockCheckHealthParams contains parameters of the Client.CheckHealth
+type ClientMockCheckHealthParams struct {
+	ctx     context.Context
+	request *mm_client.CheckHealthRequest
+}
+
+// ClientMockCheckHealthParamPtrs contains pointers to parameters of the Client.CheckHealth
+type ClientMockCheckHealthParamPtrs struct {
+	ctx     *context.Context
+	request **mm_client.CheckHealthRequest
+}
+
+// ClientMockCheckHealthResults contains results of the Client.CheckHealth
+type ClientMockCheckHealthResults struct {
+	cp1 *mm_client.CheckHealthResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckHealth *mClientMockCheckHealth) Optional() *mClientMockCheckHealth {
+	mmCheckHealth.optional = true
+	return mmCheckHealth
+}
+
+// Expect sets up expected params for Client.CheckHealth
+func (mmCheckHealth *mClientMockCheckHealth) Expect(ctx context.Context, request *mm_client.CheckHealthRequest) *mClientMockCheckHealth {
+	if mmCheckHealth.mock.funcCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Set")
+	}
+
+	if mmCheckHealth.defaultExpectation == nil {
+		mmCheckHealth.defaultExpectation = &ClientMockCheckHealthExpectation{}
+	}
+
+	if mmCheckHealth.defaultExpectation.paramPtrs != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by ExpectParams functions")
+	}
+
+	mmCheckHealth.defaultExpectation.params = &ClientMockCheckHealthParams{ctx, request}
+	for _, e := range mmCheckHealth.expectations {
+		if minimock.Equal(e.params, mmCheckHealth.defaultExpectation.params) {
+			mmCheckHealth.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckHealth.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckHealth
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.CheckHealth
+func (mmCheckHealth *mClientMockCheckHealth) ExpectCtxParam1(ctx context.Context) *mClientMockCheckHealth {
+	if mmCheckHealth.mock.funcCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Set")
+	}
+
+	if mmCheckHealth.defaultExpectation == nil {
+		mmCheckHealth.defaultExpectation = &ClientMockCheckHealthExpectation{}
+	}
+
+	if mmCheckHealth.defaultExpectation.params != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Expect")
+	}
+
+	if mmCheckHealth.defaultExpectation.paramPtrs == nil {
+		mmCheckHealth.defaultExpectation.paramPtrs = &ClientMockCheckHealthParamPtrs{}
+	}
+	mmCheckHealth.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckHealth
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.CheckHealth
+func (mmCheckHealth *mClientMockCheckHealth) ExpectRequestParam2(request *mm_client.CheckHealthRequest) *mClientMockCheckHealth {
+	if mmCheckHealth.mock.funcCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Set")
+	}
+
+	if mmCheckHealth.defaultExpectation == nil {
+		mmCheckHealth.defaultExpectation = &ClientMockCheckHealthExpectation{}
+	}
+
+	if mmCheckHealth.defaultExpectation.params != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Expect")
+	}
+
+	if mmCheckHealth.defaultExpectation.paramPtrs == nil {
+		mmCheckHealth.defaultExpectation.paramPtrs = &ClientMockCheckHealthParamPtrs{}
+	}
+	mmCheckHealth.defaultExpectation.paramPtrs.request = &request
+
+	return mmCheckHealth
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.CheckHealth
+func (mmCheckHealth *mClientMockCheckHealth) Inspect(f func(ctx context.Context, request *mm_client.CheckHealthRequest)) *mClientMockCheckHealth {
+	if mmCheckHealth.mock.inspectFuncCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("Inspect function is already set for ClientMock.CheckHealth")
+	}
+
+	mmCheckHealth.mock.inspectFuncCheckHealth = f
+
+	return mmCheckHealth
+}
+
+// Return sets up results that will be returned by Client.CheckHealth
+func (mmCheckHealth *mClientMockCheckHealth) Return(cp1 *mm_client.CheckHealthResponse, err error) *ClientMock {
+	if mmCheckHealth.mock.funcCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Set")
+	}
+
+	if mmCheckHealth.defaultExpectation == nil {
+		mmCheckHealth.defaultExpectation = &ClientMockCheckHealthExpectation{mock: mmCheckHealth.mock}
+	}
+	mmCheckHealth.defaultExpectation.results = &ClientMockCheckHealthResults{cp1, err}
+	return mmCheckHealth.mock
+}
+
+// Set uses given function f to mock the Client.CheckHealth method
+func (mmCheckHealth *mClientMockCheckHealth) Set(f func(ctx context.Context, request *mm_client.CheckHealthRequest) (cp1 *mm_client.CheckHealthResponse, err error)) *ClientMock {
+	if mmCheckHealth.defaultExpectation != nil {
+		mmCheckHealth.mock.t.Fatalf("Default expectation is already set for the Client.CheckHealth method")
+	}
+
+	if len(mmCheckHealth.expectations) > 0 {
+		mmCheckHealth.mock.t.Fatalf("Some expectations are already set for the Client.CheckHealth method")
+	}
+
+	mmCheckHealth.mock.funcCheckHealth = f
+	return mmCheckHealth.mock
+}
+
+// When sets expectation for the Client.CheckHealth which will trigger the result defined by the following
+// Then helper
+func (mmCheckHealth *mClientMockCheckHealth) When(ctx context.Context, request *mm_client.CheckHealthRequest) *ClientMockCheckHealthExpectation {
+	if mmCheckHealth.mock.funcCheckHealth != nil {
+		mmCheckHealth.mock.t.Fatalf("ClientMock.CheckHealth mock is already set by Set")
+	}
+
+	expectation := &ClientMockCheckHealthExpectation{
+		mock:   mmCheckHealth.mock,
+		params: &ClientMockCheckHealthParams{ctx, request},
+	}
+	mmCheckHealth.expectations = append(mmCheckHealth.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.CheckHealth return parameters for the expectation previously defined by the When method
+func (e *ClientMockCheckHealthExpectation) Then(cp1 *mm_client.CheckHealthResponse, err error) *ClientMock {
+	e.results = &ClientMockCheckHealthResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.CheckHealth should be invoked
+func (mmCheckHealth *mClientMockCheckHealth) Times(n uint64) *mClientMockCheckHealth {
+	if n == 0 {
+		mmCheckHealth.mock.t.Fatalf("Times of ClientMock.CheckHealth mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckHealth.expectedInvocations, n)
+	return mmCheckHealth
+}
+
+func (mmCheckHealth *mClientMockCheckHealth) invocationsDone() bool {
+	if len(mmCheckHealth.expectations) == 0 && mmCheckHealth.defaultExpectation == nil && mmCheckHealth.mock.funcCheckHealth == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckHealth.mock.afterCheckHealthCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckHealth.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckHealth implements client.Client
+func (mmCheckHealth *ClientMock) CheckHealth(ctx context.Context, request *mm_client.CheckHealthRequest) (cp1 *mm_client.CheckHealthResponse, err error) {
+	mm_atomic.AddUint64(&mmCheckHealth.beforeCheckHealthCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckHealth.afterCheckHealthCounter, 1)
+
+	if mmCheckHealth.inspectFuncCheckHealth != nil {
+		mmCheckHealth.inspectFuncCheckHealth(ctx, request)
+	}
+
+	mm_params := ClientMockCheckHealthParams{ctx, request}
+
+	// Record call args
+	mmCheckHealth.CheckHealthMock.mutex.Lock()
+	mmCheckHealth.CheckHealthMock.callArgs = append(mmCheckHealth.CheckHealthMock.callArgs, &mm_params)
+	mmCheckHealth.CheckHealthMock.mutex.Unlock()
+
+	for _, e := range mmCheckHealth.CheckHealthMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmCheckHealth.CheckHealthMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckHealth.CheckHealthMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckHealth.CheckHealthMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckHealth.CheckHealthMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockCheckHealthParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckHealth.t.Errorf("ClientMock.CheckHealth got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmCheckHealth.t.Errorf("ClientMock.CheckHealth got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckHealth.t.Errorf("ClientMock.CheckHealth got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckHealth.CheckHealthMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckHealth.t.Fatal("No results are set for the ClientMock.CheckHealth")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmCheckHealth.funcCheckHealth != nil {
+		return mmCheckHealth.funcCheckHealth(ctx, request)
+	}
+	mmCheckHealth.t.Fatalf("Unexpected call to ClientMock.CheckHealth. %v %v", ctx, request)
+	return
+}
+
+// CheckHealthAfterCounter returns a count of finished ClientMock.CheckHealth invocations
+func (mmCheckHealth *ClientMock) CheckHealthAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckHealth.afterCheckHealthCounter)
+}
+
+// CheckHealthBeforeCounter returns a count of ClientMock.CheckHealth invocations
+func (mmCheckHealth *ClientMock) CheckHealthBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckHealth.beforeCheckHealthCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.CheckHealth.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckHealth *mClientMockCheckHealth) Calls() []*ClientMockCheckHealthParams {
+	mmCheckHealth.mutex.RLock()
+
+	argCopy := make([]*ClientMockCheckHealthParams, len(mmCheckHealth.callArgs))
+	copy(argCopy, mmCheckHealth.callArgs)
+
+	mmCheckHealth.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckHealthDone returns true if the count of the CheckHealth invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCheckHealthDone() bool {
+	if m.CheckHealthMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckHealthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckHealthMock.invocationsDone()
+}
+
+// MinimockCheckHealthInspect logs each unmet expectation
+func (m *ClientMock) MinimockCheckHealthInspect() {
+	for _, e := range m.CheckHealthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.CheckHealth with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckHealthCounter := mm_atomic.LoadUint64(&m.afterCheckHealthCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckHealthMock.defaultExpectation != nil && afterCheckHealthCounter < 1 {
+		if m.CheckHealthMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.CheckHealth")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.CheckHealth with params: %#v", *m.CheckHealthMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckHealth != nil && afterCheckHealthCounter < 1 {
+		m.t.Error("Expected call to ClientMock.CheckHealth")
+	}
+
+	if !m.CheckHealthMock.invocationsDone() && afterCheckHealthCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.CheckHealth but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckHealthMock.expectedInvocations), afterCheckHealthCounter)
+	}
+}
+
+type mClientMockClose struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCloseExpectation
+	expectations       []*ClientMockCloseExpectation
+
+	expectedInvocations uint64
+}
+
+// ClientMockCloseExpectation specifies expectation struct of the Client.Close
+type ClientMockCloseExpectation struct {
+	mock *ClientMock
+
+	Counter uint64
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClose *mClientMockClose) Optional() *mClientMockClose {
+	mmClose.optional = true
+	return mmClose
+}
+
+// Expect sets up expected params for Client.Close
+func (mmClose *mClientMockClose) Expect() *mClientMockClose {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("ClientMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &ClientMockCloseExpectation{}
+	}
+
+	return mmClose
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Close
+func (mmClose *mClientMockClose) Inspect(f func()) *mClientMockClose {
+	if mmClose.mock.inspectFuncClose != nil {
+		mmClose.mock.t.Fatalf("Inspect function is already set for ClientMock.Close")
+	}
+
+	mmClose.mock.inspectFuncClose = f
+
+	return mmClose
+}
+
+// Return sets up results that will be returned by Client.Close
+func (mmClose *mClientMockClose) Return() *ClientMock {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("ClientMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &ClientMockCloseExpectation{mock: mmClose.mock}
+	}
+
+	return mmClose.mock
+}
+
+// Set uses given function f to mock the Client.Close method
+func (mmClose *mClientMockClose) Set(f func()) *ClientMock {
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.t.Fatalf("Default expectation is already set for the Client.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.t.Fatalf("Some expectations are already set for the Client.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	return mmClose.mock
+}
+
+// Times sets number of times Client.Close should be invoked
+func (mmClose *mClientMockClose) Times(n uint64) *mClientMockClose {
+	if n == 0 {
+		mmClose.mock.t.Fatalf("Times of ClientMock.Close mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClose.expectedInvocations, n)
+	return mmClose
+}
+
+func (mmClose *mClientMockClose) invocationsDone() bool {
+	if len(mmClose.expectations) == 0 && mmClose.defaultExpectation == nil && mmClose.mock.funcClose == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClose.mock.afterCloseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClose.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Close implements client.Client
+func (mmClose *ClientMock) Close() {
+	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
+	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+
+	if mmClose.inspectFuncClose != nil {
+		mmClose.inspectFuncClose()
+	}
+
+	if mmClose.CloseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+
+		return
+
+	}
+	if mmClose.funcClose != nil {
+		mmClose.funcClose()
+		return
+	}
+	mmClose.t.Fatalf("Unexpected call to ClientMock.Close.")
+
+}
+
+// CloseAfterCounter returns a count of finished ClientMock.Close invocations
+func (mmClose *ClientMock) CloseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+}
+
+// CloseBeforeCounter returns a count of ClientMock.Close invocations
+func (mmClose *ClientMock) CloseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCloseDone() bool {
+	if m.CloseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseMock.invocationsDone()
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (m *ClientMock) MinimockCloseInspect() {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.Close")
+		}
+	}
+
+	afterCloseCounter := mm_atomic.LoadUint64(&m.afterCloseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && afterCloseCounter < 1 {
+		m.t.Error("Expected call to ClientMock.Close")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && afterCloseCounter < 1 {
+		m.t.Error("Expected call to ClientMock.Close")
+	}
+
+	if !m.CloseMock.invocationsDone() && afterCloseCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Close but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseMock.expectedInvocations), afterCloseCounter)
+	}
+}
+
+type mClientMockCompleteActivity struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCompleteActivityExpectation
+	expectations       []*ClientMockCompleteActivityExpectation
+
+	callArgs []*ClientMockCompleteActivityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockCompleteActivityExpectation specifies expectation struct of the Client.CompleteActivity
+type ClientMockCompleteActivityExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockCompleteActivityParams
+	paramPtrs *ClientMockCompleteActivityParamPtrs
+	results   *ClientMockCompleteActivityResults
+	Counter   uint64
+}
+
+// ClientMockCompleteActivityParams contains parameters of the Client.CompleteActivity
+type ClientMockCompleteActivityParams struct {
+	ctx       context.Context
+	taskToken []byte
+	result    interface{}
+	e1        error
+}
+
+// ClientMockCompleteActivityParamPtrs contains pointers to parameters of the Client.CompleteActivity
+type ClientMockCompleteActivityParamPtrs struct {
+	ctx       *context.Context
+	taskToken *[]byte
+	result    *interface{}
+	e1        *error
+}
+
+// ClientMockCompleteActivityResults contains results of the Client.CompleteActivity
+type ClientMockCompleteActivityResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCompleteActivity *mClientMockCompleteActivity) Optional() *mClientMockCompleteActivity {
+	mmCompleteActivity.optional = true
+	return mmCompleteActivity
+}
+
+// Expect sets up expected params for Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) Expect(ctx context.Context, taskToken []byte, result interface{}, e1 error) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{}
+	}
+
+	if mmCompleteActivity.defaultExpectation.paramPtrs != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by ExpectParams functions")
+	}
+
+	mmCompleteActivity.defaultExpectation.params = &ClientMockCompleteActivityParams{ctx, taskToken, result, e1}
+	for _, e := range mmCompleteActivity.expectations {
+		if minimock.Equal(e.params, mmCompleteActivity.defaultExpectation.params) {
+			mmCompleteActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteActivity.defaultExpectation.params)
+		}
+	}
+
+	return mmCompleteActivity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) ExpectCtxParam1(ctx context.Context) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{}
+	}
+
+	if mmCompleteActivity.defaultExpectation.params != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Expect")
+	}
+
+	if mmCompleteActivity.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivity.defaultExpectation.paramPtrs = &ClientMockCompleteActivityParamPtrs{}
+	}
+	mmCompleteActivity.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCompleteActivity
+}
+
+// ExpectTaskTokenParam2 sets up expected param taskToken for Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) ExpectTaskTokenParam2(taskToken []byte) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{}
+	}
+
+	if mmCompleteActivity.defaultExpectation.params != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Expect")
+	}
+
+	if mmCompleteActivity.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivity.defaultExpectation.paramPtrs = &ClientMockCompleteActivityParamPtrs{}
+	}
+	mmCompleteActivity.defaultExpectation.paramPtrs.taskToken = &taskToken
+
+	return mmCompleteActivity
+}
+
+// ExpectResultParam3 sets up expected param result for Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) ExpectResultParam3(result interface{}) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{}
+	}
+
+	if mmCompleteActivity.defaultExpectation.params != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Expect")
+	}
+
+	if mmCompleteActivity.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivity.defaultExpectation.paramPtrs = &ClientMockCompleteActivityParamPtrs{}
+	}
+	mmCompleteActivity.defaultExpectation.paramPtrs.result = &result
+
+	return mmCompleteActivity
+}
+
+// ExpectE1Param4 sets up expected param e1 for Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) ExpectE1Param4(e1 error) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{}
+	}
+
+	if mmCompleteActivity.defaultExpectation.params != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Expect")
+	}
+
+	if mmCompleteActivity.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivity.defaultExpectation.paramPtrs = &ClientMockCompleteActivityParamPtrs{}
+	}
+	mmCompleteActivity.defaultExpectation.paramPtrs.e1 = &e1
+
+	return mmCompleteActivity
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) Inspect(f func(ctx context.Context, taskToken []byte, result interface{}, e1 error)) *mClientMockCompleteActivity {
+	if mmCompleteActivity.mock.inspectFuncCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("Inspect function is already set for ClientMock.CompleteActivity")
+	}
+
+	mmCompleteActivity.mock.inspectFuncCompleteActivity = f
+
+	return mmCompleteActivity
+}
+
+// Return sets up results that will be returned by Client.CompleteActivity
+func (mmCompleteActivity *mClientMockCompleteActivity) Return(err error) *ClientMock {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	if mmCompleteActivity.defaultExpectation == nil {
+		mmCompleteActivity.defaultExpectation = &ClientMockCompleteActivityExpectation{mock: mmCompleteActivity.mock}
+	}
+	mmCompleteActivity.defaultExpectation.results = &ClientMockCompleteActivityResults{err}
+	return mmCompleteActivity.mock
+}
+
+// Set uses given function f to mock the Client.CompleteActivity method
+func (mmCompleteActivity *mClientMockCompleteActivity) Set(f func(ctx context.Context, taskToken []byte, result interface{}, e1 error) (err error)) *ClientMock {
+	if mmCompleteActivity.defaultExpectation != nil {
+		mmCompleteActivity.mock.t.Fatalf("Default expectation is already set for the Client.CompleteActivity method")
+	}
+
+	if len(mmCompleteActivity.expectations) > 0 {
+		mmCompleteActivity.mock.t.Fatalf("Some expectations are already set for the Client.CompleteActivity method")
+	}
+
+	mmCompleteActivity.mock.funcCompleteActivity = f
+	return mmCompleteActivity.mock
+}
+
+// When sets expectation for the Client.CompleteActivity which will trigger the result defined by the following
+// Then helper
+func (mmCompleteActivity *mClientMockCompleteActivity) When(ctx context.Context, taskToken []byte, result interface{}, e1 error) *ClientMockCompleteActivityExpectation {
+	if mmCompleteActivity.mock.funcCompleteActivity != nil {
+		mmCompleteActivity.mock.t.Fatalf("ClientMock.CompleteActivity mock is already set by Set")
+	}
+
+	expectation := &ClientMockCompleteActivityExpectation{
+		mock:   mmCompleteActivity.mock,
+		params: &ClientMockCompleteActivityParams{ctx, taskToken, result, e1},
+	}
+	mmCompleteActivity.expectations = append(mmCompleteActivity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.CompleteActivity return parameters for the expectation previously defined by the When method
+func (e *ClientMockCompleteActivityExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockCompleteActivityResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.CompleteActivity should be invoked
+func (mmCompleteActivity *mClientMockCompleteActivity) Times(n uint64) *mClientMockCompleteActivity {
+	if n == 0 {
+		mmCompleteActivity.mock.t.Fatalf("Times of ClientMock.CompleteActivity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteActivity.expectedInvocations, n)
+	return mmCompleteActivity
+}
+
+func (mmCompleteActivity *mClientMockCompleteActivity) invocationsDone() bool {
+	if len(mmCompleteActivity.expectations) == 0 && mmCompleteActivity.defaultExpectation == nil && mmCompleteActivity.mock.funcCompleteActivity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteActivity.mock.afterCompleteActivityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteActivity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteActivity implements client.Client
+func (mmCompleteActivity *ClientMock) CompleteActivity(ctx context.Context, taskToken []byte, result interface{}, e1 error) (err error) {
+	mm_atomic.AddUint64(&mmCompleteActivity.beforeCompleteActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteActivity.afterCompleteActivityCounter, 1)
+
+	if mmCompleteActivity.inspectFuncCompleteActivity != nil {
+		mmCompleteActivity.inspectFuncCompleteActivity(ctx, taskToken, result, e1)
+	}
+
+	mm_params := ClientMockCompleteActivityParams{ctx, taskToken, result, e1}
+
+	// Record call args
+	mmCompleteActivity.CompleteActivityMock.mutex.Lock()
+	mmCompleteActivity.CompleteActivityMock.callArgs = append(mmCompleteActivity.CompleteActivityMock.callArgs, &mm_params)
+	mmCompleteActivity.CompleteActivityMock.mutex.Unlock()
+
+	for _, e := range mmCompleteActivity.CompleteActivityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCompleteActivity.CompleteActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteActivity.CompleteActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteActivity.CompleteActivityMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteActivity.CompleteActivityMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockCompleteActivityParams{ctx, taskToken, result, e1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteActivity.t.Errorf("ClientMock.CompleteActivity got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskToken != nil && !minimock.Equal(*mm_want_ptrs.taskToken, mm_got.taskToken) {
+				mmCompleteActivity.t.Errorf("ClientMock.CompleteActivity got unexpected parameter taskToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.taskToken, mm_got.taskToken, minimock.Diff(*mm_want_ptrs.taskToken, mm_got.taskToken))
+			}
+
+			if mm_want_ptrs.result != nil && !minimock.Equal(*mm_want_ptrs.result, mm_got.result) {
+				mmCompleteActivity.t.Errorf("ClientMock.CompleteActivity got unexpected parameter result, want: %#v, got: %#v%s\n", *mm_want_ptrs.result, mm_got.result, minimock.Diff(*mm_want_ptrs.result, mm_got.result))
+			}
+
+			if mm_want_ptrs.e1 != nil && !minimock.Equal(*mm_want_ptrs.e1, mm_got.e1) {
+				mmCompleteActivity.t.Errorf("ClientMock.CompleteActivity got unexpected parameter e1, want: %#v, got: %#v%s\n", *mm_want_ptrs.e1, mm_got.e1, minimock.Diff(*mm_want_ptrs.e1, mm_got.e1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteActivity.t.Errorf("ClientMock.CompleteActivity got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteActivity.CompleteActivityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteActivity.t.Fatal("No results are set for the ClientMock.CompleteActivity")
+		}
+		return (*mm_results).err
+	}
+	if mmCompleteActivity.funcCompleteActivity != nil {
+		return mmCompleteActivity.funcCompleteActivity(ctx, taskToken, result, e1)
+	}
+	mmCompleteActivity.t.Fatalf("Unexpected call to ClientMock.CompleteActivity. %v %v %v %v", ctx, taskToken, result, e1)
+	return
+}
+
+// CompleteActivityAfterCounter returns a count of finished ClientMock.CompleteActivity invocations
+func (mmCompleteActivity *ClientMock) CompleteActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteActivity.afterCompleteActivityCounter)
+}
+
+// CompleteActivityBeforeCounter returns a count of ClientMock.CompleteActivity invocations
+func (mmCompleteActivity *ClientMock) CompleteActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteActivity.beforeCompleteActivityCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.CompleteActivity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteActivity *mClientMockCompleteActivity) Calls() []*ClientMockCompleteActivityParams {
+	mmCompleteActivity.mutex.RLock()
+
+	argCopy := make([]*ClientMockCompleteActivityParams, len(mmCompleteActivity.callArgs))
+	copy(argCopy, mmCompleteActivity.callArgs)
+
+	mmCompleteActivity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteActivityDone returns true if the count of the CompleteActivity invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCompleteActivityDone() bool {
+	if m.CompleteActivityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteActivityMock.invocationsDone()
+}
+
+// MinimockCompleteActivityInspect logs each unmet expectation
+func (m *ClientMock) MinimockCompleteActivityInspect() {
+	for _, e := range m.CompleteActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.CompleteActivity with params: %#v", *e.params)
+		}
+	}
+
+	afterCompleteActivityCounter := mm_atomic.LoadUint64(&m.afterCompleteActivityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteActivityMock.defaultExpectation != nil && afterCompleteActivityCounter < 1 {
+		if m.CompleteActivityMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.CompleteActivity")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.CompleteActivity with params: %#v", *m.CompleteActivityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteActivity != nil && afterCompleteActivityCounter < 1 {
+		m.t.Error("Expected call to ClientMock.CompleteActivity")
+	}
+
+	if !m.CompleteActivityMock.invocationsDone() && afterCompleteActivityCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.CompleteActivity but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteActivityMock.expectedInvocations), afterCompleteActivityCounter)
+	}
+}
+
+type mClientMockCompleteActivityByID struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCompleteActivityByIDExpectation
+	expectations       []*ClientMockCompleteActivityByIDExpectation
+
+	callArgs []*ClientMockCompleteActivityByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockCompleteActivityByIDExpectation specifies expectation struct of the Client.CompleteActivityByID
+type ClientMockCompleteActivityByIDExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockCompleteActivityByIDParams
+	paramPtrs *ClientMockCompleteActivityByIDParamPtrs
+	results   *ClientMockCompleteActivityByIDResults
+	Counter   uint64
+}
+
+// ClientMockCompleteActivityByIDParams contains parameters of the Client.CompleteActivityByID
+type ClientMockCompleteActivityByIDParams struct {
+	ctx        context.Context
+	namespace  string
+	workflowID string
+	runID      string
+	activityID string
+	result     interface{}
+	e1         error
+}
+
+// ClientMockCompleteActivityByIDParamPtrs contains pointers to parameters of the Client.CompleteActivityByID
+type ClientMockCompleteActivityByIDParamPtrs struct {
+	ctx        *context.Context
+	namespace  *string
+	workflowID *string
+	runID      *string
+	activityID *string
+	result     *interface{}
+	e1         *error
+}
+
+// ClientMockCompleteActivityByIDResults contains results of the Client.CompleteActivityByID
+type ClientMockCompleteActivityByIDResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Optional() *mClientMockCompleteActivityByID {
+	mmCompleteActivityByID.optional = true
+	return mmCompleteActivityByID
+}
+
+// Expect sets up expected params for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Expect(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by ExpectParams functions")
+	}
+
+	mmCompleteActivityByID.defaultExpectation.params = &ClientMockCompleteActivityByIDParams{ctx, namespace, workflowID, runID, activityID, result, e1}
+	for _, e := range mmCompleteActivityByID.expectations {
+		if minimock.Equal(e.params, mmCompleteActivityByID.defaultExpectation.params) {
+			mmCompleteActivityByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteActivityByID.defaultExpectation.params)
+		}
+	}
+
+	return mmCompleteActivityByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectCtxParam1(ctx context.Context) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCompleteActivityByID
+}
+
+// ExpectNamespaceParam2 sets up expected param namespace for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectNamespaceParam2(namespace string) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.namespace = &namespace
+
+	return mmCompleteActivityByID
+}
+
+// ExpectWorkflowIDParam3 sets up expected param workflowID for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectWorkflowIDParam3(workflowID string) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmCompleteActivityByID
+}
+
+// ExpectRunIDParam4 sets up expected param runID for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectRunIDParam4(runID string) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmCompleteActivityByID
+}
+
+// ExpectActivityIDParam5 sets up expected param activityID for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectActivityIDParam5(activityID string) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.activityID = &activityID
+
+	return mmCompleteActivityByID
+}
+
+// ExpectResultParam6 sets up expected param result for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectResultParam6(result interface{}) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.result = &result
+
+	return mmCompleteActivityByID
+}
+
+// ExpectE1Param7 sets up expected param e1 for Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) ExpectE1Param7(e1 error) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{}
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.params != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Expect")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation.paramPtrs == nil {
+		mmCompleteActivityByID.defaultExpectation.paramPtrs = &ClientMockCompleteActivityByIDParamPtrs{}
+	}
+	mmCompleteActivityByID.defaultExpectation.paramPtrs.e1 = &e1
+
+	return mmCompleteActivityByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Inspect(f func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error)) *mClientMockCompleteActivityByID {
+	if mmCompleteActivityByID.mock.inspectFuncCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("Inspect function is already set for ClientMock.CompleteActivityByID")
+	}
+
+	mmCompleteActivityByID.mock.inspectFuncCompleteActivityByID = f
+
+	return mmCompleteActivityByID
+}
+
+// Return sets up results that will be returned by Client.CompleteActivityByID
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Return(err error) *ClientMock {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	if mmCompleteActivityByID.defaultExpectation == nil {
+		mmCompleteActivityByID.defaultExpectation = &ClientMockCompleteActivityByIDExpectation{mock: mmCompleteActivityByID.mock}
+	}
+	mmCompleteActivityByID.defaultExpectation.results = &ClientMockCompleteActivityByIDResults{err}
+	return mmCompleteActivityByID.mock
+}
+
+// Set uses given function f to mock the Client.CompleteActivityByID method
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Set(f func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error) (err error)) *ClientMock {
+	if mmCompleteActivityByID.defaultExpectation != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("Default expectation is already set for the Client.CompleteActivityByID method")
+	}
+
+	if len(mmCompleteActivityByID.expectations) > 0 {
+		mmCompleteActivityByID.mock.t.Fatalf("Some expectations are already set for the Client.CompleteActivityByID method")
+	}
+
+	mmCompleteActivityByID.mock.funcCompleteActivityByID = f
+	return mmCompleteActivityByID.mock
+}
+
+// When sets expectation for the Client.CompleteActivityByID which will trigger the result defined by the following
+// Then helper
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) When(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error) *ClientMockCompleteActivityByIDExpectation {
+	if mmCompleteActivityByID.mock.funcCompleteActivityByID != nil {
+		mmCompleteActivityByID.mock.t.Fatalf("ClientMock.CompleteActivityByID mock is already set by Set")
+	}
+
+	expectation := &ClientMockCompleteActivityByIDExpectation{
+		mock:   mmCompleteActivityByID.mock,
+		params: &ClientMockCompleteActivityByIDParams{ctx, namespace, workflowID, runID, activityID, result, e1},
+	}
+	mmCompleteActivityByID.expectations = append(mmCompleteActivityByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.CompleteActivityByID return parameters for the expectation previously defined by the When method
+func (e *ClientMockCompleteActivityByIDExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockCompleteActivityByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.CompleteActivityByID should be invoked
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Times(n uint64) *mClientMockCompleteActivityByID {
+	if n == 0 {
+		mmCompleteActivityByID.mock.t.Fatalf("Times of ClientMock.CompleteActivityByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteActivityByID.expectedInvocations, n)
+	return mmCompleteActivityByID
+}
+
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) invocationsDone() bool {
+	if len(mmCompleteActivityByID.expectations) == 0 && mmCompleteActivityByID.defaultExpectation == nil && mmCompleteActivityByID.mock.funcCompleteActivityByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteActivityByID.mock.afterCompleteActivityByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteActivityByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteActivityByID implements client.Client
+func (mmCompleteActivityByID *ClientMock) CompleteActivityByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, result interface{}, e1 error) (err error) {
+	mm_atomic.AddUint64(&mmCompleteActivityByID.beforeCompleteActivityByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteActivityByID.afterCompleteActivityByIDCounter, 1)
+
+	if mmCompleteActivityByID.inspectFuncCompleteActivityByID != nil {
+		mmCompleteActivityByID.inspectFuncCompleteActivityByID(ctx, namespace, workflowID, runID, activityID, result, e1)
+	}
+
+	mm_params := ClientMockCompleteActivityByIDParams{ctx, namespace, workflowID, runID, activityID, result, e1}
+
+	// Record call args
+	mmCompleteActivityByID.CompleteActivityByIDMock.mutex.Lock()
+	mmCompleteActivityByID.CompleteActivityByIDMock.callArgs = append(mmCompleteActivityByID.CompleteActivityByIDMock.callArgs, &mm_params)
+	mmCompleteActivityByID.CompleteActivityByIDMock.mutex.Unlock()
+
+	for _, e := range mmCompleteActivityByID.CompleteActivityByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCompleteActivityByID.CompleteActivityByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteActivityByID.CompleteActivityByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteActivityByID.CompleteActivityByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteActivityByID.CompleteActivityByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockCompleteActivityByIDParams{ctx, namespace, workflowID, runID, activityID, result, e1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.namespace != nil && !minimock.Equal(*mm_want_ptrs.namespace, mm_got.namespace) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter namespace, want: %#v, got: %#v%s\n", *mm_want_ptrs.namespace, mm_got.namespace, minimock.Diff(*mm_want_ptrs.namespace, mm_got.namespace))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.activityID != nil && !minimock.Equal(*mm_want_ptrs.activityID, mm_got.activityID) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter activityID, want: %#v, got: %#v%s\n", *mm_want_ptrs.activityID, mm_got.activityID, minimock.Diff(*mm_want_ptrs.activityID, mm_got.activityID))
+			}
+
+			if mm_want_ptrs.result != nil && !minimock.Equal(*mm_want_ptrs.result, mm_got.result) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter result, want: %#v, got: %#v%s\n", *mm_want_ptrs.result, mm_got.result, minimock.Diff(*mm_want_ptrs.result, mm_got.result))
+			}
+
+			if mm_want_ptrs.e1 != nil && !minimock.Equal(*mm_want_ptrs.e1, mm_got.e1) {
+				mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameter e1, want: %#v, got: %#v%s\n", *mm_want_ptrs.e1, mm_got.e1, minimock.Diff(*mm_want_ptrs.e1, mm_got.e1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteActivityByID.t.Errorf("ClientMock.CompleteActivityByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteActivityByID.CompleteActivityByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteActivityByID.t.Fatal("No results are set for the ClientMock.CompleteActivityByID")
+		}
+		return (*mm_results).err
+	}
+	if mmCompleteActivityByID.funcCompleteActivityByID != nil {
+		return mmCompleteActivityByID.funcCompleteActivityByID(ctx, namespace, workflowID, runID, activityID, result, e1)
+	}
+	mmCompleteActivityByID.t.Fatalf("Unexpected call to ClientMock.CompleteActivityByID. %v %v %v %v %v %v %v", ctx, namespace, workflowID, runID, activityID, result, e1)
+	return
+}
+
+// CompleteActivityByIDAfterCounter returns a count of finished ClientMock.CompleteActivityByID invocations
+func (mmCompleteActivityByID *ClientMock) CompleteActivityByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteActivityByID.afterCompleteActivityByIDCounter)
+}
+
+// CompleteActivityByIDBeforeCounter returns a count of ClientMock.CompleteActivityByID invocations
+func (mmCompleteActivityByID *ClientMock) CompleteActivityByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteActivityByID.beforeCompleteActivityByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.CompleteActivityByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteActivityByID *mClientMockCompleteActivityByID) Calls() []*ClientMockCompleteActivityByIDParams {
+	mmCompleteActivityByID.mutex.RLock()
+
+	argCopy := make([]*ClientMockCompleteActivityByIDParams, len(mmCompleteActivityByID.callArgs))
+	copy(argCopy, mmCompleteActivityByID.callArgs)
+
+	mmCompleteActivityByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteActivityByIDDone returns true if the count of the CompleteActivityByID invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCompleteActivityByIDDone() bool {
+	if m.CompleteActivityByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteActivityByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteActivityByIDMock.invocationsDone()
+}
+
+// MinimockCompleteActivityByIDInspect logs each unmet expectation
+func (m *ClientMock) MinimockCompleteActivityByIDInspect() {
+	for _, e := range m.CompleteActivityByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.CompleteActivityByID with params: %#v", *e.params)
+		}
+	}
+
+	afterCompleteActivityByIDCounter := mm_atomic.LoadUint64(&m.afterCompleteActivityByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteActivityByIDMock.defaultExpectation != nil && afterCompleteActivityByIDCounter < 1 {
+		if m.CompleteActivityByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.CompleteActivityByID")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.CompleteActivityByID with params: %#v", *m.CompleteActivityByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteActivityByID != nil && afterCompleteActivityByIDCounter < 1 {
+		m.t.Error("Expected call to ClientMock.CompleteActivityByID")
+	}
+
+	if !m.CompleteActivityByIDMock.invocationsDone() && afterCompleteActivityByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.CompleteActivityByID but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteActivityByIDMock.expectedInvocations), afterCompleteActivityByIDCounter)
+	}
+}
+
+type mClientMockCountWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCountWorkflowExpectation
+	expectations       []*ClientMockCountWorkflowExpectation
+
+	callArgs []*ClientMockCountWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockCountWorkflowExpectation specifies expectation struct of the Client.CountWorkflow
+type ClientMockCountWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockCountWorkflowParams
+	paramPtrs *ClientMockCountWorkflowParamPtrs
+	results   *ClientMockCountWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockCountWorkflowParams contains parameters of the Client.CountWorkflow
+type ClientMockCountWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.CountWorkflowExecutionsRequest
+}
+
+// ClientMockCountWorkflowParamPtrs contains pointers to parameters of the Client.CountWorkflow
+type ClientMockCountWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.CountWorkflowExecutionsRequest
+}
+
+// ClientMockCountWorkflowResults contains results of the Client.CountWorkflow
+type ClientMockCountWorkflowResults struct {
+	cp1 *workflowservice.CountWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountWorkflow *mClientMockCountWorkflow) Optional() *mClientMockCountWorkflow {
+	mmCountWorkflow.optional = true
+	return mmCountWorkflow
+}
+
+// Expect sets up expected params for Client.CountWorkflow
+func (mmCountWorkflow *mClientMockCountWorkflow) Expect(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) *mClientMockCountWorkflow {
+	if mmCountWorkflow.mock.funcCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Set")
+	}
+
+	if mmCountWorkflow.defaultExpectation == nil {
+		mmCountWorkflow.defaultExpectation = &ClientMockCountWorkflowExpectation{}
+	}
+
+	if mmCountWorkflow.defaultExpectation.paramPtrs != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmCountWorkflow.defaultExpectation.params = &ClientMockCountWorkflowParams{ctx, request}
+	for _, e := range mmCountWorkflow.expectations {
+		if minimock.Equal(e.params, mmCountWorkflow.defaultExpectation.params) {
+			mmCountWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmCountWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.CountWorkflow
+func (mmCountWorkflow *mClientMockCountWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockCountWorkflow {
+	if mmCountWorkflow.mock.funcCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Set")
+	}
+
+	if mmCountWorkflow.defaultExpectation == nil {
+		mmCountWorkflow.defaultExpectation = &ClientMockCountWorkflowExpectation{}
+	}
+
+	if mmCountWorkflow.defaultExpectation.params != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Expect")
+	}
+
+	if mmCountWorkflow.defaultExpectation.paramPtrs == nil {
+		mmCountWorkflow.defaultExpectation.paramPtrs = &ClientMockCountWorkflowParamPtrs{}
+	}
+	mmCountWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCountWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.CountWorkflow
+func (mmCountWorkflow *mClientMockCountWorkflow) ExpectRequestParam2(request *workflowservice.CountWorkflowExecutionsRequest) *mClientMockCountWorkflow {
+	if mmCountWorkflow.mock.funcCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Set")
+	}
+
+	if mmCountWorkflow.defaultExpectation == nil {
+		mmCountWorkflow.defaultExpectation = &ClientMockCountWorkflowExpectation{}
+	}
+
+	if mmCountWorkflow.defaultExpectation.params != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Expect")
+	}
+
+	if mmCountWorkflow.defaultExpectation.paramPtrs == nil {
+		mmCountWorkflow.defaultExpectation.paramPtrs = &ClientMockCountWorkflowParamPtrs{}
+	}
+	mmCountWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmCountWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.CountWorkflow
+func (mmCountWorkflow *mClientMockCountWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest)) *mClientMockCountWorkflow {
+	if mmCountWorkflow.mock.inspectFuncCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.CountWorkflow")
+	}
+
+	mmCountWorkflow.mock.inspectFuncCountWorkflow = f
+
+	return mmCountWorkflow
+}
+
+// Return sets up results that will be returned by Client.CountWorkflow
+func (mmCountWorkflow *mClientMockCountWorkflow) Return(cp1 *workflowservice.CountWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmCountWorkflow.mock.funcCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Set")
+	}
+
+	if mmCountWorkflow.defaultExpectation == nil {
+		mmCountWorkflow.defaultExpectation = &ClientMockCountWorkflowExpectation{mock: mmCountWorkflow.mock}
+	}
+	mmCountWorkflow.defaultExpectation.results = &ClientMockCountWorkflowResults{cp1, err}
+	return mmCountWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.CountWorkflow method
+func (mmCountWorkflow *mClientMockCountWorkflow) Set(f func(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) (cp1 *workflowservice.CountWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmCountWorkflow.defaultExpectation != nil {
+		mmCountWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.CountWorkflow method")
+	}
+
+	if len(mmCountWorkflow.expectations) > 0 {
+		mmCountWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.CountWorkflow method")
+	}
+
+	mmCountWorkflow.mock.funcCountWorkflow = f
+	return mmCountWorkflow.mock
+}
+
+// When sets expectation for the Client.CountWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmCountWorkflow *mClientMockCountWorkflow) When(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) *ClientMockCountWorkflowExpectation {
+	if mmCountWorkflow.mock.funcCountWorkflow != nil {
+		mmCountWorkflow.mock.t.Fatalf("ClientMock.CountWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockCountWorkflowExpectation{
+		mock:   mmCountWorkflow.mock,
+		params: &ClientMockCountWorkflowParams{ctx, request},
+	}
+	mmCountWorkflow.expectations = append(mmCountWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.CountWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockCountWorkflowExpectation) Then(cp1 *workflowservice.CountWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockCountWorkflowResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.CountWorkflow should be invoked
+func (mmCountWorkflow *mClientMockCountWorkflow) Times(n uint64) *mClientMockCountWorkflow {
+	if n == 0 {
+		mmCountWorkflow.mock.t.Fatalf("Times of ClientMock.CountWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountWorkflow.expectedInvocations, n)
+	return mmCountWorkflow
+}
+
+func (mmCountWorkflow *mClientMockCountWorkflow) invocationsDone() bool {
+	if len(mmCountWorkflow.expectations) == 0 && mmCountWorkflow.defaultExpectation == nil && mmCountWorkflow.mock.funcCountWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountWorkflow.mock.afterCountWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountWorkflow implements client.Client
+func (mmCountWorkflow *ClientMock) CountWorkflow(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) (cp1 *workflowservice.CountWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmCountWorkflow.beforeCountWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountWorkflow.afterCountWorkflowCounter, 1)
+
+	if mmCountWorkflow.inspectFuncCountWorkflow != nil {
+		mmCountWorkflow.inspectFuncCountWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockCountWorkflowParams{ctx, request}
+
+	// Record call args
+	mmCountWorkflow.CountWorkflowMock.mutex.Lock()
+	mmCountWorkflow.CountWorkflowMock.callArgs = append(mmCountWorkflow.CountWorkflowMock.callArgs, &mm_params)
+	mmCountWorkflow.CountWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmCountWorkflow.CountWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmCountWorkflow.CountWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountWorkflow.CountWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountWorkflow.CountWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmCountWorkflow.CountWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockCountWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountWorkflow.t.Errorf("ClientMock.CountWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmCountWorkflow.t.Errorf("ClientMock.CountWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountWorkflow.t.Errorf("ClientMock.CountWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountWorkflow.CountWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountWorkflow.t.Fatal("No results are set for the ClientMock.CountWorkflow")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmCountWorkflow.funcCountWorkflow != nil {
+		return mmCountWorkflow.funcCountWorkflow(ctx, request)
+	}
+	mmCountWorkflow.t.Fatalf("Unexpected call to ClientMock.CountWorkflow. %v %v", ctx, request)
+	return
+}
+
+// CountWorkflowAfterCounter returns a count of finished ClientMock.CountWorkflow invocations
+func (mmCountWorkflow *ClientMock) CountWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountWorkflow.afterCountWorkflowCounter)
+}
+
+// CountWorkflowBeforeCounter returns a count of ClientMock.CountWorkflow invocations
+func (mmCountWorkflow *ClientMock) CountWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountWorkflow.beforeCountWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.CountWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountWorkflow *mClientMockCountWorkflow) Calls() []*ClientMockCountWorkflowParams {
+	mmCountWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockCountWorkflowParams, len(mmCountWorkflow.callArgs))
+	copy(argCopy, mmCountWorkflow.callArgs)
+
+	mmCountWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountWorkflowDone returns true if the count of the CountWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCountWorkflowDone() bool {
+	if m.CountWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountWorkflowMock.invocationsDone()
+}
+
+// MinimockCountWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockCountWorkflowInspect() {
+	for _, e := range m.CountWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.CountWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterCountWorkflowCounter := mm_atomic.LoadUint64(&m.afterCountWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountWorkflowMock.defaultExpectation != nil && afterCountWorkflowCounter < 1 {
+		if m.CountWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.CountWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.CountWorkflow with params: %#v", *m.CountWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountWorkflow != nil && afterCountWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.CountWorkflow")
+	}
+
+	if !m.CountWorkflowMock.invocationsDone() && afterCountWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.CountWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.CountWorkflowMock.expectedInvocations), afterCountWorkflowCounter)
+	}
+}
+
+type mClientMockDescribeTaskQueue struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockDescribeTaskQueueExpectation
+	expectations       []*ClientMockDescribeTaskQueueExpectation
+
+	callArgs []*ClientMockDescribeTaskQueueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockDescribeTaskQueueExpectation specifies expectation struct of the Client.DescribeTaskQueue
+type ClientMockDescribeTaskQueueExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockDescribeTaskQueueParams
+	paramPtrs *ClientMockDescribeTaskQueueParamPtrs
+	results   *ClientMockDescribeTaskQueueResults
+	Counter   uint64
+}
+
+// ClientMockDescribeTaskQueueParams contains parameters of the Client.DescribeTaskQueue
+type ClientMockDescribeTaskQueueParams struct {
+	ctx           context.Context
+	taskqueue     string
+	taskqueueType enumspb.TaskQueueType
+}
+
+// ClientMockDescribeTaskQueueParamPtrs contains pointers to parameters of the Client.DescribeTaskQueue
+type ClientMockDescribeTaskQueueParamPtrs struct {
+	ctx           *context.Context
+	taskqueue     *string
+	taskqueueType *enumspb.TaskQueueType
+}
+
+// ClientMockDescribeTaskQueueResults contains results of the Client.DescribeTaskQueue
+type ClientMockDescribeTaskQueueResults struct {
+	dp1 *workflowservice.DescribeTaskQueueResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Optional() *mClientMockDescribeTaskQueue {
+	mmDescribeTaskQueue.optional = true
+	return mmDescribeTaskQueue
+}
+
+// Expect sets up expected params for Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Expect(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType) *mClientMockDescribeTaskQueue {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation == nil {
+		mmDescribeTaskQueue.defaultExpectation = &ClientMockDescribeTaskQueueExpectation{}
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.paramPtrs != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by ExpectParams functions")
+	}
+
+	mmDescribeTaskQueue.defaultExpectation.params = &ClientMockDescribeTaskQueueParams{ctx, taskqueue, taskqueueType}
+	for _, e := range mmDescribeTaskQueue.expectations {
+		if minimock.Equal(e.params, mmDescribeTaskQueue.defaultExpectation.params) {
+			mmDescribeTaskQueue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDescribeTaskQueue.defaultExpectation.params)
+		}
+	}
+
+	return mmDescribeTaskQueue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) ExpectCtxParam1(ctx context.Context) *mClientMockDescribeTaskQueue {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation == nil {
+		mmDescribeTaskQueue.defaultExpectation = &ClientMockDescribeTaskQueueExpectation{}
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.params != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Expect")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.paramPtrs == nil {
+		mmDescribeTaskQueue.defaultExpectation.paramPtrs = &ClientMockDescribeTaskQueueParamPtrs{}
+	}
+	mmDescribeTaskQueue.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDescribeTaskQueue
+}
+
+// ExpectTaskqueueParam2 sets up expected param taskqueue for Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) ExpectTaskqueueParam2(taskqueue string) *mClientMockDescribeTaskQueue {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation == nil {
+		mmDescribeTaskQueue.defaultExpectation = &ClientMockDescribeTaskQueueExpectation{}
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.params != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Expect")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.paramPtrs == nil {
+		mmDescribeTaskQueue.defaultExpectation.paramPtrs = &ClientMockDescribeTaskQueueParamPtrs{}
+	}
+	mmDescribeTaskQueue.defaultExpectation.paramPtrs.taskqueue = &taskqueue
+
+	return mmDescribeTaskQueue
+}
+
+// ExpectTaskqueueTypeParam3 sets up expected param taskqueueType for Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) ExpectTaskqueueTypeParam3(taskqueueType enumspb.TaskQueueType) *mClientMockDescribeTaskQueue {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation == nil {
+		mmDescribeTaskQueue.defaultExpectation = &ClientMockDescribeTaskQueueExpectation{}
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.params != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Expect")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation.paramPtrs == nil {
+		mmDescribeTaskQueue.defaultExpectation.paramPtrs = &ClientMockDescribeTaskQueueParamPtrs{}
+	}
+	mmDescribeTaskQueue.defaultExpectation.paramPtrs.taskqueueType = &taskqueueType
+
+	return mmDescribeTaskQueue
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Inspect(f func(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType)) *mClientMockDescribeTaskQueue {
+	if mmDescribeTaskQueue.mock.inspectFuncDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("Inspect function is already set for ClientMock.DescribeTaskQueue")
+	}
+
+	mmDescribeTaskQueue.mock.inspectFuncDescribeTaskQueue = f
+
+	return mmDescribeTaskQueue
+}
+
+// Return sets up results that will be returned by Client.DescribeTaskQueue
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Return(dp1 *workflowservice.DescribeTaskQueueResponse, err error) *ClientMock {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	if mmDescribeTaskQueue.defaultExpectation == nil {
+		mmDescribeTaskQueue.defaultExpectation = &ClientMockDescribeTaskQueueExpectation{mock: mmDescribeTaskQueue.mock}
+	}
+	mmDescribeTaskQueue.defaultExpectation.results = &ClientMockDescribeTaskQueueResults{dp1, err}
+	return mmDescribeTaskQueue.mock
+}
+
+// Set uses given function f to mock the Client.DescribeTaskQueue method
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Set(f func(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType) (dp1 *workflowservice.DescribeTaskQueueResponse, err error)) *ClientMock {
+	if mmDescribeTaskQueue.defaultExpectation != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("Default expectation is already set for the Client.DescribeTaskQueue method")
+	}
+
+	if len(mmDescribeTaskQueue.expectations) > 0 {
+		mmDescribeTaskQueue.mock.t.Fatalf("Some expectations are already set for the Client.DescribeTaskQueue method")
+	}
+
+	mmDescribeTaskQueue.mock.funcDescribeTaskQueue = f
+	return mmDescribeTaskQueue.mock
+}
+
+// When sets expectation for the Client.DescribeTaskQueue which will trigger the result defined by the following
+// Then helper
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) When(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType) *ClientMockDescribeTaskQueueExpectation {
+	if mmDescribeTaskQueue.mock.funcDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.mock.t.Fatalf("ClientMock.DescribeTaskQueue mock is already set by Set")
+	}
+
+	expectation := &ClientMockDescribeTaskQueueExpectation{
+		mock:   mmDescribeTaskQueue.mock,
+		params: &ClientMockDescribeTaskQueueParams{ctx, taskqueue, taskqueueType},
+	}
+	mmDescribeTaskQueue.expectations = append(mmDescribeTaskQueue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.DescribeTaskQueue return parameters for the expectation previously defined by the When method
+func (e *ClientMockDescribeTaskQueueExpectation) Then(dp1 *workflowservice.DescribeTaskQueueResponse, err error) *ClientMock {
+	e.results = &ClientMockDescribeTaskQueueResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.DescribeTaskQueue should be invoked
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Times(n uint64) *mClientMockDescribeTaskQueue {
+	if n == 0 {
+		mmDescribeTaskQueue.mock.t.Fatalf("Times of ClientMock.DescribeTaskQueue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDescribeTaskQueue.expectedInvocations, n)
+	return mmDescribeTaskQueue
+}
+
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) invocationsDone() bool {
+	if len(mmDescribeTaskQueue.expectations) == 0 && mmDescribeTaskQueue.defaultExpectation == nil && mmDescribeTaskQueue.mock.funcDescribeTaskQueue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDescribeTaskQueue.mock.afterDescribeTaskQueueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDescribeTaskQueue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DescribeTaskQueue implements client.Client
+func (mmDescribeTaskQueue *ClientMock) DescribeTaskQueue(ctx context.Context, taskqueue string, taskqueueType enumspb.TaskQueueType) (dp1 *workflowservice.DescribeTaskQueueResponse, err error) {
+	mm_atomic.AddUint64(&mmDescribeTaskQueue.beforeDescribeTaskQueueCounter, 1)
+	defer mm_atomic.AddUint64(&mmDescribeTaskQueue.afterDescribeTaskQueueCounter, 1)
+
+	if mmDescribeTaskQueue.inspectFuncDescribeTaskQueue != nil {
+		mmDescribeTaskQueue.inspectFuncDescribeTaskQueue(ctx, taskqueue, taskqueueType)
+	}
+
+	mm_params := ClientMockDescribeTaskQueueParams{ctx, taskqueue, taskqueueType}
+
+	// Record call args
+	mmDescribeTaskQueue.DescribeTaskQueueMock.mutex.Lock()
+	mmDescribeTaskQueue.DescribeTaskQueueMock.callArgs = append(mmDescribeTaskQueue.DescribeTaskQueueMock.callArgs, &mm_params)
+	mmDescribeTaskQueue.DescribeTaskQueueMock.mutex.Unlock()
+
+	for _, e := range mmDescribeTaskQueue.DescribeTaskQueueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmDescribeTaskQueue.DescribeTaskQueueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDescribeTaskQueue.DescribeTaskQueueMock.defaultExpectation.Counter, 1)
+		mm_want := mmDescribeTaskQueue.DescribeTaskQueueMock.defaultExpectation.params
+		mm_want_ptrs := mmDescribeTaskQueue.DescribeTaskQueueMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockDescribeTaskQueueParams{ctx, taskqueue, taskqueueType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDescribeTaskQueue.t.Errorf("ClientMock.DescribeTaskQueue got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskqueue != nil && !minimock.Equal(*mm_want_ptrs.taskqueue, mm_got.taskqueue) {
+				mmDescribeTaskQueue.t.Errorf("ClientMock.DescribeTaskQueue got unexpected parameter taskqueue, want: %#v, got: %#v%s\n", *mm_want_ptrs.taskqueue, mm_got.taskqueue, minimock.Diff(*mm_want_ptrs.taskqueue, mm_got.taskqueue))
+			}
+
+			if mm_want_ptrs.taskqueueType != nil && !minimock.Equal(*mm_want_ptrs.taskqueueType, mm_got.taskqueueType) {
+				mmDescribeTaskQueue.t.Errorf("ClientMock.DescribeTaskQueue got unexpected parameter taskqueueType, want: %#v, got: %#v%s\n", *mm_want_ptrs.taskqueueType, mm_got.taskqueueType, minimock.Diff(*mm_want_ptrs.taskqueueType, mm_got.taskqueueType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDescribeTaskQueue.t.Errorf("ClientMock.DescribeTaskQueue got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDescribeTaskQueue.DescribeTaskQueueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDescribeTaskQueue.t.Fatal("No results are set for the ClientMock.DescribeTaskQueue")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmDescribeTaskQueue.funcDescribeTaskQueue != nil {
+		return mmDescribeTaskQueue.funcDescribeTaskQueue(ctx, taskqueue, taskqueueType)
+	}
+	mmDescribeTaskQueue.t.Fatalf("Unexpected call to ClientMock.DescribeTaskQueue. %v %v %v", ctx, taskqueue, taskqueueType)
+	return
+}
+
+// DescribeTaskQueueAfterCounter returns a count of finished ClientMock.DescribeTaskQueue invocations
+func (mmDescribeTaskQueue *ClientMock) DescribeTaskQueueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDescribeTaskQueue.afterDescribeTaskQueueCounter)
+}
+
+// DescribeTaskQueueBeforeCounter returns a count of ClientMock.DescribeTaskQueue invocations
+func (mmDescribeTaskQueue *ClientMock) DescribeTaskQueueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDescribeTaskQueue.beforeDescribeTaskQueueCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.DescribeTaskQueue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDescribeTaskQueue *mClientMockDescribeTaskQueue) Calls() []*ClientMockDescribeTaskQueueParams {
+	mmDescribeTaskQueue.mutex.RLock()
+
+	argCopy := make([]*ClientMockDescribeTaskQueueParams, len(mmDescribeTaskQueue.callArgs))
+	copy(argCopy, mmDescribeTaskQueue.callArgs)
+
+	mmDescribeTaskQueue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDescribeTaskQueueDone returns true if the count of the DescribeTaskQueue invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockDescribeTaskQueueDone() bool {
+	if m.DescribeTaskQueueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DescribeTaskQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DescribeTaskQueueMock.invocationsDone()
+}
+
+// MinimockDescribeTaskQueueInspect logs each unmet expectation
+func (m *ClientMock) MinimockDescribeTaskQueueInspect() {
+	for _, e := range m.DescribeTaskQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.DescribeTaskQueue with params: %#v", *e.params)
+		}
+	}
+
+	afterDescribeTaskQueueCounter := mm_atomic.LoadUint64(&m.afterDescribeTaskQueueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DescribeTaskQueueMock.defaultExpectation != nil && afterDescribeTaskQueueCounter < 1 {
+		if m.DescribeTaskQueueMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.DescribeTaskQueue")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.DescribeTaskQueue with params: %#v", *m.DescribeTaskQueueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDescribeTaskQueue != nil && afterDescribeTaskQueueCounter < 1 {
+		m.t.Error("Expected call to ClientMock.DescribeTaskQueue")
+	}
+
+	if !m.DescribeTaskQueueMock.invocationsDone() && afterDescribeTaskQueueCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.DescribeTaskQueue but found %d calls",
+			mm_atomic.LoadUint64(&m.DescribeTaskQueueMock.expectedInvocations), afterDescribeTaskQueueCounter)
+	}
+}
+
+type mClientMockDescribeWorkflowExecution struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockDescribeWorkflowExecutionExpectation
+	expectations       []*ClientMockDescribeWorkflowExecutionExpectation
+
+	callArgs []*ClientMockDescribeWorkflowExecutionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockDescribeWorkflowExecutionExpectation specifies expectation struct of the Client.DescribeWorkflowExecution
+type ClientMockDescribeWorkflowExecutionExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockDescribeWorkflowExecutionParams
+	paramPtrs *ClientMockDescribeWorkflowExecutionParamPtrs
+	results   *ClientMockDescribeWorkflowExecutionResults
+	Counter   uint64
+}
+
+// ClientMockDescribeWorkflowExecutionParams contains parameters of the Client.DescribeWorkflowExecution
+type ClientMockDescribeWorkflowExecutionParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+}
+
+// ClientMockDescribeWorkflowExecutionParamPtrs contains pointers to parameters of the Client.DescribeWorkflowExecution
+type ClientMockDescribeWorkflowExecutionParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+}
+
+// ClientMockDescribeWorkflowExecutionResults contains results of the Client.DescribeWorkflowExecution
+type ClientMockDescribeWorkflowExecutionResults struct {
+	dp1 *workflowservice.DescribeWorkflowExecutionResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Optional() *mClientMockDescribeWorkflowExecution {
+	mmDescribeWorkflowExecution.optional = true
+	return mmDescribeWorkflowExecution
+}
+
+// Expect sets up expected params for Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Expect(ctx context.Context, workflowID string, runID string) *mClientMockDescribeWorkflowExecution {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation == nil {
+		mmDescribeWorkflowExecution.defaultExpectation = &ClientMockDescribeWorkflowExecutionExpectation{}
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.paramPtrs != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by ExpectParams functions")
+	}
+
+	mmDescribeWorkflowExecution.defaultExpectation.params = &ClientMockDescribeWorkflowExecutionParams{ctx, workflowID, runID}
+	for _, e := range mmDescribeWorkflowExecution.expectations {
+		if minimock.Equal(e.params, mmDescribeWorkflowExecution.defaultExpectation.params) {
+			mmDescribeWorkflowExecution.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDescribeWorkflowExecution.defaultExpectation.params)
+		}
+	}
+
+	return mmDescribeWorkflowExecution
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) ExpectCtxParam1(ctx context.Context) *mClientMockDescribeWorkflowExecution {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation == nil {
+		mmDescribeWorkflowExecution.defaultExpectation = &ClientMockDescribeWorkflowExecutionExpectation{}
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.params != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Expect")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.paramPtrs == nil {
+		mmDescribeWorkflowExecution.defaultExpectation.paramPtrs = &ClientMockDescribeWorkflowExecutionParamPtrs{}
+	}
+	mmDescribeWorkflowExecution.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDescribeWorkflowExecution
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) ExpectWorkflowIDParam2(workflowID string) *mClientMockDescribeWorkflowExecution {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation == nil {
+		mmDescribeWorkflowExecution.defaultExpectation = &ClientMockDescribeWorkflowExecutionExpectation{}
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.params != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Expect")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.paramPtrs == nil {
+		mmDescribeWorkflowExecution.defaultExpectation.paramPtrs = &ClientMockDescribeWorkflowExecutionParamPtrs{}
+	}
+	mmDescribeWorkflowExecution.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmDescribeWorkflowExecution
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) ExpectRunIDParam3(runID string) *mClientMockDescribeWorkflowExecution {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation == nil {
+		mmDescribeWorkflowExecution.defaultExpectation = &ClientMockDescribeWorkflowExecutionExpectation{}
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.params != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Expect")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation.paramPtrs == nil {
+		mmDescribeWorkflowExecution.defaultExpectation.paramPtrs = &ClientMockDescribeWorkflowExecutionParamPtrs{}
+	}
+	mmDescribeWorkflowExecution.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmDescribeWorkflowExecution
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Inspect(f func(ctx context.Context, workflowID string, runID string)) *mClientMockDescribeWorkflowExecution {
+	if mmDescribeWorkflowExecution.mock.inspectFuncDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("Inspect function is already set for ClientMock.DescribeWorkflowExecution")
+	}
+
+	mmDescribeWorkflowExecution.mock.inspectFuncDescribeWorkflowExecution = f
+
+	return mmDescribeWorkflowExecution
+}
+
+// Return sets up results that will be returned by Client.DescribeWorkflowExecution
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Return(dp1 *workflowservice.DescribeWorkflowExecutionResponse, err error) *ClientMock {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	if mmDescribeWorkflowExecution.defaultExpectation == nil {
+		mmDescribeWorkflowExecution.defaultExpectation = &ClientMockDescribeWorkflowExecutionExpectation{mock: mmDescribeWorkflowExecution.mock}
+	}
+	mmDescribeWorkflowExecution.defaultExpectation.results = &ClientMockDescribeWorkflowExecutionResults{dp1, err}
+	return mmDescribeWorkflowExecution.mock
+}
+
+// Set uses given function f to mock the Client.DescribeWorkflowExecution method
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Set(f func(ctx context.Context, workflowID string, runID string) (dp1 *workflowservice.DescribeWorkflowExecutionResponse, err error)) *ClientMock {
+	if mmDescribeWorkflowExecution.defaultExpectation != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("Default expectation is already set for the Client.DescribeWorkflowExecution method")
+	}
+
+	if len(mmDescribeWorkflowExecution.expectations) > 0 {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("Some expectations are already set for the Client.DescribeWorkflowExecution method")
+	}
+
+	mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution = f
+	return mmDescribeWorkflowExecution.mock
+}
+
+// When sets expectation for the Client.DescribeWorkflowExecution which will trigger the result defined by the following
+// Then helper
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) When(ctx context.Context, workflowID string, runID string) *ClientMockDescribeWorkflowExecutionExpectation {
+	if mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("ClientMock.DescribeWorkflowExecution mock is already set by Set")
+	}
+
+	expectation := &ClientMockDescribeWorkflowExecutionExpectation{
+		mock:   mmDescribeWorkflowExecution.mock,
+		params: &ClientMockDescribeWorkflowExecutionParams{ctx, workflowID, runID},
+	}
+	mmDescribeWorkflowExecution.expectations = append(mmDescribeWorkflowExecution.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.DescribeWorkflowExecution return parameters for the expectation previously defined by the When method
+func (e *ClientMockDescribeWorkflowExecutionExpectation) Then(dp1 *workflowservice.DescribeWorkflowExecutionResponse, err error) *ClientMock {
+	e.results = &ClientMockDescribeWorkflowExecutionResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.DescribeWorkflowExecution should be invoked
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Times(n uint64) *mClientMockDescribeWorkflowExecution {
+	if n == 0 {
+		mmDescribeWorkflowExecution.mock.t.Fatalf("Times of ClientMock.DescribeWorkflowExecution mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDescribeWorkflowExecution.expectedInvocations, n)
+	return mmDescribeWorkflowExecution
+}
+
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) invocationsDone() bool {
+	if len(mmDescribeWorkflowExecution.expectations) == 0 && mmDescribeWorkflowExecution.defaultExpectation == nil && mmDescribeWorkflowExecution.mock.funcDescribeWorkflowExecution == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDescribeWorkflowExecution.mock.afterDescribeWorkflowExecutionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDescribeWorkflowExecution.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DescribeWorkflowExecution implements client.Client
+func (mmDescribeWorkflowExecution *ClientMock) DescribeWorkflowExecution(ctx context.Context, workflowID string, runID string) (dp1 *workflowservice.DescribeWorkflowExecutionResponse, err error) {
+	mm_atomic.AddUint64(&mmDescribeWorkflowExecution.beforeDescribeWorkflowExecutionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDescribeWorkflowExecution.afterDescribeWorkflowExecutionCounter, 1)
+
+	if mmDescribeWorkflowExecution.inspectFuncDescribeWorkflowExecution != nil {
+		mmDescribeWorkflowExecution.inspectFuncDescribeWorkflowExecution(ctx, workflowID, runID)
+	}
+
+	mm_params := ClientMockDescribeWorkflowExecutionParams{ctx, workflowID, runID}
+
+	// Record call args
+	mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.mutex.Lock()
+	mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.callArgs = append(mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.callArgs, &mm_params)
+	mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.mutex.Unlock()
+
+	for _, e := range mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.defaultExpectation.params
+		mm_want_ptrs := mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockDescribeWorkflowExecutionParams{ctx, workflowID, runID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDescribeWorkflowExecution.t.Errorf("ClientMock.DescribeWorkflowExecution got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmDescribeWorkflowExecution.t.Errorf("ClientMock.DescribeWorkflowExecution got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmDescribeWorkflowExecution.t.Errorf("ClientMock.DescribeWorkflowExecution got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDescribeWorkflowExecution.t.Errorf("ClientMock.DescribeWorkflowExecution got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDescribeWorkflowExecution.DescribeWorkflowExecutionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDescribeWorkflowExecution.t.Fatal("No results are set for the ClientMock.DescribeWorkflowExecution")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmDescribeWorkflowExecution.funcDescribeWorkflowExecution != nil {
+		return mmDescribeWorkflowExecution.funcDescribeWorkflowExecution(ctx, workflowID, runID)
+	}
+	mmDescribeWorkflowExecution.t.Fatalf("Unexpected call to ClientMock.DescribeWorkflowExecution. %v %v %v", ctx, workflowID, runID)
+	return
+}
+
+// DescribeWorkflowExecutionAfterCounter returns a count of finished ClientMock.DescribeWorkflowExecution invocations
+func (mmDescribeWorkflowExecution *ClientMock) DescribeWorkflowExecutionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDescribeWorkflowExecution.afterDescribeWorkflowExecutionCounter)
+}
+
+// DescribeWorkflowExecutionBeforeCounter returns a count of ClientMock.DescribeWorkflowExecution invocations
+func (mmDescribeWorkflowExecution *ClientMock) DescribeWorkflowExecutionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDescribeWorkflowExecution.beforeDescribeWorkflowExecutionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.DescribeWorkflowExecution.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDescribeWorkflowExecution *mClientMockDescribeWorkflowExecution) Calls() []*ClientMockDescribeWorkflowExecutionParams {
+	mmDescribeWorkflowExecution.mutex.RLock()
+
+	argCopy := make([]*ClientMockDescribeWorkflowExecutionParams, len(mmDescribeWorkflowExecution.callArgs))
+	copy(argCopy, mmDescribeWorkflowExecution.callArgs)
+
+	mmDescribeWorkflowExecution.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDescribeWorkflowExecutionDone returns true if the count of the DescribeWorkflowExecution invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockDescribeWorkflowExecutionDone() bool {
+	if m.DescribeWorkflowExecutionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DescribeWorkflowExecutionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DescribeWorkflowExecutionMock.invocationsDone()
+}
+
+// MinimockDescribeWorkflowExecutionInspect logs each unmet expectation
+func (m *ClientMock) MinimockDescribeWorkflowExecutionInspect() {
+	for _, e := range m.DescribeWorkflowExecutionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.DescribeWorkflowExecution with params: %#v", *e.params)
+		}
+	}
+
+	afterDescribeWorkflowExecutionCounter := mm_atomic.LoadUint64(&m.afterDescribeWorkflowExecutionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DescribeWorkflowExecutionMock.defaultExpectation != nil && afterDescribeWorkflowExecutionCounter < 1 {
+		if m.DescribeWorkflowExecutionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.DescribeWorkflowExecution")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.DescribeWorkflowExecution with params: %#v", *m.DescribeWorkflowExecutionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDescribeWorkflowExecution != nil && afterDescribeWorkflowExecutionCounter < 1 {
+		m.t.Error("Expected call to ClientMock.DescribeWorkflowExecution")
+	}
+
+	if !m.DescribeWorkflowExecutionMock.invocationsDone() && afterDescribeWorkflowExecutionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.DescribeWorkflowExecution but found %d calls",
+			mm_atomic.LoadUint64(&m.DescribeWorkflowExecutionMock.expectedInvocations), afterDescribeWorkflowExecutionCounter)
+	}
+}
+
+type mClientMockExecuteWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockExecuteWorkflowExpectation
+	expectations       []*ClientMockExecuteWorkflowExpectation
+
+	callArgs []*ClientMockExecuteWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockExecuteWorkflowExpectation specifies expectation struct of the Client.ExecuteWorkflow
+type ClientMockExecuteWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockExecuteWorkflowParams
+	paramPtrs *ClientMockExecuteWorkflowParamPtrs
+	results   *ClientMockExecuteWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockExecuteWorkflowParams contains parameters of the Client.ExecuteWorkflow
+type ClientMockExecuteWorkflowParams struct {
+	ctx      context.Context
+	options  mm_client.StartWorkflowOptions
+	workflow interface{}
+	args     []interface{}
+}
+
+// ClientMockExecuteWorkflowParamPtrs contains pointers to parameters of the Client.ExecuteWorkflow
+type ClientMockExecuteWorkflowParamPtrs struct {
+	ctx      *context.Context
+	options  *mm_client.StartWorkflowOptions
+	workflow *interface{}
+	args     *[]interface{}
+}
+
+// ClientMockExecuteWorkflowResults contains results of the Client.ExecuteWorkflow
+type ClientMockExecuteWorkflowResults struct {
+	w1  mm_client.WorkflowRun
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Optional() *mClientMockExecuteWorkflow {
+	mmExecuteWorkflow.optional = true
+	return mmExecuteWorkflow
+}
+
+// Expect sets up expected params for Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Expect(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{}) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{}
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.paramPtrs != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmExecuteWorkflow.defaultExpectation.params = &ClientMockExecuteWorkflowParams{ctx, options, workflow, args}
+	for _, e := range mmExecuteWorkflow.expectations {
+		if minimock.Equal(e.params, mmExecuteWorkflow.defaultExpectation.params) {
+			mmExecuteWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExecuteWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmExecuteWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{}
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.params != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Expect")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.paramPtrs == nil {
+		mmExecuteWorkflow.defaultExpectation.paramPtrs = &ClientMockExecuteWorkflowParamPtrs{}
+	}
+	mmExecuteWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExecuteWorkflow
+}
+
+// ExpectOptionsParam2 sets up expected param options for Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) ExpectOptionsParam2(options mm_client.StartWorkflowOptions) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{}
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.params != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Expect")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.paramPtrs == nil {
+		mmExecuteWorkflow.defaultExpectation.paramPtrs = &ClientMockExecuteWorkflowParamPtrs{}
+	}
+	mmExecuteWorkflow.defaultExpectation.paramPtrs.options = &options
+
+	return mmExecuteWorkflow
+}
+
+// ExpectWorkflowParam3 sets up expected param workflow for Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) ExpectWorkflowParam3(workflow interface{}) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{}
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.params != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Expect")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.paramPtrs == nil {
+		mmExecuteWorkflow.defaultExpectation.paramPtrs = &ClientMockExecuteWorkflowParamPtrs{}
+	}
+	mmExecuteWorkflow.defaultExpectation.paramPtrs.workflow = &workflow
+
+	return mmExecuteWorkflow
+}
+
+// ExpectArgsParam4 sets up expected param args for Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) ExpectArgsParam4(args ...interface{}) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{}
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.params != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Expect")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation.paramPtrs == nil {
+		mmExecuteWorkflow.defaultExpectation.paramPtrs = &ClientMockExecuteWorkflowParamPtrs{}
+	}
+	mmExecuteWorkflow.defaultExpectation.paramPtrs.args = &args
+
+	return mmExecuteWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Inspect(f func(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{})) *mClientMockExecuteWorkflow {
+	if mmExecuteWorkflow.mock.inspectFuncExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ExecuteWorkflow")
+	}
+
+	mmExecuteWorkflow.mock.inspectFuncExecuteWorkflow = f
+
+	return mmExecuteWorkflow
+}
+
+// Return sets up results that will be returned by Client.ExecuteWorkflow
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Return(w1 mm_client.WorkflowRun, err error) *ClientMock {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	if mmExecuteWorkflow.defaultExpectation == nil {
+		mmExecuteWorkflow.defaultExpectation = &ClientMockExecuteWorkflowExpectation{mock: mmExecuteWorkflow.mock}
+	}
+	mmExecuteWorkflow.defaultExpectation.results = &ClientMockExecuteWorkflowResults{w1, err}
+	return mmExecuteWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ExecuteWorkflow method
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Set(f func(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (w1 mm_client.WorkflowRun, err error)) *ClientMock {
+	if mmExecuteWorkflow.defaultExpectation != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ExecuteWorkflow method")
+	}
+
+	if len(mmExecuteWorkflow.expectations) > 0 {
+		mmExecuteWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ExecuteWorkflow method")
+	}
+
+	mmExecuteWorkflow.mock.funcExecuteWorkflow = f
+	return mmExecuteWorkflow.mock
+}
+
+// When sets expectation for the Client.ExecuteWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) When(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{}) *ClientMockExecuteWorkflowExpectation {
+	if mmExecuteWorkflow.mock.funcExecuteWorkflow != nil {
+		mmExecuteWorkflow.mock.t.Fatalf("ClientMock.ExecuteWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockExecuteWorkflowExpectation{
+		mock:   mmExecuteWorkflow.mock,
+		params: &ClientMockExecuteWorkflowParams{ctx, options, workflow, args},
+	}
+	mmExecuteWorkflow.expectations = append(mmExecuteWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ExecuteWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockExecuteWorkflowExpectation) Then(w1 mm_client.WorkflowRun, err error) *ClientMock {
+	e.results = &ClientMockExecuteWorkflowResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ExecuteWorkflow should be invoked
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Times(n uint64) *mClientMockExecuteWorkflow {
+	if n == 0 {
+		mmExecuteWorkflow.mock.t.Fatalf("Times of ClientMock.ExecuteWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExecuteWorkflow.expectedInvocations, n)
+	return mmExecuteWorkflow
+}
+
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) invocationsDone() bool {
+	if len(mmExecuteWorkflow.expectations) == 0 && mmExecuteWorkflow.defaultExpectation == nil && mmExecuteWorkflow.mock.funcExecuteWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExecuteWorkflow.mock.afterExecuteWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExecuteWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExecuteWorkflow implements client.Client
+func (mmExecuteWorkflow *ClientMock) ExecuteWorkflow(ctx context.Context, options mm_client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (w1 mm_client.WorkflowRun, err error) {
+	mm_atomic.AddUint64(&mmExecuteWorkflow.beforeExecuteWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmExecuteWorkflow.afterExecuteWorkflowCounter, 1)
+
+	if mmExecuteWorkflow.inspectFuncExecuteWorkflow != nil {
+		mmExecuteWorkflow.inspectFuncExecuteWorkflow(ctx, options, workflow, args...)
+	}
+
+	mm_params := ClientMockExecuteWorkflowParams{ctx, options, workflow, args}
+
+	// Record call args
+	mmExecuteWorkflow.ExecuteWorkflowMock.mutex.Lock()
+	mmExecuteWorkflow.ExecuteWorkflowMock.callArgs = append(mmExecuteWorkflow.ExecuteWorkflowMock.callArgs, &mm_params)
+	mmExecuteWorkflow.ExecuteWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmExecuteWorkflow.ExecuteWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmExecuteWorkflow.ExecuteWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExecuteWorkflow.ExecuteWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmExecuteWorkflow.ExecuteWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmExecuteWorkflow.ExecuteWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockExecuteWorkflowParams{ctx, options, workflow, args}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExecuteWorkflow.t.Errorf("ClientMock.ExecuteWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmExecuteWorkflow.t.Errorf("ClientMock.ExecuteWorkflow got unexpected parameter options, want: %#v, got: %#v%s\n", *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
+			if mm_want_ptrs.workflow != nil && !minimock.Equal(*mm_want_ptrs.workflow, mm_got.workflow) {
+				mmExecuteWorkflow.t.Errorf("ClientMock.ExecuteWorkflow got unexpected parameter workflow, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflow, mm_got.workflow, minimock.Diff(*mm_want_ptrs.workflow, mm_got.workflow))
+			}
+
+			if mm_want_ptrs.args != nil && !minimock.Equal(*mm_want_ptrs.args, mm_got.args) {
+				mmExecuteWorkflow.t.Errorf("ClientMock.ExecuteWorkflow got unexpected parameter args, want: %#v, got: %#v%s\n", *mm_want_ptrs.args, mm_got.args, minimock.Diff(*mm_want_ptrs.args, mm_got.args))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExecuteWorkflow.t.Errorf("ClientMock.ExecuteWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExecuteWorkflow.ExecuteWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExecuteWorkflow.t.Fatal("No results are set for the ClientMock.ExecuteWorkflow")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmExecuteWorkflow.funcExecuteWorkflow != nil {
+		return mmExecuteWorkflow.funcExecuteWorkflow(ctx, options, workflow, args...)
+	}
+	mmExecuteWorkflow.t.Fatalf("Unexpected call to ClientMock.ExecuteWorkflow. %v %v %v %v", ctx, options, workflow, args)
+	return
+}
+
+// ExecuteWorkflowAfterCounter returns a count of finished ClientMock.ExecuteWorkflow invocations
+func (mmExecuteWorkflow *ClientMock) ExecuteWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExecuteWorkflow.afterExecuteWorkflowCounter)
+}
+
+// ExecuteWorkflowBeforeCounter returns a count of ClientMock.ExecuteWorkflow invocations
+func (mmExecuteWorkflow *ClientMock) ExecuteWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExecuteWorkflow.beforeExecuteWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ExecuteWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExecuteWorkflow *mClientMockExecuteWorkflow) Calls() []*ClientMockExecuteWorkflowParams {
+	mmExecuteWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockExecuteWorkflowParams, len(mmExecuteWorkflow.callArgs))
+	copy(argCopy, mmExecuteWorkflow.callArgs)
+
+	mmExecuteWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExecuteWorkflowDone returns true if the count of the ExecuteWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockExecuteWorkflowDone() bool {
+	if m.ExecuteWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExecuteWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExecuteWorkflowMock.invocationsDone()
+}
+
+// MinimockExecuteWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockExecuteWorkflowInspect() {
+	for _, e := range m.ExecuteWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ExecuteWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterExecuteWorkflowCounter := mm_atomic.LoadUint64(&m.afterExecuteWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExecuteWorkflowMock.defaultExpectation != nil && afterExecuteWorkflowCounter < 1 {
+		if m.ExecuteWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ExecuteWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ExecuteWorkflow with params: %#v", *m.ExecuteWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExecuteWorkflow != nil && afterExecuteWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ExecuteWorkflow")
+	}
+
+	if !m.ExecuteWorkflowMock.invocationsDone() && afterExecuteWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ExecuteWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ExecuteWorkflowMock.expectedInvocations), afterExecuteWorkflowCounter)
+	}
+}
+
+type mClientMockGetSearchAttributes struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetSearchAttributesExpectation
+	expectations       []*ClientMockGetSearchAttributesExpectation
+
+	callArgs []*ClientMockGetSearchAttributesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockGetSearchAttributesExpectation specifies expectation struct of the Client.GetSearchAttributes
+type ClientMockGetSearchAttributesExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockGetSearchAttributesParams
+	paramPtrs *ClientMockGetSearchAttributesParamPtrs
+	results   *ClientMockGetSearchAttributesResults
+	Counter   uint64
+}
+
+// ClientMockGetSearchAttributesParams contains parameters of the Client.GetSearchAttributes
+type ClientMockGetSearchAttributesParams struct {
+	ctx context.Context
+}
+
+// ClientMockGetSearchAttributesParamPtrs contains pointers to parameters of the Client.GetSearchAttributes
+type ClientMockGetSearchAttributesParamPtrs struct {
+	ctx *context.Context
+}
+
+// ClientMockGetSearchAttributesResults contains results of the Client.GetSearchAttributes
+type ClientMockGetSearchAttributesResults struct {
+	gp1 *workflowservice.GetSearchAttributesResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Optional() *mClientMockGetSearchAttributes {
+	mmGetSearchAttributes.optional = true
+	return mmGetSearchAttributes
+}
+
+// Expect sets up expected params for Client.GetSearchAttributes
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Expect(ctx context.Context) *mClientMockGetSearchAttributes {
+	if mmGetSearchAttributes.mock.funcGetSearchAttributes != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by Set")
+	}
+
+	if mmGetSearchAttributes.defaultExpectation == nil {
+		mmGetSearchAttributes.defaultExpectation = &ClientMockGetSearchAttributesExpectation{}
+	}
+
+	if mmGetSearchAttributes.defaultExpectation.paramPtrs != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by ExpectParams functions")
+	}
+
+	mmGetSearchAttributes.defaultExpectation.params = &ClientMockGetSearchAttributesParams{ctx}
+	for _, e := range mmGetSearchAttributes.expectations {
+		if minimock.Equal(e.params, mmGetSearchAttributes.defaultExpectation.params) {
+			mmGetSearchAttributes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSearchAttributes.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSearchAttributes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.GetSearchAttributes
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) ExpectCtxParam1(ctx context.Context) *mClientMockGetSearchAttributes {
+	if mmGetSearchAttributes.mock.funcGetSearchAttributes != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by Set")
+	}
+
+	if mmGetSearchAttributes.defaultExpectation == nil {
+		mmGetSearchAttributes.defaultExpectation = &ClientMockGetSearchAttributesExpectation{}
+	}
+
+	if mmGetSearchAttributes.defaultExpectation.params != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by Expect")
+	}
+
+	if mmGetSearchAttributes.defaultExpectation.paramPtrs == nil {
+		mmGetSearchAttributes.defaultExpectation.paramPtrs = &ClientMockGetSearchAttributesParamPtrs{}
+	}
+	mmGetSearchAttributes.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetSearchAttributes
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetSearchAttributes
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Inspect(f func(ctx context.Context)) *mClientMockGetSearchAttributes {
+	if mmGetSearchAttributes.mock.inspectFuncGetSearchAttributes != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("Inspect function is already set for ClientMock.GetSearchAttributes")
+	}
+
+	mmGetSearchAttributes.mock.inspectFuncGetSearchAttributes = f
+
+	return mmGetSearchAttributes
+}
+
+// Return sets up results that will be returned by Client.GetSearchAttributes
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Return(gp1 *workflowservice.GetSearchAttributesResponse, err error) *ClientMock {
+	if mmGetSearchAttributes.mock.funcGetSearchAttributes != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by Set")
+	}
+
+	if mmGetSearchAttributes.defaultExpectation == nil {
+		mmGetSearchAttributes.defaultExpectation = &ClientMockGetSearchAttributesExpectation{mock: mmGetSearchAttributes.mock}
+	}
+	mmGetSearchAttributes.defaultExpectation.results = &ClientMockGetSearchAttributesResults{gp1, err}
+	return mmGetSearchAttributes.mock
+}
+
+// Set uses given function f to mock the Client.GetSearchAttributes method
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Set(f func(ctx context.Context) (gp1 *workflowservice.GetSearchAttributesResponse, err error)) *ClientMock {
+	if mmGetSearchAttributes.defaultExpectation != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("Default expectation is already set for the Client.GetSearchAttributes method")
+	}
+
+	if len(mmGetSearchAttributes.expectations) > 0 {
+		mmGetSearchAttributes.mock.t.Fatalf("Some expectations are already set for the Client.GetSearchAttributes method")
+	}
+
+	mmGetSearchAttributes.mock.funcGetSearchAttributes = f
+	return mmGetSearchAttributes.mock
+}
+
+// When sets expectation for the Client.GetSearchAttributes which will trigger the result defined by the following
+// Then helper
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) When(ctx context.Context) *ClientMockGetSearchAttributesExpectation {
+	if mmGetSearchAttributes.mock.funcGetSearchAttributes != nil {
+		mmGetSearchAttributes.mock.t.Fatalf("ClientMock.GetSearchAttributes mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetSearchAttributesExpectation{
+		mock:   mmGetSearchAttributes.mock,
+		params: &ClientMockGetSearchAttributesParams{ctx},
+	}
+	mmGetSearchAttributes.expectations = append(mmGetSearchAttributes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetSearchAttributes return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetSearchAttributesExpectation) Then(gp1 *workflowservice.GetSearchAttributesResponse, err error) *ClientMock {
+	e.results = &ClientMockGetSearchAttributesResults{gp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.GetSearchAttributes should be invoked
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Times(n uint64) *mClientMockGetSearchAttributes {
+	if n == 0 {
+		mmGetSearchAttributes.mock.t.Fatalf("Times of ClientMock.GetSearchAttributes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSearchAttributes.expectedInvocations, n)
+	return mmGetSearchAttributes
+}
+
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) invocationsDone() bool {
+	if len(mmGetSearchAttributes.expectations) == 0 && mmGetSearchAttributes.defaultExpectation == nil && mmGetSearchAttributes.mock.funcGetSearchAttributes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSearchAttributes.mock.afterGetSearchAttributesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSearchAttributes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSearchAttributes implements client.Client
+func (mmGetSearchAttributes *ClientMock) GetSearchAttributes(ctx context.Context) (gp1 *workflowservice.GetSearchAttributesResponse, err error) {
+	mm_atomic.AddUint64(&mmGetSearchAttributes.beforeGetSearchAttributesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSearchAttributes.afterGetSearchAttributesCounter, 1)
+
+	if mmGetSearchAttributes.inspectFuncGetSearchAttributes != nil {
+		mmGetSearchAttributes.inspectFuncGetSearchAttributes(ctx)
+	}
+
+	mm_params := ClientMockGetSearchAttributesParams{ctx}
+
+	// Record call args
+	mmGetSearchAttributes.GetSearchAttributesMock.mutex.Lock()
+	mmGetSearchAttributes.GetSearchAttributesMock.callArgs = append(mmGetSearchAttributes.GetSearchAttributesMock.callArgs, &mm_params)
+	mmGetSearchAttributes.GetSearchAttributesMock.mutex.Unlock()
+
+	for _, e := range mmGetSearchAttributes.GetSearchAttributesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGetSearchAttributes.GetSearchAttributesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSearchAttributes.GetSearchAttributesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSearchAttributes.GetSearchAttributesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSearchAttributes.GetSearchAttributesMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockGetSearchAttributesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSearchAttributes.t.Errorf("ClientMock.GetSearchAttributes got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSearchAttributes.t.Errorf("ClientMock.GetSearchAttributes got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSearchAttributes.GetSearchAttributesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSearchAttributes.t.Fatal("No results are set for the ClientMock.GetSearchAttributes")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGetSearchAttributes.funcGetSearchAttributes != nil {
+		return mmGetSearchAttributes.funcGetSearchAttributes(ctx)
+	}
+	mmGetSearchAttributes.t.Fatalf("Unexpected call to ClientMock.GetSearchAttributes. %v", ctx)
+	return
+}
+
+// GetSearchAttributesAfterCounter returns a count of finished ClientMock.GetSearchAttributes invocations
+func (mmGetSearchAttributes *ClientMock) GetSearchAttributesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSearchAttributes.afterGetSearchAttributesCounter)
+}
+
+// GetSearchAttributesBeforeCounter returns a count of ClientMock.GetSearchAttributes invocations
+func (mmGetSearchAttributes *ClientMock) GetSearchAttributesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSearchAttributes.beforeGetSearchAttributesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetSearchAttributes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSearchAttributes *mClientMockGetSearchAttributes) Calls() []*ClientMockGetSearchAttributesParams {
+	mmGetSearchAttributes.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetSearchAttributesParams, len(mmGetSearchAttributes.callArgs))
+	copy(argCopy, mmGetSearchAttributes.callArgs)
+
+	mmGetSearchAttributes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSearchAttributesDone returns true if the count of the GetSearchAttributes invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetSearchAttributesDone() bool {
+	if m.GetSearchAttributesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSearchAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSearchAttributesMock.invocationsDone()
+}
+
+// MinimockGetSearchAttributesInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetSearchAttributesInspect() {
+	for _, e := range m.GetSearchAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetSearchAttributes with params: %#v", *e.params)
+		}
+	}
+
+	afterGetSearchAttributesCounter := mm_atomic.LoadUint64(&m.afterGetSearchAttributesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSearchAttributesMock.defaultExpectation != nil && afterGetSearchAttributesCounter < 1 {
+		if m.GetSearchAttributesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetSearchAttributes")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetSearchAttributes with params: %#v", *m.GetSearchAttributesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSearchAttributes != nil && afterGetSearchAttributesCounter < 1 {
+		m.t.Error("Expected call to ClientMock.GetSearchAttributes")
+	}
+
+	if !m.GetSearchAttributesMock.invocationsDone() && afterGetSearchAttributesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.GetSearchAttributes but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSearchAttributesMock.expectedInvocations), afterGetSearchAttributesCounter)
+	}
+}
+
+type mClientMockGetWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetWorkflowExpectation
+	expectations       []*ClientMockGetWorkflowExpectation
+
+	callArgs []*ClientMockGetWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockGetWorkflowExpectation specifies expectation struct of the Client.GetWorkflow
+type ClientMockGetWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockGetWorkflowParams
+	paramPtrs *ClientMockGetWorkflowParamPtrs
+	results   *ClientMockGetWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockGetWorkflowParams contains parameters of the Client.GetWorkflow
+type ClientMockGetWorkflowParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+}
+
+// ClientMockGetWorkflowParamPtrs contains pointers to parameters of the Client.GetWorkflow
+type ClientMockGetWorkflowParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+}
+
+// ClientMockGetWorkflowResults contains results of the Client.GetWorkflow
+type ClientMockGetWorkflowResults struct {
+	w1 mm_client.WorkflowRun
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWorkflow *mClientMockGetWorkflow) Optional() *mClientMockGetWorkflow {
+	mmGetWorkflow.optional = true
+	return mmGetWorkflow
+}
+
+// Expect sets up expected params for Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) Expect(ctx context.Context, workflowID string, runID string) *mClientMockGetWorkflow {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	if mmGetWorkflow.defaultExpectation == nil {
+		mmGetWorkflow.defaultExpectation = &ClientMockGetWorkflowExpectation{}
+	}
+
+	if mmGetWorkflow.defaultExpectation.paramPtrs != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmGetWorkflow.defaultExpectation.params = &ClientMockGetWorkflowParams{ctx, workflowID, runID}
+	for _, e := range mmGetWorkflow.expectations {
+		if minimock.Equal(e.params, mmGetWorkflow.defaultExpectation.params) {
+			mmGetWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockGetWorkflow {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	if mmGetWorkflow.defaultExpectation == nil {
+		mmGetWorkflow.defaultExpectation = &ClientMockGetWorkflowExpectation{}
+	}
+
+	if mmGetWorkflow.defaultExpectation.params != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Expect")
+	}
+
+	if mmGetWorkflow.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflow.defaultExpectation.paramPtrs = &ClientMockGetWorkflowParamPtrs{}
+	}
+	mmGetWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockGetWorkflow {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	if mmGetWorkflow.defaultExpectation == nil {
+		mmGetWorkflow.defaultExpectation = &ClientMockGetWorkflowExpectation{}
+	}
+
+	if mmGetWorkflow.defaultExpectation.params != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Expect")
+	}
+
+	if mmGetWorkflow.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflow.defaultExpectation.paramPtrs = &ClientMockGetWorkflowParamPtrs{}
+	}
+	mmGetWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmGetWorkflow
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) ExpectRunIDParam3(runID string) *mClientMockGetWorkflow {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	if mmGetWorkflow.defaultExpectation == nil {
+		mmGetWorkflow.defaultExpectation = &ClientMockGetWorkflowExpectation{}
+	}
+
+	if mmGetWorkflow.defaultExpectation.params != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Expect")
+	}
+
+	if mmGetWorkflow.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflow.defaultExpectation.paramPtrs = &ClientMockGetWorkflowParamPtrs{}
+	}
+	mmGetWorkflow.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmGetWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) Inspect(f func(ctx context.Context, workflowID string, runID string)) *mClientMockGetWorkflow {
+	if mmGetWorkflow.mock.inspectFuncGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.GetWorkflow")
+	}
+
+	mmGetWorkflow.mock.inspectFuncGetWorkflow = f
+
+	return mmGetWorkflow
+}
+
+// Return sets up results that will be returned by Client.GetWorkflow
+func (mmGetWorkflow *mClientMockGetWorkflow) Return(w1 mm_client.WorkflowRun) *ClientMock {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	if mmGetWorkflow.defaultExpectation == nil {
+		mmGetWorkflow.defaultExpectation = &ClientMockGetWorkflowExpectation{mock: mmGetWorkflow.mock}
+	}
+	mmGetWorkflow.defaultExpectation.results = &ClientMockGetWorkflowResults{w1}
+	return mmGetWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.GetWorkflow method
+func (mmGetWorkflow *mClientMockGetWorkflow) Set(f func(ctx context.Context, workflowID string, runID string) (w1 mm_client.WorkflowRun)) *ClientMock {
+	if mmGetWorkflow.defaultExpectation != nil {
+		mmGetWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.GetWorkflow method")
+	}
+
+	if len(mmGetWorkflow.expectations) > 0 {
+		mmGetWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.GetWorkflow method")
+	}
+
+	mmGetWorkflow.mock.funcGetWorkflow = f
+	return mmGetWorkflow.mock
+}
+
+// When sets expectation for the Client.GetWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmGetWorkflow *mClientMockGetWorkflow) When(ctx context.Context, workflowID string, runID string) *ClientMockGetWorkflowExpectation {
+	if mmGetWorkflow.mock.funcGetWorkflow != nil {
+		mmGetWorkflow.mock.t.Fatalf("ClientMock.GetWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetWorkflowExpectation{
+		mock:   mmGetWorkflow.mock,
+		params: &ClientMockGetWorkflowParams{ctx, workflowID, runID},
+	}
+	mmGetWorkflow.expectations = append(mmGetWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetWorkflowExpectation) Then(w1 mm_client.WorkflowRun) *ClientMock {
+	e.results = &ClientMockGetWorkflowResults{w1}
+	return e.mock
+}
+
+// Times sets number of times Client.GetWorkflow should be invoked
+func (mmGetWorkflow *mClientMockGetWorkflow) Times(n uint64) *mClientMockGetWorkflow {
+	if n == 0 {
+		mmGetWorkflow.mock.t.Fatalf("Times of ClientMock.GetWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWorkflow.expectedInvocations, n)
+	return mmGetWorkflow
+}
+
+func (mmGetWorkflow *mClientMockGetWorkflow) invocationsDone() bool {
+	if len(mmGetWorkflow.expectations) == 0 && mmGetWorkflow.defaultExpectation == nil && mmGetWorkflow.mock.funcGetWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWorkflow.mock.afterGetWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWorkflow implements client.Client
+func (mmGetWorkflow *ClientMock) GetWorkflow(ctx context.Context, workflowID string, runID string) (w1 mm_client.WorkflowRun) {
+	mm_atomic.AddUint64(&mmGetWorkflow.beforeGetWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWorkflow.afterGetWorkflowCounter, 1)
+
+	if mmGetWorkflow.inspectFuncGetWorkflow != nil {
+		mmGetWorkflow.inspectFuncGetWorkflow(ctx, workflowID, runID)
+	}
+
+	mm_params := ClientMockGetWorkflowParams{ctx, workflowID, runID}
+
+	// Record call args
+	mmGetWorkflow.GetWorkflowMock.mutex.Lock()
+	mmGetWorkflow.GetWorkflowMock.callArgs = append(mmGetWorkflow.GetWorkflowMock.callArgs, &mm_params)
+	mmGetWorkflow.GetWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmGetWorkflow.GetWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1
+		}
+	}
+
+	if mmGetWorkflow.GetWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWorkflow.GetWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWorkflow.GetWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWorkflow.GetWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockGetWorkflowParams{ctx, workflowID, runID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWorkflow.t.Errorf("ClientMock.GetWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmGetWorkflow.t.Errorf("ClientMock.GetWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmGetWorkflow.t.Errorf("ClientMock.GetWorkflow got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWorkflow.t.Errorf("ClientMock.GetWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWorkflow.GetWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWorkflow.t.Fatal("No results are set for the ClientMock.GetWorkflow")
+		}
+		return (*mm_results).w1
+	}
+	if mmGetWorkflow.funcGetWorkflow != nil {
+		return mmGetWorkflow.funcGetWorkflow(ctx, workflowID, runID)
+	}
+	mmGetWorkflow.t.Fatalf("Unexpected call to ClientMock.GetWorkflow. %v %v %v", ctx, workflowID, runID)
+	return
+}
+
+// GetWorkflowAfterCounter returns a count of finished ClientMock.GetWorkflow invocations
+func (mmGetWorkflow *ClientMock) GetWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWorkflow.afterGetWorkflowCounter)
+}
+
+// GetWorkflowBeforeCounter returns a count of ClientMock.GetWorkflow invocations
+func (mmGetWorkflow *ClientMock) GetWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWorkflow.beforeGetWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWorkflow *mClientMockGetWorkflow) Calls() []*ClientMockGetWorkflowParams {
+	mmGetWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetWorkflowParams, len(mmGetWorkflow.callArgs))
+	copy(argCopy, mmGetWorkflow.callArgs)
+
+	mmGetWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWorkflowDone returns true if the count of the GetWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetWorkflowDone() bool {
+	if m.GetWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWorkflowMock.invocationsDone()
+}
+
+// MinimockGetWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetWorkflowInspect() {
+	for _, e := range m.GetWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterGetWorkflowCounter := mm_atomic.LoadUint64(&m.afterGetWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWorkflowMock.defaultExpectation != nil && afterGetWorkflowCounter < 1 {
+		if m.GetWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetWorkflow with params: %#v", *m.GetWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWorkflow != nil && afterGetWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.GetWorkflow")
+	}
+
+	if !m.GetWorkflowMock.invocationsDone() && afterGetWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.GetWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWorkflowMock.expectedInvocations), afterGetWorkflowCounter)
+	}
+}
+
+type mClientMockGetWorkflowHistory struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetWorkflowHistoryExpectation
+	expectations       []*ClientMockGetWorkflowHistoryExpectation
+
+	callArgs []*ClientMockGetWorkflowHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockGetWorkflowHistoryExpectation specifies expectation struct of the Client.GetWorkflowHistory
+type ClientMockGetWorkflowHistoryExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockGetWorkflowHistoryParams
+	paramPtrs *ClientMockGetWorkflowHistoryParamPtrs
+	results   *ClientMockGetWorkflowHistoryResults
+	Counter   uint64
+}
+
+// ClientMockGetWorkflowHistoryParams contains parameters of the Client.GetWorkflowHistory
+type ClientMockGetWorkflowHistoryParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+	isLongPoll bool
+	filterType enumspb.HistoryEventFilterType
+}
+
+// ClientMockGetWorkflowHistoryParamPtrs contains pointers to parameters of the Client.GetWorkflowHistory
+type ClientMockGetWorkflowHistoryParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+	isLongPoll *bool
+	filterType *enumspb.HistoryEventFilterType
+}
+
+// ClientMockGetWorkflowHistoryResults contains results of the Client.GetWorkflowHistory
+type ClientMockGetWorkflowHistoryResults struct {
+	h1 mm_client.HistoryEventIterator
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Optional() *mClientMockGetWorkflowHistory {
+	mmGetWorkflowHistory.optional = true
+	return mmGetWorkflowHistory
+}
+
+// Expect sets up expected params for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Expect(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetWorkflowHistory.defaultExpectation.params = &ClientMockGetWorkflowHistoryParams{ctx, workflowID, runID, isLongPoll, filterType}
+	for _, e := range mmGetWorkflowHistory.expectations {
+		if minimock.Equal(e.params, mmGetWorkflowHistory.defaultExpectation.params) {
+			mmGetWorkflowHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWorkflowHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWorkflowHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) ExpectCtxParam1(ctx context.Context) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.params != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Expect")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflowHistory.defaultExpectation.paramPtrs = &ClientMockGetWorkflowHistoryParamPtrs{}
+	}
+	mmGetWorkflowHistory.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetWorkflowHistory
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) ExpectWorkflowIDParam2(workflowID string) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.params != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Expect")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflowHistory.defaultExpectation.paramPtrs = &ClientMockGetWorkflowHistoryParamPtrs{}
+	}
+	mmGetWorkflowHistory.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmGetWorkflowHistory
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) ExpectRunIDParam3(runID string) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.params != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Expect")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflowHistory.defaultExpectation.paramPtrs = &ClientMockGetWorkflowHistoryParamPtrs{}
+	}
+	mmGetWorkflowHistory.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmGetWorkflowHistory
+}
+
+// ExpectIsLongPollParam4 sets up expected param isLongPoll for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) ExpectIsLongPollParam4(isLongPoll bool) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.params != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Expect")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflowHistory.defaultExpectation.paramPtrs = &ClientMockGetWorkflowHistoryParamPtrs{}
+	}
+	mmGetWorkflowHistory.defaultExpectation.paramPtrs.isLongPoll = &isLongPoll
+
+	return mmGetWorkflowHistory
+}
+
+// ExpectFilterTypeParam5 sets up expected param filterType for Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) ExpectFilterTypeParam5(filterType enumspb.HistoryEventFilterType) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{}
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.params != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Expect")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation.paramPtrs == nil {
+		mmGetWorkflowHistory.defaultExpectation.paramPtrs = &ClientMockGetWorkflowHistoryParamPtrs{}
+	}
+	mmGetWorkflowHistory.defaultExpectation.paramPtrs.filterType = &filterType
+
+	return mmGetWorkflowHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Inspect(f func(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType)) *mClientMockGetWorkflowHistory {
+	if mmGetWorkflowHistory.mock.inspectFuncGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("Inspect function is already set for ClientMock.GetWorkflowHistory")
+	}
+
+	mmGetWorkflowHistory.mock.inspectFuncGetWorkflowHistory = f
+
+	return mmGetWorkflowHistory
+}
+
+// Return sets up results that will be returned by Client.GetWorkflowHistory
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Return(h1 mm_client.HistoryEventIterator) *ClientMock {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	if mmGetWorkflowHistory.defaultExpectation == nil {
+		mmGetWorkflowHistory.defaultExpectation = &ClientMockGetWorkflowHistoryExpectation{mock: mmGetWorkflowHistory.mock}
+	}
+	mmGetWorkflowHistory.defaultExpectation.results = &ClientMockGetWorkflowHistoryResults{h1}
+	return mmGetWorkflowHistory.mock
+}
+
+// Set uses given function f to mock the Client.GetWorkflowHistory method
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Set(f func(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType) (h1 mm_client.HistoryEventIterator)) *ClientMock {
+	if mmGetWorkflowHistory.defaultExpectation != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("Default expectation is already set for the Client.GetWorkflowHistory method")
+	}
+
+	if len(mmGetWorkflowHistory.expectations) > 0 {
+		mmGetWorkflowHistory.mock.t.Fatalf("Some expectations are already set for the Client.GetWorkflowHistory method")
+	}
+
+	mmGetWorkflowHistory.mock.funcGetWorkflowHistory = f
+	return mmGetWorkflowHistory.mock
+}
+
+// When sets expectation for the Client.GetWorkflowHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) When(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType) *ClientMockGetWorkflowHistoryExpectation {
+	if mmGetWorkflowHistory.mock.funcGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.mock.t.Fatalf("ClientMock.GetWorkflowHistory mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetWorkflowHistoryExpectation{
+		mock:   mmGetWorkflowHistory.mock,
+		params: &ClientMockGetWorkflowHistoryParams{ctx, workflowID, runID, isLongPoll, filterType},
+	}
+	mmGetWorkflowHistory.expectations = append(mmGetWorkflowHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetWorkflowHistory return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetWorkflowHistoryExpectation) Then(h1 mm_client.HistoryEventIterator) *ClientMock {
+	e.results = &ClientMockGetWorkflowHistoryResults{h1}
+	return e.mock
+}
+
+// Times sets number of times Client.GetWorkflowHistory should be invoked
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Times(n uint64) *mClientMockGetWorkflowHistory {
+	if n == 0 {
+		mmGetWorkflowHistory.mock.t.Fatalf("Times of ClientMock.GetWorkflowHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWorkflowHistory.expectedInvocations, n)
+	return mmGetWorkflowHistory
+}
+
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) invocationsDone() bool {
+	if len(mmGetWorkflowHistory.expectations) == 0 && mmGetWorkflowHistory.defaultExpectation == nil && mmGetWorkflowHistory.mock.funcGetWorkflowHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWorkflowHistory.mock.afterGetWorkflowHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWorkflowHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWorkflowHistory implements client.Client
+func (mmGetWorkflowHistory *ClientMock) GetWorkflowHistory(ctx context.Context, workflowID string, runID string, isLongPoll bool, filterType enumspb.HistoryEventFilterType) (h1 mm_client.HistoryEventIterator) {
+	mm_atomic.AddUint64(&mmGetWorkflowHistory.beforeGetWorkflowHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWorkflowHistory.afterGetWorkflowHistoryCounter, 1)
+
+	if mmGetWorkflowHistory.inspectFuncGetWorkflowHistory != nil {
+		mmGetWorkflowHistory.inspectFuncGetWorkflowHistory(ctx, workflowID, runID, isLongPoll, filterType)
+	}
+
+	mm_params := ClientMockGetWorkflowHistoryParams{ctx, workflowID, runID, isLongPoll, filterType}
+
+	// Record call args
+	mmGetWorkflowHistory.GetWorkflowHistoryMock.mutex.Lock()
+	mmGetWorkflowHistory.GetWorkflowHistoryMock.callArgs = append(mmGetWorkflowHistory.GetWorkflowHistoryMock.callArgs, &mm_params)
+	mmGetWorkflowHistory.GetWorkflowHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetWorkflowHistory.GetWorkflowHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1
+		}
+	}
+
+	if mmGetWorkflowHistory.GetWorkflowHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWorkflowHistory.GetWorkflowHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWorkflowHistory.GetWorkflowHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWorkflowHistory.GetWorkflowHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockGetWorkflowHistoryParams{ctx, workflowID, runID, isLongPoll, filterType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.isLongPoll != nil && !minimock.Equal(*mm_want_ptrs.isLongPoll, mm_got.isLongPoll) {
+				mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameter isLongPoll, want: %#v, got: %#v%s\n", *mm_want_ptrs.isLongPoll, mm_got.isLongPoll, minimock.Diff(*mm_want_ptrs.isLongPoll, mm_got.isLongPoll))
+			}
+
+			if mm_want_ptrs.filterType != nil && !minimock.Equal(*mm_want_ptrs.filterType, mm_got.filterType) {
+				mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameter filterType, want: %#v, got: %#v%s\n", *mm_want_ptrs.filterType, mm_got.filterType, minimock.Diff(*mm_want_ptrs.filterType, mm_got.filterType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWorkflowHistory.t.Errorf("ClientMock.GetWorkflowHistory got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWorkflowHistory.GetWorkflowHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWorkflowHistory.t.Fatal("No results are set for the ClientMock.GetWorkflowHistory")
+		}
+		return (*mm_results).h1
+	}
+	if mmGetWorkflowHistory.funcGetWorkflowHistory != nil {
+		return mmGetWorkflowHistory.funcGetWorkflowHistory(ctx, workflowID, runID, isLongPoll, filterType)
+	}
+	mmGetWorkflowHistory.t.Fatalf("Unexpected call to ClientMock.GetWorkflowHistory. %v %v %v %v %v", ctx, workflowID, runID, isLongPoll, filterType)
+	return
+}
+
+// GetWorkflowHistoryAfterCounter returns a count of finished ClientMock.GetWorkflowHistory invocations
+func (mmGetWorkflowHistory *ClientMock) GetWorkflowHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWorkflowHistory.afterGetWorkflowHistoryCounter)
+}
+
+// GetWorkflowHistoryBeforeCounter returns a count of ClientMock.GetWorkflowHistory invocations
+func (mmGetWorkflowHistory *ClientMock) GetWorkflowHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWorkflowHistory.beforeGetWorkflowHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetWorkflowHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWorkflowHistory *mClientMockGetWorkflowHistory) Calls() []*ClientMockGetWorkflowHistoryParams {
+	mmGetWorkflowHistory.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetWorkflowHistoryParams, len(mmGetWorkflowHistory.callArgs))
+	copy(argCopy, mmGetWorkflowHistory.callArgs)
+
+	mmGetWorkflowHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWorkflowHistoryDone returns true if the count of the GetWorkflowHistory invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetWorkflowHistoryDone() bool {
+	if m.GetWorkflowHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWorkflowHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWorkflowHistoryMock.invocationsDone()
+}
+
+// MinimockGetWorkflowHistoryInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetWorkflowHistoryInspect() {
+	for _, e := range m.GetWorkflowHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetWorkflowHistory with params: %#v", *e.params)
+		}
+	}
+
+	afterGetWorkflowHistoryCounter := mm_atomic.LoadUint64(&m.afterGetWorkflowHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWorkflowHistoryMock.defaultExpectation != nil && afterGetWorkflowHistoryCounter < 1 {
+		if m.GetWorkflowHistoryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetWorkflowHistory")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetWorkflowHistory with params: %#v", *m.GetWorkflowHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWorkflowHistory != nil && afterGetWorkflowHistoryCounter < 1 {
+		m.t.Error("Expected call to ClientMock.GetWorkflowHistory")
+	}
+
+	if !m.GetWorkflowHistoryMock.invocationsDone() && afterGetWorkflowHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.GetWorkflowHistory but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWorkflowHistoryMock.expectedInvocations), afterGetWorkflowHistoryCounter)
+	}
+}
+
+type mClientMockListArchivedWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockListArchivedWorkflowExpectation
+	expectations       []*ClientMockListArchivedWorkflowExpectation
+
+	callArgs []*ClientMockListArchivedWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockListArchivedWorkflowExpectation specifies expectation struct of the Client.ListArchivedWorkflow
+type ClientMockListArchivedWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockListArchivedWorkflowParams
+	paramPtrs *ClientMockListArchivedWorkflowParamPtrs
+	results   *ClientMockListArchivedWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockListArchivedWorkflowParams contains parameters of the Client.ListArchivedWorkflow
+type ClientMockListArchivedWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.ListArchivedWorkflowExecutionsRequest
+}
+
+// ClientMockListArchivedWorkflowParamPtrs contains pointers to parameters of the Client.ListArchivedWorkflow
+type ClientMockListArchivedWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ListArchivedWorkflowExecutionsRequest
+}
+
+// ClientMockListArchivedWorkflowResults contains results of the Client.ListArchivedWorkflow
+type ClientMockListArchivedWorkflowResults struct {
+	lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Optional() *mClientMockListArchivedWorkflow {
+	mmListArchivedWorkflow.optional = true
+	return mmListArchivedWorkflow
+}
+
+// Expect sets up expected params for Client.ListArchivedWorkflow
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Expect(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) *mClientMockListArchivedWorkflow {
+	if mmListArchivedWorkflow.mock.funcListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Set")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation == nil {
+		mmListArchivedWorkflow.defaultExpectation = &ClientMockListArchivedWorkflowExpectation{}
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation.paramPtrs != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmListArchivedWorkflow.defaultExpectation.params = &ClientMockListArchivedWorkflowParams{ctx, request}
+	for _, e := range mmListArchivedWorkflow.expectations {
+		if minimock.Equal(e.params, mmListArchivedWorkflow.defaultExpectation.params) {
+			mmListArchivedWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListArchivedWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmListArchivedWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ListArchivedWorkflow
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockListArchivedWorkflow {
+	if mmListArchivedWorkflow.mock.funcListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Set")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation == nil {
+		mmListArchivedWorkflow.defaultExpectation = &ClientMockListArchivedWorkflowExpectation{}
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation.params != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Expect")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListArchivedWorkflow.defaultExpectation.paramPtrs = &ClientMockListArchivedWorkflowParamPtrs{}
+	}
+	mmListArchivedWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListArchivedWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ListArchivedWorkflow
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) ExpectRequestParam2(request *workflowservice.ListArchivedWorkflowExecutionsRequest) *mClientMockListArchivedWorkflow {
+	if mmListArchivedWorkflow.mock.funcListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Set")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation == nil {
+		mmListArchivedWorkflow.defaultExpectation = &ClientMockListArchivedWorkflowExpectation{}
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation.params != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Expect")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListArchivedWorkflow.defaultExpectation.paramPtrs = &ClientMockListArchivedWorkflowParamPtrs{}
+	}
+	mmListArchivedWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmListArchivedWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ListArchivedWorkflow
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest)) *mClientMockListArchivedWorkflow {
+	if mmListArchivedWorkflow.mock.inspectFuncListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ListArchivedWorkflow")
+	}
+
+	mmListArchivedWorkflow.mock.inspectFuncListArchivedWorkflow = f
+
+	return mmListArchivedWorkflow
+}
+
+// Return sets up results that will be returned by Client.ListArchivedWorkflow
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Return(lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmListArchivedWorkflow.mock.funcListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Set")
+	}
+
+	if mmListArchivedWorkflow.defaultExpectation == nil {
+		mmListArchivedWorkflow.defaultExpectation = &ClientMockListArchivedWorkflowExpectation{mock: mmListArchivedWorkflow.mock}
+	}
+	mmListArchivedWorkflow.defaultExpectation.results = &ClientMockListArchivedWorkflowResults{lp1, err}
+	return mmListArchivedWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ListArchivedWorkflow method
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Set(f func(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) (lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmListArchivedWorkflow.defaultExpectation != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ListArchivedWorkflow method")
+	}
+
+	if len(mmListArchivedWorkflow.expectations) > 0 {
+		mmListArchivedWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ListArchivedWorkflow method")
+	}
+
+	mmListArchivedWorkflow.mock.funcListArchivedWorkflow = f
+	return mmListArchivedWorkflow.mock
+}
+
+// When sets expectation for the Client.ListArchivedWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) When(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) *ClientMockListArchivedWorkflowExpectation {
+	if mmListArchivedWorkflow.mock.funcListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.mock.t.Fatalf("ClientMock.ListArchivedWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockListArchivedWorkflowExpectation{
+		mock:   mmListArchivedWorkflow.mock,
+		params: &ClientMockListArchivedWorkflowParams{ctx, request},
+	}
+	mmListArchivedWorkflow.expectations = append(mmListArchivedWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ListArchivedWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockListArchivedWorkflowExpectation) Then(lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockListArchivedWorkflowResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ListArchivedWorkflow should be invoked
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Times(n uint64) *mClientMockListArchivedWorkflow {
+	if n == 0 {
+		mmListArchivedWorkflow.mock.t.Fatalf("Times of ClientMock.ListArchivedWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListArchivedWorkflow.expectedInvocations, n)
+	return mmListArchivedWorkflow
+}
+
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) invocationsDone() bool {
+	if len(mmListArchivedWorkflow.expectations) == 0 && mmListArchivedWorkflow.defaultExpectation == nil && mmListArchivedWorkflow.mock.funcListArchivedWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListArchivedWorkflow.mock.afterListArchivedWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListArchivedWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListArchivedWorkflow implements client.Client
+func (mmListArchivedWorkflow *ClientMock) ListArchivedWorkflow(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) (lp1 *workflowservice.ListArchivedWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmListArchivedWorkflow.beforeListArchivedWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmListArchivedWorkflow.afterListArchivedWorkflowCounter, 1)
+
+	if mmListArchivedWorkflow.inspectFuncListArchivedWorkflow != nil {
+		mmListArchivedWorkflow.inspectFuncListArchivedWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockListArchivedWorkflowParams{ctx, request}
+
+	// Record call args
+	mmListArchivedWorkflow.ListArchivedWorkflowMock.mutex.Lock()
+	mmListArchivedWorkflow.ListArchivedWorkflowMock.callArgs = append(mmListArchivedWorkflow.ListArchivedWorkflowMock.callArgs, &mm_params)
+	mmListArchivedWorkflow.ListArchivedWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmListArchivedWorkflow.ListArchivedWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListArchivedWorkflow.ListArchivedWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListArchivedWorkflow.ListArchivedWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmListArchivedWorkflow.ListArchivedWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmListArchivedWorkflow.ListArchivedWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockListArchivedWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListArchivedWorkflow.t.Errorf("ClientMock.ListArchivedWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmListArchivedWorkflow.t.Errorf("ClientMock.ListArchivedWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListArchivedWorkflow.t.Errorf("ClientMock.ListArchivedWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListArchivedWorkflow.ListArchivedWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListArchivedWorkflow.t.Fatal("No results are set for the ClientMock.ListArchivedWorkflow")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListArchivedWorkflow.funcListArchivedWorkflow != nil {
+		return mmListArchivedWorkflow.funcListArchivedWorkflow(ctx, request)
+	}
+	mmListArchivedWorkflow.t.Fatalf("Unexpected call to ClientMock.ListArchivedWorkflow. %v %v", ctx, request)
+	return
+}
+
+// ListArchivedWorkflowAfterCounter returns a count of finished ClientMock.ListArchivedWorkflow invocations
+func (mmListArchivedWorkflow *ClientMock) ListArchivedWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListArchivedWorkflow.afterListArchivedWorkflowCounter)
+}
+
+// ListArchivedWorkflowBeforeCounter returns a count of ClientMock.ListArchivedWorkflow invocations
+func (mmListArchivedWorkflow *ClientMock) ListArchivedWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListArchivedWorkflow.beforeListArchivedWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ListArchivedWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListArchivedWorkflow *mClientMockListArchivedWorkflow) Calls() []*ClientMockListArchivedWorkflowParams {
+	mmListArchivedWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockListArchivedWorkflowParams, len(mmListArchivedWorkflow.callArgs))
+	copy(argCopy, mmListArchivedWorkflow.callArgs)
+
+	mmListArchivedWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListArchivedWorkflowDone returns true if the count of the ListArchivedWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockListArchivedWorkflowDone() bool {
+	if m.ListArchivedWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListArchivedWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListArchivedWorkflowMock.invocationsDone()
+}
+
+// MinimockListArchivedWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockListArchivedWorkflowInspect() {
+	for _, e := range m.ListArchivedWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ListArchivedWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterListArchivedWorkflowCounter := mm_atomic.LoadUint64(&m.afterListArchivedWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListArchivedWorkflowMock.defaultExpectation != nil && afterListArchivedWorkflowCounter < 1 {
+		if m.ListArchivedWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ListArchivedWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ListArchivedWorkflow with params: %#v", *m.ListArchivedWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListArchivedWorkflow != nil && afterListArchivedWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ListArchivedWorkflow")
+	}
+
+	if !m.ListArchivedWorkflowMock.invocationsDone() && afterListArchivedWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ListArchivedWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ListArchivedWorkflowMock.expectedInvocations), afterListArchivedWorkflowCounter)
+	}
+}
+
+type mClientMockListClosedWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockListClosedWorkflowExpectation
+	expectations       []*ClientMockListClosedWorkflowExpectation
+
+	callArgs []*ClientMockListClosedWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockListClosedWorkflowExpectation specifies expectation struct of the Client.ListClosedWorkflow
+type ClientMockListClosedWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockListClosedWorkflowParams
+	paramPtrs *ClientMockListClosedWorkflowParamPtrs
+	results   *ClientMockListClosedWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockListClosedWorkflowParams contains parameters of the Client.ListClosedWorkflow
+type ClientMockListClosedWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.ListClosedWorkflowExecutionsRequest
+}
+
+// ClientMockListClosedWorkflowParamPtrs contains pointers to parameters of the Client.ListClosedWorkflow
+type ClientMockListClosedWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ListClosedWorkflowExecutionsRequest
+}
+
+// ClientMockListClosedWorkflowResults contains results of the Client.ListClosedWorkflow
+type ClientMockListClosedWorkflowResults struct {
+	lp1 *workflowservice.ListClosedWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Optional() *mClientMockListClosedWorkflow {
+	mmListClosedWorkflow.optional = true
+	return mmListClosedWorkflow
+}
+
+// Expect sets up expected params for Client.ListClosedWorkflow
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Expect(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest) *mClientMockListClosedWorkflow {
+	if mmListClosedWorkflow.mock.funcListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Set")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation == nil {
+		mmListClosedWorkflow.defaultExpectation = &ClientMockListClosedWorkflowExpectation{}
+	}
+
+	if mmListClosedWorkflow.defaultExpectation.paramPtrs != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmListClosedWorkflow.defaultExpectation.params = &ClientMockListClosedWorkflowParams{ctx, request}
+	for _, e := range mmListClosedWorkflow.expectations {
+		if minimock.Equal(e.params, mmListClosedWorkflow.defaultExpectation.params) {
+			mmListClosedWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListClosedWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmListClosedWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ListClosedWorkflow
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockListClosedWorkflow {
+	if mmListClosedWorkflow.mock.funcListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Set")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation == nil {
+		mmListClosedWorkflow.defaultExpectation = &ClientMockListClosedWorkflowExpectation{}
+	}
+
+	if mmListClosedWorkflow.defaultExpectation.params != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Expect")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListClosedWorkflow.defaultExpectation.paramPtrs = &ClientMockListClosedWorkflowParamPtrs{}
+	}
+	mmListClosedWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListClosedWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ListClosedWorkflow
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) ExpectRequestParam2(request *workflowservice.ListClosedWorkflowExecutionsRequest) *mClientMockListClosedWorkflow {
+	if mmListClosedWorkflow.mock.funcListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Set")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation == nil {
+		mmListClosedWorkflow.defaultExpectation = &ClientMockListClosedWorkflowExpectation{}
+	}
+
+	if mmListClosedWorkflow.defaultExpectation.params != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Expect")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListClosedWorkflow.defaultExpectation.paramPtrs = &ClientMockListClosedWorkflowParamPtrs{}
+	}
+	mmListClosedWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmListClosedWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ListClosedWorkflow
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest)) *mClientMockListClosedWorkflow {
+	if mmListClosedWorkflow.mock.inspectFuncListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ListClosedWorkflow")
+	}
+
+	mmListClosedWorkflow.mock.inspectFuncListClosedWorkflow = f
+
+	return mmListClosedWorkflow
+}
+
+// Return sets up results that will be returned by Client.ListClosedWorkflow
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Return(lp1 *workflowservice.ListClosedWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmListClosedWorkflow.mock.funcListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Set")
+	}
+
+	if mmListClosedWorkflow.defaultExpectation == nil {
+		mmListClosedWorkflow.defaultExpectation = &ClientMockListClosedWorkflowExpectation{mock: mmListClosedWorkflow.mock}
+	}
+	mmListClosedWorkflow.defaultExpectation.results = &ClientMockListClosedWorkflowResults{lp1, err}
+	return mmListClosedWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ListClosedWorkflow method
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Set(f func(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest) (lp1 *workflowservice.ListClosedWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmListClosedWorkflow.defaultExpectation != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ListClosedWorkflow method")
+	}
+
+	if len(mmListClosedWorkflow.expectations) > 0 {
+		mmListClosedWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ListClosedWorkflow method")
+	}
+
+	mmListClosedWorkflow.mock.funcListClosedWorkflow = f
+	return mmListClosedWorkflow.mock
+}
+
+// When sets expectation for the Client.ListClosedWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) When(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest) *ClientMockListClosedWorkflowExpectation {
+	if mmListClosedWorkflow.mock.funcListClosedWorkflow != nil {
+		mmListClosedWorkflow.mock.t.Fatalf("ClientMock.ListClosedWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockListClosedWorkflowExpectation{
+		mock:   mmListClosedWorkflow.mock,
+		params: &ClientMockListClosedWorkflowParams{ctx, request},
+	}
+	mmListClosedWorkflow.expectations = append(mmListClosedWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ListClosedWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockListClosedWorkflowExpectation) Then(lp1 *workflowservice.ListClosedWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockListClosedWorkflowResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ListClosedWorkflow should be invoked
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Times(n uint64) *mClientMockListClosedWorkflow {
+	if n == 0 {
+		mmListClosedWorkflow.mock.t.Fatalf("Times of ClientMock.ListClosedWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListClosedWorkflow.expectedInvocations, n)
+	return mmListClosedWorkflow
+}
+
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) invocationsDone() bool {
+	if len(mmListClosedWorkflow.expectations) == 0 && mmListClosedWorkflow.defaultExpectation == nil && mmListClosedWorkflow.mock.funcListClosedWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListClosedWorkflow.mock.afterListClosedWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListClosedWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListClosedWorkflow implements client.Client
+func (mmListClosedWorkflow *ClientMock) ListClosedWorkflow(ctx context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest) (lp1 *workflowservice.ListClosedWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmListClosedWorkflow.beforeListClosedWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmListClosedWorkflow.afterListClosedWorkflowCounter, 1)
+
+	if mmListClosedWorkflow.inspectFuncListClosedWorkflow != nil {
+		mmListClosedWorkflow.inspectFuncListClosedWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockListClosedWorkflowParams{ctx, request}
+
+	// Record call args
+	mmListClosedWorkflow.ListClosedWorkflowMock.mutex.Lock()
+	mmListClosedWorkflow.ListClosedWorkflowMock.callArgs = append(mmListClosedWorkflow.ListClosedWorkflowMock.callArgs, &mm_params)
+	mmListClosedWorkflow.ListClosedWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmListClosedWorkflow.ListClosedWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListClosedWorkflow.ListClosedWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListClosedWorkflow.ListClosedWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmListClosedWorkflow.ListClosedWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmListClosedWorkflow.ListClosedWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockListClosedWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListClosedWorkflow.t.Errorf("ClientMock.ListClosedWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmListClosedWorkflow.t.Errorf("ClientMock.ListClosedWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListClosedWorkflow.t.Errorf("ClientMock.ListClosedWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListClosedWorkflow.ListClosedWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListClosedWorkflow.t.Fatal("No results are set for the ClientMock.ListClosedWorkflow")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListClosedWorkflow.funcListClosedWorkflow != nil {
+		return mmListClosedWorkflow.funcListClosedWorkflow(ctx, request)
+	}
+	mmListClosedWorkflow.t.Fatalf("Unexpected call to ClientMock.ListClosedWorkflow. %v %v", ctx, request)
+	return
+}
+
+// ListClosedWorkflowAfterCounter returns a count of finished ClientMock.ListClosedWorkflow invocations
+func (mmListClosedWorkflow *ClientMock) ListClosedWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListClosedWorkflow.afterListClosedWorkflowCounter)
+}
+
+// ListClosedWorkflowBeforeCounter returns a count of ClientMock.ListClosedWorkflow invocations
+func (mmListClosedWorkflow *ClientMock) ListClosedWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListClosedWorkflow.beforeListClosedWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ListClosedWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListClosedWorkflow *mClientMockListClosedWorkflow) Calls() []*ClientMockListClosedWorkflowParams {
+	mmListClosedWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockListClosedWorkflowParams, len(mmListClosedWorkflow.callArgs))
+	copy(argCopy, mmListClosedWorkflow.callArgs)
+
+	mmListClosedWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListClosedWorkflowDone returns true if the count of the ListClosedWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockListClosedWorkflowDone() bool {
+	if m.ListClosedWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListClosedWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListClosedWorkflowMock.invocationsDone()
+}
+
+// MinimockListClosedWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockListClosedWorkflowInspect() {
+	for _, e := range m.ListClosedWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ListClosedWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterListClosedWorkflowCounter := mm_atomic.LoadUint64(&m.afterListClosedWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListClosedWorkflowMock.defaultExpectation != nil && afterListClosedWorkflowCounter < 1 {
+		if m.ListClosedWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ListClosedWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ListClosedWorkflow with params: %#v", *m.ListClosedWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListClosedWorkflow != nil && afterListClosedWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ListClosedWorkflow")
+	}
+
+	if !m.ListClosedWorkflowMock.invocationsDone() && afterListClosedWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ListClosedWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ListClosedWorkflowMock.expectedInvocations), afterListClosedWorkflowCounter)
+	}
+}
+
+type mClientMockListOpenWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockListOpenWorkflowExpectation
+	expectations       []*ClientMockListOpenWorkflowExpectation
+
+	callArgs []*ClientMockListOpenWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockListOpenWorkflowExpectation specifies expectation struct of the Client.ListOpenWorkflow
+type ClientMockListOpenWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockListOpenWorkflowParams
+	paramPtrs *ClientMockListOpenWorkflowParamPtrs
+	results   *ClientMockListOpenWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockListOpenWorkflowParams contains parameters of the Client.ListOpenWorkflow
+type ClientMockListOpenWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.ListOpenWorkflowExecutionsRequest
+}
+
+// ClientMockListOpenWorkflowParamPtrs contains pointers to parameters of the Client.ListOpenWorkflow
+type ClientMockListOpenWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ListOpenWorkflowExecutionsRequest
+}
+
+// ClientMockListOpenWorkflowResults contains results of the Client.ListOpenWorkflow
+type ClientMockListOpenWorkflowResults struct {
+	lp1 *workflowservice.ListOpenWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Optional() *mClientMockListOpenWorkflow {
+	mmListOpenWorkflow.optional = true
+	return mmListOpenWorkflow
+}
+
+// Expect sets up expected params for Client.ListOpenWorkflow
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Expect(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) *mClientMockListOpenWorkflow {
+	if mmListOpenWorkflow.mock.funcListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Set")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation == nil {
+		mmListOpenWorkflow.defaultExpectation = &ClientMockListOpenWorkflowExpectation{}
+	}
+
+	if mmListOpenWorkflow.defaultExpectation.paramPtrs != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmListOpenWorkflow.defaultExpectation.params = &ClientMockListOpenWorkflowParams{ctx, request}
+	for _, e := range mmListOpenWorkflow.expectations {
+		if minimock.Equal(e.params, mmListOpenWorkflow.defaultExpectation.params) {
+			mmListOpenWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOpenWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmListOpenWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ListOpenWorkflow
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockListOpenWorkflow {
+	if mmListOpenWorkflow.mock.funcListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Set")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation == nil {
+		mmListOpenWorkflow.defaultExpectation = &ClientMockListOpenWorkflowExpectation{}
+	}
+
+	if mmListOpenWorkflow.defaultExpectation.params != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Expect")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListOpenWorkflow.defaultExpectation.paramPtrs = &ClientMockListOpenWorkflowParamPtrs{}
+	}
+	mmListOpenWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListOpenWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ListOpenWorkflow
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) ExpectRequestParam2(request *workflowservice.ListOpenWorkflowExecutionsRequest) *mClientMockListOpenWorkflow {
+	if mmListOpenWorkflow.mock.funcListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Set")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation == nil {
+		mmListOpenWorkflow.defaultExpectation = &ClientMockListOpenWorkflowExpectation{}
+	}
+
+	if mmListOpenWorkflow.defaultExpectation.params != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Expect")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListOpenWorkflow.defaultExpectation.paramPtrs = &ClientMockListOpenWorkflowParamPtrs{}
+	}
+	mmListOpenWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmListOpenWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ListOpenWorkflow
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest)) *mClientMockListOpenWorkflow {
+	if mmListOpenWorkflow.mock.inspectFuncListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ListOpenWorkflow")
+	}
+
+	mmListOpenWorkflow.mock.inspectFuncListOpenWorkflow = f
+
+	return mmListOpenWorkflow
+}
+
+// Return sets up results that will be returned by Client.ListOpenWorkflow
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Return(lp1 *workflowservice.ListOpenWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmListOpenWorkflow.mock.funcListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Set")
+	}
+
+	if mmListOpenWorkflow.defaultExpectation == nil {
+		mmListOpenWorkflow.defaultExpectation = &ClientMockListOpenWorkflowExpectation{mock: mmListOpenWorkflow.mock}
+	}
+	mmListOpenWorkflow.defaultExpectation.results = &ClientMockListOpenWorkflowResults{lp1, err}
+	return mmListOpenWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ListOpenWorkflow method
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Set(f func(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) (lp1 *workflowservice.ListOpenWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmListOpenWorkflow.defaultExpectation != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ListOpenWorkflow method")
+	}
+
+	if len(mmListOpenWorkflow.expectations) > 0 {
+		mmListOpenWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ListOpenWorkflow method")
+	}
+
+	mmListOpenWorkflow.mock.funcListOpenWorkflow = f
+	return mmListOpenWorkflow.mock
+}
+
+// When sets expectation for the Client.ListOpenWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) When(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) *ClientMockListOpenWorkflowExpectation {
+	if mmListOpenWorkflow.mock.funcListOpenWorkflow != nil {
+		mmListOpenWorkflow.mock.t.Fatalf("ClientMock.ListOpenWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockListOpenWorkflowExpectation{
+		mock:   mmListOpenWorkflow.mock,
+		params: &ClientMockListOpenWorkflowParams{ctx, request},
+	}
+	mmListOpenWorkflow.expectations = append(mmListOpenWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ListOpenWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockListOpenWorkflowExpectation) Then(lp1 *workflowservice.ListOpenWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockListOpenWorkflowResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ListOpenWorkflow should be invoked
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Times(n uint64) *mClientMockListOpenWorkflow {
+	if n == 0 {
+		mmListOpenWorkflow.mock.t.Fatalf("Times of ClientMock.ListOpenWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOpenWorkflow.expectedInvocations, n)
+	return mmListOpenWorkflow
+}
+
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) invocationsDone() bool {
+	if len(mmListOpenWorkflow.expectations) == 0 && mmListOpenWorkflow.defaultExpectation == nil && mmListOpenWorkflow.mock.funcListOpenWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOpenWorkflow.mock.afterListOpenWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOpenWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOpenWorkflow implements client.Client
+func (mmListOpenWorkflow *ClientMock) ListOpenWorkflow(ctx context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) (lp1 *workflowservice.ListOpenWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmListOpenWorkflow.beforeListOpenWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOpenWorkflow.afterListOpenWorkflowCounter, 1)
+
+	if mmListOpenWorkflow.inspectFuncListOpenWorkflow != nil {
+		mmListOpenWorkflow.inspectFuncListOpenWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockListOpenWorkflowParams{ctx, request}
+
+	// Record call args
+	mmListOpenWorkflow.ListOpenWorkflowMock.mutex.Lock()
+	mmListOpenWorkflow.ListOpenWorkflowMock.callArgs = append(mmListOpenWorkflow.ListOpenWorkflowMock.callArgs, &mm_params)
+	mmListOpenWorkflow.ListOpenWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmListOpenWorkflow.ListOpenWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListOpenWorkflow.ListOpenWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOpenWorkflow.ListOpenWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOpenWorkflow.ListOpenWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmListOpenWorkflow.ListOpenWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockListOpenWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOpenWorkflow.t.Errorf("ClientMock.ListOpenWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmListOpenWorkflow.t.Errorf("ClientMock.ListOpenWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOpenWorkflow.t.Errorf("ClientMock.ListOpenWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOpenWorkflow.ListOpenWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOpenWorkflow.t.Fatal("No results are set for the ClientMock.ListOpenWorkflow")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListOpenWorkflow.funcListOpenWorkflow != nil {
+		return mmListOpenWorkflow.funcListOpenWorkflow(ctx, request)
+	}
+	mmListOpenWorkflow.t.Fatalf("Unexpected call to ClientMock.ListOpenWorkflow. %v %v", ctx, request)
+	return
+}
+
+// ListOpenWorkflowAfterCounter returns a count of finished ClientMock.ListOpenWorkflow invocations
+func (mmListOpenWorkflow *ClientMock) ListOpenWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOpenWorkflow.afterListOpenWorkflowCounter)
+}
+
+// ListOpenWorkflowBeforeCounter returns a count of ClientMock.ListOpenWorkflow invocations
+func (mmListOpenWorkflow *ClientMock) ListOpenWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOpenWorkflow.beforeListOpenWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ListOpenWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOpenWorkflow *mClientMockListOpenWorkflow) Calls() []*ClientMockListOpenWorkflowParams {
+	mmListOpenWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockListOpenWorkflowParams, len(mmListOpenWorkflow.callArgs))
+	copy(argCopy, mmListOpenWorkflow.callArgs)
+
+	mmListOpenWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOpenWorkflowDone returns true if the count of the ListOpenWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockListOpenWorkflowDone() bool {
+	if m.ListOpenWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOpenWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOpenWorkflowMock.invocationsDone()
+}
+
+// MinimockListOpenWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockListOpenWorkflowInspect() {
+	for _, e := range m.ListOpenWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ListOpenWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterListOpenWorkflowCounter := mm_atomic.LoadUint64(&m.afterListOpenWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOpenWorkflowMock.defaultExpectation != nil && afterListOpenWorkflowCounter < 1 {
+		if m.ListOpenWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ListOpenWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ListOpenWorkflow with params: %#v", *m.ListOpenWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOpenWorkflow != nil && afterListOpenWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ListOpenWorkflow")
+	}
+
+	if !m.ListOpenWorkflowMock.invocationsDone() && afterListOpenWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ListOpenWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOpenWorkflowMock.expectedInvocations), afterListOpenWorkflowCounter)
+	}
+}
+
+type mClientMockListWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockListWorkflowExpectation
+	expectations       []*ClientMockListWorkflowExpectation
+
+	callArgs []*ClientMockListWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockListWorkflowExpectation specifies expectation struct of the Client.ListWorkflow
+type ClientMockListWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockListWorkflowParams
+	paramPtrs *ClientMockListWorkflowParamPtrs
+	results   *ClientMockListWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockListWorkflowParams contains parameters of the Client.ListWorkflow
+type ClientMockListWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.ListWorkflowExecutionsRequest
+}
+
+// ClientMockListWorkflowParamPtrs contains pointers to parameters of the Client.ListWorkflow
+type ClientMockListWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ListWorkflowExecutionsRequest
+}
+
+// ClientMockListWorkflowResults contains results of the Client.ListWorkflow
+type ClientMockListWorkflowResults struct {
+	lp1 *workflowservice.ListWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListWorkflow *mClientMockListWorkflow) Optional() *mClientMockListWorkflow {
+	mmListWorkflow.optional = true
+	return mmListWorkflow
+}
+
+// Expect sets up expected params for Client.ListWorkflow
+func (mmListWorkflow *mClientMockListWorkflow) Expect(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) *mClientMockListWorkflow {
+	if mmListWorkflow.mock.funcListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Set")
+	}
+
+	if mmListWorkflow.defaultExpectation == nil {
+		mmListWorkflow.defaultExpectation = &ClientMockListWorkflowExpectation{}
+	}
+
+	if mmListWorkflow.defaultExpectation.paramPtrs != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmListWorkflow.defaultExpectation.params = &ClientMockListWorkflowParams{ctx, request}
+	for _, e := range mmListWorkflow.expectations {
+		if minimock.Equal(e.params, mmListWorkflow.defaultExpectation.params) {
+			mmListWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmListWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ListWorkflow
+func (mmListWorkflow *mClientMockListWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockListWorkflow {
+	if mmListWorkflow.mock.funcListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Set")
+	}
+
+	if mmListWorkflow.defaultExpectation == nil {
+		mmListWorkflow.defaultExpectation = &ClientMockListWorkflowExpectation{}
+	}
+
+	if mmListWorkflow.defaultExpectation.params != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Expect")
+	}
+
+	if mmListWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListWorkflow.defaultExpectation.paramPtrs = &ClientMockListWorkflowParamPtrs{}
+	}
+	mmListWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ListWorkflow
+func (mmListWorkflow *mClientMockListWorkflow) ExpectRequestParam2(request *workflowservice.ListWorkflowExecutionsRequest) *mClientMockListWorkflow {
+	if mmListWorkflow.mock.funcListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Set")
+	}
+
+	if mmListWorkflow.defaultExpectation == nil {
+		mmListWorkflow.defaultExpectation = &ClientMockListWorkflowExpectation{}
+	}
+
+	if mmListWorkflow.defaultExpectation.params != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Expect")
+	}
+
+	if mmListWorkflow.defaultExpectation.paramPtrs == nil {
+		mmListWorkflow.defaultExpectation.paramPtrs = &ClientMockListWorkflowParamPtrs{}
+	}
+	mmListWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmListWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ListWorkflow
+func (mmListWorkflow *mClientMockListWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest)) *mClientMockListWorkflow {
+	if mmListWorkflow.mock.inspectFuncListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ListWorkflow")
+	}
+
+	mmListWorkflow.mock.inspectFuncListWorkflow = f
+
+	return mmListWorkflow
+}
+
+// Return sets up results that will be returned by Client.ListWorkflow
+func (mmListWorkflow *mClientMockListWorkflow) Return(lp1 *workflowservice.ListWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmListWorkflow.mock.funcListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Set")
+	}
+
+	if mmListWorkflow.defaultExpectation == nil {
+		mmListWorkflow.defaultExpectation = &ClientMockListWorkflowExpectation{mock: mmListWorkflow.mock}
+	}
+	mmListWorkflow.defaultExpectation.results = &ClientMockListWorkflowResults{lp1, err}
+	return mmListWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ListWorkflow method
+func (mmListWorkflow *mClientMockListWorkflow) Set(f func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (lp1 *workflowservice.ListWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmListWorkflow.defaultExpectation != nil {
+		mmListWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ListWorkflow method")
+	}
+
+	if len(mmListWorkflow.expectations) > 0 {
+		mmListWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ListWorkflow method")
+	}
+
+	mmListWorkflow.mock.funcListWorkflow = f
+	return mmListWorkflow.mock
+}
+
+// When sets expectation for the Client.ListWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmListWorkflow *mClientMockListWorkflow) When(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) *ClientMockListWorkflowExpectation {
+	if mmListWorkflow.mock.funcListWorkflow != nil {
+		mmListWorkflow.mock.t.Fatalf("ClientMock.ListWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockListWorkflowExpectation{
+		mock:   mmListWorkflow.mock,
+		params: &ClientMockListWorkflowParams{ctx, request},
+	}
+	mmListWorkflow.expectations = append(mmListWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ListWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockListWorkflowExpectation) Then(lp1 *workflowservice.ListWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockListWorkflowResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ListWorkflow should be invoked
+func (mmListWorkflow *mClientMockListWorkflow) Times(n uint64) *mClientMockListWorkflow {
+	if n == 0 {
+		mmListWorkflow.mock.t.Fatalf("Times of ClientMock.ListWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListWorkflow.expectedInvocations, n)
+	return mmListWorkflow
+}
+
+func (mmListWorkflow *mClientMockListWorkflow) invocationsDone() bool {
+	if len(mmListWorkflow.expectations) == 0 && mmListWorkflow.defaultExpectation == nil && mmListWorkflow.mock.funcListWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListWorkflow.mock.afterListWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListWorkflow implements client.Client
+func (mmListWorkflow *ClientMock) ListWorkflow(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (lp1 *workflowservice.ListWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmListWorkflow.beforeListWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmListWorkflow.afterListWorkflowCounter, 1)
+
+	if mmListWorkflow.inspectFuncListWorkflow != nil {
+		mmListWorkflow.inspectFuncListWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockListWorkflowParams{ctx, request}
+
+	// Record call args
+	mmListWorkflow.ListWorkflowMock.mutex.Lock()
+	mmListWorkflow.ListWorkflowMock.callArgs = append(mmListWorkflow.ListWorkflowMock.callArgs, &mm_params)
+	mmListWorkflow.ListWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmListWorkflow.ListWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListWorkflow.ListWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListWorkflow.ListWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmListWorkflow.ListWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmListWorkflow.ListWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockListWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListWorkflow.t.Errorf("ClientMock.ListWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmListWorkflow.t.Errorf("ClientMock.ListWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListWorkflow.t.Errorf("ClientMock.ListWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListWorkflow.ListWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListWorkflow.t.Fatal("No results are set for the ClientMock.ListWorkflow")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListWorkflow.funcListWorkflow != nil {
+		return mmListWorkflow.funcListWorkflow(ctx, request)
+	}
+	mmListWorkflow.t.Fatalf("Unexpected call to ClientMock.ListWorkflow. %v %v", ctx, request)
+	return
+}
+
+// ListWorkflowAfterCounter returns a count of finished ClientMock.ListWorkflow invocations
+func (mmListWorkflow *ClientMock) ListWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWorkflow.afterListWorkflowCounter)
+}
+
+// ListWorkflowBeforeCounter returns a count of ClientMock.ListWorkflow invocations
+func (mmListWorkflow *ClientMock) ListWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWorkflow.beforeListWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ListWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListWorkflow *mClientMockListWorkflow) Calls() []*ClientMockListWorkflowParams {
+	mmListWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockListWorkflowParams, len(mmListWorkflow.callArgs))
+	copy(argCopy, mmListWorkflow.callArgs)
+
+	mmListWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListWorkflowDone returns true if the count of the ListWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockListWorkflowDone() bool {
+	if m.ListWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListWorkflowMock.invocationsDone()
+}
+
+// MinimockListWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockListWorkflowInspect() {
+	for _, e := range m.ListWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ListWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterListWorkflowCounter := mm_atomic.LoadUint64(&m.afterListWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListWorkflowMock.defaultExpectation != nil && afterListWorkflowCounter < 1 {
+		if m.ListWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ListWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ListWorkflow with params: %#v", *m.ListWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListWorkflow != nil && afterListWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ListWorkflow")
+	}
+
+	if !m.ListWorkflowMock.invocationsDone() && afterListWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ListWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ListWorkflowMock.expectedInvocations), afterListWorkflowCounter)
+	}
+}
+
+type mClientMockOperatorService struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockOperatorServiceExpectation
+	expectations       []*ClientMockOperatorServiceExpectation
+
+	expectedInvocations uint64
+}
+
+// ClientMockOperatorServiceExpectation specifies expectation struct of the Client.OperatorService
+type ClientMockOperatorServiceExpectation struct {
+	mock *ClientMock
+
+	results *ClientMockOperatorServiceResults
+	Counter uint64
+}
+
+// ClientMockOperatorServiceResults contains results of the Client.OperatorService
+type ClientMockOperatorServiceResults struct {
+	o1 operatorservice.OperatorServiceClient
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOperatorService *mClientMockOperatorService) Optional() *mClientMockOperatorService {
+	mmOperatorService.optional = true
+	return mmOperatorService
+}
+
+// Expect sets up expected params for Client.OperatorService
+func (mmOperatorService *mClientMockOperatorService) Expect() *mClientMockOperatorService {
+	if mmOperatorService.mock.funcOperatorService != nil {
+		mmOperatorService.mock.t.Fatalf("ClientMock.OperatorService mock is already set by Set")
+	}
+
+	if mmOperatorService.defaultExpectation == nil {
+		mmOperatorService.defaultExpectation = &ClientMockOperatorServiceExpectation{}
+	}
+
+	return mmOperatorService
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.OperatorService
+func (mmOperatorService *mClientMockOperatorService) Inspect(f func()) *mClientMockOperatorService {
+	if mmOperatorService.mock.inspectFuncOperatorService != nil {
+		mmOperatorService.mock.t.Fatalf("Inspect function is already set for ClientMock.OperatorService")
+	}
+
+	mmOperatorService.mock.inspectFuncOperatorService = f
+
+	return mmOperatorService
+}
+
+// Return sets up results that will be returned by Client.OperatorService
+func (mmOperatorService *mClientMockOperatorService) Return(o1 operatorservice.OperatorServiceClient) *ClientMock {
+	if mmOperatorService.mock.funcOperatorService != nil {
+		mmOperatorService.mock.t.Fatalf("ClientMock.OperatorService mock is already set by Set")
+	}
+
+	if mmOperatorService.defaultExpectation == nil {
+		mmOperatorService.defaultExpectation = &ClientMockOperatorServiceExpectation{mock: mmOperatorService.mock}
+	}
+	mmOperatorService.defaultExpectation.results = &ClientMockOperatorServiceResults{o1}
+	return mmOperatorService.mock
+}
+
+// Set uses given function f to mock the Client.OperatorService method
+func (mmOperatorService *mClientMockOperatorService) Set(f func() (o1 operatorservice.OperatorServiceClient)) *ClientMock {
+	if mmOperatorService.defaultExpectation != nil {
+		mmOperatorService.mock.t.Fatalf("Default expectation is already set for the Client.OperatorService method")
+	}
+
+	if len(mmOperatorService.expectations) > 0 {
+		mmOperatorService.mock.t.Fatalf("Some expectations are already set for the Client.OperatorService method")
+	}
+
+	mmOperatorService.mock.funcOperatorService = f
+	return mmOperatorService.mock
+}
+
+// Times sets number of times Client.OperatorService should be invoked
+func (mmOperatorService *mClientMockOperatorService) Times(n uint64) *mClientMockOperatorService {
+	if n == 0 {
+		mmOperatorService.mock.t.Fatalf("Times of ClientMock.OperatorService mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOperatorService.expectedInvocations, n)
+	return mmOperatorService
+}
+
+func (mmOperatorService *mClientMockOperatorService) invocationsDone() bool {
+	if len(mmOperatorService.expectations) == 0 && mmOperatorService.defaultExpectation == nil && mmOperatorService.mock.funcOperatorService == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOperatorService.mock.afterOperatorServiceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOperatorService.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OperatorService implements client.Client
+func (mmOperatorService *ClientMock) OperatorService() (o1 operatorservice.OperatorServiceClient) {
+	mm_atomic.AddUint64(&mmOperatorService.beforeOperatorServiceCounter, 1)
+	defer mm_atomic.AddUint64(&mmOperatorService.afterOperatorServiceCounter, 1)
+
+	if mmOperatorService.inspectFuncOperatorService != nil {
+		mmOperatorService.inspectFuncOperatorService()
+	}
+
+	if mmOperatorService.OperatorServiceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOperatorService.OperatorServiceMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmOperatorService.OperatorServiceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOperatorService.t.Fatal("No results are set for the ClientMock.OperatorService")
+		}
+		return (*mm_results).o1
+	}
+	if mmOperatorService.funcOperatorService != nil {
+		return mmOperatorService.funcOperatorService()
+	}
+	mmOperatorService.t.Fatalf("Unexpected call to ClientMock.OperatorService.")
+	return
+}
+
+// OperatorServiceAfterCounter returns a count of finished ClientMock.OperatorService invocations
+func (mmOperatorService *ClientMock) OperatorServiceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOperatorService.afterOperatorServiceCounter)
+}
+
+// OperatorServiceBeforeCounter returns a count of ClientMock.OperatorService invocations
+func (mmOperatorService *ClientMock) OperatorServiceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOperatorService.beforeOperatorServiceCounter)
+}
+
+// MinimockOperatorServiceDone returns true if the count of the OperatorService invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockOperatorServiceDone() bool {
+	if m.OperatorServiceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OperatorServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OperatorServiceMock.invocationsDone()
+}
+
+// MinimockOperatorServiceInspect logs each unmet expectation
+func (m *ClientMock) MinimockOperatorServiceInspect() {
+	for _, e := range m.OperatorServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.OperatorService")
+		}
+	}
+
+	afterOperatorServiceCounter := mm_atomic.LoadUint64(&m.afterOperatorServiceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OperatorServiceMock.defaultExpectation != nil && afterOperatorServiceCounter < 1 {
+		m.t.Error("Expected call to ClientMock.OperatorService")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOperatorService != nil && afterOperatorServiceCounter < 1 {
+		m.t.Error("Expected call to ClientMock.OperatorService")
+	}
+
+	if !m.OperatorServiceMock.invocationsDone() && afterOperatorServiceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.OperatorService but found %d calls",
+			mm_atomic.LoadUint64(&m.OperatorServiceMock.expectedInvocations), afterOperatorServiceCounter)
+	}
+}
+
+type mClientMockQueryWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockQueryWorkflowExpectation
+	expectations       []*ClientMockQueryWorkflowExpectation
+
+	callArgs []*ClientMockQueryWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockQueryWorkflowExpectation specifies expectation struct of the Client.QueryWorkflow
+type ClientMockQueryWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockQueryWorkflowParams
+	paramPtrs *ClientMockQueryWorkflowParamPtrs
+	results   *ClientMockQueryWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockQueryWorkflowParams contains parameters of the Client.QueryWorkflow
+type ClientMockQueryWorkflowParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+	queryType  string
+	args       []interface{}
+}
+
+// ClientMockQueryWorkflowParamPtrs contains pointers to parameters of the Client.QueryWorkflow
+type ClientMockQueryWorkflowParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+	queryType  *string
+	args       *[]interface{}
+}
+
+// ClientMockQueryWorkflowResults contains results of the Client.QueryWorkflow
+type ClientMockQueryWorkflowResults struct {
+	e1  converter.EncodedValue
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Optional() *mClientMockQueryWorkflow {
+	mmQueryWorkflow.optional = true
+	return mmQueryWorkflow
+}
+
+// Expect sets up expected params for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Expect(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmQueryWorkflow.defaultExpectation.params = &ClientMockQueryWorkflowParams{ctx, workflowID, runID, queryType, args}
+	for _, e := range mmQueryWorkflow.expectations {
+		if minimock.Equal(e.params, mmQueryWorkflow.defaultExpectation.params) {
+			mmQueryWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQueryWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmQueryWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.params != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Expect")
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflow.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowParamPtrs{}
+	}
+	mmQueryWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmQueryWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.params != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Expect")
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflow.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowParamPtrs{}
+	}
+	mmQueryWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmQueryWorkflow
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) ExpectRunIDParam3(runID string) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.params != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Expect")
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflow.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowParamPtrs{}
+	}
+	mmQueryWorkflow.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmQueryWorkflow
+}
+
+// ExpectQueryTypeParam4 sets up expected param queryType for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) ExpectQueryTypeParam4(queryType string) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.params != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Expect")
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflow.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowParamPtrs{}
+	}
+	mmQueryWorkflow.defaultExpectation.paramPtrs.queryType = &queryType
+
+	return mmQueryWorkflow
+}
+
+// ExpectArgsParam5 sets up expected param args for Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) ExpectArgsParam5(args ...interface{}) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{}
+	}
+
+	if mmQueryWorkflow.defaultExpectation.params != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Expect")
+	}
+
+	if mmQueryWorkflow.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflow.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowParamPtrs{}
+	}
+	mmQueryWorkflow.defaultExpectation.paramPtrs.args = &args
+
+	return mmQueryWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Inspect(f func(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{})) *mClientMockQueryWorkflow {
+	if mmQueryWorkflow.mock.inspectFuncQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.QueryWorkflow")
+	}
+
+	mmQueryWorkflow.mock.inspectFuncQueryWorkflow = f
+
+	return mmQueryWorkflow
+}
+
+// Return sets up results that will be returned by Client.QueryWorkflow
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Return(e1 converter.EncodedValue, err error) *ClientMock {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	if mmQueryWorkflow.defaultExpectation == nil {
+		mmQueryWorkflow.defaultExpectation = &ClientMockQueryWorkflowExpectation{mock: mmQueryWorkflow.mock}
+	}
+	mmQueryWorkflow.defaultExpectation.results = &ClientMockQueryWorkflowResults{e1, err}
+	return mmQueryWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.QueryWorkflow method
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Set(f func(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) (e1 converter.EncodedValue, err error)) *ClientMock {
+	if mmQueryWorkflow.defaultExpectation != nil {
+		mmQueryWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.QueryWorkflow method")
+	}
+
+	if len(mmQueryWorkflow.expectations) > 0 {
+		mmQueryWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.QueryWorkflow method")
+	}
+
+	mmQueryWorkflow.mock.funcQueryWorkflow = f
+	return mmQueryWorkflow.mock
+}
+
+// When sets expectation for the Client.QueryWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmQueryWorkflow *mClientMockQueryWorkflow) When(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) *ClientMockQueryWorkflowExpectation {
+	if mmQueryWorkflow.mock.funcQueryWorkflow != nil {
+		mmQueryWorkflow.mock.t.Fatalf("ClientMock.QueryWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockQueryWorkflowExpectation{
+		mock:   mmQueryWorkflow.mock,
+		params: &ClientMockQueryWorkflowParams{ctx, workflowID, runID, queryType, args},
+	}
+	mmQueryWorkflow.expectations = append(mmQueryWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.QueryWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockQueryWorkflowExpectation) Then(e1 converter.EncodedValue, err error) *ClientMock {
+	e.results = &ClientMockQueryWorkflowResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.QueryWorkflow should be invoked
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Times(n uint64) *mClientMockQueryWorkflow {
+	if n == 0 {
+		mmQueryWorkflow.mock.t.Fatalf("Times of ClientMock.QueryWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQueryWorkflow.expectedInvocations, n)
+	return mmQueryWorkflow
+}
+
+func (mmQueryWorkflow *mClientMockQueryWorkflow) invocationsDone() bool {
+	if len(mmQueryWorkflow.expectations) == 0 && mmQueryWorkflow.defaultExpectation == nil && mmQueryWorkflow.mock.funcQueryWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQueryWorkflow.mock.afterQueryWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQueryWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QueryWorkflow implements client.Client
+func (mmQueryWorkflow *ClientMock) QueryWorkflow(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) (e1 converter.EncodedValue, err error) {
+	mm_atomic.AddUint64(&mmQueryWorkflow.beforeQueryWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmQueryWorkflow.afterQueryWorkflowCounter, 1)
+
+	if mmQueryWorkflow.inspectFuncQueryWorkflow != nil {
+		mmQueryWorkflow.inspectFuncQueryWorkflow(ctx, workflowID, runID, queryType, args...)
+	}
+
+	mm_params := ClientMockQueryWorkflowParams{ctx, workflowID, runID, queryType, args}
+
+	// Record call args
+	mmQueryWorkflow.QueryWorkflowMock.mutex.Lock()
+	mmQueryWorkflow.QueryWorkflowMock.callArgs = append(mmQueryWorkflow.QueryWorkflowMock.callArgs, &mm_params)
+	mmQueryWorkflow.QueryWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmQueryWorkflow.QueryWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmQueryWorkflow.QueryWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQueryWorkflow.QueryWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmQueryWorkflow.QueryWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmQueryWorkflow.QueryWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockQueryWorkflowParams{ctx, workflowID, runID, queryType, args}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.queryType != nil && !minimock.Equal(*mm_want_ptrs.queryType, mm_got.queryType) {
+				mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameter queryType, want: %#v, got: %#v%s\n", *mm_want_ptrs.queryType, mm_got.queryType, minimock.Diff(*mm_want_ptrs.queryType, mm_got.queryType))
+			}
+
+			if mm_want_ptrs.args != nil && !minimock.Equal(*mm_want_ptrs.args, mm_got.args) {
+				mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameter args, want: %#v, got: %#v%s\n", *mm_want_ptrs.args, mm_got.args, minimock.Diff(*mm_want_ptrs.args, mm_got.args))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryWorkflow.t.Errorf("ClientMock.QueryWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQueryWorkflow.QueryWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQueryWorkflow.t.Fatal("No results are set for the ClientMock.QueryWorkflow")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmQueryWorkflow.funcQueryWorkflow != nil {
+		return mmQueryWorkflow.funcQueryWorkflow(ctx, workflowID, runID, queryType, args...)
+	}
+	mmQueryWorkflow.t.Fatalf("Unexpected call to ClientMock.QueryWorkflow. %v %v %v %v %v", ctx, workflowID, runID, queryType, args)
+	return
+}
+
+// QueryWorkflowAfterCounter returns a count of finished ClientMock.QueryWorkflow invocations
+func (mmQueryWorkflow *ClientMock) QueryWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryWorkflow.afterQueryWorkflowCounter)
+}
+
+// QueryWorkflowBeforeCounter returns a count of ClientMock.QueryWorkflow invocations
+func (mmQueryWorkflow *ClientMock) QueryWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryWorkflow.beforeQueryWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.QueryWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQueryWorkflow *mClientMockQueryWorkflow) Calls() []*ClientMockQueryWorkflowParams {
+	mmQueryWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockQueryWorkflowParams, len(mmQueryWorkflow.callArgs))
+	copy(argCopy, mmQueryWorkflow.callArgs)
+
+	mmQueryWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryWorkflowDone returns true if the count of the QueryWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockQueryWorkflowDone() bool {
+	if m.QueryWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryWorkflowMock.invocationsDone()
+}
+
+// MinimockQueryWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockQueryWorkflowInspect() {
+	for _, e := range m.QueryWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.QueryWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterQueryWorkflowCounter := mm_atomic.LoadUint64(&m.afterQueryWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryWorkflowMock.defaultExpectation != nil && afterQueryWorkflowCounter < 1 {
+		if m.QueryWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.QueryWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.QueryWorkflow with params: %#v", *m.QueryWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQueryWorkflow != nil && afterQueryWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.QueryWorkflow")
+	}
+
+	if !m.QueryWorkflowMock.invocationsDone() && afterQueryWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.QueryWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryWorkflowMock.expectedInvocations), afterQueryWorkflowCounter)
+	}
+}
+
+type mClientMockQueryWorkflowWithOptions struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockQueryWorkflowWithOptionsExpectation
+	expectations       []*ClientMockQueryWorkflowWithOptionsExpectation
+
+	callArgs []*ClientMockQueryWorkflowWithOptionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockQueryWorkflowWithOptionsExpectation specifies expectation struct of the Client.QueryWorkflowWithOptions
+type ClientMockQueryWorkflowWithOptionsExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockQueryWorkflowWithOptionsParams
+	paramPtrs *ClientMockQueryWorkflowWithOptionsParamPtrs
+	results   *ClientMockQueryWorkflowWithOptionsResults
+	Counter   uint64
+}
+
+// ClientMockQueryWorkflowWithOptionsParams contains parameters of the Client.QueryWorkflowWithOptions
+type ClientMockQueryWorkflowWithOptionsParams struct {
+	ctx     context.Context
+	request *mm_client.QueryWorkflowWithOptionsRequest
+}
+
+// ClientMockQueryWorkflowWithOptionsParamPtrs contains pointers to parameters of the Client.QueryWorkflowWithOptions
+type ClientMockQueryWorkflowWithOptionsParamPtrs struct {
+	ctx     *context.Context
+	request **mm_client.QueryWorkflowWithOptionsRequest
+}
+
+// ClientMockQueryWorkflowWithOptionsResults contains results of the Client.QueryWorkflowWithOptions
+type ClientMockQueryWorkflowWithOptionsResults struct {
+	qp1 *mm_client.QueryWorkflowWithOptionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Optional() *mClientMockQueryWorkflowWithOptions {
+	mmQueryWorkflowWithOptions.optional = true
+	return mmQueryWorkflowWithOptions
+}
+
+// Expect sets up expected params for Client.QueryWorkflowWithOptions
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Expect(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest) *mClientMockQueryWorkflowWithOptions {
+	if mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation = &ClientMockQueryWorkflowWithOptionsExpectation{}
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by ExpectParams functions")
+	}
+
+	mmQueryWorkflowWithOptions.defaultExpectation.params = &ClientMockQueryWorkflowWithOptionsParams{ctx, request}
+	for _, e := range mmQueryWorkflowWithOptions.expectations {
+		if minimock.Equal(e.params, mmQueryWorkflowWithOptions.defaultExpectation.params) {
+			mmQueryWorkflowWithOptions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQueryWorkflowWithOptions.defaultExpectation.params)
+		}
+	}
+
+	return mmQueryWorkflowWithOptions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.QueryWorkflowWithOptions
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) ExpectCtxParam1(ctx context.Context) *mClientMockQueryWorkflowWithOptions {
+	if mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation = &ClientMockQueryWorkflowWithOptionsExpectation{}
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation.params != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Expect")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowWithOptionsParamPtrs{}
+	}
+	mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmQueryWorkflowWithOptions
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.QueryWorkflowWithOptions
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) ExpectRequestParam2(request *mm_client.QueryWorkflowWithOptionsRequest) *mClientMockQueryWorkflowWithOptions {
+	if mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation = &ClientMockQueryWorkflowWithOptionsExpectation{}
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation.params != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Expect")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs = &ClientMockQueryWorkflowWithOptionsParamPtrs{}
+	}
+	mmQueryWorkflowWithOptions.defaultExpectation.paramPtrs.request = &request
+
+	return mmQueryWorkflowWithOptions
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.QueryWorkflowWithOptions
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Inspect(f func(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest)) *mClientMockQueryWorkflowWithOptions {
+	if mmQueryWorkflowWithOptions.mock.inspectFuncQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("Inspect function is already set for ClientMock.QueryWorkflowWithOptions")
+	}
+
+	mmQueryWorkflowWithOptions.mock.inspectFuncQueryWorkflowWithOptions = f
+
+	return mmQueryWorkflowWithOptions
+}
+
+// Return sets up results that will be returned by Client.QueryWorkflowWithOptions
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Return(qp1 *mm_client.QueryWorkflowWithOptionsResponse, err error) *ClientMock {
+	if mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmQueryWorkflowWithOptions.defaultExpectation == nil {
+		mmQueryWorkflowWithOptions.defaultExpectation = &ClientMockQueryWorkflowWithOptionsExpectation{mock: mmQueryWorkflowWithOptions.mock}
+	}
+	mmQueryWorkflowWithOptions.defaultExpectation.results = &ClientMockQueryWorkflowWithOptionsResults{qp1, err}
+	return mmQueryWorkflowWithOptions.mock
+}
+
+// Set uses given function f to mock the Client.QueryWorkflowWithOptions method
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Set(f func(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest) (qp1 *mm_client.QueryWorkflowWithOptionsResponse, err error)) *ClientMock {
+	if mmQueryWorkflowWithOptions.defaultExpectation != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("Default expectation is already set for the Client.QueryWorkflowWithOptions method")
+	}
+
+	if len(mmQueryWorkflowWithOptions.expectations) > 0 {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("Some expectations are already set for the Client.QueryWorkflowWithOptions method")
+	}
+
+	mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions = f
+	return mmQueryWorkflowWithOptions.mock
+}
+
+// When sets expectation for the Client.QueryWorkflowWithOptions which will trigger the result defined by the following
+// Then helper
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) When(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest) *ClientMockQueryWorkflowWithOptionsExpectation {
+	if mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("ClientMock.QueryWorkflowWithOptions mock is already set by Set")
+	}
+
+	expectation := &ClientMockQueryWorkflowWithOptionsExpectation{
+		mock:   mmQueryWorkflowWithOptions.mock,
+		params: &ClientMockQueryWorkflowWithOptionsParams{ctx, request},
+	}
+	mmQueryWorkflowWithOptions.expectations = append(mmQueryWorkflowWithOptions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.QueryWorkflowWithOptions return parameters for the expectation previously defined by the When method
+func (e *ClientMockQueryWorkflowWithOptionsExpectation) Then(qp1 *mm_client.QueryWorkflowWithOptionsResponse, err error) *ClientMock {
+	e.results = &ClientMockQueryWorkflowWithOptionsResults{qp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.QueryWorkflowWithOptions should be invoked
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Times(n uint64) *mClientMockQueryWorkflowWithOptions {
+	if n == 0 {
+		mmQueryWorkflowWithOptions.mock.t.Fatalf("Times of ClientMock.QueryWorkflowWithOptions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQueryWorkflowWithOptions.expectedInvocations, n)
+	return mmQueryWorkflowWithOptions
+}
+
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) invocationsDone() bool {
+	if len(mmQueryWorkflowWithOptions.expectations) == 0 && mmQueryWorkflowWithOptions.defaultExpectation == nil && mmQueryWorkflowWithOptions.mock.funcQueryWorkflowWithOptions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQueryWorkflowWithOptions.mock.afterQueryWorkflowWithOptionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQueryWorkflowWithOptions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QueryWorkflowWithOptions implements client.Client
+func (mmQueryWorkflowWithOptions *ClientMock) QueryWorkflowWithOptions(ctx context.Context, request *mm_client.QueryWorkflowWithOptionsRequest) (qp1 *mm_client.QueryWorkflowWithOptionsResponse, err error) {
+	mm_atomic.AddUint64(&mmQueryWorkflowWithOptions.beforeQueryWorkflowWithOptionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmQueryWorkflowWithOptions.afterQueryWorkflowWithOptionsCounter, 1)
+
+	if mmQueryWorkflowWithOptions.inspectFuncQueryWorkflowWithOptions != nil {
+		mmQueryWorkflowWithOptions.inspectFuncQueryWorkflowWithOptions(ctx, request)
+	}
+
+	mm_params := ClientMockQueryWorkflowWithOptionsParams{ctx, request}
+
+	// Record call args
+	mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.mutex.Lock()
+	mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.callArgs = append(mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.callArgs, &mm_params)
+	mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.mutex.Unlock()
+
+	for _, e := range mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.qp1, e.results.err
+		}
+	}
+
+	if mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.defaultExpectation.params
+		mm_want_ptrs := mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockQueryWorkflowWithOptionsParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQueryWorkflowWithOptions.t.Errorf("ClientMock.QueryWorkflowWithOptions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmQueryWorkflowWithOptions.t.Errorf("ClientMock.QueryWorkflowWithOptions got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryWorkflowWithOptions.t.Errorf("ClientMock.QueryWorkflowWithOptions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQueryWorkflowWithOptions.QueryWorkflowWithOptionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQueryWorkflowWithOptions.t.Fatal("No results are set for the ClientMock.QueryWorkflowWithOptions")
+		}
+		return (*mm_results).qp1, (*mm_results).err
+	}
+	if mmQueryWorkflowWithOptions.funcQueryWorkflowWithOptions != nil {
+		return mmQueryWorkflowWithOptions.funcQueryWorkflowWithOptions(ctx, request)
+	}
+	mmQueryWorkflowWithOptions.t.Fatalf("Unexpected call to ClientMock.QueryWorkflowWithOptions. %v %v", ctx, request)
+	return
+}
+
+// QueryWorkflowWithOptionsAfterCounter returns a count of finished ClientMock.QueryWorkflowWithOptions invocations
+func (mmQueryWorkflowWithOptions *ClientMock) QueryWorkflowWithOptionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryWorkflowWithOptions.afterQueryWorkflowWithOptionsCounter)
+}
+
+// QueryWorkflowWithOptionsBeforeCounter returns a count of ClientMock.QueryWorkflowWithOptions invocations
+func (mmQueryWorkflowWithOptions *ClientMock) QueryWorkflowWithOptionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryWorkflowWithOptions.beforeQueryWorkflowWithOptionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.QueryWorkflowWithOptions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQueryWorkflowWithOptions *mClientMockQueryWorkflowWithOptions) Calls() []*ClientMockQueryWorkflowWithOptionsParams {
+	mmQueryWorkflowWithOptions.mutex.RLock()
+
+	argCopy := make([]*ClientMockQueryWorkflowWithOptionsParams, len(mmQueryWorkflowWithOptions.callArgs))
+	copy(argCopy, mmQueryWorkflowWithOptions.callArgs)
+
+	mmQueryWorkflowWithOptions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryWorkflowWithOptionsDone returns true if the count of the QueryWorkflowWithOptions invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockQueryWorkflowWithOptionsDone() bool {
+	if m.QueryWorkflowWithOptionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryWorkflowWithOptionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryWorkflowWithOptionsMock.invocationsDone()
+}
+
+// MinimockQueryWorkflowWithOptionsInspect logs each unmet expectation
+func (m *ClientMock) MinimockQueryWorkflowWithOptionsInspect() {
+	for _, e := range m.QueryWorkflowWithOptionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.QueryWorkflowWithOptions with params: %#v", *e.params)
+		}
+	}
+
+	afterQueryWorkflowWithOptionsCounter := mm_atomic.LoadUint64(&m.afterQueryWorkflowWithOptionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryWorkflowWithOptionsMock.defaultExpectation != nil && afterQueryWorkflowWithOptionsCounter < 1 {
+		if m.QueryWorkflowWithOptionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.QueryWorkflowWithOptions")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.QueryWorkflowWithOptions with params: %#v", *m.QueryWorkflowWithOptionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQueryWorkflowWithOptions != nil && afterQueryWorkflowWithOptionsCounter < 1 {
+		m.t.Error("Expected call to ClientMock.QueryWorkflowWithOptions")
+	}
+
+	if !m.QueryWorkflowWithOptionsMock.invocationsDone() && afterQueryWorkflowWithOptionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.QueryWorkflowWithOptions but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryWorkflowWithOptionsMock.expectedInvocations), afterQueryWorkflowWithOptionsCounter)
+	}
+}
+
+type mClientMockRecordActivityHeartbeat struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockRecordActivityHeartbeatExpectation
+	expectations       []*ClientMockRecordActivityHeartbeatExpectation
+
+	callArgs []*ClientMockRecordActivityHeartbeatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockRecordActivityHeartbeatExpectation specifies expectation struct of the Client.RecordActivityHeartbeat
+type ClientMockRecordActivityHeartbeatExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockRecordActivityHeartbeatParams
+	paramPtrs *ClientMockRecordActivityHeartbeatParamPtrs
+	results   *ClientMockRecordActivityHeartbeatResults
+	Counter   uint64
+}
+
+// ClientMockRecordActivityHeartbeatParams contains parameters of the Client.RecordActivityHeartbeat
+type ClientMockRecordActivityHeartbeatParams struct {
+	ctx       context.Context
+	taskToken []byte
+	details   []interface{}
+}
+
+// ClientMockRecordActivityHeartbeatParamPtrs contains pointers to parameters of the Client.RecordActivityHeartbeat
+type ClientMockRecordActivityHeartbeatParamPtrs struct {
+	ctx       *context.Context
+	taskToken *[]byte
+	details   *[]interface{}
+}
+
+// ClientMockRecordActivityHeartbeatResults contains results of the Client.RecordActivityHeartbeat
+type ClientMockRecordActivityHeartbeatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Optional() *mClientMockRecordActivityHeartbeat {
+	mmRecordActivityHeartbeat.optional = true
+	return mmRecordActivityHeartbeat
+}
+
+// Expect sets up expected params for Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Expect(ctx context.Context, taskToken []byte, details ...interface{}) *mClientMockRecordActivityHeartbeat {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation == nil {
+		mmRecordActivityHeartbeat.defaultExpectation = &ClientMockRecordActivityHeartbeatExpectation{}
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.paramPtrs != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by ExpectParams functions")
+	}
+
+	mmRecordActivityHeartbeat.defaultExpectation.params = &ClientMockRecordActivityHeartbeatParams{ctx, taskToken, details}
+	for _, e := range mmRecordActivityHeartbeat.expectations {
+		if minimock.Equal(e.params, mmRecordActivityHeartbeat.defaultExpectation.params) {
+			mmRecordActivityHeartbeat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordActivityHeartbeat.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordActivityHeartbeat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) ExpectCtxParam1(ctx context.Context) *mClientMockRecordActivityHeartbeat {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation == nil {
+		mmRecordActivityHeartbeat.defaultExpectation = &ClientMockRecordActivityHeartbeatExpectation{}
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeat.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatParamPtrs{}
+	}
+	mmRecordActivityHeartbeat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordActivityHeartbeat
+}
+
+// ExpectTaskTokenParam2 sets up expected param taskToken for Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) ExpectTaskTokenParam2(taskToken []byte) *mClientMockRecordActivityHeartbeat {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation == nil {
+		mmRecordActivityHeartbeat.defaultExpectation = &ClientMockRecordActivityHeartbeatExpectation{}
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeat.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatParamPtrs{}
+	}
+	mmRecordActivityHeartbeat.defaultExpectation.paramPtrs.taskToken = &taskToken
+
+	return mmRecordActivityHeartbeat
+}
+
+// ExpectDetailsParam3 sets up expected param details for Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) ExpectDetailsParam3(details ...interface{}) *mClientMockRecordActivityHeartbeat {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation == nil {
+		mmRecordActivityHeartbeat.defaultExpectation = &ClientMockRecordActivityHeartbeatExpectation{}
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeat.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatParamPtrs{}
+	}
+	mmRecordActivityHeartbeat.defaultExpectation.paramPtrs.details = &details
+
+	return mmRecordActivityHeartbeat
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Inspect(f func(ctx context.Context, taskToken []byte, details ...interface{})) *mClientMockRecordActivityHeartbeat {
+	if mmRecordActivityHeartbeat.mock.inspectFuncRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("Inspect function is already set for ClientMock.RecordActivityHeartbeat")
+	}
+
+	mmRecordActivityHeartbeat.mock.inspectFuncRecordActivityHeartbeat = f
+
+	return mmRecordActivityHeartbeat
+}
+
+// Return sets up results that will be returned by Client.RecordActivityHeartbeat
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Return(err error) *ClientMock {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeat.defaultExpectation == nil {
+		mmRecordActivityHeartbeat.defaultExpectation = &ClientMockRecordActivityHeartbeatExpectation{mock: mmRecordActivityHeartbeat.mock}
+	}
+	mmRecordActivityHeartbeat.defaultExpectation.results = &ClientMockRecordActivityHeartbeatResults{err}
+	return mmRecordActivityHeartbeat.mock
+}
+
+// Set uses given function f to mock the Client.RecordActivityHeartbeat method
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Set(f func(ctx context.Context, taskToken []byte, details ...interface{}) (err error)) *ClientMock {
+	if mmRecordActivityHeartbeat.defaultExpectation != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("Default expectation is already set for the Client.RecordActivityHeartbeat method")
+	}
+
+	if len(mmRecordActivityHeartbeat.expectations) > 0 {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("Some expectations are already set for the Client.RecordActivityHeartbeat method")
+	}
+
+	mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat = f
+	return mmRecordActivityHeartbeat.mock
+}
+
+// When sets expectation for the Client.RecordActivityHeartbeat which will trigger the result defined by the following
+// Then helper
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) When(ctx context.Context, taskToken []byte, details ...interface{}) *ClientMockRecordActivityHeartbeatExpectation {
+	if mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("ClientMock.RecordActivityHeartbeat mock is already set by Set")
+	}
+
+	expectation := &ClientMockRecordActivityHeartbeatExpectation{
+		mock:   mmRecordActivityHeartbeat.mock,
+		params: &ClientMockRecordActivityHeartbeatParams{ctx, taskToken, details},
+	}
+	mmRecordActivityHeartbeat.expectations = append(mmRecordActivityHeartbeat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.RecordActivityHeartbeat return parameters for the expectation previously defined by the When method
+func (e *ClientMockRecordActivityHeartbeatExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockRecordActivityHeartbeatResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.RecordActivityHeartbeat should be invoked
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Times(n uint64) *mClientMockRecordActivityHeartbeat {
+	if n == 0 {
+		mmRecordActivityHeartbeat.mock.t.Fatalf("Times of ClientMock.RecordActivityHeartbeat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordActivityHeartbeat.expectedInvocations, n)
+	return mmRecordActivityHeartbeat
+}
+
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) invocationsDone() bool {
+	if len(mmRecordActivityHeartbeat.expectations) == 0 && mmRecordActivityHeartbeat.defaultExpectation == nil && mmRecordActivityHeartbeat.mock.funcRecordActivityHeartbeat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordActivityHeartbeat.mock.afterRecordActivityHeartbeatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordActivityHeartbeat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordActivityHeartbeat implements client.Client
+func (mmRecordActivityHeartbeat *ClientMock) RecordActivityHeartbeat(ctx context.Context, taskToken []byte, details ...interface{}) (err error) {
+	mm_atomic.AddUint64(&mmRecordActivityHeartbeat.beforeRecordActivityHeartbeatCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordActivityHeartbeat.afterRecordActivityHeartbeatCounter, 1)
+
+	if mmRecordActivityHeartbeat.inspectFuncRecordActivityHeartbeat != nil {
+		mmRecordActivityHeartbeat.inspectFuncRecordActivityHeartbeat(ctx, taskToken, details...)
+	}
+
+	mm_params := ClientMockRecordActivityHeartbeatParams{ctx, taskToken, details}
+
+	// Record call args
+	mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.mutex.Lock()
+	mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.callArgs = append(mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.callArgs, &mm_params)
+	mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.mutex.Unlock()
+
+	for _, e := range mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockRecordActivityHeartbeatParams{ctx, taskToken, details}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordActivityHeartbeat.t.Errorf("ClientMock.RecordActivityHeartbeat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskToken != nil && !minimock.Equal(*mm_want_ptrs.taskToken, mm_got.taskToken) {
+				mmRecordActivityHeartbeat.t.Errorf("ClientMock.RecordActivityHeartbeat got unexpected parameter taskToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.taskToken, mm_got.taskToken, minimock.Diff(*mm_want_ptrs.taskToken, mm_got.taskToken))
+			}
+
+			if mm_want_ptrs.details != nil && !minimock.Equal(*mm_want_ptrs.details, mm_got.details) {
+				mmRecordActivityHeartbeat.t.Errorf("ClientMock.RecordActivityHeartbeat got unexpected parameter details, want: %#v, got: %#v%s\n", *mm_want_ptrs.details, mm_got.details, minimock.Diff(*mm_want_ptrs.details, mm_got.details))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordActivityHeartbeat.t.Errorf("ClientMock.RecordActivityHeartbeat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordActivityHeartbeat.RecordActivityHeartbeatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordActivityHeartbeat.t.Fatal("No results are set for the ClientMock.RecordActivityHeartbeat")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordActivityHeartbeat.funcRecordActivityHeartbeat != nil {
+		return mmRecordActivityHeartbeat.funcRecordActivityHeartbeat(ctx, taskToken, details...)
+	}
+	mmRecordActivityHeartbeat.t.Fatalf("Unexpected call to ClientMock.RecordActivityHeartbeat. %v %v %v", ctx, taskToken, details)
+	return
+}
+
+// RecordActivityHeartbeatAfterCounter returns a count of finished ClientMock.RecordActivityHeartbeat invocations
+func (mmRecordActivityHeartbeat *ClientMock) RecordActivityHeartbeatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordActivityHeartbeat.afterRecordActivityHeartbeatCounter)
+}
+
+// RecordActivityHeartbeatBeforeCounter returns a count of ClientMock.RecordActivityHeartbeat invocations
+func (mmRecordActivityHeartbeat *ClientMock) RecordActivityHeartbeatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordActivityHeartbeat.beforeRecordActivityHeartbeatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.RecordActivityHeartbeat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordActivityHeartbeat *mClientMockRecordActivityHeartbeat) Calls() []*ClientMockRecordActivityHeartbeatParams {
+	mmRecordActivityHeartbeat.mutex.RLock()
+
+	argCopy := make([]*ClientMockRecordActivityHeartbeatParams, len(mmRecordActivityHeartbeat.callArgs))
+	copy(argCopy, mmRecordActivityHeartbeat.callArgs)
+
+	mmRecordActivityHeartbeat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordActivityHeartbeatDone returns true if the count of the RecordActivityHeartbeat invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockRecordActivityHeartbeatDone() bool {
+	if m.RecordActivityHeartbeatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordActivityHeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordActivityHeartbeatMock.invocationsDone()
+}
+
+// MinimockRecordActivityHeartbeatInspect logs each unmet expectation
+func (m *ClientMock) MinimockRecordActivityHeartbeatInspect() {
+	for _, e := range m.RecordActivityHeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.RecordActivityHeartbeat with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordActivityHeartbeatCounter := mm_atomic.LoadUint64(&m.afterRecordActivityHeartbeatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordActivityHeartbeatMock.defaultExpectation != nil && afterRecordActivityHeartbeatCounter < 1 {
+		if m.RecordActivityHeartbeatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.RecordActivityHeartbeat")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.RecordActivityHeartbeat with params: %#v", *m.RecordActivityHeartbeatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordActivityHeartbeat != nil && afterRecordActivityHeartbeatCounter < 1 {
+		m.t.Error("Expected call to ClientMock.RecordActivityHeartbeat")
+	}
+
+	if !m.RecordActivityHeartbeatMock.invocationsDone() && afterRecordActivityHeartbeatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.RecordActivityHeartbeat but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordActivityHeartbeatMock.expectedInvocations), afterRecordActivityHeartbeatCounter)
+	}
+}
+
+type mClientMockRecordActivityHeartbeatByID struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockRecordActivityHeartbeatByIDExpectation
+	expectations       []*ClientMockRecordActivityHeartbeatByIDExpectation
+
+	callArgs []*ClientMockRecordActivityHeartbeatByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockRecordActivityHeartbeatByIDExpectation specifies expectation struct of the Client.RecordActivityHeartbeatByID
+type ClientMockRecordActivityHeartbeatByIDExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockRecordActivityHeartbeatByIDParams
+	paramPtrs *ClientMockRecordActivityHeartbeatByIDParamPtrs
+	results   *ClientMockRecordActivityHeartbeatByIDResults
+	Counter   uint64
+}
+
+// ClientMockRecordActivityHeartbeatByIDParams contains parameters of the Client.RecordActivityHeartbeatByID
+type ClientMockRecordActivityHeartbeatByIDParams struct {
+	ctx        context.Context
+	namespace  string
+	workflowID string
+	runID      string
+	activityID string
+	details    []interface{}
+}
+
+// ClientMockRecordActivityHeartbeatByIDParamPtrs contains pointers to parameters of the Client.RecordActivityHeartbeatByID
+type ClientMockRecordActivityHeartbeatByIDParamPtrs struct {
+	ctx        *context.Context
+	namespace  *string
+	workflowID *string
+	runID      *string
+	activityID *string
+	details    *[]interface{}
+}
+
+// ClientMockRecordActivityHeartbeatByIDResults contains results of the Client.RecordActivityHeartbeatByID
+type ClientMockRecordActivityHeartbeatByIDResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Optional() *mClientMockRecordActivityHeartbeatByID {
+	mmRecordActivityHeartbeatByID.optional = true
+	return mmRecordActivityHeartbeatByID
+}
+
+// Expect sets up expected params for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Expect(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{}) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by ExpectParams functions")
+	}
+
+	mmRecordActivityHeartbeatByID.defaultExpectation.params = &ClientMockRecordActivityHeartbeatByIDParams{ctx, namespace, workflowID, runID, activityID, details}
+	for _, e := range mmRecordActivityHeartbeatByID.expectations {
+		if minimock.Equal(e.params, mmRecordActivityHeartbeatByID.defaultExpectation.params) {
+			mmRecordActivityHeartbeatByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordActivityHeartbeatByID.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectCtxParam1(ctx context.Context) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectNamespaceParam2 sets up expected param namespace for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectNamespaceParam2(namespace string) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.namespace = &namespace
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectWorkflowIDParam3 sets up expected param workflowID for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectWorkflowIDParam3(workflowID string) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectRunIDParam4 sets up expected param runID for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectRunIDParam4(runID string) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectActivityIDParam5 sets up expected param activityID for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectActivityIDParam5(activityID string) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.activityID = &activityID
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// ExpectDetailsParam6 sets up expected param details for Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) ExpectDetailsParam6(details ...interface{}) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{}
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.params != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Expect")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs = &ClientMockRecordActivityHeartbeatByIDParamPtrs{}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.paramPtrs.details = &details
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Inspect(f func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{})) *mClientMockRecordActivityHeartbeatByID {
+	if mmRecordActivityHeartbeatByID.mock.inspectFuncRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("Inspect function is already set for ClientMock.RecordActivityHeartbeatByID")
+	}
+
+	mmRecordActivityHeartbeatByID.mock.inspectFuncRecordActivityHeartbeatByID = f
+
+	return mmRecordActivityHeartbeatByID
+}
+
+// Return sets up results that will be returned by Client.RecordActivityHeartbeatByID
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Return(err error) *ClientMock {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	if mmRecordActivityHeartbeatByID.defaultExpectation == nil {
+		mmRecordActivityHeartbeatByID.defaultExpectation = &ClientMockRecordActivityHeartbeatByIDExpectation{mock: mmRecordActivityHeartbeatByID.mock}
+	}
+	mmRecordActivityHeartbeatByID.defaultExpectation.results = &ClientMockRecordActivityHeartbeatByIDResults{err}
+	return mmRecordActivityHeartbeatByID.mock
+}
+
+// Set uses given function f to mock the Client.RecordActivityHeartbeatByID method
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Set(f func(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{}) (err error)) *ClientMock {
+	if mmRecordActivityHeartbeatByID.defaultExpectation != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("Default expectation is already set for the Client.RecordActivityHeartbeatByID method")
+	}
+
+	if len(mmRecordActivityHeartbeatByID.expectations) > 0 {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("Some expectations are already set for the Client.RecordActivityHeartbeatByID method")
+	}
+
+	mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID = f
+	return mmRecordActivityHeartbeatByID.mock
+}
+
+// When sets expectation for the Client.RecordActivityHeartbeatByID which will trigger the result defined by the following
+// Then helper
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) When(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{}) *ClientMockRecordActivityHeartbeatByIDExpectation {
+	if mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("ClientMock.RecordActivityHeartbeatByID mock is already set by Set")
+	}
+
+	expectation := &ClientMockRecordActivityHeartbeatByIDExpectation{
+		mock:   mmRecordActivityHeartbeatByID.mock,
+		params: &ClientMockRecordActivityHeartbeatByIDParams{ctx, namespace, workflowID, runID, activityID, details},
+	}
+	mmRecordActivityHeartbeatByID.expectations = append(mmRecordActivityHeartbeatByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.RecordActivityHeartbeatByID return parameters for the expectation previously defined by the When method
+func (e *ClientMockRecordActivityHeartbeatByIDExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockRecordActivityHeartbeatByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.RecordActivityHeartbeatByID should be invoked
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Times(n uint64) *mClientMockRecordActivityHeartbeatByID {
+	if n == 0 {
+		mmRecordActivityHeartbeatByID.mock.t.Fatalf("Times of ClientMock.RecordActivityHeartbeatByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordActivityHeartbeatByID.expectedInvocations, n)
+	return mmRecordActivityHeartbeatByID
+}
+
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) invocationsDone() bool {
+	if len(mmRecordActivityHeartbeatByID.expectations) == 0 && mmRecordActivityHeartbeatByID.defaultExpectation == nil && mmRecordActivityHeartbeatByID.mock.funcRecordActivityHeartbeatByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordActivityHeartbeatByID.mock.afterRecordActivityHeartbeatByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordActivityHeartbeatByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordActivityHeartbeatByID implements client.Client
+func (mmRecordActivityHeartbeatByID *ClientMock) RecordActivityHeartbeatByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, details ...interface{}) (err error) {
+	mm_atomic.AddUint64(&mmRecordActivityHeartbeatByID.beforeRecordActivityHeartbeatByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordActivityHeartbeatByID.afterRecordActivityHeartbeatByIDCounter, 1)
+
+	if mmRecordActivityHeartbeatByID.inspectFuncRecordActivityHeartbeatByID != nil {
+		mmRecordActivityHeartbeatByID.inspectFuncRecordActivityHeartbeatByID(ctx, namespace, workflowID, runID, activityID, details...)
+	}
+
+	mm_params := ClientMockRecordActivityHeartbeatByIDParams{ctx, namespace, workflowID, runID, activityID, details}
+
+	// Record call args
+	mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.mutex.Lock()
+	mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.callArgs = append(mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.callArgs, &mm_params)
+	mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.mutex.Unlock()
+
+	for _, e := range mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockRecordActivityHeartbeatByIDParams{ctx, namespace, workflowID, runID, activityID, details}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.namespace != nil && !minimock.Equal(*mm_want_ptrs.namespace, mm_got.namespace) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter namespace, want: %#v, got: %#v%s\n", *mm_want_ptrs.namespace, mm_got.namespace, minimock.Diff(*mm_want_ptrs.namespace, mm_got.namespace))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.activityID != nil && !minimock.Equal(*mm_want_ptrs.activityID, mm_got.activityID) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter activityID, want: %#v, got: %#v%s\n", *mm_want_ptrs.activityID, mm_got.activityID, minimock.Diff(*mm_want_ptrs.activityID, mm_got.activityID))
+			}
+
+			if mm_want_ptrs.details != nil && !minimock.Equal(*mm_want_ptrs.details, mm_got.details) {
+				mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameter details, want: %#v, got: %#v%s\n", *mm_want_ptrs.details, mm_got.details, minimock.Diff(*mm_want_ptrs.details, mm_got.details))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordActivityHeartbeatByID.t.Errorf("ClientMock.RecordActivityHeartbeatByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordActivityHeartbeatByID.RecordActivityHeartbeatByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordActivityHeartbeatByID.t.Fatal("No results are set for the ClientMock.RecordActivityHeartbeatByID")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordActivityHeartbeatByID.funcRecordActivityHeartbeatByID != nil {
+		return mmRecordActivityHeartbeatByID.funcRecordActivityHeartbeatByID(ctx, namespace, workflowID, runID, activityID, details...)
+	}
+	mmRecordActivityHeartbeatByID.t.Fatalf("Unexpected call to ClientMock.RecordActivityHeartbeatByID. %v %v %v %v %v %v", ctx, namespace, workflowID, runID, activityID, details)
+	return
+}
+
+// RecordActivityHeartbeatByIDAfterCounter returns a count of finished ClientMock.RecordActivityHeartbeatByID invocations
+func (mmRecordActivityHeartbeatByID *ClientMock) RecordActivityHeartbeatByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordActivityHeartbeatByID.afterRecordActivityHeartbeatByIDCounter)
+}
+
+// RecordActivityHeartbeatByIDBeforeCounter returns a count of ClientMock.RecordActivityHeartbeatByID invocations
+func (mmRecordActivityHeartbeatByID *ClientMock) RecordActivityHeartbeatByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordActivityHeartbeatByID.beforeRecordActivityHeartbeatByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.RecordActivityHeartbeatByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordActivityHeartbeatByID *mClientMockRecordActivityHeartbeatByID) Calls() []*ClientMockRecordActivityHeartbeatByIDParams {
+	mmRecordActivityHeartbeatByID.mutex.RLock()
+
+	argCopy := make([]*ClientMockRecordActivityHeartbeatByIDParams, len(mmRecordActivityHeartbeatByID.callArgs))
+	copy(argCopy, mmRecordActivityHeartbeatByID.callArgs)
+
+	mmRecordActivityHeartbeatByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordActivityHeartbeatByIDDone returns true if the count of the RecordActivityHeartbeatByID invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockRecordActivityHeartbeatByIDDone() bool {
+	if m.RecordActivityHeartbeatByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordActivityHeartbeatByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordActivityHeartbeatByIDMock.invocationsDone()
+}
+
+// MinimockRecordActivityHeartbeatByIDInspect logs each unmet expectation
+func (m *ClientMock) MinimockRecordActivityHeartbeatByIDInspect() {
+	for _, e := range m.RecordActivityHeartbeatByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.RecordActivityHeartbeatByID with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordActivityHeartbeatByIDCounter := mm_atomic.LoadUint64(&m.afterRecordActivityHeartbeatByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordActivityHeartbeatByIDMock.defaultExpectation != nil && afterRecordActivityHeartbeatByIDCounter < 1 {
+		if m.RecordActivityHeartbeatByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.RecordActivityHeartbeatByID")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.RecordActivityHeartbeatByID with params: %#v", *m.RecordActivityHeartbeatByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordActivityHeartbeatByID != nil && afterRecordActivityHeartbeatByIDCounter < 1 {
+		m.t.Error("Expected call to ClientMock.RecordActivityHeartbeatByID")
+	}
+
+	if !m.RecordActivityHeartbeatByIDMock.invocationsDone() && afterRecordActivityHeartbeatByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.RecordActivityHeartbeatByID but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordActivityHeartbeatByIDMock.expectedInvocations), afterRecordActivityHeartbeatByIDCounter)
+	}
+}
+
+type mClientMockResetWorkflowExecution struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockResetWorkflowExecutionExpectation
+	expectations       []*ClientMockResetWorkflowExecutionExpectation
+
+	callArgs []*ClientMockResetWorkflowExecutionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockResetWorkflowExecutionExpectation specifies expectation struct of the Client.ResetWorkflowExecution
+type ClientMockResetWorkflowExecutionExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockResetWorkflowExecutionParams
+	paramPtrs *ClientMockResetWorkflowExecutionParamPtrs
+	results   *ClientMockResetWorkflowExecutionResults
+	Counter   uint64
+}
+
+// ClientMockResetWorkflowExecutionParams contains parameters of the Client.ResetWorkflowExecution
+type ClientMockResetWorkflowExecutionParams struct {
+	ctx     context.Context
+	request *workflowservice.ResetWorkflowExecutionRequest
+}
+
+// ClientMockResetWorkflowExecutionParamPtrs contains pointers to parameters of the Client.ResetWorkflowExecution
+type ClientMockResetWorkflowExecutionParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ResetWorkflowExecutionRequest
+}
+
+// ClientMockResetWorkflowExecutionResults contains results of the Client.ResetWorkflowExecution
+type ClientMockResetWorkflowExecutionResults struct {
+	rp1 *workflowservice.ResetWorkflowExecutionResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Optional() *mClientMockResetWorkflowExecution {
+	mmResetWorkflowExecution.optional = true
+	return mmResetWorkflowExecution
+}
+
+// Expect sets up expected params for Client.ResetWorkflowExecution
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Expect(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest) *mClientMockResetWorkflowExecution {
+	if mmResetWorkflowExecution.mock.funcResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Set")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation == nil {
+		mmResetWorkflowExecution.defaultExpectation = &ClientMockResetWorkflowExecutionExpectation{}
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation.paramPtrs != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by ExpectParams functions")
+	}
+
+	mmResetWorkflowExecution.defaultExpectation.params = &ClientMockResetWorkflowExecutionParams{ctx, request}
+	for _, e := range mmResetWorkflowExecution.expectations {
+		if minimock.Equal(e.params, mmResetWorkflowExecution.defaultExpectation.params) {
+			mmResetWorkflowExecution.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetWorkflowExecution.defaultExpectation.params)
+		}
+	}
+
+	return mmResetWorkflowExecution
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ResetWorkflowExecution
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) ExpectCtxParam1(ctx context.Context) *mClientMockResetWorkflowExecution {
+	if mmResetWorkflowExecution.mock.funcResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Set")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation == nil {
+		mmResetWorkflowExecution.defaultExpectation = &ClientMockResetWorkflowExecutionExpectation{}
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation.params != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Expect")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation.paramPtrs == nil {
+		mmResetWorkflowExecution.defaultExpectation.paramPtrs = &ClientMockResetWorkflowExecutionParamPtrs{}
+	}
+	mmResetWorkflowExecution.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmResetWorkflowExecution
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ResetWorkflowExecution
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) ExpectRequestParam2(request *workflowservice.ResetWorkflowExecutionRequest) *mClientMockResetWorkflowExecution {
+	if mmResetWorkflowExecution.mock.funcResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Set")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation == nil {
+		mmResetWorkflowExecution.defaultExpectation = &ClientMockResetWorkflowExecutionExpectation{}
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation.params != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Expect")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation.paramPtrs == nil {
+		mmResetWorkflowExecution.defaultExpectation.paramPtrs = &ClientMockResetWorkflowExecutionParamPtrs{}
+	}
+	mmResetWorkflowExecution.defaultExpectation.paramPtrs.request = &request
+
+	return mmResetWorkflowExecution
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ResetWorkflowExecution
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Inspect(f func(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest)) *mClientMockResetWorkflowExecution {
+	if mmResetWorkflowExecution.mock.inspectFuncResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("Inspect function is already set for ClientMock.ResetWorkflowExecution")
+	}
+
+	mmResetWorkflowExecution.mock.inspectFuncResetWorkflowExecution = f
+
+	return mmResetWorkflowExecution
+}
+
+// Return sets up results that will be returned by Client.ResetWorkflowExecution
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Return(rp1 *workflowservice.ResetWorkflowExecutionResponse, err error) *ClientMock {
+	if mmResetWorkflowExecution.mock.funcResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Set")
+	}
+
+	if mmResetWorkflowExecution.defaultExpectation == nil {
+		mmResetWorkflowExecution.defaultExpectation = &ClientMockResetWorkflowExecutionExpectation{mock: mmResetWorkflowExecution.mock}
+	}
+	mmResetWorkflowExecution.defaultExpectation.results = &ClientMockResetWorkflowExecutionResults{rp1, err}
+	return mmResetWorkflowExecution.mock
+}
+
+// Set uses given function f to mock the Client.ResetWorkflowExecution method
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Set(f func(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest) (rp1 *workflowservice.ResetWorkflowExecutionResponse, err error)) *ClientMock {
+	if mmResetWorkflowExecution.defaultExpectation != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("Default expectation is already set for the Client.ResetWorkflowExecution method")
+	}
+
+	if len(mmResetWorkflowExecution.expectations) > 0 {
+		mmResetWorkflowExecution.mock.t.Fatalf("Some expectations are already set for the Client.ResetWorkflowExecution method")
+	}
+
+	mmResetWorkflowExecution.mock.funcResetWorkflowExecution = f
+	return mmResetWorkflowExecution.mock
+}
+
+// When sets expectation for the Client.ResetWorkflowExecution which will trigger the result defined by the following
+// Then helper
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) When(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest) *ClientMockResetWorkflowExecutionExpectation {
+	if mmResetWorkflowExecution.mock.funcResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.mock.t.Fatalf("ClientMock.ResetWorkflowExecution mock is already set by Set")
+	}
+
+	expectation := &ClientMockResetWorkflowExecutionExpectation{
+		mock:   mmResetWorkflowExecution.mock,
+		params: &ClientMockResetWorkflowExecutionParams{ctx, request},
+	}
+	mmResetWorkflowExecution.expectations = append(mmResetWorkflowExecution.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ResetWorkflowExecution return parameters for the expectation previously defined by the When method
+func (e *ClientMockResetWorkflowExecutionExpectation) Then(rp1 *workflowservice.ResetWorkflowExecutionResponse, err error) *ClientMock {
+	e.results = &ClientMockResetWorkflowExecutionResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ResetWorkflowExecution should be invoked
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Times(n uint64) *mClientMockResetWorkflowExecution {
+	if n == 0 {
+		mmResetWorkflowExecution.mock.t.Fatalf("Times of ClientMock.ResetWorkflowExecution mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResetWorkflowExecution.expectedInvocations, n)
+	return mmResetWorkflowExecution
+}
+
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) invocationsDone() bool {
+	if len(mmResetWorkflowExecution.expectations) == 0 && mmResetWorkflowExecution.defaultExpectation == nil && mmResetWorkflowExecution.mock.funcResetWorkflowExecution == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResetWorkflowExecution.mock.afterResetWorkflowExecutionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetWorkflowExecution.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResetWorkflowExecution implements client.Client
+func (mmResetWorkflowExecution *ClientMock) ResetWorkflowExecution(ctx context.Context, request *workflowservice.ResetWorkflowExecutionRequest) (rp1 *workflowservice.ResetWorkflowExecutionResponse, err error) {
+	mm_atomic.AddUint64(&mmResetWorkflowExecution.beforeResetWorkflowExecutionCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetWorkflowExecution.afterResetWorkflowExecutionCounter, 1)
+
+	if mmResetWorkflowExecution.inspectFuncResetWorkflowExecution != nil {
+		mmResetWorkflowExecution.inspectFuncResetWorkflowExecution(ctx, request)
+	}
+
+	mm_params := ClientMockResetWorkflowExecutionParams{ctx, request}
+
+	// Record call args
+	mmResetWorkflowExecution.ResetWorkflowExecutionMock.mutex.Lock()
+	mmResetWorkflowExecution.ResetWorkflowExecutionMock.callArgs = append(mmResetWorkflowExecution.ResetWorkflowExecutionMock.callArgs, &mm_params)
+	mmResetWorkflowExecution.ResetWorkflowExecutionMock.mutex.Unlock()
+
+	for _, e := range mmResetWorkflowExecution.ResetWorkflowExecutionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmResetWorkflowExecution.ResetWorkflowExecutionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetWorkflowExecution.ResetWorkflowExecutionMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetWorkflowExecution.ResetWorkflowExecutionMock.defaultExpectation.params
+		mm_want_ptrs := mmResetWorkflowExecution.ResetWorkflowExecutionMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockResetWorkflowExecutionParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResetWorkflowExecution.t.Errorf("ClientMock.ResetWorkflowExecution got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmResetWorkflowExecution.t.Errorf("ClientMock.ResetWorkflowExecution got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResetWorkflowExecution.t.Errorf("ClientMock.ResetWorkflowExecution got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResetWorkflowExecution.ResetWorkflowExecutionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResetWorkflowExecution.t.Fatal("No results are set for the ClientMock.ResetWorkflowExecution")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmResetWorkflowExecution.funcResetWorkflowExecution != nil {
+		return mmResetWorkflowExecution.funcResetWorkflowExecution(ctx, request)
+	}
+	mmResetWorkflowExecution.t.Fatalf("Unexpected call to ClientMock.ResetWorkflowExecution. %v %v", ctx, request)
+	return
+}
+
+// ResetWorkflowExecutionAfterCounter returns a count of finished ClientMock.ResetWorkflowExecution invocations
+func (mmResetWorkflowExecution *ClientMock) ResetWorkflowExecutionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetWorkflowExecution.afterResetWorkflowExecutionCounter)
+}
+
+// ResetWorkflowExecutionBeforeCounter returns a count of ClientMock.ResetWorkflowExecution invocations
+func (mmResetWorkflowExecution *ClientMock) ResetWorkflowExecutionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetWorkflowExecution.beforeResetWorkflowExecutionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ResetWorkflowExecution.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResetWorkflowExecution *mClientMockResetWorkflowExecution) Calls() []*ClientMockResetWorkflowExecutionParams {
+	mmResetWorkflowExecution.mutex.RLock()
+
+	argCopy := make([]*ClientMockResetWorkflowExecutionParams, len(mmResetWorkflowExecution.callArgs))
+	copy(argCopy, mmResetWorkflowExecution.callArgs)
+
+	mmResetWorkflowExecution.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetWorkflowExecutionDone returns true if the count of the ResetWorkflowExecution invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockResetWorkflowExecutionDone() bool {
+	if m.ResetWorkflowExecutionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetWorkflowExecutionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetWorkflowExecutionMock.invocationsDone()
+}
+
+// MinimockResetWorkflowExecutionInspect logs each unmet expectation
+func (m *ClientMock) MinimockResetWorkflowExecutionInspect() {
+	for _, e := range m.ResetWorkflowExecutionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ResetWorkflowExecution with params: %#v", *e.params)
+		}
+	}
+
+	afterResetWorkflowExecutionCounter := mm_atomic.LoadUint64(&m.afterResetWorkflowExecutionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetWorkflowExecutionMock.defaultExpectation != nil && afterResetWorkflowExecutionCounter < 1 {
+		if m.ResetWorkflowExecutionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ResetWorkflowExecution")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ResetWorkflowExecution with params: %#v", *m.ResetWorkflowExecutionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResetWorkflowExecution != nil && afterResetWorkflowExecutionCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ResetWorkflowExecution")
+	}
+
+	if !m.ResetWorkflowExecutionMock.invocationsDone() && afterResetWorkflowExecutionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ResetWorkflowExecution but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetWorkflowExecutionMock.expectedInvocations), afterResetWorkflowExecutionCounter)
+	}
+}
+
+type mClientMockScanWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockScanWorkflowExpectation
+	expectations       []*ClientMockScanWorkflowExpectation
+
+	callArgs []*ClientMockScanWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockScanWorkflowExpectation specifies expectation struct of the Client.ScanWorkflow
+type ClientMockScanWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockScanWorkflowParams
+	paramPtrs *ClientMockScanWorkflowParamPtrs
+	results   *ClientMockScanWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockScanWorkflowParams contains parameters of the Client.ScanWorkflow
+type ClientMockScanWorkflowParams struct {
+	ctx     context.Context
+	request *workflowservice.ScanWorkflowExecutionsRequest
+}
+
+// ClientMockScanWorkflowParamPtrs contains pointers to parameters of the Client.ScanWorkflow
+type ClientMockScanWorkflowParamPtrs struct {
+	ctx     *context.Context
+	request **workflowservice.ScanWorkflowExecutionsRequest
+}
+
+// ClientMockScanWorkflowResults contains results of the Client.ScanWorkflow
+type ClientMockScanWorkflowResults struct {
+	sp1 *workflowservice.ScanWorkflowExecutionsResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScanWorkflow *mClientMockScanWorkflow) Optional() *mClientMockScanWorkflow {
+	mmScanWorkflow.optional = true
+	return mmScanWorkflow
+}
+
+// Expect sets up expected params for Client.ScanWorkflow
+func (mmScanWorkflow *mClientMockScanWorkflow) Expect(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest) *mClientMockScanWorkflow {
+	if mmScanWorkflow.mock.funcScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Set")
+	}
+
+	if mmScanWorkflow.defaultExpectation == nil {
+		mmScanWorkflow.defaultExpectation = &ClientMockScanWorkflowExpectation{}
+	}
+
+	if mmScanWorkflow.defaultExpectation.paramPtrs != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmScanWorkflow.defaultExpectation.params = &ClientMockScanWorkflowParams{ctx, request}
+	for _, e := range mmScanWorkflow.expectations {
+		if minimock.Equal(e.params, mmScanWorkflow.defaultExpectation.params) {
+			mmScanWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmScanWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.ScanWorkflow
+func (mmScanWorkflow *mClientMockScanWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockScanWorkflow {
+	if mmScanWorkflow.mock.funcScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Set")
+	}
+
+	if mmScanWorkflow.defaultExpectation == nil {
+		mmScanWorkflow.defaultExpectation = &ClientMockScanWorkflowExpectation{}
+	}
+
+	if mmScanWorkflow.defaultExpectation.params != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Expect")
+	}
+
+	if mmScanWorkflow.defaultExpectation.paramPtrs == nil {
+		mmScanWorkflow.defaultExpectation.paramPtrs = &ClientMockScanWorkflowParamPtrs{}
+	}
+	mmScanWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmScanWorkflow
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.ScanWorkflow
+func (mmScanWorkflow *mClientMockScanWorkflow) ExpectRequestParam2(request *workflowservice.ScanWorkflowExecutionsRequest) *mClientMockScanWorkflow {
+	if mmScanWorkflow.mock.funcScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Set")
+	}
+
+	if mmScanWorkflow.defaultExpectation == nil {
+		mmScanWorkflow.defaultExpectation = &ClientMockScanWorkflowExpectation{}
+	}
+
+	if mmScanWorkflow.defaultExpectation.params != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Expect")
+	}
+
+	if mmScanWorkflow.defaultExpectation.paramPtrs == nil {
+		mmScanWorkflow.defaultExpectation.paramPtrs = &ClientMockScanWorkflowParamPtrs{}
+	}
+	mmScanWorkflow.defaultExpectation.paramPtrs.request = &request
+
+	return mmScanWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ScanWorkflow
+func (mmScanWorkflow *mClientMockScanWorkflow) Inspect(f func(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest)) *mClientMockScanWorkflow {
+	if mmScanWorkflow.mock.inspectFuncScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.ScanWorkflow")
+	}
+
+	mmScanWorkflow.mock.inspectFuncScanWorkflow = f
+
+	return mmScanWorkflow
+}
+
+// Return sets up results that will be returned by Client.ScanWorkflow
+func (mmScanWorkflow *mClientMockScanWorkflow) Return(sp1 *workflowservice.ScanWorkflowExecutionsResponse, err error) *ClientMock {
+	if mmScanWorkflow.mock.funcScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Set")
+	}
+
+	if mmScanWorkflow.defaultExpectation == nil {
+		mmScanWorkflow.defaultExpectation = &ClientMockScanWorkflowExpectation{mock: mmScanWorkflow.mock}
+	}
+	mmScanWorkflow.defaultExpectation.results = &ClientMockScanWorkflowResults{sp1, err}
+	return mmScanWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.ScanWorkflow method
+func (mmScanWorkflow *mClientMockScanWorkflow) Set(f func(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest) (sp1 *workflowservice.ScanWorkflowExecutionsResponse, err error)) *ClientMock {
+	if mmScanWorkflow.defaultExpectation != nil {
+		mmScanWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.ScanWorkflow method")
+	}
+
+	if len(mmScanWorkflow.expectations) > 0 {
+		mmScanWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.ScanWorkflow method")
+	}
+
+	mmScanWorkflow.mock.funcScanWorkflow = f
+	return mmScanWorkflow.mock
+}
+
+// When sets expectation for the Client.ScanWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmScanWorkflow *mClientMockScanWorkflow) When(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest) *ClientMockScanWorkflowExpectation {
+	if mmScanWorkflow.mock.funcScanWorkflow != nil {
+		mmScanWorkflow.mock.t.Fatalf("ClientMock.ScanWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockScanWorkflowExpectation{
+		mock:   mmScanWorkflow.mock,
+		params: &ClientMockScanWorkflowParams{ctx, request},
+	}
+	mmScanWorkflow.expectations = append(mmScanWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.ScanWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockScanWorkflowExpectation) Then(sp1 *workflowservice.ScanWorkflowExecutionsResponse, err error) *ClientMock {
+	e.results = &ClientMockScanWorkflowResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.ScanWorkflow should be invoked
+func (mmScanWorkflow *mClientMockScanWorkflow) Times(n uint64) *mClientMockScanWorkflow {
+	if n == 0 {
+		mmScanWorkflow.mock.t.Fatalf("Times of ClientMock.ScanWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScanWorkflow.expectedInvocations, n)
+	return mmScanWorkflow
+}
+
+func (mmScanWorkflow *mClientMockScanWorkflow) invocationsDone() bool {
+	if len(mmScanWorkflow.expectations) == 0 && mmScanWorkflow.defaultExpectation == nil && mmScanWorkflow.mock.funcScanWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScanWorkflow.mock.afterScanWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScanWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScanWorkflow implements client.Client
+func (mmScanWorkflow *ClientMock) ScanWorkflow(ctx context.Context, request *workflowservice.ScanWorkflowExecutionsRequest) (sp1 *workflowservice.ScanWorkflowExecutionsResponse, err error) {
+	mm_atomic.AddUint64(&mmScanWorkflow.beforeScanWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanWorkflow.afterScanWorkflowCounter, 1)
+
+	if mmScanWorkflow.inspectFuncScanWorkflow != nil {
+		mmScanWorkflow.inspectFuncScanWorkflow(ctx, request)
+	}
+
+	mm_params := ClientMockScanWorkflowParams{ctx, request}
+
+	// Record call args
+	mmScanWorkflow.ScanWorkflowMock.mutex.Lock()
+	mmScanWorkflow.ScanWorkflowMock.callArgs = append(mmScanWorkflow.ScanWorkflowMock.callArgs, &mm_params)
+	mmScanWorkflow.ScanWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmScanWorkflow.ScanWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmScanWorkflow.ScanWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanWorkflow.ScanWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanWorkflow.ScanWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmScanWorkflow.ScanWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockScanWorkflowParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScanWorkflow.t.Errorf("ClientMock.ScanWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmScanWorkflow.t.Errorf("ClientMock.ScanWorkflow got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanWorkflow.t.Errorf("ClientMock.ScanWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanWorkflow.ScanWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanWorkflow.t.Fatal("No results are set for the ClientMock.ScanWorkflow")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmScanWorkflow.funcScanWorkflow != nil {
+		return mmScanWorkflow.funcScanWorkflow(ctx, request)
+	}
+	mmScanWorkflow.t.Fatalf("Unexpected call to ClientMock.ScanWorkflow. %v %v", ctx, request)
+	return
+}
+
+// ScanWorkflowAfterCounter returns a count of finished ClientMock.ScanWorkflow invocations
+func (mmScanWorkflow *ClientMock) ScanWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanWorkflow.afterScanWorkflowCounter)
+}
+
+// ScanWorkflowBeforeCounter returns a count of ClientMock.ScanWorkflow invocations
+func (mmScanWorkflow *ClientMock) ScanWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanWorkflow.beforeScanWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.ScanWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanWorkflow *mClientMockScanWorkflow) Calls() []*ClientMockScanWorkflowParams {
+	mmScanWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockScanWorkflowParams, len(mmScanWorkflow.callArgs))
+	copy(argCopy, mmScanWorkflow.callArgs)
+
+	mmScanWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanWorkflowDone returns true if the count of the ScanWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockScanWorkflowDone() bool {
+	if m.ScanWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScanWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScanWorkflowMock.invocationsDone()
+}
+
+// MinimockScanWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockScanWorkflowInspect() {
+	for _, e := range m.ScanWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.ScanWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterScanWorkflowCounter := mm_atomic.LoadUint64(&m.afterScanWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanWorkflowMock.defaultExpectation != nil && afterScanWorkflowCounter < 1 {
+		if m.ScanWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.ScanWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.ScanWorkflow with params: %#v", *m.ScanWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanWorkflow != nil && afterScanWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ScanWorkflow")
+	}
+
+	if !m.ScanWorkflowMock.invocationsDone() && afterScanWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ScanWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.ScanWorkflowMock.expectedInvocations), afterScanWorkflowCounter)
+	}
+}
+
+type mClientMockScheduleClient struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockScheduleClientExpectation
+	expectations       []*ClientMockScheduleClientExpectation
+
+	expectedInvocations uint64
+}
+
+// ClientMockScheduleClientExpectation specifies expectation struct of the Client.ScheduleClient
+type ClientMockScheduleClientExpectation struct {
+	mock *ClientMock
+
+	results *ClientMockScheduleClientResults
+	Counter uint64
+}
+
+// ClientMockScheduleClientResults contains results of the Client.ScheduleClient
+type ClientMockScheduleClientResults struct {
+	s1 mm_client.ScheduleClient
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScheduleClient *mClientMockScheduleClient) Optional() *mClientMockScheduleClient {
+	mmScheduleClient.optional = true
+	return mmScheduleClient
+}
+
+// Expect sets up expected params for Client.ScheduleClient
+func (mmScheduleClient *mClientMockScheduleClient) Expect() *mClientMockScheduleClient {
+	if mmScheduleClient.mock.funcScheduleClient != nil {
+		mmScheduleClient.mock.t.Fatalf("ClientMock.ScheduleClient mock is already set by Set")
+	}
+
+	if mmScheduleClient.defaultExpectation == nil {
+		mmScheduleClient.defaultExpectation = &ClientMockScheduleClientExpectation{}
+	}
+
+	return mmScheduleClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.ScheduleClient
+func (mmScheduleClient *mClientMockScheduleClient) Inspect(f func()) *mClientMockScheduleClient {
+	if mmScheduleClient.mock.inspectFuncScheduleClient != nil {
+		mmScheduleClient.mock.t.Fatalf("Inspect function is already set for ClientMock.ScheduleClient")
+	}
+
+	mmScheduleClient.mock.inspectFuncScheduleClient = f
+
+	return mmScheduleClient
+}
+
+// Return sets up results that will be returned by Client.ScheduleClient
+func (mmScheduleClient *mClientMockScheduleClient) Return(s1 mm_client.ScheduleClient) *ClientMock {
+	if mmScheduleClient.mock.funcScheduleClient != nil {
+		mmScheduleClient.mock.t.Fatalf("ClientMock.ScheduleClient mock is already set by Set")
+	}
+
+	if mmScheduleClient.defaultExpectation == nil {
+		mmScheduleClient.defaultExpectation = &ClientMockScheduleClientExpectation{mock: mmScheduleClient.mock}
+	}
+	mmScheduleClient.defaultExpectation.results = &ClientMockScheduleClientResults{s1}
+	return mmScheduleClient.mock
+}
+
+// Set uses given function f to mock the Client.ScheduleClient method
+func (mmScheduleClient *mClientMockScheduleClient) Set(f func() (s1 mm_client.ScheduleClient)) *ClientMock {
+	if mmScheduleClient.defaultExpectation != nil {
+		mmScheduleClient.mock.t.Fatalf("Default expectation is already set for the Client.ScheduleClient method")
+	}
+
+	if len(mmScheduleClient.expectations) > 0 {
+		mmScheduleClient.mock.t.Fatalf("Some expectations are already set for the Client.ScheduleClient method")
+	}
+
+	mmScheduleClient.mock.funcScheduleClient = f
+	return mmScheduleClient.mock
+}
+
+// Times sets number of times Client.ScheduleClient should be invoked
+func (mmScheduleClient *mClientMockScheduleClient) Times(n uint64) *mClientMockScheduleClient {
+	if n == 0 {
+		mmScheduleClient.mock.t.Fatalf("Times of ClientMock.ScheduleClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScheduleClient.expectedInvocations, n)
+	return mmScheduleClient
+}
+
+func (mmScheduleClient *mClientMockScheduleClient) invocationsDone() bool {
+	if len(mmScheduleClient.expectations) == 0 && mmScheduleClient.defaultExpectation == nil && mmScheduleClient.mock.funcScheduleClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScheduleClient.mock.afterScheduleClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScheduleClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScheduleClient implements client.Client
+func (mmScheduleClient *ClientMock) ScheduleClient() (s1 mm_client.ScheduleClient) {
+	mm_atomic.AddUint64(&mmScheduleClient.beforeScheduleClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmScheduleClient.afterScheduleClientCounter, 1)
+
+	if mmScheduleClient.inspectFuncScheduleClient != nil {
+		mmScheduleClient.inspectFuncScheduleClient()
+	}
+
+	if mmScheduleClient.ScheduleClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScheduleClient.ScheduleClientMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmScheduleClient.ScheduleClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScheduleClient.t.Fatal("No results are set for the ClientMock.ScheduleClient")
+		}
+		return (*mm_results).s1
+	}
+	if mmScheduleClient.funcScheduleClient != nil {
+		return mmScheduleClient.funcScheduleClient()
+	}
+	mmScheduleClient.t.Fatalf("Unexpected call to ClientMock.ScheduleClient.")
+	return
+}
+
+// ScheduleClientAfterCounter returns a count of finished ClientMock.ScheduleClient invocations
+func (mmScheduleClient *ClientMock) ScheduleClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleClient.afterScheduleClientCounter)
+}
+
+// ScheduleClientBeforeCounter returns a count of ClientMock.ScheduleClient invocations
+func (mmScheduleClient *ClientMock) ScheduleClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleClient.beforeScheduleClientCounter)
+}
+
+// MinimockScheduleClientDone returns true if the count of the ScheduleClient invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockScheduleClientDone() bool {
+	if m.ScheduleClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScheduleClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScheduleClientMock.invocationsDone()
+}
+
+// MinimockScheduleClientInspect logs each unmet expectation
+func (m *ClientMock) MinimockScheduleClientInspect() {
+	for _, e := range m.ScheduleClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.ScheduleClient")
+		}
+	}
+
+	afterScheduleClientCounter := mm_atomic.LoadUint64(&m.afterScheduleClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScheduleClientMock.defaultExpectation != nil && afterScheduleClientCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ScheduleClient")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScheduleClient != nil && afterScheduleClientCounter < 1 {
+		m.t.Error("Expected call to ClientMock.ScheduleClient")
+	}
+
+	if !m.ScheduleClientMock.invocationsDone() && afterScheduleClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.ScheduleClient but found %d calls",
+			mm_atomic.LoadUint64(&m.ScheduleClientMock.expectedInvocations), afterScheduleClientCounter)
+	}
+}
+
+type mClientMockSignalWithStartWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockSignalWithStartWorkflowExpectation
+	expectations       []*ClientMockSignalWithStartWorkflowExpectation
+
+	callArgs []*ClientMockSignalWithStartWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockSignalWithStartWorkflowExpectation specifies expectation struct of the Client.SignalWithStartWorkflow
+type ClientMockSignalWithStartWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockSignalWithStartWorkflowParams
+	paramPtrs *ClientMockSignalWithStartWorkflowParamPtrs
+	results   *ClientMockSignalWithStartWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockSignalWithStartWorkflowParams contains parameters of the Client.SignalWithStartWorkflow
+type ClientMockSignalWithStartWorkflowParams struct {
+	ctx          context.Context
+	workflowID   string
+	signalName   string
+	signalArg    interface{}
+	options      mm_client.StartWorkflowOptions
+	workflow     interface{}
+	workflowArgs []interface{}
+}
+
+// ClientMockSignalWithStartWorkflowParamPtrs contains pointers to parameters of the Client.SignalWithStartWorkflow
+type ClientMockSignalWithStartWorkflowParamPtrs struct {
+	ctx          *context.Context
+	workflowID   *string
+	signalName   *string
+	signalArg    *interface{}
+	options      *mm_client.StartWorkflowOptions
+	workflow     *interface{}
+	workflowArgs *[]interface{}
+}
+
+// ClientMockSignalWithStartWorkflowResults contains results of the Client.SignalWithStartWorkflow
+type ClientMockSignalWithStartWorkflowResults struct {
+	w1  mm_client.WorkflowRun
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Optional() *mClientMockSignalWithStartWorkflow {
+	mmSignalWithStartWorkflow.optional = true
+	return mmSignalWithStartWorkflow
+}
+
+// Expect sets up expected params for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Expect(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmSignalWithStartWorkflow.defaultExpectation.params = &ClientMockSignalWithStartWorkflowParams{ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs}
+	for _, e := range mmSignalWithStartWorkflow.expectations {
+		if minimock.Equal(e.params, mmSignalWithStartWorkflow.defaultExpectation.params) {
+			mmSignalWithStartWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignalWithStartWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectSignalNameParam3 sets up expected param signalName for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectSignalNameParam3(signalName string) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.signalName = &signalName
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectSignalArgParam4 sets up expected param signalArg for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectSignalArgParam4(signalArg interface{}) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.signalArg = &signalArg
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectOptionsParam5 sets up expected param options for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectOptionsParam5(options mm_client.StartWorkflowOptions) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.options = &options
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectWorkflowParam6 sets up expected param workflow for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectWorkflowParam6(workflow interface{}) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.workflow = &workflow
+
+	return mmSignalWithStartWorkflow
+}
+
+// ExpectWorkflowArgsParam7 sets up expected param workflowArgs for Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) ExpectWorkflowArgsParam7(workflowArgs ...interface{}) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{}
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.params != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWithStartWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWithStartWorkflowParamPtrs{}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.paramPtrs.workflowArgs = &workflowArgs
+
+	return mmSignalWithStartWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Inspect(f func(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{})) *mClientMockSignalWithStartWorkflow {
+	if mmSignalWithStartWorkflow.mock.inspectFuncSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.SignalWithStartWorkflow")
+	}
+
+	mmSignalWithStartWorkflow.mock.inspectFuncSignalWithStartWorkflow = f
+
+	return mmSignalWithStartWorkflow
+}
+
+// Return sets up results that will be returned by Client.SignalWithStartWorkflow
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Return(w1 mm_client.WorkflowRun, err error) *ClientMock {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWithStartWorkflow.defaultExpectation == nil {
+		mmSignalWithStartWorkflow.defaultExpectation = &ClientMockSignalWithStartWorkflowExpectation{mock: mmSignalWithStartWorkflow.mock}
+	}
+	mmSignalWithStartWorkflow.defaultExpectation.results = &ClientMockSignalWithStartWorkflowResults{w1, err}
+	return mmSignalWithStartWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.SignalWithStartWorkflow method
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Set(f func(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) (w1 mm_client.WorkflowRun, err error)) *ClientMock {
+	if mmSignalWithStartWorkflow.defaultExpectation != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.SignalWithStartWorkflow method")
+	}
+
+	if len(mmSignalWithStartWorkflow.expectations) > 0 {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.SignalWithStartWorkflow method")
+	}
+
+	mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow = f
+	return mmSignalWithStartWorkflow.mock
+}
+
+// When sets expectation for the Client.SignalWithStartWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) When(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) *ClientMockSignalWithStartWorkflowExpectation {
+	if mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("ClientMock.SignalWithStartWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockSignalWithStartWorkflowExpectation{
+		mock:   mmSignalWithStartWorkflow.mock,
+		params: &ClientMockSignalWithStartWorkflowParams{ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs},
+	}
+	mmSignalWithStartWorkflow.expectations = append(mmSignalWithStartWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.SignalWithStartWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockSignalWithStartWorkflowExpectation) Then(w1 mm_client.WorkflowRun, err error) *ClientMock {
+	e.results = &ClientMockSignalWithStartWorkflowResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.SignalWithStartWorkflow should be invoked
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Times(n uint64) *mClientMockSignalWithStartWorkflow {
+	if n == 0 {
+		mmSignalWithStartWorkflow.mock.t.Fatalf("Times of ClientMock.SignalWithStartWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSignalWithStartWorkflow.expectedInvocations, n)
+	return mmSignalWithStartWorkflow
+}
+
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) invocationsDone() bool {
+	if len(mmSignalWithStartWorkflow.expectations) == 0 && mmSignalWithStartWorkflow.defaultExpectation == nil && mmSignalWithStartWorkflow.mock.funcSignalWithStartWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSignalWithStartWorkflow.mock.afterSignalWithStartWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSignalWithStartWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SignalWithStartWorkflow implements client.Client
+func (mmSignalWithStartWorkflow *ClientMock) SignalWithStartWorkflow(ctx context.Context, workflowID string, signalName string, signalArg interface{}, options mm_client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{}) (w1 mm_client.WorkflowRun, err error) {
+	mm_atomic.AddUint64(&mmSignalWithStartWorkflow.beforeSignalWithStartWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmSignalWithStartWorkflow.afterSignalWithStartWorkflowCounter, 1)
+
+	if mmSignalWithStartWorkflow.inspectFuncSignalWithStartWorkflow != nil {
+		mmSignalWithStartWorkflow.inspectFuncSignalWithStartWorkflow(ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs...)
+	}
+
+	mm_params := ClientMockSignalWithStartWorkflowParams{ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs}
+
+	// Record call args
+	mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.mutex.Lock()
+	mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.callArgs = append(mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.callArgs, &mm_params)
+	mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockSignalWithStartWorkflowParams{ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.signalName != nil && !minimock.Equal(*mm_want_ptrs.signalName, mm_got.signalName) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter signalName, want: %#v, got: %#v%s\n", *mm_want_ptrs.signalName, mm_got.signalName, minimock.Diff(*mm_want_ptrs.signalName, mm_got.signalName))
+			}
+
+			if mm_want_ptrs.signalArg != nil && !minimock.Equal(*mm_want_ptrs.signalArg, mm_got.signalArg) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter signalArg, want: %#v, got: %#v%s\n", *mm_want_ptrs.signalArg, mm_got.signalArg, minimock.Diff(*mm_want_ptrs.signalArg, mm_got.signalArg))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter options, want: %#v, got: %#v%s\n", *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
+			if mm_want_ptrs.workflow != nil && !minimock.Equal(*mm_want_ptrs.workflow, mm_got.workflow) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter workflow, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflow, mm_got.workflow, minimock.Diff(*mm_want_ptrs.workflow, mm_got.workflow))
+			}
+
+			if mm_want_ptrs.workflowArgs != nil && !minimock.Equal(*mm_want_ptrs.workflowArgs, mm_got.workflowArgs) {
+				mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameter workflowArgs, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowArgs, mm_got.workflowArgs, minimock.Diff(*mm_want_ptrs.workflowArgs, mm_got.workflowArgs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSignalWithStartWorkflow.t.Errorf("ClientMock.SignalWithStartWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSignalWithStartWorkflow.SignalWithStartWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSignalWithStartWorkflow.t.Fatal("No results are set for the ClientMock.SignalWithStartWorkflow")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmSignalWithStartWorkflow.funcSignalWithStartWorkflow != nil {
+		return mmSignalWithStartWorkflow.funcSignalWithStartWorkflow(ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs...)
+	}
+	mmSignalWithStartWorkflow.t.Fatalf("Unexpected call to ClientMock.SignalWithStartWorkflow. %v %v %v %v %v %v %v", ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs)
+	return
+}
+
+// SignalWithStartWorkflowAfterCounter returns a count of finished ClientMock.SignalWithStartWorkflow invocations
+func (mmSignalWithStartWorkflow *ClientMock) SignalWithStartWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignalWithStartWorkflow.afterSignalWithStartWorkflowCounter)
+}
+
+// SignalWithStartWorkflowBeforeCounter returns a count of ClientMock.SignalWithStartWorkflow invocations
+func (mmSignalWithStartWorkflow *ClientMock) SignalWithStartWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignalWithStartWorkflow.beforeSignalWithStartWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.SignalWithStartWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSignalWithStartWorkflow *mClientMockSignalWithStartWorkflow) Calls() []*ClientMockSignalWithStartWorkflowParams {
+	mmSignalWithStartWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockSignalWithStartWorkflowParams, len(mmSignalWithStartWorkflow.callArgs))
+	copy(argCopy, mmSignalWithStartWorkflow.callArgs)
+
+	mmSignalWithStartWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSignalWithStartWorkflowDone returns true if the count of the SignalWithStartWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockSignalWithStartWorkflowDone() bool {
+	if m.SignalWithStartWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SignalWithStartWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SignalWithStartWorkflowMock.invocationsDone()
+}
+
+// MinimockSignalWithStartWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockSignalWithStartWorkflowInspect() {
+	for _, e := range m.SignalWithStartWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.SignalWithStartWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterSignalWithStartWorkflowCounter := mm_atomic.LoadUint64(&m.afterSignalWithStartWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SignalWithStartWorkflowMock.defaultExpectation != nil && afterSignalWithStartWorkflowCounter < 1 {
+		if m.SignalWithStartWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.SignalWithStartWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.SignalWithStartWorkflow with params: %#v", *m.SignalWithStartWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSignalWithStartWorkflow != nil && afterSignalWithStartWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.SignalWithStartWorkflow")
+	}
+
+	if !m.SignalWithStartWorkflowMock.invocationsDone() && afterSignalWithStartWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.SignalWithStartWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.SignalWithStartWorkflowMock.expectedInvocations), afterSignalWithStartWorkflowCounter)
+	}
+}
+
+type mClientMockSignalWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockSignalWorkflowExpectation
+	expectations       []*ClientMockSignalWorkflowExpectation
+
+	callArgs []*ClientMockSignalWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockSignalWorkflowExpectation specifies expectation struct of the Client.SignalWorkflow
+type ClientMockSignalWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockSignalWorkflowParams
+	paramPtrs *ClientMockSignalWorkflowParamPtrs
+	results   *ClientMockSignalWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockSignalWorkflowParams contains parameters of the Client.SignalWorkflow
+type ClientMockSignalWorkflowParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+	signalName string
+	arg        interface{}
+}
+
+// ClientMockSignalWorkflowParamPtrs contains pointers to parameters of the Client.SignalWorkflow
+type ClientMockSignalWorkflowParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+	signalName *string
+	arg        *interface{}
+}
+
+// ClientMockSignalWorkflowResults contains results of the Client.SignalWorkflow
+type ClientMockSignalWorkflowResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Optional() *mClientMockSignalWorkflow {
+	mmSignalWorkflow.optional = true
+	return mmSignalWorkflow
+}
+
+// Expect sets up expected params for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Expect(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmSignalWorkflow.defaultExpectation.params = &ClientMockSignalWorkflowParams{ctx, workflowID, runID, signalName, arg}
+	for _, e := range mmSignalWorkflow.expectations {
+		if minimock.Equal(e.params, mmSignalWorkflow.defaultExpectation.params) {
+			mmSignalWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSignalWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmSignalWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.params != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWorkflowParamPtrs{}
+	}
+	mmSignalWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSignalWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.params != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWorkflowParamPtrs{}
+	}
+	mmSignalWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmSignalWorkflow
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) ExpectRunIDParam3(runID string) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.params != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWorkflowParamPtrs{}
+	}
+	mmSignalWorkflow.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmSignalWorkflow
+}
+
+// ExpectSignalNameParam4 sets up expected param signalName for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) ExpectSignalNameParam4(signalName string) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.params != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWorkflowParamPtrs{}
+	}
+	mmSignalWorkflow.defaultExpectation.paramPtrs.signalName = &signalName
+
+	return mmSignalWorkflow
+}
+
+// ExpectArgParam5 sets up expected param arg for Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) ExpectArgParam5(arg interface{}) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{}
+	}
+
+	if mmSignalWorkflow.defaultExpectation.params != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Expect")
+	}
+
+	if mmSignalWorkflow.defaultExpectation.paramPtrs == nil {
+		mmSignalWorkflow.defaultExpectation.paramPtrs = &ClientMockSignalWorkflowParamPtrs{}
+	}
+	mmSignalWorkflow.defaultExpectation.paramPtrs.arg = &arg
+
+	return mmSignalWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Inspect(f func(ctx context.Context, workflowID string, runID string, signalName string, arg interface{})) *mClientMockSignalWorkflow {
+	if mmSignalWorkflow.mock.inspectFuncSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.SignalWorkflow")
+	}
+
+	mmSignalWorkflow.mock.inspectFuncSignalWorkflow = f
+
+	return mmSignalWorkflow
+}
+
+// Return sets up results that will be returned by Client.SignalWorkflow
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Return(err error) *ClientMock {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	if mmSignalWorkflow.defaultExpectation == nil {
+		mmSignalWorkflow.defaultExpectation = &ClientMockSignalWorkflowExpectation{mock: mmSignalWorkflow.mock}
+	}
+	mmSignalWorkflow.defaultExpectation.results = &ClientMockSignalWorkflowResults{err}
+	return mmSignalWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.SignalWorkflow method
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Set(f func(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) (err error)) *ClientMock {
+	if mmSignalWorkflow.defaultExpectation != nil {
+		mmSignalWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.SignalWorkflow method")
+	}
+
+	if len(mmSignalWorkflow.expectations) > 0 {
+		mmSignalWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.SignalWorkflow method")
+	}
+
+	mmSignalWorkflow.mock.funcSignalWorkflow = f
+	return mmSignalWorkflow.mock
+}
+
+// When sets expectation for the Client.SignalWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmSignalWorkflow *mClientMockSignalWorkflow) When(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) *ClientMockSignalWorkflowExpectation {
+	if mmSignalWorkflow.mock.funcSignalWorkflow != nil {
+		mmSignalWorkflow.mock.t.Fatalf("ClientMock.SignalWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockSignalWorkflowExpectation{
+		mock:   mmSignalWorkflow.mock,
+		params: &ClientMockSignalWorkflowParams{ctx, workflowID, runID, signalName, arg},
+	}
+	mmSignalWorkflow.expectations = append(mmSignalWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.SignalWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockSignalWorkflowExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockSignalWorkflowResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.SignalWorkflow should be invoked
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Times(n uint64) *mClientMockSignalWorkflow {
+	if n == 0 {
+		mmSignalWorkflow.mock.t.Fatalf("Times of ClientMock.SignalWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSignalWorkflow.expectedInvocations, n)
+	return mmSignalWorkflow
+}
+
+func (mmSignalWorkflow *mClientMockSignalWorkflow) invocationsDone() bool {
+	if len(mmSignalWorkflow.expectations) == 0 && mmSignalWorkflow.defaultExpectation == nil && mmSignalWorkflow.mock.funcSignalWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSignalWorkflow.mock.afterSignalWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSignalWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SignalWorkflow implements client.Client
+func (mmSignalWorkflow *ClientMock) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) (err error) {
+	mm_atomic.AddUint64(&mmSignalWorkflow.beforeSignalWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmSignalWorkflow.afterSignalWorkflowCounter, 1)
+
+	if mmSignalWorkflow.inspectFuncSignalWorkflow != nil {
+		mmSignalWorkflow.inspectFuncSignalWorkflow(ctx, workflowID, runID, signalName, arg)
+	}
+
+	mm_params := ClientMockSignalWorkflowParams{ctx, workflowID, runID, signalName, arg}
+
+	// Record call args
+	mmSignalWorkflow.SignalWorkflowMock.mutex.Lock()
+	mmSignalWorkflow.SignalWorkflowMock.callArgs = append(mmSignalWorkflow.SignalWorkflowMock.callArgs, &mm_params)
+	mmSignalWorkflow.SignalWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmSignalWorkflow.SignalWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSignalWorkflow.SignalWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSignalWorkflow.SignalWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmSignalWorkflow.SignalWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmSignalWorkflow.SignalWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockSignalWorkflowParams{ctx, workflowID, runID, signalName, arg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.signalName != nil && !minimock.Equal(*mm_want_ptrs.signalName, mm_got.signalName) {
+				mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameter signalName, want: %#v, got: %#v%s\n", *mm_want_ptrs.signalName, mm_got.signalName, minimock.Diff(*mm_want_ptrs.signalName, mm_got.signalName))
+			}
+
+			if mm_want_ptrs.arg != nil && !minimock.Equal(*mm_want_ptrs.arg, mm_got.arg) {
+				mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameter arg, want: %#v, got: %#v%s\n", *mm_want_ptrs.arg, mm_got.arg, minimock.Diff(*mm_want_ptrs.arg, mm_got.arg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSignalWorkflow.t.Errorf("ClientMock.SignalWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSignalWorkflow.SignalWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSignalWorkflow.t.Fatal("No results are set for the ClientMock.SignalWorkflow")
+		}
+		return (*mm_results).err
+	}
+	if mmSignalWorkflow.funcSignalWorkflow != nil {
+		return mmSignalWorkflow.funcSignalWorkflow(ctx, workflowID, runID, signalName, arg)
+	}
+	mmSignalWorkflow.t.Fatalf("Unexpected call to ClientMock.SignalWorkflow. %v %v %v %v %v", ctx, workflowID, runID, signalName, arg)
+	return
+}
+
+// SignalWorkflowAfterCounter returns a count of finished ClientMock.SignalWorkflow invocations
+func (mmSignalWorkflow *ClientMock) SignalWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignalWorkflow.afterSignalWorkflowCounter)
+}
+
+// SignalWorkflowBeforeCounter returns a count of ClientMock.SignalWorkflow invocations
+func (mmSignalWorkflow *ClientMock) SignalWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSignalWorkflow.beforeSignalWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.SignalWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSignalWorkflow *mClientMockSignalWorkflow) Calls() []*ClientMockSignalWorkflowParams {
+	mmSignalWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockSignalWorkflowParams, len(mmSignalWorkflow.callArgs))
+	copy(argCopy, mmSignalWorkflow.callArgs)
+
+	mmSignalWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSignalWorkflowDone returns true if the count of the SignalWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockSignalWorkflowDone() bool {
+	if m.SignalWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SignalWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SignalWorkflowMock.invocationsDone()
+}
+
+// MinimockSignalWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockSignalWorkflowInspect() {
+	for _, e := range m.SignalWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.SignalWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterSignalWorkflowCounter := mm_atomic.LoadUint64(&m.afterSignalWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SignalWorkflowMock.defaultExpectation != nil && afterSignalWorkflowCounter < 1 {
+		if m.SignalWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.SignalWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.SignalWorkflow with params: %#v", *m.SignalWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSignalWorkflow != nil && afterSignalWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.SignalWorkflow")
+	}
+
+	if !m.SignalWorkflowMock.invocationsDone() && afterSignalWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.SignalWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.SignalWorkflowMock.expectedInvocations), afterSignalWorkflowCounter)
+	}
+}
+
+type mClientMockTerminateWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockTerminateWorkflowExpectation
+	expectations       []*ClientMockTerminateWorkflowExpectation
+
+	callArgs []*ClientMockTerminateWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockTerminateWorkflowExpectation specifies expectation struct of the Client.TerminateWorkflow
+type ClientMockTerminateWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockTerminateWorkflowParams
+	paramPtrs *ClientMockTerminateWorkflowParamPtrs
+	results   *ClientMockTerminateWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockTerminateWorkflowParams contains parameters of the Client.TerminateWorkflow
+type ClientMockTerminateWorkflowParams struct {
+	ctx        context.Context
+	workflowID string
+	runID      string
+	reason     string
+	details    []interface{}
+}
+
+// ClientMockTerminateWorkflowParamPtrs contains pointers to parameters of the Client.TerminateWorkflow
+type ClientMockTerminateWorkflowParamPtrs struct {
+	ctx        *context.Context
+	workflowID *string
+	runID      *string
+	reason     *string
+	details    *[]interface{}
+}
+
+// ClientMockTerminateWorkflowResults contains results of the Client.TerminateWorkflow
+type ClientMockTerminateWorkflowResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Optional() *mClientMockTerminateWorkflow {
+	mmTerminateWorkflow.optional = true
+	return mmTerminateWorkflow
+}
+
+// Expect sets up expected params for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Expect(ctx context.Context, workflowID string, runID string, reason string, details ...interface{}) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmTerminateWorkflow.defaultExpectation.params = &ClientMockTerminateWorkflowParams{ctx, workflowID, runID, reason, details}
+	for _, e := range mmTerminateWorkflow.expectations {
+		if minimock.Equal(e.params, mmTerminateWorkflow.defaultExpectation.params) {
+			mmTerminateWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTerminateWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmTerminateWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.params != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Expect")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmTerminateWorkflow.defaultExpectation.paramPtrs = &ClientMockTerminateWorkflowParamPtrs{}
+	}
+	mmTerminateWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTerminateWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.params != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Expect")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmTerminateWorkflow.defaultExpectation.paramPtrs = &ClientMockTerminateWorkflowParamPtrs{}
+	}
+	mmTerminateWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmTerminateWorkflow
+}
+
+// ExpectRunIDParam3 sets up expected param runID for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) ExpectRunIDParam3(runID string) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.params != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Expect")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmTerminateWorkflow.defaultExpectation.paramPtrs = &ClientMockTerminateWorkflowParamPtrs{}
+	}
+	mmTerminateWorkflow.defaultExpectation.paramPtrs.runID = &runID
+
+	return mmTerminateWorkflow
+}
+
+// ExpectReasonParam4 sets up expected param reason for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) ExpectReasonParam4(reason string) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.params != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Expect")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmTerminateWorkflow.defaultExpectation.paramPtrs = &ClientMockTerminateWorkflowParamPtrs{}
+	}
+	mmTerminateWorkflow.defaultExpectation.paramPtrs.reason = &reason
+
+	return mmTerminateWorkflow
+}
+
+// ExpectDetailsParam5 sets up expected param details for Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) ExpectDetailsParam5(details ...interface{}) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{}
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.params != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Expect")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmTerminateWorkflow.defaultExpectation.paramPtrs = &ClientMockTerminateWorkflowParamPtrs{}
+	}
+	mmTerminateWorkflow.defaultExpectation.paramPtrs.details = &details
+
+	return mmTerminateWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Inspect(f func(ctx context.Context, workflowID string, runID string, reason string, details ...interface{})) *mClientMockTerminateWorkflow {
+	if mmTerminateWorkflow.mock.inspectFuncTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.TerminateWorkflow")
+	}
+
+	mmTerminateWorkflow.mock.inspectFuncTerminateWorkflow = f
+
+	return mmTerminateWorkflow
+}
+
+// Return sets up results that will be returned by Client.TerminateWorkflow
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Return(err error) *ClientMock {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	if mmTerminateWorkflow.defaultExpectation == nil {
+		mmTerminateWorkflow.defaultExpectation = &ClientMockTerminateWorkflowExpectation{mock: mmTerminateWorkflow.mock}
+	}
+	mmTerminateWorkflow.defaultExpectation.results = &ClientMockTerminateWorkflowResults{err}
+	return mmTerminateWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.TerminateWorkflow method
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Set(f func(ctx context.Context, workflowID string, runID string, reason string, details ...interface{}) (err error)) *ClientMock {
+	if mmTerminateWorkflow.defaultExpectation != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.TerminateWorkflow method")
+	}
+
+	if len(mmTerminateWorkflow.expectations) > 0 {
+		mmTerminateWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.TerminateWorkflow method")
+	}
+
+	mmTerminateWorkflow.mock.funcTerminateWorkflow = f
+	return mmTerminateWorkflow.mock
+}
+
+// When sets expectation for the Client.TerminateWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) When(ctx context.Context, workflowID string, runID string, reason string, details ...interface{}) *ClientMockTerminateWorkflowExpectation {
+	if mmTerminateWorkflow.mock.funcTerminateWorkflow != nil {
+		mmTerminateWorkflow.mock.t.Fatalf("ClientMock.TerminateWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockTerminateWorkflowExpectation{
+		mock:   mmTerminateWorkflow.mock,
+		params: &ClientMockTerminateWorkflowParams{ctx, workflowID, runID, reason, details},
+	}
+	mmTerminateWorkflow.expectations = append(mmTerminateWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.TerminateWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockTerminateWorkflowExpectation) Then(err error) *ClientMock {
+	e.results = &ClientMockTerminateWorkflowResults{err}
+	return e.mock
+}
+
+// Times sets number of times Client.TerminateWorkflow should be invoked
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Times(n uint64) *mClientMockTerminateWorkflow {
+	if n == 0 {
+		mmTerminateWorkflow.mock.t.Fatalf("Times of ClientMock.TerminateWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTerminateWorkflow.expectedInvocations, n)
+	return mmTerminateWorkflow
+}
+
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) invocationsDone() bool {
+	if len(mmTerminateWorkflow.expectations) == 0 && mmTerminateWorkflow.defaultExpectation == nil && mmTerminateWorkflow.mock.funcTerminateWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTerminateWorkflow.mock.afterTerminateWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTerminateWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TerminateWorkflow implements client.Client
+func (mmTerminateWorkflow *ClientMock) TerminateWorkflow(ctx context.Context, workflowID string, runID string, reason string, details ...interface{}) (err error) {
+	mm_atomic.AddUint64(&mmTerminateWorkflow.beforeTerminateWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmTerminateWorkflow.afterTerminateWorkflowCounter, 1)
+
+	if mmTerminateWorkflow.inspectFuncTerminateWorkflow != nil {
+		mmTerminateWorkflow.inspectFuncTerminateWorkflow(ctx, workflowID, runID, reason, details...)
+	}
+
+	mm_params := ClientMockTerminateWorkflowParams{ctx, workflowID, runID, reason, details}
+
+	// Record call args
+	mmTerminateWorkflow.TerminateWorkflowMock.mutex.Lock()
+	mmTerminateWorkflow.TerminateWorkflowMock.callArgs = append(mmTerminateWorkflow.TerminateWorkflowMock.callArgs, &mm_params)
+	mmTerminateWorkflow.TerminateWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmTerminateWorkflow.TerminateWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTerminateWorkflow.TerminateWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTerminateWorkflow.TerminateWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmTerminateWorkflow.TerminateWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmTerminateWorkflow.TerminateWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockTerminateWorkflowParams{ctx, workflowID, runID, reason, details}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameter runID, want: %#v, got: %#v%s\n", *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameter reason, want: %#v, got: %#v%s\n", *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+			if mm_want_ptrs.details != nil && !minimock.Equal(*mm_want_ptrs.details, mm_got.details) {
+				mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameter details, want: %#v, got: %#v%s\n", *mm_want_ptrs.details, mm_got.details, minimock.Diff(*mm_want_ptrs.details, mm_got.details))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTerminateWorkflow.t.Errorf("ClientMock.TerminateWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTerminateWorkflow.TerminateWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTerminateWorkflow.t.Fatal("No results are set for the ClientMock.TerminateWorkflow")
+		}
+		return (*mm_results).err
+	}
+	if mmTerminateWorkflow.funcTerminateWorkflow != nil {
+		return mmTerminateWorkflow.funcTerminateWorkflow(ctx, workflowID, runID, reason, details...)
+	}
+	mmTerminateWorkflow.t.Fatalf("Unexpected call to ClientMock.TerminateWorkflow. %v %v %v %v %v", ctx, workflowID, runID, reason, details)
+	return
+}
+
+// TerminateWorkflowAfterCounter returns a count of finished ClientMock.TerminateWorkflow invocations
+func (mmTerminateWorkflow *ClientMock) TerminateWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTerminateWorkflow.afterTerminateWorkflowCounter)
+}
+
+// TerminateWorkflowBeforeCounter returns a count of ClientMock.TerminateWorkflow invocations
+func (mmTerminateWorkflow *ClientMock) TerminateWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTerminateWorkflow.beforeTerminateWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.TerminateWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTerminateWorkflow *mClientMockTerminateWorkflow) Calls() []*ClientMockTerminateWorkflowParams {
+	mmTerminateWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockTerminateWorkflowParams, len(mmTerminateWorkflow.callArgs))
+	copy(argCopy, mmTerminateWorkflow.callArgs)
+
+	mmTerminateWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTerminateWorkflowDone returns true if the count of the TerminateWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockTerminateWorkflowDone() bool {
+	if m.TerminateWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TerminateWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TerminateWorkflowMock.invocationsDone()
+}
+
+// MinimockTerminateWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockTerminateWorkflowInspect() {
+	for _, e := range m.TerminateWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.TerminateWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterTerminateWorkflowCounter := mm_atomic.LoadUint64(&m.afterTerminateWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TerminateWorkflowMock.defaultExpectation != nil && afterTerminateWorkflowCounter < 1 {
+		if m.TerminateWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.TerminateWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.TerminateWorkflow with params: %#v", *m.TerminateWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTerminateWorkflow != nil && afterTerminateWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.TerminateWorkflow")
+	}
+
+	if !m.TerminateWorkflowMock.invocationsDone() && afterTerminateWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.TerminateWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.TerminateWorkflowMock.expectedInvocations), afterTerminateWorkflowCounter)
+	}
+}
+
+type mClientMockUpdateWorkflow struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockUpdateWorkflowExpectation
+	expectations       []*ClientMockUpdateWorkflowExpectation
+
+	callArgs []*ClientMockUpdateWorkflowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockUpdateWorkflowExpectation specifies expectation struct of the Client.UpdateWorkflow
+type ClientMockUpdateWorkflowExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockUpdateWorkflowParams
+	paramPtrs *ClientMockUpdateWorkflowParamPtrs
+	results   *ClientMockUpdateWorkflowResults
+	Counter   uint64
+}
+
+// ClientMockUpdateWorkflowParams contains parameters of the Client.UpdateWorkflow
+type ClientMockUpdateWorkflowParams struct {
+	ctx           context.Context
+	workflowID    string
+	workflowRunID string
+	updateName    string
+	args          []interface{}
+}
+
+// ClientMockUpdateWorkflowParamPtrs contains pointers to parameters of the Client.UpdateWorkflow
+type ClientMockUpdateWorkflowParamPtrs struct {
+	ctx           *context.Context
+	workflowID    *string
+	workflowRunID *string
+	updateName    *string
+	args          *[]interface{}
+}
+
+// ClientMockUpdateWorkflowResults contains results of the Client.UpdateWorkflow
+type ClientMockUpdateWorkflowResults struct {
+	w1  mm_client.WorkflowUpdateHandle
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Optional() *mClientMockUpdateWorkflow {
+	mmUpdateWorkflow.optional = true
+	return mmUpdateWorkflow
+}
+
+// Expect sets up expected params for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Expect(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{}) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateWorkflow.defaultExpectation.params = &ClientMockUpdateWorkflowParams{ctx, workflowID, workflowRunID, updateName, args}
+	for _, e := range mmUpdateWorkflow.expectations {
+		if minimock.Equal(e.params, mmUpdateWorkflow.defaultExpectation.params) {
+			mmUpdateWorkflow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateWorkflow.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateWorkflow
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) ExpectCtxParam1(ctx context.Context) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.params != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflow.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowParamPtrs{}
+	}
+	mmUpdateWorkflow.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateWorkflow
+}
+
+// ExpectWorkflowIDParam2 sets up expected param workflowID for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) ExpectWorkflowIDParam2(workflowID string) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.params != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflow.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowParamPtrs{}
+	}
+	mmUpdateWorkflow.defaultExpectation.paramPtrs.workflowID = &workflowID
+
+	return mmUpdateWorkflow
+}
+
+// ExpectWorkflowRunIDParam3 sets up expected param workflowRunID for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) ExpectWorkflowRunIDParam3(workflowRunID string) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.params != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflow.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowParamPtrs{}
+	}
+	mmUpdateWorkflow.defaultExpectation.paramPtrs.workflowRunID = &workflowRunID
+
+	return mmUpdateWorkflow
+}
+
+// ExpectUpdateNameParam4 sets up expected param updateName for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) ExpectUpdateNameParam4(updateName string) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.params != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflow.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowParamPtrs{}
+	}
+	mmUpdateWorkflow.defaultExpectation.paramPtrs.updateName = &updateName
+
+	return mmUpdateWorkflow
+}
+
+// ExpectArgsParam5 sets up expected param args for Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) ExpectArgsParam5(args ...interface{}) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{}
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.params != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflow.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowParamPtrs{}
+	}
+	mmUpdateWorkflow.defaultExpectation.paramPtrs.args = &args
+
+	return mmUpdateWorkflow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Inspect(f func(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{})) *mClientMockUpdateWorkflow {
+	if mmUpdateWorkflow.mock.inspectFuncUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("Inspect function is already set for ClientMock.UpdateWorkflow")
+	}
+
+	mmUpdateWorkflow.mock.inspectFuncUpdateWorkflow = f
+
+	return mmUpdateWorkflow
+}
+
+// Return sets up results that will be returned by Client.UpdateWorkflow
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Return(w1 mm_client.WorkflowUpdateHandle, err error) *ClientMock {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	if mmUpdateWorkflow.defaultExpectation == nil {
+		mmUpdateWorkflow.defaultExpectation = &ClientMockUpdateWorkflowExpectation{mock: mmUpdateWorkflow.mock}
+	}
+	mmUpdateWorkflow.defaultExpectation.results = &ClientMockUpdateWorkflowResults{w1, err}
+	return mmUpdateWorkflow.mock
+}
+
+// Set uses given function f to mock the Client.UpdateWorkflow method
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Set(f func(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{}) (w1 mm_client.WorkflowUpdateHandle, err error)) *ClientMock {
+	if mmUpdateWorkflow.defaultExpectation != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("Default expectation is already set for the Client.UpdateWorkflow method")
+	}
+
+	if len(mmUpdateWorkflow.expectations) > 0 {
+		mmUpdateWorkflow.mock.t.Fatalf("Some expectations are already set for the Client.UpdateWorkflow method")
+	}
+
+	mmUpdateWorkflow.mock.funcUpdateWorkflow = f
+	return mmUpdateWorkflow.mock
+}
+
+// When sets expectation for the Client.UpdateWorkflow which will trigger the result defined by the following
+// Then helper
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) When(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{}) *ClientMockUpdateWorkflowExpectation {
+	if mmUpdateWorkflow.mock.funcUpdateWorkflow != nil {
+		mmUpdateWorkflow.mock.t.Fatalf("ClientMock.UpdateWorkflow mock is already set by Set")
+	}
+
+	expectation := &ClientMockUpdateWorkflowExpectation{
+		mock:   mmUpdateWorkflow.mock,
+		params: &ClientMockUpdateWorkflowParams{ctx, workflowID, workflowRunID, updateName, args},
+	}
+	mmUpdateWorkflow.expectations = append(mmUpdateWorkflow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.UpdateWorkflow return parameters for the expectation previously defined by the When method
+func (e *ClientMockUpdateWorkflowExpectation) Then(w1 mm_client.WorkflowUpdateHandle, err error) *ClientMock {
+	e.results = &ClientMockUpdateWorkflowResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.UpdateWorkflow should be invoked
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Times(n uint64) *mClientMockUpdateWorkflow {
+	if n == 0 {
+		mmUpdateWorkflow.mock.t.Fatalf("Times of ClientMock.UpdateWorkflow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateWorkflow.expectedInvocations, n)
+	return mmUpdateWorkflow
+}
+
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) invocationsDone() bool {
+	if len(mmUpdateWorkflow.expectations) == 0 && mmUpdateWorkflow.defaultExpectation == nil && mmUpdateWorkflow.mock.funcUpdateWorkflow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateWorkflow.mock.afterUpdateWorkflowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateWorkflow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateWorkflow implements client.Client
+func (mmUpdateWorkflow *ClientMock) UpdateWorkflow(ctx context.Context, workflowID string, workflowRunID string, updateName string, args ...interface{}) (w1 mm_client.WorkflowUpdateHandle, err error) {
+	mm_atomic.AddUint64(&mmUpdateWorkflow.beforeUpdateWorkflowCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateWorkflow.afterUpdateWorkflowCounter, 1)
+
+	if mmUpdateWorkflow.inspectFuncUpdateWorkflow != nil {
+		mmUpdateWorkflow.inspectFuncUpdateWorkflow(ctx, workflowID, workflowRunID, updateName, args...)
+	}
+
+	mm_params := ClientMockUpdateWorkflowParams{ctx, workflowID, workflowRunID, updateName, args}
+
+	// Record call args
+	mmUpdateWorkflow.UpdateWorkflowMock.mutex.Lock()
+	mmUpdateWorkflow.UpdateWorkflowMock.callArgs = append(mmUpdateWorkflow.UpdateWorkflowMock.callArgs, &mm_params)
+	mmUpdateWorkflow.UpdateWorkflowMock.mutex.Unlock()
+
+	for _, e := range mmUpdateWorkflow.UpdateWorkflowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmUpdateWorkflow.UpdateWorkflowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateWorkflow.UpdateWorkflowMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateWorkflow.UpdateWorkflowMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateWorkflow.UpdateWorkflowMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockUpdateWorkflowParams{ctx, workflowID, workflowRunID, updateName, args}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.workflowID != nil && !minimock.Equal(*mm_want_ptrs.workflowID, mm_got.workflowID) {
+				mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameter workflowID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowID, mm_got.workflowID, minimock.Diff(*mm_want_ptrs.workflowID, mm_got.workflowID))
+			}
+
+			if mm_want_ptrs.workflowRunID != nil && !minimock.Equal(*mm_want_ptrs.workflowRunID, mm_got.workflowRunID) {
+				mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameter workflowRunID, want: %#v, got: %#v%s\n", *mm_want_ptrs.workflowRunID, mm_got.workflowRunID, minimock.Diff(*mm_want_ptrs.workflowRunID, mm_got.workflowRunID))
+			}
+
+			if mm_want_ptrs.updateName != nil && !minimock.Equal(*mm_want_ptrs.updateName, mm_got.updateName) {
+				mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameter updateName, want: %#v, got: %#v%s\n", *mm_want_ptrs.updateName, mm_got.updateName, minimock.Diff(*mm_want_ptrs.updateName, mm_got.updateName))
+			}
+
+			if mm_want_ptrs.args != nil && !minimock.Equal(*mm_want_ptrs.args, mm_got.args) {
+				mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameter args, want: %#v, got: %#v%s\n", *mm_want_ptrs.args, mm_got.args, minimock.Diff(*mm_want_ptrs.args, mm_got.args))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateWorkflow.t.Errorf("ClientMock.UpdateWorkflow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateWorkflow.UpdateWorkflowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateWorkflow.t.Fatal("No results are set for the ClientMock.UpdateWorkflow")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmUpdateWorkflow.funcUpdateWorkflow != nil {
+		return mmUpdateWorkflow.funcUpdateWorkflow(ctx, workflowID, workflowRunID, updateName, args...)
+	}
+	mmUpdateWorkflow.t.Fatalf("Unexpected call to ClientMock.UpdateWorkflow. %v %v %v %v %v", ctx, workflowID, workflowRunID, updateName, args)
+	return
+}
+
+// UpdateWorkflowAfterCounter returns a count of finished ClientMock.UpdateWorkflow invocations
+func (mmUpdateWorkflow *ClientMock) UpdateWorkflowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateWorkflow.afterUpdateWorkflowCounter)
+}
+
+// UpdateWorkflowBeforeCounter returns a count of ClientMock.UpdateWorkflow invocations
+func (mmUpdateWorkflow *ClientMock) UpdateWorkflowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateWorkflow.beforeUpdateWorkflowCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.UpdateWorkflow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateWorkflow *mClientMockUpdateWorkflow) Calls() []*ClientMockUpdateWorkflowParams {
+	mmUpdateWorkflow.mutex.RLock()
+
+	argCopy := make([]*ClientMockUpdateWorkflowParams, len(mmUpdateWorkflow.callArgs))
+	copy(argCopy, mmUpdateWorkflow.callArgs)
+
+	mmUpdateWorkflow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateWorkflowDone returns true if the count of the UpdateWorkflow invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockUpdateWorkflowDone() bool {
+	if m.UpdateWorkflowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateWorkflowMock.invocationsDone()
+}
+
+// MinimockUpdateWorkflowInspect logs each unmet expectation
+func (m *ClientMock) MinimockUpdateWorkflowInspect() {
+	for _, e := range m.UpdateWorkflowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.UpdateWorkflow with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateWorkflowCounter := mm_atomic.LoadUint64(&m.afterUpdateWorkflowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateWorkflowMock.defaultExpectation != nil && afterUpdateWorkflowCounter < 1 {
+		if m.UpdateWorkflowMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.UpdateWorkflow")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.UpdateWorkflow with params: %#v", *m.UpdateWorkflowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateWorkflow != nil && afterUpdateWorkflowCounter < 1 {
+		m.t.Error("Expected call to ClientMock.UpdateWorkflow")
+	}
+
+	if !m.UpdateWorkflowMock.invocationsDone() && afterUpdateWorkflowCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.UpdateWorkflow but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateWorkflowMock.expectedInvocations), afterUpdateWorkflowCounter)
+	}
+}
+
+type mClientMockUpdateWorkflowWithOptions struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockUpdateWorkflowWithOptionsExpectation
+	expectations       []*ClientMockUpdateWorkflowWithOptionsExpectation
+
+	callArgs []*ClientMockUpdateWorkflowWithOptionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ClientMockUpdateWorkflowWithOptionsExpectation specifies expectation struct of the Client.UpdateWorkflowWithOptions
+type ClientMockUpdateWorkflowWithOptionsExpectation struct {
+	mock      *ClientMock
+	params    *ClientMockUpdateWorkflowWithOptionsParams
+	paramPtrs *ClientMockUpdateWorkflowWithOptionsParamPtrs
+	results   *ClientMockUpdateWorkflowWithOptionsResults
+	Counter   uint64
+}
+
+// ClientMockUpdateWorkflowWithOptionsParams contains parameters of the Client.UpdateWorkflowWithOptions
+type ClientMockUpdateWorkflowWithOptionsParams struct {
+	ctx     context.Context
+	request *mm_client.UpdateWorkflowWithOptionsRequest
+}
+
+// ClientMockUpdateWorkflowWithOptionsParamPtrs contains pointers to parameters of the Client.UpdateWorkflowWithOptions
+type ClientMockUpdateWorkflowWithOptionsParamPtrs struct {
+	ctx     *context.Context
+	request **mm_client.UpdateWorkflowWithOptionsRequest
+}
+
+// ClientMockUpdateWorkflowWithOptionsResults contains results of the Client.UpdateWorkflowWithOptions
+type ClientMockUpdateWorkflowWithOptionsResults struct {
+	w1  mm_client.WorkflowUpdateHandle
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Optional() *mClientMockUpdateWorkflowWithOptions {
+	mmUpdateWorkflowWithOptions.optional = true
+	return mmUpdateWorkflowWithOptions
+}
+
+// Expect sets up expected params for Client.UpdateWorkflowWithOptions
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Expect(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest) *mClientMockUpdateWorkflowWithOptions {
+	if mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation = &ClientMockUpdateWorkflowWithOptionsExpectation{}
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateWorkflowWithOptions.defaultExpectation.params = &ClientMockUpdateWorkflowWithOptionsParams{ctx, request}
+	for _, e := range mmUpdateWorkflowWithOptions.expectations {
+		if minimock.Equal(e.params, mmUpdateWorkflowWithOptions.defaultExpectation.params) {
+			mmUpdateWorkflowWithOptions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateWorkflowWithOptions.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateWorkflowWithOptions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Client.UpdateWorkflowWithOptions
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) ExpectCtxParam1(ctx context.Context) *mClientMockUpdateWorkflowWithOptions {
+	if mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation = &ClientMockUpdateWorkflowWithOptionsExpectation{}
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation.params != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowWithOptionsParamPtrs{}
+	}
+	mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateWorkflowWithOptions
+}
+
+// ExpectRequestParam2 sets up expected param request for Client.UpdateWorkflowWithOptions
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) ExpectRequestParam2(request *mm_client.UpdateWorkflowWithOptionsRequest) *mClientMockUpdateWorkflowWithOptions {
+	if mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation = &ClientMockUpdateWorkflowWithOptionsExpectation{}
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation.params != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Expect")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs = &ClientMockUpdateWorkflowWithOptionsParamPtrs{}
+	}
+	mmUpdateWorkflowWithOptions.defaultExpectation.paramPtrs.request = &request
+
+	return mmUpdateWorkflowWithOptions
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.UpdateWorkflowWithOptions
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Inspect(f func(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest)) *mClientMockUpdateWorkflowWithOptions {
+	if mmUpdateWorkflowWithOptions.mock.inspectFuncUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("Inspect function is already set for ClientMock.UpdateWorkflowWithOptions")
+	}
+
+	mmUpdateWorkflowWithOptions.mock.inspectFuncUpdateWorkflowWithOptions = f
+
+	return mmUpdateWorkflowWithOptions
+}
+
+// Return sets up results that will be returned by Client.UpdateWorkflowWithOptions
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Return(w1 mm_client.WorkflowUpdateHandle, err error) *ClientMock {
+	if mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Set")
+	}
+
+	if mmUpdateWorkflowWithOptions.defaultExpectation == nil {
+		mmUpdateWorkflowWithOptions.defaultExpectation = &ClientMockUpdateWorkflowWithOptionsExpectation{mock: mmUpdateWorkflowWithOptions.mock}
+	}
+	mmUpdateWorkflowWithOptions.defaultExpectation.results = &ClientMockUpdateWorkflowWithOptionsResults{w1, err}
+	return mmUpdateWorkflowWithOptions.mock
+}
+
+// Set uses given function f to mock the Client.UpdateWorkflowWithOptions method
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Set(f func(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest) (w1 mm_client.WorkflowUpdateHandle, err error)) *ClientMock {
+	if mmUpdateWorkflowWithOptions.defaultExpectation != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("Default expectation is already set for the Client.UpdateWorkflowWithOptions method")
+	}
+
+	if len(mmUpdateWorkflowWithOptions.expectations) > 0 {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("Some expectations are already set for the Client.UpdateWorkflowWithOptions method")
+	}
+
+	mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions = f
+	return mmUpdateWorkflowWithOptions.mock
+}
+
+// When sets expectation for the Client.UpdateWorkflowWithOptions which will trigger the result defined by the following
+// Then helper
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) When(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest) *ClientMockUpdateWorkflowWithOptionsExpectation {
+	if mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("ClientMock.UpdateWorkflowWithOptions mock is already set by Set")
+	}
+
+	expectation := &ClientMockUpdateWorkflowWithOptionsExpectation{
+		mock:   mmUpdateWorkflowWithOptions.mock,
+		params: &ClientMockUpdateWorkflowWithOptionsParams{ctx, request},
+	}
+	mmUpdateWorkflowWithOptions.expectations = append(mmUpdateWorkflowWithOptions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.UpdateWorkflowWithOptions return parameters for the expectation previously defined by the When method
+func (e *ClientMockUpdateWorkflowWithOptionsExpectation) Then(w1 mm_client.WorkflowUpdateHandle, err error) *ClientMock {
+	e.results = &ClientMockUpdateWorkflowWithOptionsResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times Client.UpdateWorkflowWithOptions should be invoked
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Times(n uint64) *mClientMockUpdateWorkflowWithOptions {
+	if n == 0 {
+		mmUpdateWorkflowWithOptions.mock.t.Fatalf("Times of ClientMock.UpdateWorkflowWithOptions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateWorkflowWithOptions.expectedInvocations, n)
+	return mmUpdateWorkflowWithOptions
+}
+
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) invocationsDone() bool {
+	if len(mmUpdateWorkflowWithOptions.expectations) == 0 && mmUpdateWorkflowWithOptions.defaultExpectation == nil && mmUpdateWorkflowWithOptions.mock.funcUpdateWorkflowWithOptions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateWorkflowWithOptions.mock.afterUpdateWorkflowWithOptionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateWorkflowWithOptions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateWorkflowWithOptions implements client.Client
+func (mmUpdateWorkflowWithOptions *ClientMock) UpdateWorkflowWithOptions(ctx context.Context, request *mm_client.UpdateWorkflowWithOptionsRequest) (w1 mm_client.WorkflowUpdateHandle, err error) {
+	mm_atomic.AddUint64(&mmUpdateWorkflowWithOptions.beforeUpdateWorkflowWithOptionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateWorkflowWithOptions.afterUpdateWorkflowWithOptionsCounter, 1)
+
+	if mmUpdateWorkflowWithOptions.inspectFuncUpdateWorkflowWithOptions != nil {
+		mmUpdateWorkflowWithOptions.inspectFuncUpdateWorkflowWithOptions(ctx, request)
+	}
+
+	mm_params := ClientMockUpdateWorkflowWithOptionsParams{ctx, request}
+
+	// Record call args
+	mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.mutex.Lock()
+	mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.callArgs = append(mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.callArgs, &mm_params)
+	mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.mutex.Unlock()
+
+	for _, e := range mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientMockUpdateWorkflowWithOptionsParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateWorkflowWithOptions.t.Errorf("ClientMock.UpdateWorkflowWithOptions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmUpdateWorkflowWithOptions.t.Errorf("ClientMock.UpdateWorkflowWithOptions got unexpected parameter request, want: %#v, got: %#v%s\n", *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateWorkflowWithOptions.t.Errorf("ClientMock.UpdateWorkflowWithOptions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateWorkflowWithOptions.UpdateWorkflowWithOptionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateWorkflowWithOptions.t.Fatal("No results are set for the ClientMock.UpdateWorkflowWithOptions")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmUpdateWorkflowWithOptions.funcUpdateWorkflowWithOptions != nil {
+		return mmUpdateWorkflowWithOptions.funcUpdateWorkflowWithOptions(ctx, request)
+	}
+	mmUpdateWorkflowWithOptions.t.Fatalf("Unexpected call to ClientMock.UpdateWorkflowWithOptions. %v %v", ctx, request)
+	return
+}
+
+// UpdateWorkflowWithOptionsAfterCounter returns a count of finished ClientMock.UpdateWorkflowWithOptions invocations
+func (mmUpdateWorkflowWithOptions *ClientMock) UpdateWorkflowWithOptionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateWorkflowWithOptions.afterUpdateWorkflowWithOptionsCounter)
+}
+
+// UpdateWorkflowWithOptionsBeforeCounter returns a count of ClientMock.UpdateWorkflowWithOptions invocations
+func (mmUpdateWorkflowWithOptions *ClientMock) UpdateWorkflowWithOptionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateWorkflowWithOptions.beforeUpdateWorkflowWithOptionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.UpdateWorkflowWithOptions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateWorkflowWithOptions *mClientMockUpdateWorkflowWithOptions) Calls() []*ClientMockUpdateWorkflowWithOptionsParams {
+	mmUpdateWorkflowWithOptions.mutex.RLock()
+
+	argCopy := make([]*ClientMockUpdateWorkflowWithOptionsParams, len(mmUpdateWorkflowWithOptions.callArgs))
+	copy(argCopy, mmUpdateWorkflowWithOptions.callArgs)
+
+	mmUpdateWorkflowWithOptions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateWorkflowWithOptionsDone returns true if the count of the UpdateWorkflowWithOptions invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockUpdateWorkflowWithOptionsDone() bool {
+	if m.UpdateWorkflowWithOptionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateWorkflowWithOptionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateWorkflowWithOptionsMock.invocationsDone()
+}
+
+// MinimockUpdateWorkflowWithOptionsInspect logs each unmet expectation
+func (m *ClientMock) MinimockUpdateWorkflowWithOptionsInspect() {
+	for _, e := range m.UpdateWorkflowWithOptionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.UpdateWorkflowWithOptions with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateWorkflowWithOptionsCounter := mm_atomic.LoadUint64(&m.afterUpdateWorkflowWithOptionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateWorkflowWithOptionsMock.defaultExpectation != nil && afterUpdateWorkflowWithOptionsCounter < 1 {
+		if m.UpdateWorkflowWithOptionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.UpdateWorkflowWithOptions")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.UpdateWorkflowWithOptions with params: %#v", *m.UpdateWorkflowWithOptionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateWorkflowWithOptions != nil && afterUpdateWorkflowWithOptionsCounter < 1 {
+		m.t.Error("Expected call to ClientMock.UpdateWorkflowWithOptions")
+	}
+
+	if !m.UpdateWorkflowWithOptionsMock.invocationsDone() && afterUpdateWorkflowWithOptionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.UpdateWorkflowWithOptions but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateWorkflowWithOptionsMock.expectedInvocations), afterUpdateWorkflowWithOptionsCounter)
+	}
+}
+
+type mClientMockWorkflowService struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockWorkflowServiceExpectation
+	expectations       []*ClientMockWorkflowServiceExpectation
+
+	expectedInvocations uint64
+}
+
+// ClientMockWorkflowServiceExpectation specifies expectation struct of the Client.WorkflowService
+type ClientMockWorkflowServiceExpectation struct {
+	mock *ClientMock
+
+	results *ClientMockWorkflowServiceResults
+	Counter uint64
+}
+
+// ClientMockWorkflowServiceResults contains results of the Client.WorkflowService
+type ClientMockWorkflowServiceResults struct {
+	w1 workflowservice.WorkflowServiceClient
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWorkflowService *mClientMockWorkflowService) Optional() *mClientMockWorkflowService {
+	mmWorkflowService.optional = true
+	return mmWorkflowService
+}
+
+// Expect sets up expected params for Client.WorkflowService
+func (mmWorkflowService *mClientMockWorkflowService) Expect() *mClientMockWorkflowService {
+	if mmWorkflowService.mock.funcWorkflowService != nil {
+		mmWorkflowService.mock.t.Fatalf("ClientMock.WorkflowService mock is already set by Set")
+	}
+
+	if mmWorkflowService.defaultExpectation == nil {
+		mmWorkflowService.defaultExpectation = &ClientMockWorkflowServiceExpectation{}
+	}
+
+	return mmWorkflowService
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.WorkflowService
+func (mmWorkflowService *mClientMockWorkflowService) Inspect(f func()) *mClientMockWorkflowService {
+	if mmWorkflowService.mock.inspectFuncWorkflowService != nil {
+		mmWorkflowService.mock.t.Fatalf("Inspect function is already set for ClientMock.WorkflowService")
+	}
+
+	mmWorkflowService.mock.inspectFuncWorkflowService = f
+
+	return mmWorkflowService
+}
+
+// Return sets up results that will be returned by Client.WorkflowService
+func (mmWorkflowService *mClientMockWorkflowService) Return(w1 workflowservice.WorkflowServiceClient) *ClientMock {
+	if mmWorkflowService.mock.funcWorkflowService != nil {
+		mmWorkflowService.mock.t.Fatalf("ClientMock.WorkflowService mock is already set by Set")
+	}
+
+	if mmWorkflowService.defaultExpectation == nil {
+		mmWorkflowService.defaultExpectation = &ClientMockWorkflowServiceExpectation{mock: mmWorkflowService.mock}
+	}
+	mmWorkflowService.defaultExpectation.results = &ClientMockWorkflowServiceResults{w1}
+	return mmWorkflowService.mock
+}
+
+// Set uses given function f to mock the Client.WorkflowService method
+func (mmWorkflowService *mClientMockWorkflowService) Set(f func() (w1 workflowservice.WorkflowServiceClient)) *ClientMock {
+	if mmWorkflowService.defaultExpectation != nil {
+		mmWorkflowService.mock.t.Fatalf("Default expectation is already set for the Client.WorkflowService method")
+	}
+
+	if len(mmWorkflowService.expectations) > 0 {
+		mmWorkflowService.mock.t.Fatalf("Some expectations are already set for the Client.WorkflowService method")
+	}
+
+	mmWorkflowService.mock.funcWorkflowService = f
+	return mmWorkflowService.mock
+}
+
+// Times sets number of times Client.WorkflowService should be invoked
+func (mmWorkflowService *mClientMockWorkflowService) Times(n uint64) *mClientMockWorkflowService {
+	if n == 0 {
+		mmWorkflowService.mock.t.Fatalf("Times of ClientMock.WorkflowService mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWorkflowService.expectedInvocations, n)
+	return mmWorkflowService
+}
+
+func (mmWorkflowService *mClientMockWorkflowService) invocationsDone() bool {
+	if len(mmWorkflowService.expectations) == 0 && mmWorkflowService.defaultExpectation == nil && mmWorkflowService.mock.funcWorkflowService == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWorkflowService.mock.afterWorkflowServiceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWorkflowService.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WorkflowService implements client.Client
+func (mmWorkflowService *ClientMock) WorkflowService() (w1 workflowservice.WorkflowServiceClient) {
+	mm_atomic.AddUint64(&mmWorkflowService.beforeWorkflowServiceCounter, 1)
+	defer mm_atomic.AddUint64(&mmWorkflowService.afterWorkflowServiceCounter, 1)
+
+	if mmWorkflowService.inspectFuncWorkflowService != nil {
+		mmWorkflowService.inspectFuncWorkflowService()
+	}
+
+	if mmWorkflowService.WorkflowServiceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWorkflowService.WorkflowServiceMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmWorkflowService.WorkflowServiceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWorkflowService.t.Fatal("No results are set for the ClientMock.WorkflowService")
+		}
+		return (*mm_results).w1
+	}
+	if mmWorkflowService.funcWorkflowService != nil {
+		return mmWorkflowService.funcWorkflowService()
+	}
+	mmWorkflowService.t.Fatalf("Unexpected call to ClientMock.WorkflowService.")
+	return
+}
+
+// WorkflowServiceAfterCounter returns a count of finished ClientMock.WorkflowService invocations
+func (mmWorkflowService *ClientMock) WorkflowServiceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWorkflowService.afterWorkflowServiceCounter)
+}
+
+// WorkflowServiceBeforeCounter returns a count of ClientMock.WorkflowService invocations
+func (mmWorkflowService *ClientMock) WorkflowServiceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWorkflowService.beforeWorkflowServiceCounter)
+}
+
+// MinimockWorkflowServiceDone returns true if the count of the WorkflowService invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockWorkflowServiceDone() bool {
+	if m.WorkflowServiceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WorkflowServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WorkflowServiceMock.invocationsDone()
+}
+
+// MinimockWorkflowServiceInspect logs each unmet expectation
+func (m *ClientMock) MinimockWorkflowServiceInspect() {
+	for _, e := range m.WorkflowServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.WorkflowService")
+		}
+	}
+
+	afterWorkflowServiceCounter := mm_atomic.LoadUint64(&m.afterWorkflowServiceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WorkflowServiceMock.defaultExpectation != nil && afterWorkflowServiceCounter < 1 {
+		m.t.Error("Expected call to ClientMock.WorkflowService")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWorkflowService != nil && afterWorkflowServiceCounter < 1 {
+		m.t.Error("Expected call to ClientMock.WorkflowService")
+	}
+
+	if !m.WorkflowServiceMock.invocationsDone() && afterWorkflowServiceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.WorkflowService but found %d calls",
+			mm_atomic.LoadUint64(&m.WorkflowServiceMock.expectedInvocations), afterWorkflowServiceCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCancelWorkflowInspect()
+
+			m.MinimockCheckHealthInspect()
+
+			m.MinimockCloseInspect()
+
+			m.MinimockCompleteActivityInspect()
+
+			m.MinimockCompleteActivityByIDInspect()
+
+			m.MinimockCountWorkflowInspect()
+
+			m.MinimockDescribeTaskQueueInspect()
+
+			m.MinimockDescribeWorkflowExecutionInspect()
+
+			m.MinimockExecuteWorkflowInspect()
+
+			m.MinimockGetSearchAttributesInspect()
+
+			m.MinimockGetWorkflowInspect()
+
+			m.MinimockGetWorkflowHistoryInspect()
+
+			m.MinimockListArchivedWorkflowInspect()
+
+			m.MinimockListClosedWorkflowInspect()
+
+			m.MinimockListOpenWorkflowInspect()
+
+			m.MinimockListWorkflowInspect()
+
+			m.MinimockOperatorServiceInspect()
+
+			m.MinimockQueryWorkflowInspect()
+
+			m.MinimockQueryWorkflowWithOptionsInspect()
+
+			m.MinimockRecordActivityHeartbeatInspect()
+
+			m.MinimockRecordActivityHeartbeatByIDInspect()
+
+			m.MinimockResetWorkflowExecutionInspect()
+
+			m.MinimockScanWorkflowInspect()
+
+			m.MinimockScheduleClientInspect()
+
+			m.MinimockSignalWithStartWorkflowInspect()
+
+			m.MinimockSignalWorkflowInspect()
+
+			m.MinimockTerminateWorkflowInspect()
+
+			m.MinimockUpdateWorkflowInspect()
+
+			m.MinimockUpdateWorkflowWithOptionsInspect()
+
+			m.MinimockWorkflowServiceInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCancelWorkflowDone() &&
+		m.MinimockCheckHealthDone() &&
+		m.MinimockCloseDone() &&
+		m.MinimockCompleteActivityDone() &&
+		m.MinimockCompleteActivityByIDDone() &&
+		m.MinimockCountWorkflowDone() &&
+		m.MinimockDescribeTaskQueueDone() &&
+		m.MinimockDescribeWorkflowExecutionDone() &&
+		m.MinimockExecuteWorkflowDone() &&
+		m.MinimockGetSearchAttributesDone() &&
+		m.MinimockGetWorkflowDone() &&
+		m.MinimockGetWorkflowHistoryDone() &&
+		m.MinimockListArchivedWorkflowDone() &&
+		m.MinimockListClosedWorkflowDone() &&
+		m.MinimockListOpenWorkflowDone() &&
+		m.MinimockListWorkflowDone() &&
+		m.MinimockOperatorServiceDone() &&
+		m.MinimockQueryWorkflowDone() &&
+		m.MinimockQueryWorkflowWithOptionsDone() &&
+		m.MinimockRecordActivityHeartbeatDone() &&
+		m.MinimockRecordActivityHeartbeatByIDDone() &&
+		m.MinimockResetWorkflowExecutionDone() &&
+		m.MinimockScanWorkflowDone() &&
+		m.MinimockScheduleClientDone() &&
+		m.MinimockSignalWithStartWorkflowDone() &&
+		m.MinimockSignalWorkflowDone() &&
+		m.MinimockTerminateWorkflowDone() &&
+		m.MinimockUpdateWorkflowDone() &&
+		m.MinimockUpdateWorkflowWithOptionsDone() &&
+		m.MinimockWorkflowServiceDone()
+}
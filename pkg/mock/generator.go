@@ -4,3 +4,4 @@ package mock
 //go:generate minimock -g -i github.com/instill-ai/pipeline-backend/pkg/acl.ACLClientInterface -o ./ -s "_mock.gen.go"
 //go:generate minimock -g -i github.com/instill-ai/pipeline-backend/pkg/service.Converter -o ./ -s "_mock.gen.go"
 //go:generate minimock -g -i github.com/instill-ai/protogen-go/core/mgmt/v1beta.MgmtPrivateServiceClient -o ./ -s "_mock.gen.go"
+//go:generate minimock -g -i go.temporal.io/sdk/client.Client -o ./ -s "_mock.gen.go"
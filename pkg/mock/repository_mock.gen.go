@@ -42,6 +42,12 @@ type RepositoryMock struct {
 	beforeCheckPinnedUserCounter uint64
 	CheckPinnedUserMock          mRepositoryMockCheckPinnedUser
 
+	funcClearExpiredPipelineRunData          func(ctx context.Context, limit int) (i1 int64, err error)
+	inspectFuncClearExpiredPipelineRunData   func(ctx context.Context, limit int)
+	afterClearExpiredPipelineRunDataCounter  uint64
+	beforeClearExpiredPipelineRunDataCounter uint64
+	ClearExpiredPipelineRunDataMock          mRepositoryMockClearExpiredPipelineRunData
+
 	funcCreateNamespacePipeline          func(ctx context.Context, pipeline *datamodel.Pipeline) (err error)
 	inspectFuncCreateNamespacePipeline   func(ctx context.Context, pipeline *datamodel.Pipeline)
 	afterCreateNamespacePipelineCounter  uint64
@@ -144,18 +150,42 @@ type RepositoryMock struct {
 	beforeGetPipelineByUIDAdminCounter uint64
 	GetPipelineByUIDAdminMock          mRepositoryMockGetPipelineByUIDAdmin
 
+	funcGetPipelineRunByTriggerID          func(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineRun, err error)
+	inspectFuncGetPipelineRunByTriggerID   func(ctx context.Context, pipelineTriggerID string)
+	afterGetPipelineRunByTriggerIDCounter  uint64
+	beforeGetPipelineRunByTriggerIDCounter uint64
+	GetPipelineRunByTriggerIDMock          mRepositoryMockGetPipelineRunByTriggerID
+
+	funcGetPipelineTriggerResult          func(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineTriggerResult, err error)
+	inspectFuncGetPipelineTriggerResult   func(ctx context.Context, pipelineTriggerID string)
+	afterGetPipelineTriggerResultCounter  uint64
+	beforeGetPipelineTriggerResultCounter uint64
+	GetPipelineTriggerResultMock          mRepositoryMockGetPipelineTriggerResult
+
 	funcListComponentDefinitionUIDs          func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) (uids []*datamodel.ComponentDefinition, totalSize int64, err error)
 	inspectFuncListComponentDefinitionUIDs   func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams)
 	afterListComponentDefinitionUIDsCounter  uint64
 	beforeListComponentDefinitionUIDsCounter uint64
 	ListComponentDefinitionUIDsMock          mRepositoryMockListComponentDefinitionUIDs
 
+	funcListComponentRuns          func(ctx context.Context, pipelineTriggerIDs []string) (cpa1 []*datamodel.ComponentRun, err error)
+	inspectFuncListComponentRuns   func(ctx context.Context, pipelineTriggerIDs []string)
+	afterListComponentRunsCounter  uint64
+	beforeListComponentRunsCounter uint64
+	ListComponentRunsMock          mRepositoryMockListComponentRuns
+
 	funcListNamespacePipelineReleases          func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) (ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error)
 	inspectFuncListNamespacePipelineReleases   func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool)
 	afterListNamespacePipelineReleasesCounter  uint64
 	beforeListNamespacePipelineReleasesCounter uint64
 	ListNamespacePipelineReleasesMock          mRepositoryMockListNamespacePipelineReleases
 
+	funcListNamespacePipelineRuns          func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error)
+	inspectFuncListNamespacePipelineRuns   func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter)
+	afterListNamespacePipelineRunsCounter  uint64
+	beforeListNamespacePipelineRunsCounter uint64
+	ListNamespacePipelineRunsMock          mRepositoryMockListNamespacePipelineRuns
+
 	funcListNamespacePipelines          func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)
 	inspectFuncListNamespacePipelines   func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy)
 	afterListNamespacePipelinesCounter  uint64
@@ -168,6 +198,12 @@ type RepositoryMock struct {
 	beforeListNamespaceSecretsCounter uint64
 	ListNamespaceSecretsMock          mRepositoryMockListNamespaceSecrets
 
+	funcListPipelineRuns          func(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error)
+	inspectFuncListPipelineRuns   func(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter)
+	afterListPipelineRunsCounter  uint64
+	beforeListPipelineRunsCounter uint64
+	ListPipelineRunsMock          mRepositoryMockListPipelineRuns
+
 	funcListPipelineTags          func(ctx context.Context, pipelineUID uuid.UUID) (ta1 []datamodel.Tag, err error)
 	inspectFuncListPipelineTags   func(ctx context.Context, pipelineUID uuid.UUID)
 	afterListPipelineTagsCounter  uint64
@@ -228,11 +264,35 @@ type RepositoryMock struct {
 	beforeUpdateNamespaceSecretByIDCounter uint64
 	UpdateNamespaceSecretByIDMock          mRepositoryMockUpdateNamespaceSecretByID
 
+	funcUpdatePipelineRun          func(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) (err error)
+	inspectFuncUpdatePipelineRun   func(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun)
+	afterUpdatePipelineRunCounter  uint64
+	beforeUpdatePipelineRunCounter uint64
+	UpdatePipelineRunMock          mRepositoryMockUpdatePipelineRun
+
 	funcUpsertComponentDefinition          func(ctx context.Context, cp1 *pb.ComponentDefinition) (err error)
 	inspectFuncUpsertComponentDefinition   func(ctx context.Context, cp1 *pb.ComponentDefinition)
 	afterUpsertComponentDefinitionCounter  uint64
 	beforeUpsertComponentDefinitionCounter uint64
 	UpsertComponentDefinitionMock          mRepositoryMockUpsertComponentDefinition
+
+	funcUpsertComponentRuns          func(ctx context.Context, runs []*datamodel.ComponentRun) (err error)
+	inspectFuncUpsertComponentRuns   func(ctx context.Context, runs []*datamodel.ComponentRun)
+	afterUpsertComponentRunsCounter  uint64
+	beforeUpsertComponentRunsCounter uint64
+	UpsertComponentRunsMock          mRepositoryMockUpsertComponentRuns
+
+	funcUpsertPipelineRun          func(ctx context.Context, run *datamodel.PipelineRun) (err error)
+	inspectFuncUpsertPipelineRun   func(ctx context.Context, run *datamodel.PipelineRun)
+	afterUpsertPipelineRunCounter  uint64
+	beforeUpsertPipelineRunCounter uint64
+	UpsertPipelineRunMock          mRepositoryMockUpsertPipelineRun
+
+	funcUpsertPipelineTriggerResult          func(ctx context.Context, result *datamodel.PipelineTriggerResult) (err error)
+	inspectFuncUpsertPipelineTriggerResult   func(ctx context.Context, result *datamodel.PipelineTriggerResult)
+	afterUpsertPipelineTriggerResultCounter  uint64
+	beforeUpsertPipelineTriggerResultCounter uint64
+	UpsertPipelineTriggerResultMock          mRepositoryMockUpsertPipelineTriggerResult
 }
 
 // NewRepositoryMock returns a mock for repository.Repository
@@ -252,6 +312,9 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.CheckPinnedUserMock = mRepositoryMockCheckPinnedUser{mock: m}
 	m.CheckPinnedUserMock.callArgs = []*RepositoryMockCheckPinnedUserParams{}
 
+	m.ClearExpiredPipelineRunDataMock = mRepositoryMockClearExpiredPipelineRunData{mock: m}
+	m.ClearExpiredPipelineRunDataMock.callArgs = []*RepositoryMockClearExpiredPipelineRunDataParams{}
+
 	m.CreateNamespacePipelineMock = mRepositoryMockCreateNamespacePipeline{mock: m}
 	m.CreateNamespacePipelineMock.callArgs = []*RepositoryMockCreateNamespacePipelineParams{}
 
@@ -303,18 +366,33 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.GetPipelineByUIDAdminMock = mRepositoryMockGetPipelineByUIDAdmin{mock: m}
 	m.GetPipelineByUIDAdminMock.callArgs = []*RepositoryMockGetPipelineByUIDAdminParams{}
 
+	m.GetPipelineRunByTriggerIDMock = mRepositoryMockGetPipelineRunByTriggerID{mock: m}
+	m.GetPipelineRunByTriggerIDMock.callArgs = []*RepositoryMockGetPipelineRunByTriggerIDParams{}
+
+	m.GetPipelineTriggerResultMock = mRepositoryMockGetPipelineTriggerResult{mock: m}
+	m.GetPipelineTriggerResultMock.callArgs = []*RepositoryMockGetPipelineTriggerResultParams{}
+
 	m.ListComponentDefinitionUIDsMock = mRepositoryMockListComponentDefinitionUIDs{mock: m}
 	m.ListComponentDefinitionUIDsMock.callArgs = []*RepositoryMockListComponentDefinitionUIDsParams{}
 
+	m.ListComponentRunsMock = mRepositoryMockListComponentRuns{mock: m}
+	m.ListComponentRunsMock.callArgs = []*RepositoryMockListComponentRunsParams{}
+
 	m.ListNamespacePipelineReleasesMock = mRepositoryMockListNamespacePipelineReleases{mock: m}
 	m.ListNamespacePipelineReleasesMock.callArgs = []*RepositoryMockListNamespacePipelineReleasesParams{}
 
+	m.ListNamespacePipelineRunsMock = mRepositoryMockListNamespacePipelineRuns{mock: m}
+	m.ListNamespacePipelineRunsMock.callArgs = []*RepositoryMockListNamespacePipelineRunsParams{}
+
 	m.ListNamespacePipelinesMock = mRepositoryMockListNamespacePipelines{mock: m}
 	m.ListNamespacePipelinesMock.callArgs = []*RepositoryMockListNamespacePipelinesParams{}
 
 	m.ListNamespaceSecretsMock = mRepositoryMockListNamespaceSecrets{mock: m}
 	m.ListNamespaceSecretsMock.callArgs = []*RepositoryMockListNamespaceSecretsParams{}
 
+	m.ListPipelineRunsMock = mRepositoryMockListPipelineRuns{mock: m}
+	m.ListPipelineRunsMock.callArgs = []*RepositoryMockListPipelineRunsParams{}
+
 	m.ListPipelineTagsMock = mRepositoryMockListPipelineTags{mock: m}
 	m.ListPipelineTagsMock.callArgs = []*RepositoryMockListPipelineTagsParams{}
 
@@ -345,9 +423,21 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.UpdateNamespaceSecretByIDMock = mRepositoryMockUpdateNamespaceSecretByID{mock: m}
 	m.UpdateNamespaceSecretByIDMock.callArgs = []*RepositoryMockUpdateNamespaceSecretByIDParams{}
 
+	m.UpdatePipelineRunMock = mRepositoryMockUpdatePipelineRun{mock: m}
+	m.UpdatePipelineRunMock.callArgs = []*RepositoryMockUpdatePipelineRunParams{}
+
 	m.UpsertComponentDefinitionMock = mRepositoryMockUpsertComponentDefinition{mock: m}
 	m.UpsertComponentDefinitionMock.callArgs = []*RepositoryMockUpsertComponentDefinitionParams{}
 
+	m.UpsertComponentRunsMock = mRepositoryMockUpsertComponentRuns{mock: m}
+	m.UpsertComponentRunsMock.callArgs = []*RepositoryMockUpsertComponentRunsParams{}
+
+	m.UpsertPipelineRunMock = mRepositoryMockUpsertPipelineRun{mock: m}
+	m.UpsertPipelineRunMock.callArgs = []*RepositoryMockUpsertPipelineRunParams{}
+
+	m.UpsertPipelineTriggerResultMock = mRepositoryMockUpsertPipelineTriggerResult{mock: m}
+	m.UpsertPipelineTriggerResultMock.callArgs = []*RepositoryMockUpsertPipelineTriggerResultParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1341,6 +1431,327 @@ func (m *RepositoryMock) MinimockCheckPinnedUserInspect() {
 	}
 }
 
+type mRepositoryMockClearExpiredPipelineRunData struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockClearExpiredPipelineRunDataExpectation
+	expectations       []*RepositoryMockClearExpiredPipelineRunDataExpectation
+
+	callArgs []*RepositoryMockClearExpiredPipelineRunDataParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockClearExpiredPipelineRunDataExpectation specifies expectation struct of the Repository.ClearExpiredPipelineRunData
+type RepositoryMockClearExpiredPipelineRunDataExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockClearExpiredPipelineRunDataParams
+	paramPtrs *RepositoryMockClearExpiredPipelineRunDataParamPtrs
+	results   *RepositoryMockClearExpiredPipelineRunDataResults
+	Counter   uint64
+}
+
+// RepositoryMockClearExpiredPipelineRunDataParams contains parameters of the Repository.ClearExpiredPipelineRunData
+type RepositoryMockClearExpiredPipelineRunDataParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// RepositoryMockClearExpiredPipelineRunDataParamPtrs contains pointers to parameters of the Repository.ClearExpiredPipelineRunData
+type RepositoryMockClearExpiredPipelineRunDataParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// RepositoryMockClearExpiredPipelineRunDataResults contains results of the Repository.ClearExpiredPipelineRunData
+type RepositoryMockClearExpiredPipelineRunDataResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Optional() *mRepositoryMockClearExpiredPipelineRunData {
+	mmClearExpiredPipelineRunData.optional = true
+	return mmClearExpiredPipelineRunData
+}
+
+// Expect sets up expected params for Repository.ClearExpiredPipelineRunData
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Expect(ctx context.Context, limit int) *mRepositoryMockClearExpiredPipelineRunData {
+	if mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Set")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation = &RepositoryMockClearExpiredPipelineRunDataExpectation{}
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by ExpectParams functions")
+	}
+
+	mmClearExpiredPipelineRunData.defaultExpectation.params = &RepositoryMockClearExpiredPipelineRunDataParams{ctx, limit}
+	for _, e := range mmClearExpiredPipelineRunData.expectations {
+		if minimock.Equal(e.params, mmClearExpiredPipelineRunData.defaultExpectation.params) {
+			mmClearExpiredPipelineRunData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClearExpiredPipelineRunData.defaultExpectation.params)
+		}
+	}
+
+	return mmClearExpiredPipelineRunData
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ClearExpiredPipelineRunData
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) ExpectCtxParam1(ctx context.Context) *mRepositoryMockClearExpiredPipelineRunData {
+	if mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Set")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation = &RepositoryMockClearExpiredPipelineRunDataExpectation{}
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation.params != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Expect")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs = &RepositoryMockClearExpiredPipelineRunDataParamPtrs{}
+	}
+	mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClearExpiredPipelineRunData
+}
+
+// ExpectLimitParam2 sets up expected param limit for Repository.ClearExpiredPipelineRunData
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) ExpectLimitParam2(limit int) *mRepositoryMockClearExpiredPipelineRunData {
+	if mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Set")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation = &RepositoryMockClearExpiredPipelineRunDataExpectation{}
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation.params != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Expect")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs = &RepositoryMockClearExpiredPipelineRunDataParamPtrs{}
+	}
+	mmClearExpiredPipelineRunData.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmClearExpiredPipelineRunData
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ClearExpiredPipelineRunData
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Inspect(f func(ctx context.Context, limit int)) *mRepositoryMockClearExpiredPipelineRunData {
+	if mmClearExpiredPipelineRunData.mock.inspectFuncClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ClearExpiredPipelineRunData")
+	}
+
+	mmClearExpiredPipelineRunData.mock.inspectFuncClearExpiredPipelineRunData = f
+
+	return mmClearExpiredPipelineRunData
+}
+
+// Return sets up results that will be returned by Repository.ClearExpiredPipelineRunData
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Return(i1 int64, err error) *RepositoryMock {
+	if mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Set")
+	}
+
+	if mmClearExpiredPipelineRunData.defaultExpectation == nil {
+		mmClearExpiredPipelineRunData.defaultExpectation = &RepositoryMockClearExpiredPipelineRunDataExpectation{mock: mmClearExpiredPipelineRunData.mock}
+	}
+	mmClearExpiredPipelineRunData.defaultExpectation.results = &RepositoryMockClearExpiredPipelineRunDataResults{i1, err}
+	return mmClearExpiredPipelineRunData.mock
+}
+
+// Set uses given function f to mock the Repository.ClearExpiredPipelineRunData method
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Set(f func(ctx context.Context, limit int) (i1 int64, err error)) *RepositoryMock {
+	if mmClearExpiredPipelineRunData.defaultExpectation != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("Default expectation is already set for the Repository.ClearExpiredPipelineRunData method")
+	}
+
+	if len(mmClearExpiredPipelineRunData.expectations) > 0 {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("Some expectations are already set for the Repository.ClearExpiredPipelineRunData method")
+	}
+
+	mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData = f
+	return mmClearExpiredPipelineRunData.mock
+}
+
+// When sets expectation for the Repository.ClearExpiredPipelineRunData which will trigger the result defined by the following
+// Then helper
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) When(ctx context.Context, limit int) *RepositoryMockClearExpiredPipelineRunDataExpectation {
+	if mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("RepositoryMock.ClearExpiredPipelineRunData mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockClearExpiredPipelineRunDataExpectation{
+		mock:   mmClearExpiredPipelineRunData.mock,
+		params: &RepositoryMockClearExpiredPipelineRunDataParams{ctx, limit},
+	}
+	mmClearExpiredPipelineRunData.expectations = append(mmClearExpiredPipelineRunData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ClearExpiredPipelineRunData return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockClearExpiredPipelineRunDataExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockClearExpiredPipelineRunDataResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ClearExpiredPipelineRunData should be invoked
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Times(n uint64) *mRepositoryMockClearExpiredPipelineRunData {
+	if n == 0 {
+		mmClearExpiredPipelineRunData.mock.t.Fatalf("Times of RepositoryMock.ClearExpiredPipelineRunData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClearExpiredPipelineRunData.expectedInvocations, n)
+	return mmClearExpiredPipelineRunData
+}
+
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) invocationsDone() bool {
+	if len(mmClearExpiredPipelineRunData.expectations) == 0 && mmClearExpiredPipelineRunData.defaultExpectation == nil && mmClearExpiredPipelineRunData.mock.funcClearExpiredPipelineRunData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClearExpiredPipelineRunData.mock.afterClearExpiredPipelineRunDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClearExpiredPipelineRunData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClearExpiredPipelineRunData implements repository.Repository
+func (mmClearExpiredPipelineRunData *RepositoryMock) ClearExpiredPipelineRunData(ctx context.Context, limit int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmClearExpiredPipelineRunData.beforeClearExpiredPipelineRunDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmClearExpiredPipelineRunData.afterClearExpiredPipelineRunDataCounter, 1)
+
+	if mmClearExpiredPipelineRunData.inspectFuncClearExpiredPipelineRunData != nil {
+		mmClearExpiredPipelineRunData.inspectFuncClearExpiredPipelineRunData(ctx, limit)
+	}
+
+	mm_params := RepositoryMockClearExpiredPipelineRunDataParams{ctx, limit}
+
+	// Record call args
+	mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.mutex.Lock()
+	mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.callArgs = append(mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.callArgs, &mm_params)
+	mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.mutex.Unlock()
+
+	for _, e := range mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.defaultExpectation.params
+		mm_want_ptrs := mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockClearExpiredPipelineRunDataParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClearExpiredPipelineRunData.t.Errorf("RepositoryMock.ClearExpiredPipelineRunData got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClearExpiredPipelineRunData.t.Errorf("RepositoryMock.ClearExpiredPipelineRunData got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClearExpiredPipelineRunData.t.Errorf("RepositoryMock.ClearExpiredPipelineRunData got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClearExpiredPipelineRunData.ClearExpiredPipelineRunDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClearExpiredPipelineRunData.t.Fatal("No results are set for the RepositoryMock.ClearExpiredPipelineRunData")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmClearExpiredPipelineRunData.funcClearExpiredPipelineRunData != nil {
+		return mmClearExpiredPipelineRunData.funcClearExpiredPipelineRunData(ctx, limit)
+	}
+	mmClearExpiredPipelineRunData.t.Fatalf("Unexpected call to RepositoryMock.ClearExpiredPipelineRunData. %v %v", ctx, limit)
+	return
+}
+
+// ClearExpiredPipelineRunDataAfterCounter returns a count of finished RepositoryMock.ClearExpiredPipelineRunData invocations
+func (mmClearExpiredPipelineRunData *RepositoryMock) ClearExpiredPipelineRunDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearExpiredPipelineRunData.afterClearExpiredPipelineRunDataCounter)
+}
+
+// ClearExpiredPipelineRunDataBeforeCounter returns a count of RepositoryMock.ClearExpiredPipelineRunData invocations
+func (mmClearExpiredPipelineRunData *RepositoryMock) ClearExpiredPipelineRunDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearExpiredPipelineRunData.beforeClearExpiredPipelineRunDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ClearExpiredPipelineRunData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClearExpiredPipelineRunData *mRepositoryMockClearExpiredPipelineRunData) Calls() []*RepositoryMockClearExpiredPipelineRunDataParams {
+	mmClearExpiredPipelineRunData.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockClearExpiredPipelineRunDataParams, len(mmClearExpiredPipelineRunData.callArgs))
+	copy(argCopy, mmClearExpiredPipelineRunData.callArgs)
+
+	mmClearExpiredPipelineRunData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClearExpiredPipelineRunDataDone returns true if the count of the ClearExpiredPipelineRunData invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockClearExpiredPipelineRunDataDone() bool {
+	if m.ClearExpiredPipelineRunDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClearExpiredPipelineRunDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClearExpiredPipelineRunDataMock.invocationsDone()
+}
+
+// MinimockClearExpiredPipelineRunDataInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockClearExpiredPipelineRunDataInspect() {
+	for _, e := range m.ClearExpiredPipelineRunDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ClearExpiredPipelineRunData with params: %#v", *e.params)
+		}
+	}
+
+	afterClearExpiredPipelineRunDataCounter := mm_atomic.LoadUint64(&m.afterClearExpiredPipelineRunDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClearExpiredPipelineRunDataMock.defaultExpectation != nil && afterClearExpiredPipelineRunDataCounter < 1 {
+		if m.ClearExpiredPipelineRunDataMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ClearExpiredPipelineRunData")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ClearExpiredPipelineRunData with params: %#v", *m.ClearExpiredPipelineRunDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClearExpiredPipelineRunData != nil && afterClearExpiredPipelineRunDataCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ClearExpiredPipelineRunData")
+	}
+
+	if !m.ClearExpiredPipelineRunDataMock.invocationsDone() && afterClearExpiredPipelineRunDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ClearExpiredPipelineRunData but found %d calls",
+			mm_atomic.LoadUint64(&m.ClearExpiredPipelineRunDataMock.expectedInvocations), afterClearExpiredPipelineRunDataCounter)
+	}
+}
+
 type mRepositoryMockCreateNamespacePipeline struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -7434,44 +7845,43 @@ func (m *RepositoryMock) MinimockGetPipelineByUIDAdminInspect() {
 	}
 }
 
-type mRepositoryMockListComponentDefinitionUIDs struct {
+type mRepositoryMockGetPipelineRunByTriggerID struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListComponentDefinitionUIDsExpectation
-	expectations       []*RepositoryMockListComponentDefinitionUIDsExpectation
+	defaultExpectation *RepositoryMockGetPipelineRunByTriggerIDExpectation
+	expectations       []*RepositoryMockGetPipelineRunByTriggerIDExpectation
 
-	callArgs []*RepositoryMockListComponentDefinitionUIDsParams
+	callArgs []*RepositoryMockGetPipelineRunByTriggerIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListComponentDefinitionUIDsExpectation specifies expectation struct of the Repository.ListComponentDefinitionUIDs
-type RepositoryMockListComponentDefinitionUIDsExpectation struct {
+// RepositoryMockGetPipelineRunByTriggerIDExpectation specifies expectation struct of the Repository.GetPipelineRunByTriggerID
+type RepositoryMockGetPipelineRunByTriggerIDExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListComponentDefinitionUIDsParams
-	paramPtrs *RepositoryMockListComponentDefinitionUIDsParamPtrs
-	results   *RepositoryMockListComponentDefinitionUIDsResults
+	params    *RepositoryMockGetPipelineRunByTriggerIDParams
+	paramPtrs *RepositoryMockGetPipelineRunByTriggerIDParamPtrs
+	results   *RepositoryMockGetPipelineRunByTriggerIDResults
 	Counter   uint64
 }
 
-// RepositoryMockListComponentDefinitionUIDsParams contains parameters of the Repository.ListComponentDefinitionUIDs
-type RepositoryMockListComponentDefinitionUIDsParams struct {
-	ctx context.Context
-	l1  mm_repository.ListComponentDefinitionsParams
+// RepositoryMockGetPipelineRunByTriggerIDParams contains parameters of the Repository.GetPipelineRunByTriggerID
+type RepositoryMockGetPipelineRunByTriggerIDParams struct {
+	ctx               context.Context
+	pipelineTriggerID string
 }
 
-// RepositoryMockListComponentDefinitionUIDsParamPtrs contains pointers to parameters of the Repository.ListComponentDefinitionUIDs
-type RepositoryMockListComponentDefinitionUIDsParamPtrs struct {
-	ctx *context.Context
-	l1  *mm_repository.ListComponentDefinitionsParams
+// RepositoryMockGetPipelineRunByTriggerIDParamPtrs contains pointers to parameters of the Repository.GetPipelineRunByTriggerID
+type RepositoryMockGetPipelineRunByTriggerIDParamPtrs struct {
+	ctx               *context.Context
+	pipelineTriggerID *string
 }
 
-// RepositoryMockListComponentDefinitionUIDsResults contains results of the Repository.ListComponentDefinitionUIDs
-type RepositoryMockListComponentDefinitionUIDsResults struct {
-	uids      []*datamodel.ComponentDefinition
-	totalSize int64
-	err       error
+// RepositoryMockGetPipelineRunByTriggerIDResults contains results of the Repository.GetPipelineRunByTriggerID
+type RepositoryMockGetPipelineRunByTriggerIDResults struct {
+	pp1 *datamodel.PipelineRun
+	err error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -7479,336 +7889,320 @@ type RepositoryMockListComponentDefinitionUIDsResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Optional() *mRepositoryMockListComponentDefinitionUIDs {
-	mmListComponentDefinitionUIDs.optional = true
-	return mmListComponentDefinitionUIDs
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Optional() *mRepositoryMockGetPipelineRunByTriggerID {
+	mmGetPipelineRunByTriggerID.optional = true
+	return mmGetPipelineRunByTriggerID
 }
 
-// Expect sets up expected params for Repository.ListComponentDefinitionUIDs
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Expect(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) *mRepositoryMockListComponentDefinitionUIDs {
-	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
+// Expect sets up expected params for Repository.GetPipelineRunByTriggerID
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Expect(ctx context.Context, pipelineTriggerID string) *mRepositoryMockGetPipelineRunByTriggerID {
+	if mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Set")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
+	if mmGetPipelineRunByTriggerID.defaultExpectation == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation = &RepositoryMockGetPipelineRunByTriggerIDExpectation{}
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by ExpectParams functions")
+	if mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by ExpectParams functions")
 	}
 
-	mmListComponentDefinitionUIDs.defaultExpectation.params = &RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
-	for _, e := range mmListComponentDefinitionUIDs.expectations {
-		if minimock.Equal(e.params, mmListComponentDefinitionUIDs.defaultExpectation.params) {
-			mmListComponentDefinitionUIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListComponentDefinitionUIDs.defaultExpectation.params)
+	mmGetPipelineRunByTriggerID.defaultExpectation.params = &RepositoryMockGetPipelineRunByTriggerIDParams{ctx, pipelineTriggerID}
+	for _, e := range mmGetPipelineRunByTriggerID.expectations {
+		if minimock.Equal(e.params, mmGetPipelineRunByTriggerID.defaultExpectation.params) {
+			mmGetPipelineRunByTriggerID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPipelineRunByTriggerID.defaultExpectation.params)
 		}
 	}
 
-	return mmListComponentDefinitionUIDs
+	return mmGetPipelineRunByTriggerID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListComponentDefinitionUIDs
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListComponentDefinitionUIDs {
-	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPipelineRunByTriggerID
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPipelineRunByTriggerID {
+	if mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Set")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
+	if mmGetPipelineRunByTriggerID.defaultExpectation == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation = &RepositoryMockGetPipelineRunByTriggerIDExpectation{}
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation.params != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Expect")
+	if mmGetPipelineRunByTriggerID.defaultExpectation.params != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Expect")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs = &RepositoryMockListComponentDefinitionUIDsParamPtrs{}
+	if mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs = &RepositoryMockGetPipelineRunByTriggerIDParamPtrs{}
 	}
-	mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListComponentDefinitionUIDs
+	return mmGetPipelineRunByTriggerID
 }
 
-// ExpectL1Param2 sets up expected param l1 for Repository.ListComponentDefinitionUIDs
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) ExpectL1Param2(l1 mm_repository.ListComponentDefinitionsParams) *mRepositoryMockListComponentDefinitionUIDs {
-	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
+// ExpectPipelineTriggerIDParam2 sets up expected param pipelineTriggerID for Repository.GetPipelineRunByTriggerID
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) ExpectPipelineTriggerIDParam2(pipelineTriggerID string) *mRepositoryMockGetPipelineRunByTriggerID {
+	if mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Set")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
+	if mmGetPipelineRunByTriggerID.defaultExpectation == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation = &RepositoryMockGetPipelineRunByTriggerIDExpectation{}
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation.params != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Expect")
+	if mmGetPipelineRunByTriggerID.defaultExpectation.params != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Expect")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs = &RepositoryMockListComponentDefinitionUIDsParamPtrs{}
+	if mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs = &RepositoryMockGetPipelineRunByTriggerIDParamPtrs{}
 	}
-	mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs.l1 = &l1
+	mmGetPipelineRunByTriggerID.defaultExpectation.paramPtrs.pipelineTriggerID = &pipelineTriggerID
 
-	return mmListComponentDefinitionUIDs
+	return mmGetPipelineRunByTriggerID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListComponentDefinitionUIDs
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Inspect(f func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams)) *mRepositoryMockListComponentDefinitionUIDs {
-	if mmListComponentDefinitionUIDs.mock.inspectFuncListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListComponentDefinitionUIDs")
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPipelineRunByTriggerID
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Inspect(f func(ctx context.Context, pipelineTriggerID string)) *mRepositoryMockGetPipelineRunByTriggerID {
+	if mmGetPipelineRunByTriggerID.mock.inspectFuncGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPipelineRunByTriggerID")
 	}
 
-	mmListComponentDefinitionUIDs.mock.inspectFuncListComponentDefinitionUIDs = f
+	mmGetPipelineRunByTriggerID.mock.inspectFuncGetPipelineRunByTriggerID = f
 
-	return mmListComponentDefinitionUIDs
+	return mmGetPipelineRunByTriggerID
 }
 
-// Return sets up results that will be returned by Repository.ListComponentDefinitionUIDs
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Return(uids []*datamodel.ComponentDefinition, totalSize int64, err error) *RepositoryMock {
-	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
+// Return sets up results that will be returned by Repository.GetPipelineRunByTriggerID
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Return(pp1 *datamodel.PipelineRun, err error) *RepositoryMock {
+	if mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Set")
 	}
 
-	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
-		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{mock: mmListComponentDefinitionUIDs.mock}
+	if mmGetPipelineRunByTriggerID.defaultExpectation == nil {
+		mmGetPipelineRunByTriggerID.defaultExpectation = &RepositoryMockGetPipelineRunByTriggerIDExpectation{mock: mmGetPipelineRunByTriggerID.mock}
 	}
-	mmListComponentDefinitionUIDs.defaultExpectation.results = &RepositoryMockListComponentDefinitionUIDsResults{uids, totalSize, err}
-	return mmListComponentDefinitionUIDs.mock
+	mmGetPipelineRunByTriggerID.defaultExpectation.results = &RepositoryMockGetPipelineRunByTriggerIDResults{pp1, err}
+	return mmGetPipelineRunByTriggerID.mock
 }
 
-// Set uses given function f to mock the Repository.ListComponentDefinitionUIDs method
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Set(f func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) (uids []*datamodel.ComponentDefinition, totalSize int64, err error)) *RepositoryMock {
-	if mmListComponentDefinitionUIDs.defaultExpectation != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("Default expectation is already set for the Repository.ListComponentDefinitionUIDs method")
+// Set uses given function f to mock the Repository.GetPipelineRunByTriggerID method
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Set(f func(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineRun, err error)) *RepositoryMock {
+	if mmGetPipelineRunByTriggerID.defaultExpectation != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("Default expectation is already set for the Repository.GetPipelineRunByTriggerID method")
 	}
 
-	if len(mmListComponentDefinitionUIDs.expectations) > 0 {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("Some expectations are already set for the Repository.ListComponentDefinitionUIDs method")
+	if len(mmGetPipelineRunByTriggerID.expectations) > 0 {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("Some expectations are already set for the Repository.GetPipelineRunByTriggerID method")
 	}
 
-	mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs = f
-	return mmListComponentDefinitionUIDs.mock
+	mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID = f
+	return mmGetPipelineRunByTriggerID.mock
 }
 
-// When sets expectation for the Repository.ListComponentDefinitionUIDs which will trigger the result defined by the following
+// When sets expectation for the Repository.GetPipelineRunByTriggerID which will trigger the result defined by the following
 // Then helper
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) When(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) *RepositoryMockListComponentDefinitionUIDsExpectation {
-	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) When(ctx context.Context, pipelineTriggerID string) *RepositoryMockGetPipelineRunByTriggerIDExpectation {
+	if mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("RepositoryMock.GetPipelineRunByTriggerID mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListComponentDefinitionUIDsExpectation{
-		mock:   mmListComponentDefinitionUIDs.mock,
-		params: &RepositoryMockListComponentDefinitionUIDsParams{ctx, l1},
+	expectation := &RepositoryMockGetPipelineRunByTriggerIDExpectation{
+		mock:   mmGetPipelineRunByTriggerID.mock,
+		params: &RepositoryMockGetPipelineRunByTriggerIDParams{ctx, pipelineTriggerID},
 	}
-	mmListComponentDefinitionUIDs.expectations = append(mmListComponentDefinitionUIDs.expectations, expectation)
+	mmGetPipelineRunByTriggerID.expectations = append(mmGetPipelineRunByTriggerID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListComponentDefinitionUIDs return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListComponentDefinitionUIDsExpectation) Then(uids []*datamodel.ComponentDefinition, totalSize int64, err error) *RepositoryMock {
-	e.results = &RepositoryMockListComponentDefinitionUIDsResults{uids, totalSize, err}
+// Then sets up Repository.GetPipelineRunByTriggerID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPipelineRunByTriggerIDExpectation) Then(pp1 *datamodel.PipelineRun, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPipelineRunByTriggerIDResults{pp1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListComponentDefinitionUIDs should be invoked
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Times(n uint64) *mRepositoryMockListComponentDefinitionUIDs {
+// Times sets number of times Repository.GetPipelineRunByTriggerID should be invoked
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Times(n uint64) *mRepositoryMockGetPipelineRunByTriggerID {
 	if n == 0 {
-		mmListComponentDefinitionUIDs.mock.t.Fatalf("Times of RepositoryMock.ListComponentDefinitionUIDs mock can not be zero")
+		mmGetPipelineRunByTriggerID.mock.t.Fatalf("Times of RepositoryMock.GetPipelineRunByTriggerID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListComponentDefinitionUIDs.expectedInvocations, n)
-	return mmListComponentDefinitionUIDs
+	mm_atomic.StoreUint64(&mmGetPipelineRunByTriggerID.expectedInvocations, n)
+	return mmGetPipelineRunByTriggerID
 }
 
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) invocationsDone() bool {
-	if len(mmListComponentDefinitionUIDs.expectations) == 0 && mmListComponentDefinitionUIDs.defaultExpectation == nil && mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs == nil {
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) invocationsDone() bool {
+	if len(mmGetPipelineRunByTriggerID.expectations) == 0 && mmGetPipelineRunByTriggerID.defaultExpectation == nil && mmGetPipelineRunByTriggerID.mock.funcGetPipelineRunByTriggerID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.mock.afterListComponentDefinitionUIDsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPipelineRunByTriggerID.mock.afterGetPipelineRunByTriggerIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPipelineRunByTriggerID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListComponentDefinitionUIDs implements repository.Repository
-func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDs(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) (uids []*datamodel.ComponentDefinition, totalSize int64, err error) {
-	mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.beforeListComponentDefinitionUIDsCounter, 1)
-	defer mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.afterListComponentDefinitionUIDsCounter, 1)
+// GetPipelineRunByTriggerID implements repository.Repository
+func (mmGetPipelineRunByTriggerID *RepositoryMock) GetPipelineRunByTriggerID(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineRun, err error) {
+	mm_atomic.AddUint64(&mmGetPipelineRunByTriggerID.beforeGetPipelineRunByTriggerIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPipelineRunByTriggerID.afterGetPipelineRunByTriggerIDCounter, 1)
 
-	if mmListComponentDefinitionUIDs.inspectFuncListComponentDefinitionUIDs != nil {
-		mmListComponentDefinitionUIDs.inspectFuncListComponentDefinitionUIDs(ctx, l1)
+	if mmGetPipelineRunByTriggerID.inspectFuncGetPipelineRunByTriggerID != nil {
+		mmGetPipelineRunByTriggerID.inspectFuncGetPipelineRunByTriggerID(ctx, pipelineTriggerID)
 	}
 
-	mm_params := RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
+	mm_params := RepositoryMockGetPipelineRunByTriggerIDParams{ctx, pipelineTriggerID}
 
 	// Record call args
-	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.mutex.Lock()
-	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.callArgs = append(mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.callArgs, &mm_params)
-	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.mutex.Unlock()
+	mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.mutex.Lock()
+	mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.callArgs = append(mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.callArgs, &mm_params)
+	mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.mutex.Unlock()
 
-	for _, e := range mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.expectations {
+	for _, e := range mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.uids, e.results.totalSize, e.results.err
+			return e.results.pp1, e.results.err
 		}
 	}
 
-	if mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.Counter, 1)
-		mm_want := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.params
-		mm_want_ptrs := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.paramPtrs
+	if mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
+		mm_got := RepositoryMockGetPipelineRunByTriggerIDParams{ctx, pipelineTriggerID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetPipelineRunByTriggerID.t.Errorf("RepositoryMock.GetPipelineRunByTriggerID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.l1 != nil && !minimock.Equal(*mm_want_ptrs.l1, mm_got.l1) {
-				mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameter l1, want: %#v, got: %#v%s\n", *mm_want_ptrs.l1, mm_got.l1, minimock.Diff(*mm_want_ptrs.l1, mm_got.l1))
+			if mm_want_ptrs.pipelineTriggerID != nil && !minimock.Equal(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID) {
+				mmGetPipelineRunByTriggerID.t.Errorf("RepositoryMock.GetPipelineRunByTriggerID got unexpected parameter pipelineTriggerID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID, minimock.Diff(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetPipelineRunByTriggerID.t.Errorf("RepositoryMock.GetPipelineRunByTriggerID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.results
+		mm_results := mmGetPipelineRunByTriggerID.GetPipelineRunByTriggerIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListComponentDefinitionUIDs.t.Fatal("No results are set for the RepositoryMock.ListComponentDefinitionUIDs")
+			mmGetPipelineRunByTriggerID.t.Fatal("No results are set for the RepositoryMock.GetPipelineRunByTriggerID")
 		}
-		return (*mm_results).uids, (*mm_results).totalSize, (*mm_results).err
+		return (*mm_results).pp1, (*mm_results).err
 	}
-	if mmListComponentDefinitionUIDs.funcListComponentDefinitionUIDs != nil {
-		return mmListComponentDefinitionUIDs.funcListComponentDefinitionUIDs(ctx, l1)
+	if mmGetPipelineRunByTriggerID.funcGetPipelineRunByTriggerID != nil {
+		return mmGetPipelineRunByTriggerID.funcGetPipelineRunByTriggerID(ctx, pipelineTriggerID)
 	}
-	mmListComponentDefinitionUIDs.t.Fatalf("Unexpected call to RepositoryMock.ListComponentDefinitionUIDs. %v %v", ctx, l1)
+	mmGetPipelineRunByTriggerID.t.Fatalf("Unexpected call to RepositoryMock.GetPipelineRunByTriggerID. %v %v", ctx, pipelineTriggerID)
 	return
 }
 
-// ListComponentDefinitionUIDsAfterCounter returns a count of finished RepositoryMock.ListComponentDefinitionUIDs invocations
-func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.afterListComponentDefinitionUIDsCounter)
+// GetPipelineRunByTriggerIDAfterCounter returns a count of finished RepositoryMock.GetPipelineRunByTriggerID invocations
+func (mmGetPipelineRunByTriggerID *RepositoryMock) GetPipelineRunByTriggerIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineRunByTriggerID.afterGetPipelineRunByTriggerIDCounter)
 }
 
-// ListComponentDefinitionUIDsBeforeCounter returns a count of RepositoryMock.ListComponentDefinitionUIDs invocations
-func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.beforeListComponentDefinitionUIDsCounter)
+// GetPipelineRunByTriggerIDBeforeCounter returns a count of RepositoryMock.GetPipelineRunByTriggerID invocations
+func (mmGetPipelineRunByTriggerID *RepositoryMock) GetPipelineRunByTriggerIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineRunByTriggerID.beforeGetPipelineRunByTriggerIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListComponentDefinitionUIDs.
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPipelineRunByTriggerID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Calls() []*RepositoryMockListComponentDefinitionUIDsParams {
-	mmListComponentDefinitionUIDs.mutex.RLock()
+func (mmGetPipelineRunByTriggerID *mRepositoryMockGetPipelineRunByTriggerID) Calls() []*RepositoryMockGetPipelineRunByTriggerIDParams {
+	mmGetPipelineRunByTriggerID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListComponentDefinitionUIDsParams, len(mmListComponentDefinitionUIDs.callArgs))
-	copy(argCopy, mmListComponentDefinitionUIDs.callArgs)
+	argCopy := make([]*RepositoryMockGetPipelineRunByTriggerIDParams, len(mmGetPipelineRunByTriggerID.callArgs))
+	copy(argCopy, mmGetPipelineRunByTriggerID.callArgs)
 
-	mmListComponentDefinitionUIDs.mutex.RUnlock()
+	mmGetPipelineRunByTriggerID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListComponentDefinitionUIDsDone returns true if the count of the ListComponentDefinitionUIDs invocations corresponds
+// MinimockGetPipelineRunByTriggerIDDone returns true if the count of the GetPipelineRunByTriggerID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListComponentDefinitionUIDsDone() bool {
-	if m.ListComponentDefinitionUIDsMock.optional {
+func (m *RepositoryMock) MinimockGetPipelineRunByTriggerIDDone() bool {
+	if m.GetPipelineRunByTriggerIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListComponentDefinitionUIDsMock.expectations {
+	for _, e := range m.GetPipelineRunByTriggerIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListComponentDefinitionUIDsMock.invocationsDone()
+	return m.GetPipelineRunByTriggerIDMock.invocationsDone()
 }
 
-// MinimockListComponentDefinitionUIDsInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListComponentDefinitionUIDsInspect() {
-	for _, e := range m.ListComponentDefinitionUIDsMock.expectations {
+// MinimockGetPipelineRunByTriggerIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPipelineRunByTriggerIDInspect() {
+	for _, e := range m.GetPipelineRunByTriggerIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListComponentDefinitionUIDs with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPipelineRunByTriggerID with params: %#v", *e.params)
 		}
 	}
 
-	afterListComponentDefinitionUIDsCounter := mm_atomic.LoadUint64(&m.afterListComponentDefinitionUIDsCounter)
+	afterGetPipelineRunByTriggerIDCounter := mm_atomic.LoadUint64(&m.afterGetPipelineRunByTriggerIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListComponentDefinitionUIDsMock.defaultExpectation != nil && afterListComponentDefinitionUIDsCounter < 1 {
-		if m.ListComponentDefinitionUIDsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListComponentDefinitionUIDs")
+	if m.GetPipelineRunByTriggerIDMock.defaultExpectation != nil && afterGetPipelineRunByTriggerIDCounter < 1 {
+		if m.GetPipelineRunByTriggerIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetPipelineRunByTriggerID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListComponentDefinitionUIDs with params: %#v", *m.ListComponentDefinitionUIDsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPipelineRunByTriggerID with params: %#v", *m.GetPipelineRunByTriggerIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListComponentDefinitionUIDs != nil && afterListComponentDefinitionUIDsCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListComponentDefinitionUIDs")
+	if m.funcGetPipelineRunByTriggerID != nil && afterGetPipelineRunByTriggerIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetPipelineRunByTriggerID")
 	}
 
-	if !m.ListComponentDefinitionUIDsMock.invocationsDone() && afterListComponentDefinitionUIDsCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListComponentDefinitionUIDs but found %d calls",
-			mm_atomic.LoadUint64(&m.ListComponentDefinitionUIDsMock.expectedInvocations), afterListComponentDefinitionUIDsCounter)
+	if !m.GetPipelineRunByTriggerIDMock.invocationsDone() && afterGetPipelineRunByTriggerIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPipelineRunByTriggerID but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPipelineRunByTriggerIDMock.expectedInvocations), afterGetPipelineRunByTriggerIDCounter)
 	}
 }
 
-type mRepositoryMockListNamespacePipelineReleases struct {
+type mRepositoryMockGetPipelineTriggerResult struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListNamespacePipelineReleasesExpectation
-	expectations       []*RepositoryMockListNamespacePipelineReleasesExpectation
+	defaultExpectation *RepositoryMockGetPipelineTriggerResultExpectation
+	expectations       []*RepositoryMockGetPipelineTriggerResultExpectation
 
-	callArgs []*RepositoryMockListNamespacePipelineReleasesParams
+	callArgs []*RepositoryMockGetPipelineTriggerResultParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListNamespacePipelineReleasesExpectation specifies expectation struct of the Repository.ListNamespacePipelineReleases
-type RepositoryMockListNamespacePipelineReleasesExpectation struct {
+// RepositoryMockGetPipelineTriggerResultExpectation specifies expectation struct of the Repository.GetPipelineTriggerResult
+type RepositoryMockGetPipelineTriggerResultExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListNamespacePipelineReleasesParams
-	paramPtrs *RepositoryMockListNamespacePipelineReleasesParamPtrs
-	results   *RepositoryMockListNamespacePipelineReleasesResults
+	params    *RepositoryMockGetPipelineTriggerResultParams
+	paramPtrs *RepositoryMockGetPipelineTriggerResultParamPtrs
+	results   *RepositoryMockGetPipelineTriggerResultResults
 	Counter   uint64
 }
 
-// RepositoryMockListNamespacePipelineReleasesParams contains parameters of the Repository.ListNamespacePipelineReleases
-type RepositoryMockListNamespacePipelineReleasesParams struct {
-	ctx            context.Context
-	ownerPermalink string
-	pipelineUID    uuid.UUID
-	pageSize       int64
-	pageToken      string
-	isBasicView    bool
-	filter         filtering.Filter
-	showDeleted    bool
-	returnCount    bool
+// RepositoryMockGetPipelineTriggerResultParams contains parameters of the Repository.GetPipelineTriggerResult
+type RepositoryMockGetPipelineTriggerResultParams struct {
+	ctx               context.Context
+	pipelineTriggerID string
 }
 
-// RepositoryMockListNamespacePipelineReleasesParamPtrs contains pointers to parameters of the Repository.ListNamespacePipelineReleases
-type RepositoryMockListNamespacePipelineReleasesParamPtrs struct {
-	ctx            *context.Context
-	ownerPermalink *string
-	pipelineUID    *uuid.UUID
-	pageSize       *int64
-	pageToken      *string
-	isBasicView    *bool
-	filter         *filtering.Filter
-	showDeleted    *bool
-	returnCount    *bool
+// RepositoryMockGetPipelineTriggerResultParamPtrs contains pointers to parameters of the Repository.GetPipelineTriggerResult
+type RepositoryMockGetPipelineTriggerResultParamPtrs struct {
+	ctx               *context.Context
+	pipelineTriggerID *string
 }
 
-// RepositoryMockListNamespacePipelineReleasesResults contains results of the Repository.ListNamespacePipelineReleases
-type RepositoryMockListNamespacePipelineReleasesResults struct {
-	ppa1 []*datamodel.PipelineRelease
-	i1   int64
-	s1   string
-	err  error
+// RepositoryMockGetPipelineTriggerResultResults contains results of the Repository.GetPipelineTriggerResult
+type RepositoryMockGetPipelineTriggerResultResults struct {
+	pp1 *datamodel.PipelineTriggerResult
+	err error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -7816,520 +8210,321 @@ type RepositoryMockListNamespacePipelineReleasesResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Optional() *mRepositoryMockListNamespacePipelineReleases {
-	mmListNamespacePipelineReleases.optional = true
-	return mmListNamespacePipelineReleases
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Optional() *mRepositoryMockGetPipelineTriggerResult {
+	mmGetPipelineTriggerResult.optional = true
+	return mmGetPipelineTriggerResult
 }
 
-// Expect sets up expected params for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+// Expect sets up expected params for Repository.GetPipelineTriggerResult
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Expect(ctx context.Context, pipelineTriggerID string) *mRepositoryMockGetPipelineTriggerResult {
+	if mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	if mmGetPipelineTriggerResult.defaultExpectation == nil {
+		mmGetPipelineTriggerResult.defaultExpectation = &RepositoryMockGetPipelineTriggerResultExpectation{}
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by ExpectParams functions")
+	if mmGetPipelineTriggerResult.defaultExpectation.paramPtrs != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by ExpectParams functions")
 	}
 
-	mmListNamespacePipelineReleases.defaultExpectation.params = &RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
-	for _, e := range mmListNamespacePipelineReleases.expectations {
-		if minimock.Equal(e.params, mmListNamespacePipelineReleases.defaultExpectation.params) {
-			mmListNamespacePipelineReleases.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespacePipelineReleases.defaultExpectation.params)
+	mmGetPipelineTriggerResult.defaultExpectation.params = &RepositoryMockGetPipelineTriggerResultParams{ctx, pipelineTriggerID}
+	for _, e := range mmGetPipelineTriggerResult.expectations {
+		if minimock.Equal(e.params, mmGetPipelineTriggerResult.defaultExpectation.params) {
+			mmGetPipelineTriggerResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPipelineTriggerResult.defaultExpectation.params)
 		}
 	}
 
-	return mmListNamespacePipelineReleases
+	return mmGetPipelineTriggerResult
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPipelineTriggerResult
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPipelineTriggerResult {
+	if mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	if mmGetPipelineTriggerResult.defaultExpectation == nil {
+		mmGetPipelineTriggerResult.defaultExpectation = &RepositoryMockGetPipelineTriggerResultExpectation{}
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	if mmGetPipelineTriggerResult.defaultExpectation.params != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Expect")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	if mmGetPipelineTriggerResult.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineTriggerResult.defaultExpectation.paramPtrs = &RepositoryMockGetPipelineTriggerResultParamPtrs{}
 	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPipelineTriggerResult.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListNamespacePipelineReleases
+	return mmGetPipelineTriggerResult
 }
 
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+// ExpectPipelineTriggerIDParam2 sets up expected param pipelineTriggerID for Repository.GetPipelineTriggerResult
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) ExpectPipelineTriggerIDParam2(pipelineTriggerID string) *mRepositoryMockGetPipelineTriggerResult {
+	if mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	if mmGetPipelineTriggerResult.defaultExpectation == nil {
+		mmGetPipelineTriggerResult.defaultExpectation = &RepositoryMockGetPipelineTriggerResultExpectation{}
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	if mmGetPipelineTriggerResult.defaultExpectation.params != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Expect")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	if mmGetPipelineTriggerResult.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineTriggerResult.defaultExpectation.paramPtrs = &RepositoryMockGetPipelineTriggerResultParamPtrs{}
 	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+	mmGetPipelineTriggerResult.defaultExpectation.paramPtrs.pipelineTriggerID = &pipelineTriggerID
 
-	return mmListNamespacePipelineReleases
+	return mmGetPipelineTriggerResult
 }
 
-// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPipelineTriggerResult
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Inspect(f func(ctx context.Context, pipelineTriggerID string)) *mRepositoryMockGetPipelineTriggerResult {
+	if mmGetPipelineTriggerResult.mock.inspectFuncGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPipelineTriggerResult")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
-	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+	mmGetPipelineTriggerResult.mock.inspectFuncGetPipelineTriggerResult = f
 
-	return mmListNamespacePipelineReleases
+	return mmGetPipelineTriggerResult
 }
 
-// ExpectPageSizeParam4 sets up expected param pageSize for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPageSizeParam4(pageSize int64) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+// Return sets up results that will be returned by Repository.GetPipelineTriggerResult
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Return(pp1 *datamodel.PipelineTriggerResult, err error) *RepositoryMock {
+	if mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	if mmGetPipelineTriggerResult.defaultExpectation == nil {
+		mmGetPipelineTriggerResult.defaultExpectation = &RepositoryMockGetPipelineTriggerResultExpectation{mock: mmGetPipelineTriggerResult.mock}
 	}
+	mmGetPipelineTriggerResult.defaultExpectation.results = &RepositoryMockGetPipelineTriggerResultResults{pp1, err}
+	return mmGetPipelineTriggerResult.mock
+}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+// Set uses given function f to mock the Repository.GetPipelineTriggerResult method
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Set(f func(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineTriggerResult, err error)) *RepositoryMock {
+	if mmGetPipelineTriggerResult.defaultExpectation != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("Default expectation is already set for the Repository.GetPipelineTriggerResult method")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	if len(mmGetPipelineTriggerResult.expectations) > 0 {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("Some expectations are already set for the Repository.GetPipelineTriggerResult method")
 	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pageSize = &pageSize
 
-	return mmListNamespacePipelineReleases
+	mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult = f
+	return mmGetPipelineTriggerResult.mock
 }
 
-// ExpectPageTokenParam5 sets up expected param pageToken for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPageTokenParam5(pageToken string) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+// When sets expectation for the Repository.GetPipelineTriggerResult which will trigger the result defined by the following
+// Then helper
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) When(ctx context.Context, pipelineTriggerID string) *RepositoryMockGetPipelineTriggerResultExpectation {
+	if mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.GetPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	expectation := &RepositoryMockGetPipelineTriggerResultExpectation{
+		mock:   mmGetPipelineTriggerResult.mock,
+		params: &RepositoryMockGetPipelineTriggerResultParams{ctx, pipelineTriggerID},
 	}
+	mmGetPipelineTriggerResult.expectations = append(mmGetPipelineTriggerResult.expectations, expectation)
+	return expectation
+}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
-	}
+// Then sets up Repository.GetPipelineTriggerResult return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPipelineTriggerResultExpectation) Then(pp1 *datamodel.PipelineTriggerResult, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPipelineTriggerResultResults{pp1, err}
+	return e.mock
+}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+// Times sets number of times Repository.GetPipelineTriggerResult should be invoked
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Times(n uint64) *mRepositoryMockGetPipelineTriggerResult {
+	if n == 0 {
+		mmGetPipelineTriggerResult.mock.t.Fatalf("Times of RepositoryMock.GetPipelineTriggerResult mock can not be zero")
 	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pageToken = &pageToken
-
-	return mmListNamespacePipelineReleases
+	mm_atomic.StoreUint64(&mmGetPipelineTriggerResult.expectedInvocations, n)
+	return mmGetPipelineTriggerResult
 }
 
-// ExpectIsBasicViewParam6 sets up expected param isBasicView for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectIsBasicViewParam6(isBasicView bool) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) invocationsDone() bool {
+	if len(mmGetPipelineTriggerResult.expectations) == 0 && mmGetPipelineTriggerResult.defaultExpectation == nil && mmGetPipelineTriggerResult.mock.funcGetPipelineTriggerResult == nil {
+		return true
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
-	}
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPipelineTriggerResult.mock.afterGetPipelineTriggerResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPipelineTriggerResult.expectedInvocations)
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
-	}
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+// GetPipelineTriggerResult implements repository.Repository
+func (mmGetPipelineTriggerResult *RepositoryMock) GetPipelineTriggerResult(ctx context.Context, pipelineTriggerID string) (pp1 *datamodel.PipelineTriggerResult, err error) {
+	mm_atomic.AddUint64(&mmGetPipelineTriggerResult.beforeGetPipelineTriggerResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPipelineTriggerResult.afterGetPipelineTriggerResultCounter, 1)
+
+	if mmGetPipelineTriggerResult.inspectFuncGetPipelineTriggerResult != nil {
+		mmGetPipelineTriggerResult.inspectFuncGetPipelineTriggerResult(ctx, pipelineTriggerID)
 	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.isBasicView = &isBasicView
 
-	return mmListNamespacePipelineReleases
-}
+	mm_params := RepositoryMockGetPipelineTriggerResultParams{ctx, pipelineTriggerID}
 
-// ExpectFilterParam7 sets up expected param filter for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectFilterParam7(filter filtering.Filter) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
+	// Record call args
+	mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.mutex.Lock()
+	mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.callArgs = append(mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.callArgs, &mm_params)
+	mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.mutex.Unlock()
 
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	for _, e := range mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
 	}
 
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
-	}
+	if mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.defaultExpectation.paramPtrs
 
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
-	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.filter = &filter
-
-	return mmListNamespacePipelineReleases
-}
-
-// ExpectShowDeletedParam8 sets up expected param showDeleted for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectShowDeletedParam8(showDeleted bool) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
-	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.showDeleted = &showDeleted
-
-	return mmListNamespacePipelineReleases
-}
-
-// ExpectReturnCountParam9 sets up expected param returnCount for Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectReturnCountParam9(returnCount bool) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
-	}
-	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.returnCount = &returnCount
-
-	return mmListNamespacePipelineReleases
-}
-
-// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool)) *mRepositoryMockListNamespacePipelineReleases {
-	if mmListNamespacePipelineReleases.mock.inspectFuncListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespacePipelineReleases")
-	}
-
-	mmListNamespacePipelineReleases.mock.inspectFuncListNamespacePipelineReleases = f
-
-	return mmListNamespacePipelineReleases
-}
-
-// Return sets up results that will be returned by Repository.ListNamespacePipelineReleases
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Return(ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) *RepositoryMock {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
-
-	if mmListNamespacePipelineReleases.defaultExpectation == nil {
-		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{mock: mmListNamespacePipelineReleases.mock}
-	}
-	mmListNamespacePipelineReleases.defaultExpectation.results = &RepositoryMockListNamespacePipelineReleasesResults{ppa1, i1, s1, err}
-	return mmListNamespacePipelineReleases.mock
-}
-
-// Set uses given function f to mock the Repository.ListNamespacePipelineReleases method
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) (ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error)) *RepositoryMock {
-	if mmListNamespacePipelineReleases.defaultExpectation != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespacePipelineReleases method")
-	}
-
-	if len(mmListNamespacePipelineReleases.expectations) > 0 {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespacePipelineReleases method")
-	}
-
-	mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases = f
-	return mmListNamespacePipelineReleases.mock
-}
-
-// When sets expectation for the Repository.ListNamespacePipelineReleases which will trigger the result defined by the following
-// Then helper
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) *RepositoryMockListNamespacePipelineReleasesExpectation {
-	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
-	}
-
-	expectation := &RepositoryMockListNamespacePipelineReleasesExpectation{
-		mock:   mmListNamespacePipelineReleases.mock,
-		params: &RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount},
-	}
-	mmListNamespacePipelineReleases.expectations = append(mmListNamespacePipelineReleases.expectations, expectation)
-	return expectation
-}
-
-// Then sets up Repository.ListNamespacePipelineReleases return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListNamespacePipelineReleasesExpectation) Then(ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) *RepositoryMock {
-	e.results = &RepositoryMockListNamespacePipelineReleasesResults{ppa1, i1, s1, err}
-	return e.mock
-}
-
-// Times sets number of times Repository.ListNamespacePipelineReleases should be invoked
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Times(n uint64) *mRepositoryMockListNamespacePipelineReleases {
-	if n == 0 {
-		mmListNamespacePipelineReleases.mock.t.Fatalf("Times of RepositoryMock.ListNamespacePipelineReleases mock can not be zero")
-	}
-	mm_atomic.StoreUint64(&mmListNamespacePipelineReleases.expectedInvocations, n)
-	return mmListNamespacePipelineReleases
-}
-
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) invocationsDone() bool {
-	if len(mmListNamespacePipelineReleases.expectations) == 0 && mmListNamespacePipelineReleases.defaultExpectation == nil && mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases == nil {
-		return true
-	}
-
-	totalInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.mock.afterListNamespacePipelineReleasesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.expectedInvocations)
-
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
-
-// ListNamespacePipelineReleases implements repository.Repository
-func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleases(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) (ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) {
-	mm_atomic.AddUint64(&mmListNamespacePipelineReleases.beforeListNamespacePipelineReleasesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListNamespacePipelineReleases.afterListNamespacePipelineReleasesCounter, 1)
-
-	if mmListNamespacePipelineReleases.inspectFuncListNamespacePipelineReleases != nil {
-		mmListNamespacePipelineReleases.inspectFuncListNamespacePipelineReleases(ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
-	}
-
-	mm_params := RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
-
-	// Record call args
-	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.mutex.Lock()
-	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.callArgs = append(mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.callArgs, &mm_params)
-	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.mutex.Unlock()
-
-	for _, e := range mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.expectations {
-		if minimock.Equal(*e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
-		}
-	}
-
-	if mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.params
-		mm_want_ptrs := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.paramPtrs
-
-		mm_got := RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
+		mm_got := RepositoryMockGetPipelineTriggerResultParams{ctx, pipelineTriggerID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
-			}
-
-			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
-			}
-
-			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
-			}
-
-			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
-			}
-
-			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
-			}
-
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
-			}
-
-			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+				mmGetPipelineTriggerResult.t.Errorf("RepositoryMock.GetPipelineTriggerResult got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.returnCount != nil && !minimock.Equal(*mm_want_ptrs.returnCount, mm_got.returnCount) {
-				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter returnCount, want: %#v, got: %#v%s\n", *mm_want_ptrs.returnCount, mm_got.returnCount, minimock.Diff(*mm_want_ptrs.returnCount, mm_got.returnCount))
+			if mm_want_ptrs.pipelineTriggerID != nil && !minimock.Equal(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID) {
+				mmGetPipelineTriggerResult.t.Errorf("RepositoryMock.GetPipelineTriggerResult got unexpected parameter pipelineTriggerID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID, minimock.Diff(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetPipelineTriggerResult.t.Errorf("RepositoryMock.GetPipelineTriggerResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.results
+		mm_results := mmGetPipelineTriggerResult.GetPipelineTriggerResultMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListNamespacePipelineReleases.t.Fatal("No results are set for the RepositoryMock.ListNamespacePipelineReleases")
+			mmGetPipelineTriggerResult.t.Fatal("No results are set for the RepositoryMock.GetPipelineTriggerResult")
 		}
-		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+		return (*mm_results).pp1, (*mm_results).err
 	}
-	if mmListNamespacePipelineReleases.funcListNamespacePipelineReleases != nil {
-		return mmListNamespacePipelineReleases.funcListNamespacePipelineReleases(ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
+	if mmGetPipelineTriggerResult.funcGetPipelineTriggerResult != nil {
+		return mmGetPipelineTriggerResult.funcGetPipelineTriggerResult(ctx, pipelineTriggerID)
 	}
-	mmListNamespacePipelineReleases.t.Fatalf("Unexpected call to RepositoryMock.ListNamespacePipelineReleases. %v %v %v %v %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
+	mmGetPipelineTriggerResult.t.Fatalf("Unexpected call to RepositoryMock.GetPipelineTriggerResult. %v %v", ctx, pipelineTriggerID)
 	return
 }
 
-// ListNamespacePipelineReleasesAfterCounter returns a count of finished RepositoryMock.ListNamespacePipelineReleases invocations
-func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleasesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.afterListNamespacePipelineReleasesCounter)
+// GetPipelineTriggerResultAfterCounter returns a count of finished RepositoryMock.GetPipelineTriggerResult invocations
+func (mmGetPipelineTriggerResult *RepositoryMock) GetPipelineTriggerResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineTriggerResult.afterGetPipelineTriggerResultCounter)
 }
 
-// ListNamespacePipelineReleasesBeforeCounter returns a count of RepositoryMock.ListNamespacePipelineReleases invocations
-func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleasesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.beforeListNamespacePipelineReleasesCounter)
+// GetPipelineTriggerResultBeforeCounter returns a count of RepositoryMock.GetPipelineTriggerResult invocations
+func (mmGetPipelineTriggerResult *RepositoryMock) GetPipelineTriggerResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineTriggerResult.beforeGetPipelineTriggerResultCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespacePipelineReleases.
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPipelineTriggerResult.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Calls() []*RepositoryMockListNamespacePipelineReleasesParams {
-	mmListNamespacePipelineReleases.mutex.RLock()
+func (mmGetPipelineTriggerResult *mRepositoryMockGetPipelineTriggerResult) Calls() []*RepositoryMockGetPipelineTriggerResultParams {
+	mmGetPipelineTriggerResult.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListNamespacePipelineReleasesParams, len(mmListNamespacePipelineReleases.callArgs))
-	copy(argCopy, mmListNamespacePipelineReleases.callArgs)
+	argCopy := make([]*RepositoryMockGetPipelineTriggerResultParams, len(mmGetPipelineTriggerResult.callArgs))
+	copy(argCopy, mmGetPipelineTriggerResult.callArgs)
 
-	mmListNamespacePipelineReleases.mutex.RUnlock()
+	mmGetPipelineTriggerResult.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListNamespacePipelineReleasesDone returns true if the count of the ListNamespacePipelineReleases invocations corresponds
+// MinimockGetPipelineTriggerResultDone returns true if the count of the GetPipelineTriggerResult invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListNamespacePipelineReleasesDone() bool {
-	if m.ListNamespacePipelineReleasesMock.optional {
+func (m *RepositoryMock) MinimockGetPipelineTriggerResultDone() bool {
+	if m.GetPipelineTriggerResultMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListNamespacePipelineReleasesMock.expectations {
+	for _, e := range m.GetPipelineTriggerResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListNamespacePipelineReleasesMock.invocationsDone()
+	return m.GetPipelineTriggerResultMock.invocationsDone()
 }
 
-// MinimockListNamespacePipelineReleasesInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListNamespacePipelineReleasesInspect() {
-	for _, e := range m.ListNamespacePipelineReleasesMock.expectations {
+// MinimockGetPipelineTriggerResultInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPipelineTriggerResultInspect() {
+	for _, e := range m.GetPipelineTriggerResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineReleases with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPipelineTriggerResult with params: %#v", *e.params)
 		}
 	}
 
-	afterListNamespacePipelineReleasesCounter := mm_atomic.LoadUint64(&m.afterListNamespacePipelineReleasesCounter)
+	afterGetPipelineTriggerResultCounter := mm_atomic.LoadUint64(&m.afterGetPipelineTriggerResultCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListNamespacePipelineReleasesMock.defaultExpectation != nil && afterListNamespacePipelineReleasesCounter < 1 {
-		if m.ListNamespacePipelineReleasesMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineReleases")
+	if m.GetPipelineTriggerResultMock.defaultExpectation != nil && afterGetPipelineTriggerResultCounter < 1 {
+		if m.GetPipelineTriggerResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetPipelineTriggerResult")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineReleases with params: %#v", *m.ListNamespacePipelineReleasesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPipelineTriggerResult with params: %#v", *m.GetPipelineTriggerResultMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListNamespacePipelineReleases != nil && afterListNamespacePipelineReleasesCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineReleases")
+	if m.funcGetPipelineTriggerResult != nil && afterGetPipelineTriggerResultCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetPipelineTriggerResult")
 	}
 
-	if !m.ListNamespacePipelineReleasesMock.invocationsDone() && afterListNamespacePipelineReleasesCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespacePipelineReleases but found %d calls",
-			mm_atomic.LoadUint64(&m.ListNamespacePipelineReleasesMock.expectedInvocations), afterListNamespacePipelineReleasesCounter)
+	if !m.GetPipelineTriggerResultMock.invocationsDone() && afterGetPipelineTriggerResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPipelineTriggerResult but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPipelineTriggerResultMock.expectedInvocations), afterGetPipelineTriggerResultCounter)
 	}
 }
 
-type mRepositoryMockListNamespacePipelines struct {
+type mRepositoryMockListComponentDefinitionUIDs struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListNamespacePipelinesExpectation
-	expectations       []*RepositoryMockListNamespacePipelinesExpectation
+	defaultExpectation *RepositoryMockListComponentDefinitionUIDsExpectation
+	expectations       []*RepositoryMockListComponentDefinitionUIDsExpectation
 
-	callArgs []*RepositoryMockListNamespacePipelinesParams
+	callArgs []*RepositoryMockListComponentDefinitionUIDsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListNamespacePipelinesExpectation specifies expectation struct of the Repository.ListNamespacePipelines
-type RepositoryMockListNamespacePipelinesExpectation struct {
+// RepositoryMockListComponentDefinitionUIDsExpectation specifies expectation struct of the Repository.ListComponentDefinitionUIDs
+type RepositoryMockListComponentDefinitionUIDsExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListNamespacePipelinesParams
-	paramPtrs *RepositoryMockListNamespacePipelinesParamPtrs
-	results   *RepositoryMockListNamespacePipelinesResults
+	params    *RepositoryMockListComponentDefinitionUIDsParams
+	paramPtrs *RepositoryMockListComponentDefinitionUIDsParamPtrs
+	results   *RepositoryMockListComponentDefinitionUIDsResults
 	Counter   uint64
 }
 
-// RepositoryMockListNamespacePipelinesParams contains parameters of the Repository.ListNamespacePipelines
-type RepositoryMockListNamespacePipelinesParams struct {
-	ctx            context.Context
-	ownerPermalink string
-	pageSize       int64
-	pageToken      string
-	isBasicView    bool
-	filter         filtering.Filter
-	uidAllowList   []uuid.UUID
-	showDeleted    bool
-	embedReleases  bool
-	order          ordering.OrderBy
+// RepositoryMockListComponentDefinitionUIDsParams contains parameters of the Repository.ListComponentDefinitionUIDs
+type RepositoryMockListComponentDefinitionUIDsParams struct {
+	ctx context.Context
+	l1  mm_repository.ListComponentDefinitionsParams
 }
 
-// RepositoryMockListNamespacePipelinesParamPtrs contains pointers to parameters of the Repository.ListNamespacePipelines
-type RepositoryMockListNamespacePipelinesParamPtrs struct {
-	ctx            *context.Context
-	ownerPermalink *string
-	pageSize       *int64
-	pageToken      *string
-	isBasicView    *bool
-	filter         *filtering.Filter
-	uidAllowList   *[]uuid.UUID
-	showDeleted    *bool
-	embedReleases  *bool
-	order          *ordering.OrderBy
+// RepositoryMockListComponentDefinitionUIDsParamPtrs contains pointers to parameters of the Repository.ListComponentDefinitionUIDs
+type RepositoryMockListComponentDefinitionUIDsParamPtrs struct {
+	ctx *context.Context
+	l1  *mm_repository.ListComponentDefinitionsParams
 }
 
-// RepositoryMockListNamespacePipelinesResults contains results of the Repository.ListNamespacePipelines
-type RepositoryMockListNamespacePipelinesResults struct {
-	ppa1 []*datamodel.Pipeline
-	i1   int64
-	s1   string
-	err  error
+// RepositoryMockListComponentDefinitionUIDsResults contains results of the Repository.ListComponentDefinitionUIDs
+type RepositoryMockListComponentDefinitionUIDsResults struct {
+	uids      []*datamodel.ComponentDefinition
+	totalSize int64
+	err       error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -8337,533 +8532,654 @@ type RepositoryMockListNamespacePipelinesResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Optional() *mRepositoryMockListNamespacePipelines {
-	mmListNamespacePipelines.optional = true
-	return mmListNamespacePipelines
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Optional() *mRepositoryMockListComponentDefinitionUIDs {
+	mmListComponentDefinitionUIDs.optional = true
+	return mmListComponentDefinitionUIDs
 }
 
-// Expect sets up expected params for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Expect(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// Expect sets up expected params for Repository.ListComponentDefinitionUIDs
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Expect(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) *mRepositoryMockListComponentDefinitionUIDs {
+	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by ExpectParams functions")
+	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by ExpectParams functions")
 	}
 
-	mmListNamespacePipelines.defaultExpectation.params = &RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
-	for _, e := range mmListNamespacePipelines.expectations {
-		if minimock.Equal(e.params, mmListNamespacePipelines.defaultExpectation.params) {
-			mmListNamespacePipelines.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespacePipelines.defaultExpectation.params)
+	mmListComponentDefinitionUIDs.defaultExpectation.params = &RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
+	for _, e := range mmListComponentDefinitionUIDs.expectations {
+		if minimock.Equal(e.params, mmListComponentDefinitionUIDs.defaultExpectation.params) {
+			mmListComponentDefinitionUIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListComponentDefinitionUIDs.defaultExpectation.params)
 		}
 	}
 
-	return mmListNamespacePipelines
+	return mmListComponentDefinitionUIDs
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListComponentDefinitionUIDs
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListComponentDefinitionUIDs {
+	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	if mmListComponentDefinitionUIDs.defaultExpectation.params != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Expect")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs = &RepositoryMockListComponentDefinitionUIDsParamPtrs{}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListNamespacePipelines
+	return mmListComponentDefinitionUIDs
 }
 
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// ExpectL1Param2 sets up expected param l1 for Repository.ListComponentDefinitionUIDs
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) ExpectL1Param2(l1 mm_repository.ListComponentDefinitionsParams) *mRepositoryMockListComponentDefinitionUIDs {
+	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	if mmListComponentDefinitionUIDs.defaultExpectation.params != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Expect")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	if mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs = &RepositoryMockListComponentDefinitionUIDsParamPtrs{}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+	mmListComponentDefinitionUIDs.defaultExpectation.paramPtrs.l1 = &l1
 
-	return mmListNamespacePipelines
+	return mmListComponentDefinitionUIDs
 }
 
-// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
-	}
-
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
-	}
-
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+// Inspect accepts an inspector function that has same arguments as the Repository.ListComponentDefinitionUIDs
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Inspect(f func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams)) *mRepositoryMockListComponentDefinitionUIDs {
+	if mmListComponentDefinitionUIDs.mock.inspectFuncListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListComponentDefinitionUIDs")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
-	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.pageSize = &pageSize
+	mmListComponentDefinitionUIDs.mock.inspectFuncListComponentDefinitionUIDs = f
 
-	return mmListNamespacePipelines
+	return mmListComponentDefinitionUIDs
 }
 
-// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// Return sets up results that will be returned by Repository.ListComponentDefinitionUIDs
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Return(uids []*datamodel.ComponentDefinition, totalSize int64, err error) *RepositoryMock {
+	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentDefinitionUIDs.defaultExpectation == nil {
+		mmListComponentDefinitionUIDs.defaultExpectation = &RepositoryMockListComponentDefinitionUIDsExpectation{mock: mmListComponentDefinitionUIDs.mock}
 	}
+	mmListComponentDefinitionUIDs.defaultExpectation.results = &RepositoryMockListComponentDefinitionUIDsResults{uids, totalSize, err}
+	return mmListComponentDefinitionUIDs.mock
+}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+// Set uses given function f to mock the Repository.ListComponentDefinitionUIDs method
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Set(f func(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) (uids []*datamodel.ComponentDefinition, totalSize int64, err error)) *RepositoryMock {
+	if mmListComponentDefinitionUIDs.defaultExpectation != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("Default expectation is already set for the Repository.ListComponentDefinitionUIDs method")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	if len(mmListComponentDefinitionUIDs.expectations) > 0 {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("Some expectations are already set for the Repository.ListComponentDefinitionUIDs method")
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.pageToken = &pageToken
 
-	return mmListNamespacePipelines
+	mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs = f
+	return mmListComponentDefinitionUIDs.mock
 }
 
-// ExpectIsBasicViewParam5 sets up expected param isBasicView for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectIsBasicViewParam5(isBasicView bool) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
-	}
-
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
-	}
-
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+// When sets expectation for the Repository.ListComponentDefinitionUIDs which will trigger the result defined by the following
+// Then helper
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) When(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) *RepositoryMockListComponentDefinitionUIDsExpectation {
+	if mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("RepositoryMock.ListComponentDefinitionUIDs mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	expectation := &RepositoryMockListComponentDefinitionUIDsExpectation{
+		mock:   mmListComponentDefinitionUIDs.mock,
+		params: &RepositoryMockListComponentDefinitionUIDsParams{ctx, l1},
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.isBasicView = &isBasicView
-
-	return mmListNamespacePipelines
+	mmListComponentDefinitionUIDs.expectations = append(mmListComponentDefinitionUIDs.expectations, expectation)
+	return expectation
 }
 
-// ExpectFilterParam6 sets up expected param filter for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectFilterParam6(filter filtering.Filter) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
-	}
+// Then sets up Repository.ListComponentDefinitionUIDs return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListComponentDefinitionUIDsExpectation) Then(uids []*datamodel.ComponentDefinition, totalSize int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockListComponentDefinitionUIDsResults{uids, totalSize, err}
+	return e.mock
+}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+// Times sets number of times Repository.ListComponentDefinitionUIDs should be invoked
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Times(n uint64) *mRepositoryMockListComponentDefinitionUIDs {
+	if n == 0 {
+		mmListComponentDefinitionUIDs.mock.t.Fatalf("Times of RepositoryMock.ListComponentDefinitionUIDs mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmListComponentDefinitionUIDs.expectedInvocations, n)
+	return mmListComponentDefinitionUIDs
+}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) invocationsDone() bool {
+	if len(mmListComponentDefinitionUIDs.expectations) == 0 && mmListComponentDefinitionUIDs.defaultExpectation == nil && mmListComponentDefinitionUIDs.mock.funcListComponentDefinitionUIDs == nil {
+		return true
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
-	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.filter = &filter
+	totalInvocations := mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.mock.afterListComponentDefinitionUIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.expectedInvocations)
 
-	return mmListNamespacePipelines
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ExpectUidAllowListParam7 sets up expected param uidAllowList for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectUidAllowListParam7(uidAllowList []uuid.UUID) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
-	}
+// ListComponentDefinitionUIDs implements repository.Repository
+func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDs(ctx context.Context, l1 mm_repository.ListComponentDefinitionsParams) (uids []*datamodel.ComponentDefinition, totalSize int64, err error) {
+	mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.beforeListComponentDefinitionUIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.afterListComponentDefinitionUIDsCounter, 1)
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentDefinitionUIDs.inspectFuncListComponentDefinitionUIDs != nil {
+		mmListComponentDefinitionUIDs.inspectFuncListComponentDefinitionUIDs(ctx, l1)
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
-	}
+	mm_params := RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	// Record call args
+	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.mutex.Lock()
+	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.callArgs = append(mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.callArgs, &mm_params)
+	mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.mutex.Unlock()
+
+	for _, e := range mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.uids, e.results.totalSize, e.results.err
+		}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.uidAllowList = &uidAllowList
 
-	return mmListNamespacePipelines
-}
+	if mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.paramPtrs
 
-// ExpectShowDeletedParam8 sets up expected param showDeleted for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectShowDeletedParam8(showDeleted bool) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+		mm_got := RepositoryMockListComponentDefinitionUIDsParams{ctx, l1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.l1 != nil && !minimock.Equal(*mm_want_ptrs.l1, mm_got.l1) {
+				mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameter l1, want: %#v, got: %#v%s\n", *mm_want_ptrs.l1, mm_got.l1, minimock.Diff(*mm_want_ptrs.l1, mm_got.l1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListComponentDefinitionUIDs.t.Errorf("RepositoryMock.ListComponentDefinitionUIDs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListComponentDefinitionUIDs.ListComponentDefinitionUIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListComponentDefinitionUIDs.t.Fatal("No results are set for the RepositoryMock.ListComponentDefinitionUIDs")
+		}
+		return (*mm_results).uids, (*mm_results).totalSize, (*mm_results).err
+	}
+	if mmListComponentDefinitionUIDs.funcListComponentDefinitionUIDs != nil {
+		return mmListComponentDefinitionUIDs.funcListComponentDefinitionUIDs(ctx, l1)
 	}
+	mmListComponentDefinitionUIDs.t.Fatalf("Unexpected call to RepositoryMock.ListComponentDefinitionUIDs. %v %v", ctx, l1)
+	return
+}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+// ListComponentDefinitionUIDsAfterCounter returns a count of finished RepositoryMock.ListComponentDefinitionUIDs invocations
+func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.afterListComponentDefinitionUIDsCounter)
+}
+
+// ListComponentDefinitionUIDsBeforeCounter returns a count of RepositoryMock.ListComponentDefinitionUIDs invocations
+func (mmListComponentDefinitionUIDs *RepositoryMock) ListComponentDefinitionUIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComponentDefinitionUIDs.beforeListComponentDefinitionUIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListComponentDefinitionUIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListComponentDefinitionUIDs *mRepositoryMockListComponentDefinitionUIDs) Calls() []*RepositoryMockListComponentDefinitionUIDsParams {
+	mmListComponentDefinitionUIDs.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListComponentDefinitionUIDsParams, len(mmListComponentDefinitionUIDs.callArgs))
+	copy(argCopy, mmListComponentDefinitionUIDs.callArgs)
+
+	mmListComponentDefinitionUIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListComponentDefinitionUIDsDone returns true if the count of the ListComponentDefinitionUIDs invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListComponentDefinitionUIDsDone() bool {
+	if m.ListComponentDefinitionUIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	for _, e := range m.ListComponentDefinitionUIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	return m.ListComponentDefinitionUIDsMock.invocationsDone()
+}
+
+// MinimockListComponentDefinitionUIDsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListComponentDefinitionUIDsInspect() {
+	for _, e := range m.ListComponentDefinitionUIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListComponentDefinitionUIDs with params: %#v", *e.params)
+		}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.showDeleted = &showDeleted
 
-	return mmListNamespacePipelines
+	afterListComponentDefinitionUIDsCounter := mm_atomic.LoadUint64(&m.afterListComponentDefinitionUIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListComponentDefinitionUIDsMock.defaultExpectation != nil && afterListComponentDefinitionUIDsCounter < 1 {
+		if m.ListComponentDefinitionUIDsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListComponentDefinitionUIDs")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListComponentDefinitionUIDs with params: %#v", *m.ListComponentDefinitionUIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListComponentDefinitionUIDs != nil && afterListComponentDefinitionUIDsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListComponentDefinitionUIDs")
+	}
+
+	if !m.ListComponentDefinitionUIDsMock.invocationsDone() && afterListComponentDefinitionUIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListComponentDefinitionUIDs but found %d calls",
+			mm_atomic.LoadUint64(&m.ListComponentDefinitionUIDsMock.expectedInvocations), afterListComponentDefinitionUIDsCounter)
+	}
 }
 
-// ExpectEmbedReleasesParam9 sets up expected param embedReleases for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectEmbedReleasesParam9(embedReleases bool) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+type mRepositoryMockListComponentRuns struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListComponentRunsExpectation
+	expectations       []*RepositoryMockListComponentRunsExpectation
+
+	callArgs []*RepositoryMockListComponentRunsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListComponentRunsExpectation specifies expectation struct of the Repository.ListComponentRuns
+type RepositoryMockListComponentRunsExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListComponentRunsParams
+	paramPtrs *RepositoryMockListComponentRunsParamPtrs
+	results   *RepositoryMockListComponentRunsResults
+	Counter   uint64
+}
+
+// RepositoryMockListComponentRunsParams contains parameters of the Repository.ListComponentRuns
+type RepositoryMockListComponentRunsParams struct {
+	ctx                context.Context
+	pipelineTriggerIDs []string
+}
+
+// RepositoryMockListComponentRunsParamPtrs contains pointers to parameters of the Repository.ListComponentRuns
+type RepositoryMockListComponentRunsParamPtrs struct {
+	ctx                *context.Context
+	pipelineTriggerIDs *[]string
+}
+
+// RepositoryMockListComponentRunsResults contains results of the Repository.ListComponentRuns
+type RepositoryMockListComponentRunsResults struct {
+	cpa1 []*datamodel.ComponentRun
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Optional() *mRepositoryMockListComponentRuns {
+	mmListComponentRuns.optional = true
+	return mmListComponentRuns
+}
+
+// Expect sets up expected params for Repository.ListComponentRuns
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Expect(ctx context.Context, pipelineTriggerIDs []string) *mRepositoryMockListComponentRuns {
+	if mmListComponentRuns.mock.funcListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentRuns.defaultExpectation == nil {
+		mmListComponentRuns.defaultExpectation = &RepositoryMockListComponentRunsExpectation{}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	if mmListComponentRuns.defaultExpectation.paramPtrs != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by ExpectParams functions")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	mmListComponentRuns.defaultExpectation.params = &RepositoryMockListComponentRunsParams{ctx, pipelineTriggerIDs}
+	for _, e := range mmListComponentRuns.expectations {
+		if minimock.Equal(e.params, mmListComponentRuns.defaultExpectation.params) {
+			mmListComponentRuns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListComponentRuns.defaultExpectation.params)
+		}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.embedReleases = &embedReleases
 
-	return mmListNamespacePipelines
+	return mmListComponentRuns
 }
 
-// ExpectOrderParam10 sets up expected param order for Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectOrderParam10(order ordering.OrderBy) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListComponentRuns
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListComponentRuns {
+	if mmListComponentRuns.mock.funcListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Set")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	if mmListComponentRuns.defaultExpectation == nil {
+		mmListComponentRuns.defaultExpectation = &RepositoryMockListComponentRunsExpectation{}
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.params != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	if mmListComponentRuns.defaultExpectation.params != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Expect")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
-		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	if mmListComponentRuns.defaultExpectation.paramPtrs == nil {
+		mmListComponentRuns.defaultExpectation.paramPtrs = &RepositoryMockListComponentRunsParamPtrs{}
 	}
-	mmListNamespacePipelines.defaultExpectation.paramPtrs.order = &order
+	mmListComponentRuns.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListNamespacePipelines
+	return mmListComponentRuns
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Inspect(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy)) *mRepositoryMockListNamespacePipelines {
-	if mmListNamespacePipelines.mock.inspectFuncListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespacePipelines")
+// ExpectPipelineTriggerIDsParam2 sets up expected param pipelineTriggerIDs for Repository.ListComponentRuns
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) ExpectPipelineTriggerIDsParam2(pipelineTriggerIDs []string) *mRepositoryMockListComponentRuns {
+	if mmListComponentRuns.mock.funcListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Set")
 	}
 
-	mmListNamespacePipelines.mock.inspectFuncListNamespacePipelines = f
+	if mmListComponentRuns.defaultExpectation == nil {
+		mmListComponentRuns.defaultExpectation = &RepositoryMockListComponentRunsExpectation{}
+	}
 
-	return mmListNamespacePipelines
+	if mmListComponentRuns.defaultExpectation.params != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Expect")
+	}
+
+	if mmListComponentRuns.defaultExpectation.paramPtrs == nil {
+		mmListComponentRuns.defaultExpectation.paramPtrs = &RepositoryMockListComponentRunsParamPtrs{}
+	}
+	mmListComponentRuns.defaultExpectation.paramPtrs.pipelineTriggerIDs = &pipelineTriggerIDs
+
+	return mmListComponentRuns
 }
 
-// Return sets up results that will be returned by Repository.ListNamespacePipelines
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Repository.ListComponentRuns
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Inspect(f func(ctx context.Context, pipelineTriggerIDs []string)) *mRepositoryMockListComponentRuns {
+	if mmListComponentRuns.mock.inspectFuncListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListComponentRuns")
 	}
 
-	if mmListNamespacePipelines.defaultExpectation == nil {
-		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{mock: mmListNamespacePipelines.mock}
+	mmListComponentRuns.mock.inspectFuncListComponentRuns = f
+
+	return mmListComponentRuns
+}
+
+// Return sets up results that will be returned by Repository.ListComponentRuns
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Return(cpa1 []*datamodel.ComponentRun, err error) *RepositoryMock {
+	if mmListComponentRuns.mock.funcListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Set")
 	}
-	mmListNamespacePipelines.defaultExpectation.results = &RepositoryMockListNamespacePipelinesResults{ppa1, i1, s1, err}
-	return mmListNamespacePipelines.mock
+
+	if mmListComponentRuns.defaultExpectation == nil {
+		mmListComponentRuns.defaultExpectation = &RepositoryMockListComponentRunsExpectation{mock: mmListComponentRuns.mock}
+	}
+	mmListComponentRuns.defaultExpectation.results = &RepositoryMockListComponentRunsResults{cpa1, err}
+	return mmListComponentRuns.mock
 }
 
-// Set uses given function f to mock the Repository.ListNamespacePipelines method
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Set(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
-	if mmListNamespacePipelines.defaultExpectation != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespacePipelines method")
+// Set uses given function f to mock the Repository.ListComponentRuns method
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Set(f func(ctx context.Context, pipelineTriggerIDs []string) (cpa1 []*datamodel.ComponentRun, err error)) *RepositoryMock {
+	if mmListComponentRuns.defaultExpectation != nil {
+		mmListComponentRuns.mock.t.Fatalf("Default expectation is already set for the Repository.ListComponentRuns method")
 	}
 
-	if len(mmListNamespacePipelines.expectations) > 0 {
-		mmListNamespacePipelines.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespacePipelines method")
+	if len(mmListComponentRuns.expectations) > 0 {
+		mmListComponentRuns.mock.t.Fatalf("Some expectations are already set for the Repository.ListComponentRuns method")
 	}
 
-	mmListNamespacePipelines.mock.funcListNamespacePipelines = f
-	return mmListNamespacePipelines.mock
+	mmListComponentRuns.mock.funcListComponentRuns = f
+	return mmListComponentRuns.mock
 }
 
-// When sets expectation for the Repository.ListNamespacePipelines which will trigger the result defined by the following
+// When sets expectation for the Repository.ListComponentRuns which will trigger the result defined by the following
 // Then helper
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) When(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *RepositoryMockListNamespacePipelinesExpectation {
-	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
-		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) When(ctx context.Context, pipelineTriggerIDs []string) *RepositoryMockListComponentRunsExpectation {
+	if mmListComponentRuns.mock.funcListComponentRuns != nil {
+		mmListComponentRuns.mock.t.Fatalf("RepositoryMock.ListComponentRuns mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListNamespacePipelinesExpectation{
-		mock:   mmListNamespacePipelines.mock,
-		params: &RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order},
+	expectation := &RepositoryMockListComponentRunsExpectation{
+		mock:   mmListComponentRuns.mock,
+		params: &RepositoryMockListComponentRunsParams{ctx, pipelineTriggerIDs},
 	}
-	mmListNamespacePipelines.expectations = append(mmListNamespacePipelines.expectations, expectation)
+	mmListComponentRuns.expectations = append(mmListComponentRuns.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListNamespacePipelines return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListNamespacePipelinesExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	e.results = &RepositoryMockListNamespacePipelinesResults{ppa1, i1, s1, err}
+// Then sets up Repository.ListComponentRuns return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListComponentRunsExpectation) Then(cpa1 []*datamodel.ComponentRun, err error) *RepositoryMock {
+	e.results = &RepositoryMockListComponentRunsResults{cpa1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListNamespacePipelines should be invoked
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Times(n uint64) *mRepositoryMockListNamespacePipelines {
+// Times sets number of times Repository.ListComponentRuns should be invoked
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Times(n uint64) *mRepositoryMockListComponentRuns {
 	if n == 0 {
-		mmListNamespacePipelines.mock.t.Fatalf("Times of RepositoryMock.ListNamespacePipelines mock can not be zero")
+		mmListComponentRuns.mock.t.Fatalf("Times of RepositoryMock.ListComponentRuns mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListNamespacePipelines.expectedInvocations, n)
-	return mmListNamespacePipelines
+	mm_atomic.StoreUint64(&mmListComponentRuns.expectedInvocations, n)
+	return mmListComponentRuns
 }
 
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) invocationsDone() bool {
-	if len(mmListNamespacePipelines.expectations) == 0 && mmListNamespacePipelines.defaultExpectation == nil && mmListNamespacePipelines.mock.funcListNamespacePipelines == nil {
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) invocationsDone() bool {
+	if len(mmListComponentRuns.expectations) == 0 && mmListComponentRuns.defaultExpectation == nil && mmListComponentRuns.mock.funcListComponentRuns == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelines.mock.afterListNamespacePipelinesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelines.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListComponentRuns.mock.afterListComponentRunsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListComponentRuns.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListNamespacePipelines implements repository.Repository
-func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelines(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
-	mm_atomic.AddUint64(&mmListNamespacePipelines.beforeListNamespacePipelinesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListNamespacePipelines.afterListNamespacePipelinesCounter, 1)
+// ListComponentRuns implements repository.Repository
+func (mmListComponentRuns *RepositoryMock) ListComponentRuns(ctx context.Context, pipelineTriggerIDs []string) (cpa1 []*datamodel.ComponentRun, err error) {
+	mm_atomic.AddUint64(&mmListComponentRuns.beforeListComponentRunsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListComponentRuns.afterListComponentRunsCounter, 1)
 
-	if mmListNamespacePipelines.inspectFuncListNamespacePipelines != nil {
-		mmListNamespacePipelines.inspectFuncListNamespacePipelines(ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	if mmListComponentRuns.inspectFuncListComponentRuns != nil {
+		mmListComponentRuns.inspectFuncListComponentRuns(ctx, pipelineTriggerIDs)
 	}
 
-	mm_params := RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+	mm_params := RepositoryMockListComponentRunsParams{ctx, pipelineTriggerIDs}
 
 	// Record call args
-	mmListNamespacePipelines.ListNamespacePipelinesMock.mutex.Lock()
-	mmListNamespacePipelines.ListNamespacePipelinesMock.callArgs = append(mmListNamespacePipelines.ListNamespacePipelinesMock.callArgs, &mm_params)
-	mmListNamespacePipelines.ListNamespacePipelinesMock.mutex.Unlock()
+	mmListComponentRuns.ListComponentRunsMock.mutex.Lock()
+	mmListComponentRuns.ListComponentRunsMock.callArgs = append(mmListComponentRuns.ListComponentRunsMock.callArgs, &mm_params)
+	mmListComponentRuns.ListComponentRunsMock.mutex.Unlock()
 
-	for _, e := range mmListNamespacePipelines.ListNamespacePipelinesMock.expectations {
+	for _, e := range mmListComponentRuns.ListComponentRunsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+			return e.results.cpa1, e.results.err
 		}
 	}
 
-	if mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.params
-		mm_want_ptrs := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.paramPtrs
+	if mmListComponentRuns.ListComponentRunsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListComponentRuns.ListComponentRunsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListComponentRuns.ListComponentRunsMock.defaultExpectation.params
+		mm_want_ptrs := mmListComponentRuns.ListComponentRunsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+		mm_got := RepositoryMockListComponentRunsParams{ctx, pipelineTriggerIDs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListComponentRuns.t.Errorf("RepositoryMock.ListComponentRuns got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			if mm_want_ptrs.pipelineTriggerIDs != nil && !minimock.Equal(*mm_want_ptrs.pipelineTriggerIDs, mm_got.pipelineTriggerIDs) {
+				mmListComponentRuns.t.Errorf("RepositoryMock.ListComponentRuns got unexpected parameter pipelineTriggerIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineTriggerIDs, mm_got.pipelineTriggerIDs, minimock.Diff(*mm_want_ptrs.pipelineTriggerIDs, mm_got.pipelineTriggerIDs))
 			}
 
-			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
-			}
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListComponentRuns.t.Errorf("RepositoryMock.ListComponentRuns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
 
-			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
-			}
+		mm_results := mmListComponentRuns.ListComponentRunsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListComponentRuns.t.Fatal("No results are set for the RepositoryMock.ListComponentRuns")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListComponentRuns.funcListComponentRuns != nil {
+		return mmListComponentRuns.funcListComponentRuns(ctx, pipelineTriggerIDs)
+	}
+	mmListComponentRuns.t.Fatalf("Unexpected call to RepositoryMock.ListComponentRuns. %v %v", ctx, pipelineTriggerIDs)
+	return
+}
 
-			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
-			}
+// ListComponentRunsAfterCounter returns a count of finished RepositoryMock.ListComponentRuns invocations
+func (mmListComponentRuns *RepositoryMock) ListComponentRunsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComponentRuns.afterListComponentRunsCounter)
+}
 
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
-			}
+// ListComponentRunsBeforeCounter returns a count of RepositoryMock.ListComponentRuns invocations
+func (mmListComponentRuns *RepositoryMock) ListComponentRunsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListComponentRuns.beforeListComponentRunsCounter)
+}
 
-			if mm_want_ptrs.uidAllowList != nil && !minimock.Equal(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter uidAllowList, want: %#v, got: %#v%s\n", *mm_want_ptrs.uidAllowList, mm_got.uidAllowList, minimock.Diff(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList))
-			}
+// Calls returns a list of arguments used in each call to RepositoryMock.ListComponentRuns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListComponentRuns *mRepositoryMockListComponentRuns) Calls() []*RepositoryMockListComponentRunsParams {
+	mmListComponentRuns.mutex.RLock()
 
-			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
-			}
+	argCopy := make([]*RepositoryMockListComponentRunsParams, len(mmListComponentRuns.callArgs))
+	copy(argCopy, mmListComponentRuns.callArgs)
 
-			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
-			}
-
-			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
-				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter order, want: %#v, got: %#v%s\n", *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
-			}
-
-		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.results
-		if mm_results == nil {
-			mmListNamespacePipelines.t.Fatal("No results are set for the RepositoryMock.ListNamespacePipelines")
-		}
-		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
-	}
-	if mmListNamespacePipelines.funcListNamespacePipelines != nil {
-		return mmListNamespacePipelines.funcListNamespacePipelines(ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
-	}
-	mmListNamespacePipelines.t.Fatalf("Unexpected call to RepositoryMock.ListNamespacePipelines. %v %v %v %v %v %v %v %v %v %v", ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
-	return
-}
-
-// ListNamespacePipelinesAfterCounter returns a count of finished RepositoryMock.ListNamespacePipelines invocations
-func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelinesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespacePipelines.afterListNamespacePipelinesCounter)
-}
-
-// ListNamespacePipelinesBeforeCounter returns a count of RepositoryMock.ListNamespacePipelines invocations
-func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelinesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespacePipelines.beforeListNamespacePipelinesCounter)
-}
-
-// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespacePipelines.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Calls() []*RepositoryMockListNamespacePipelinesParams {
-	mmListNamespacePipelines.mutex.RLock()
-
-	argCopy := make([]*RepositoryMockListNamespacePipelinesParams, len(mmListNamespacePipelines.callArgs))
-	copy(argCopy, mmListNamespacePipelines.callArgs)
-
-	mmListNamespacePipelines.mutex.RUnlock()
+	mmListComponentRuns.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListNamespacePipelinesDone returns true if the count of the ListNamespacePipelines invocations corresponds
+// MinimockListComponentRunsDone returns true if the count of the ListComponentRuns invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListNamespacePipelinesDone() bool {
-	if m.ListNamespacePipelinesMock.optional {
+func (m *RepositoryMock) MinimockListComponentRunsDone() bool {
+	if m.ListComponentRunsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListNamespacePipelinesMock.expectations {
+	for _, e := range m.ListComponentRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListNamespacePipelinesMock.invocationsDone()
+	return m.ListComponentRunsMock.invocationsDone()
 }
 
-// MinimockListNamespacePipelinesInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListNamespacePipelinesInspect() {
-	for _, e := range m.ListNamespacePipelinesMock.expectations {
+// MinimockListComponentRunsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListComponentRunsInspect() {
+	for _, e := range m.ListComponentRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelines with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListComponentRuns with params: %#v", *e.params)
 		}
 	}
 
-	afterListNamespacePipelinesCounter := mm_atomic.LoadUint64(&m.afterListNamespacePipelinesCounter)
+	afterListComponentRunsCounter := mm_atomic.LoadUint64(&m.afterListComponentRunsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListNamespacePipelinesMock.defaultExpectation != nil && afterListNamespacePipelinesCounter < 1 {
-		if m.ListNamespacePipelinesMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListNamespacePipelines")
+	if m.ListComponentRunsMock.defaultExpectation != nil && afterListComponentRunsCounter < 1 {
+		if m.ListComponentRunsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListComponentRuns")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelines with params: %#v", *m.ListNamespacePipelinesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListComponentRuns with params: %#v", *m.ListComponentRunsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListNamespacePipelines != nil && afterListNamespacePipelinesCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListNamespacePipelines")
+	if m.funcListComponentRuns != nil && afterListComponentRunsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListComponentRuns")
 	}
 
-	if !m.ListNamespacePipelinesMock.invocationsDone() && afterListNamespacePipelinesCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespacePipelines but found %d calls",
-			mm_atomic.LoadUint64(&m.ListNamespacePipelinesMock.expectedInvocations), afterListNamespacePipelinesCounter)
+	if !m.ListComponentRunsMock.invocationsDone() && afterListComponentRunsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListComponentRuns but found %d calls",
+			mm_atomic.LoadUint64(&m.ListComponentRunsMock.expectedInvocations), afterListComponentRunsCounter)
 	}
 }
 
-type mRepositoryMockListNamespaceSecrets struct {
+type mRepositoryMockListNamespacePipelineReleases struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListNamespaceSecretsExpectation
-	expectations       []*RepositoryMockListNamespaceSecretsExpectation
+	defaultExpectation *RepositoryMockListNamespacePipelineReleasesExpectation
+	expectations       []*RepositoryMockListNamespacePipelineReleasesExpectation
 
-	callArgs []*RepositoryMockListNamespaceSecretsParams
+	callArgs []*RepositoryMockListNamespacePipelineReleasesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListNamespaceSecretsExpectation specifies expectation struct of the Repository.ListNamespaceSecrets
-type RepositoryMockListNamespaceSecretsExpectation struct {
+// RepositoryMockListNamespacePipelineReleasesExpectation specifies expectation struct of the Repository.ListNamespacePipelineReleases
+type RepositoryMockListNamespacePipelineReleasesExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListNamespaceSecretsParams
-	paramPtrs *RepositoryMockListNamespaceSecretsParamPtrs
-	results   *RepositoryMockListNamespaceSecretsResults
+	params    *RepositoryMockListNamespacePipelineReleasesParams
+	paramPtrs *RepositoryMockListNamespacePipelineReleasesParamPtrs
+	results   *RepositoryMockListNamespacePipelineReleasesResults
 	Counter   uint64
 }
 
-// RepositoryMockListNamespaceSecretsParams contains parameters of the Repository.ListNamespaceSecrets
-type RepositoryMockListNamespaceSecretsParams struct {
+// RepositoryMockListNamespacePipelineReleasesParams contains parameters of the Repository.ListNamespacePipelineReleases
+type RepositoryMockListNamespacePipelineReleasesParams struct {
 	ctx            context.Context
 	ownerPermalink string
+	pipelineUID    uuid.UUID
 	pageSize       int64
 	pageToken      string
+	isBasicView    bool
 	filter         filtering.Filter
+	showDeleted    bool
+	returnCount    bool
 }
 
-// RepositoryMockListNamespaceSecretsParamPtrs contains pointers to parameters of the Repository.ListNamespaceSecrets
-type RepositoryMockListNamespaceSecretsParamPtrs struct {
+// RepositoryMockListNamespacePipelineReleasesParamPtrs contains pointers to parameters of the Repository.ListNamespacePipelineReleases
+type RepositoryMockListNamespacePipelineReleasesParamPtrs struct {
 	ctx            *context.Context
 	ownerPermalink *string
+	pipelineUID    *uuid.UUID
 	pageSize       *int64
 	pageToken      *string
+	isBasicView    *bool
 	filter         *filtering.Filter
+	showDeleted    *bool
+	returnCount    *bool
 }
 
-// RepositoryMockListNamespaceSecretsResults contains results of the Repository.ListNamespaceSecrets
-type RepositoryMockListNamespaceSecretsResults struct {
-	spa1 []*datamodel.Secret
+// RepositoryMockListNamespacePipelineReleasesResults contains results of the Repository.ListNamespacePipelineReleases
+type RepositoryMockListNamespacePipelineReleasesResults struct {
+	ppa1 []*datamodel.PipelineRelease
 	i1   int64
 	s1   string
 	err  error
@@ -8874,398 +9190,510 @@ type RepositoryMockListNamespaceSecretsResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Optional() *mRepositoryMockListNamespaceSecrets {
-	mmListNamespaceSecrets.optional = true
-	return mmListNamespaceSecrets
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Optional() *mRepositoryMockListNamespacePipelineReleases {
+	mmListNamespacePipelineReleases.optional = true
+	return mmListNamespacePipelineReleases
 }
 
-// Expect sets up expected params for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Expect(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// Expect sets up expected params for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by ExpectParams functions")
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by ExpectParams functions")
 	}
 
-	mmListNamespaceSecrets.defaultExpectation.params = &RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
-	for _, e := range mmListNamespaceSecrets.expectations {
-		if minimock.Equal(e.params, mmListNamespaceSecrets.defaultExpectation.params) {
-			mmListNamespaceSecrets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespaceSecrets.defaultExpectation.params)
+	mmListNamespacePipelineReleases.defaultExpectation.params = &RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
+	for _, e := range mmListNamespacePipelineReleases.expectations {
+		if minimock.Equal(e.params, mmListNamespacePipelineReleases.defaultExpectation.params) {
+			mmListNamespacePipelineReleases.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespacePipelineReleases.defaultExpectation.params)
 		}
 	}
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.params != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
-		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.params != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
-		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.params != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
-		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.paramPtrs.pageSize = &pageSize
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectPageSizeParam4 sets up expected param pageSize for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPageSizeParam4(pageSize int64) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.params != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
-		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.paramPtrs.pageToken = &pageToken
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pageSize = &pageSize
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// ExpectFilterParam5 sets up expected param filter for Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectPageTokenParam5 sets up expected param pageToken for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectPageTokenParam5(pageToken string) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.params != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
-		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.paramPtrs.filter = &filter
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.pageToken = &pageToken
 
-	return mmListNamespaceSecrets
+	return mmListNamespacePipelineReleases
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Inspect(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter)) *mRepositoryMockListNamespaceSecrets {
-	if mmListNamespaceSecrets.mock.inspectFuncListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespaceSecrets")
+// ExpectIsBasicViewParam6 sets up expected param isBasicView for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectIsBasicViewParam6(isBasicView bool) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	mmListNamespaceSecrets.mock.inspectFuncListNamespaceSecrets = f
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	}
 
-	return mmListNamespaceSecrets
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	}
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.isBasicView = &isBasicView
+
+	return mmListNamespacePipelineReleases
 }
 
-// Return sets up results that will be returned by Repository.ListNamespaceSecrets
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Return(spa1 []*datamodel.Secret, i1 int64, s1 string, err error) *RepositoryMock {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+// ExpectFilterParam7 sets up expected param filter for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectFilterParam7(filter filtering.Filter) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if mmListNamespaceSecrets.defaultExpectation == nil {
-		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{mock: mmListNamespaceSecrets.mock}
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
-	mmListNamespaceSecrets.defaultExpectation.results = &RepositoryMockListNamespaceSecretsResults{spa1, i1, s1, err}
-	return mmListNamespaceSecrets.mock
+
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	}
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListNamespacePipelineReleases
 }
 
-// Set uses given function f to mock the Repository.ListNamespaceSecrets method
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Set(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (spa1 []*datamodel.Secret, i1 int64, s1 string, err error)) *RepositoryMock {
-	if mmListNamespaceSecrets.defaultExpectation != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespaceSecrets method")
+// ExpectShowDeletedParam8 sets up expected param showDeleted for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectShowDeletedParam8(showDeleted bool) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	if len(mmListNamespaceSecrets.expectations) > 0 {
-		mmListNamespaceSecrets.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespaceSecrets method")
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
 	}
 
-	mmListNamespaceSecrets.mock.funcListNamespaceSecrets = f
-	return mmListNamespaceSecrets.mock
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	}
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+
+	return mmListNamespacePipelineReleases
 }
 
-// When sets expectation for the Repository.ListNamespaceSecrets which will trigger the result defined by the following
+// ExpectReturnCountParam9 sets up expected param returnCount for Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) ExpectReturnCountParam9(returnCount bool) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{}
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation.params != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineReleases.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineReleasesParamPtrs{}
+	}
+	mmListNamespacePipelineReleases.defaultExpectation.paramPtrs.returnCount = &returnCount
+
+	return mmListNamespacePipelineReleases
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool)) *mRepositoryMockListNamespacePipelineReleases {
+	if mmListNamespacePipelineReleases.mock.inspectFuncListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespacePipelineReleases")
+	}
+
+	mmListNamespacePipelineReleases.mock.inspectFuncListNamespacePipelineReleases = f
+
+	return mmListNamespacePipelineReleases
+}
+
+// Return sets up results that will be returned by Repository.ListNamespacePipelineReleases
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Return(ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
+	}
+
+	if mmListNamespacePipelineReleases.defaultExpectation == nil {
+		mmListNamespacePipelineReleases.defaultExpectation = &RepositoryMockListNamespacePipelineReleasesExpectation{mock: mmListNamespacePipelineReleases.mock}
+	}
+	mmListNamespacePipelineReleases.defaultExpectation.results = &RepositoryMockListNamespacePipelineReleasesResults{ppa1, i1, s1, err}
+	return mmListNamespacePipelineReleases.mock
+}
+
+// Set uses given function f to mock the Repository.ListNamespacePipelineReleases method
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) (ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListNamespacePipelineReleases.defaultExpectation != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespacePipelineReleases method")
+	}
+
+	if len(mmListNamespacePipelineReleases.expectations) > 0 {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespacePipelineReleases method")
+	}
+
+	mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases = f
+	return mmListNamespacePipelineReleases.mock
+}
+
+// When sets expectation for the Repository.ListNamespacePipelineReleases which will trigger the result defined by the following
 // Then helper
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) When(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *RepositoryMockListNamespaceSecretsExpectation {
-	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) *RepositoryMockListNamespacePipelineReleasesExpectation {
+	if mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineReleases mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListNamespaceSecretsExpectation{
-		mock:   mmListNamespaceSecrets.mock,
-		params: &RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter},
+	expectation := &RepositoryMockListNamespacePipelineReleasesExpectation{
+		mock:   mmListNamespacePipelineReleases.mock,
+		params: &RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount},
 	}
-	mmListNamespaceSecrets.expectations = append(mmListNamespaceSecrets.expectations, expectation)
+	mmListNamespacePipelineReleases.expectations = append(mmListNamespacePipelineReleases.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListNamespaceSecrets return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListNamespaceSecretsExpectation) Then(spa1 []*datamodel.Secret, i1 int64, s1 string, err error) *RepositoryMock {
-	e.results = &RepositoryMockListNamespaceSecretsResults{spa1, i1, s1, err}
+// Then sets up Repository.ListNamespacePipelineReleases return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListNamespacePipelineReleasesExpectation) Then(ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListNamespacePipelineReleasesResults{ppa1, i1, s1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListNamespaceSecrets should be invoked
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Times(n uint64) *mRepositoryMockListNamespaceSecrets {
+// Times sets number of times Repository.ListNamespacePipelineReleases should be invoked
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Times(n uint64) *mRepositoryMockListNamespacePipelineReleases {
 	if n == 0 {
-		mmListNamespaceSecrets.mock.t.Fatalf("Times of RepositoryMock.ListNamespaceSecrets mock can not be zero")
+		mmListNamespacePipelineReleases.mock.t.Fatalf("Times of RepositoryMock.ListNamespacePipelineReleases mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListNamespaceSecrets.expectedInvocations, n)
-	return mmListNamespaceSecrets
+	mm_atomic.StoreUint64(&mmListNamespacePipelineReleases.expectedInvocations, n)
+	return mmListNamespacePipelineReleases
 }
 
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) invocationsDone() bool {
-	if len(mmListNamespaceSecrets.expectations) == 0 && mmListNamespaceSecrets.defaultExpectation == nil && mmListNamespaceSecrets.mock.funcListNamespaceSecrets == nil {
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) invocationsDone() bool {
+	if len(mmListNamespacePipelineReleases.expectations) == 0 && mmListNamespacePipelineReleases.defaultExpectation == nil && mmListNamespacePipelineReleases.mock.funcListNamespacePipelineReleases == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListNamespaceSecrets.mock.afterListNamespaceSecretsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespaceSecrets.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.mock.afterListNamespacePipelineReleasesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListNamespaceSecrets implements repository.Repository
-func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecrets(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (spa1 []*datamodel.Secret, i1 int64, s1 string, err error) {
-	mm_atomic.AddUint64(&mmListNamespaceSecrets.beforeListNamespaceSecretsCounter, 1)
-	defer mm_atomic.AddUint64(&mmListNamespaceSecrets.afterListNamespaceSecretsCounter, 1)
+// ListNamespacePipelineReleases implements repository.Repository
+func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleases(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, returnCount bool) (ppa1 []*datamodel.PipelineRelease, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListNamespacePipelineReleases.beforeListNamespacePipelineReleasesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespacePipelineReleases.afterListNamespacePipelineReleasesCounter, 1)
 
-	if mmListNamespaceSecrets.inspectFuncListNamespaceSecrets != nil {
-		mmListNamespaceSecrets.inspectFuncListNamespaceSecrets(ctx, ownerPermalink, pageSize, pageToken, filter)
+	if mmListNamespacePipelineReleases.inspectFuncListNamespacePipelineReleases != nil {
+		mmListNamespacePipelineReleases.inspectFuncListNamespacePipelineReleases(ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
 	}
 
-	mm_params := RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+	mm_params := RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
 
 	// Record call args
-	mmListNamespaceSecrets.ListNamespaceSecretsMock.mutex.Lock()
-	mmListNamespaceSecrets.ListNamespaceSecretsMock.callArgs = append(mmListNamespaceSecrets.ListNamespaceSecretsMock.callArgs, &mm_params)
-	mmListNamespaceSecrets.ListNamespaceSecretsMock.mutex.Unlock()
+	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.mutex.Lock()
+	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.callArgs = append(mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.callArgs, &mm_params)
+	mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.mutex.Unlock()
 
-	for _, e := range mmListNamespaceSecrets.ListNamespaceSecretsMock.expectations {
+	for _, e := range mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.spa1, e.results.i1, e.results.s1, e.results.err
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
 		}
 	}
 
-	if mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.Counter, 1)
-		mm_want := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.params
-		mm_want_ptrs := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.paramPtrs
+	if mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+		mm_got := RepositoryMockListNamespacePipelineReleasesParams{ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
+
+			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
 			}
 
 			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
-				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
 			}
 
 			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
-				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
 			}
 
 			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+			}
+
+			if mm_want_ptrs.returnCount != nil && !minimock.Equal(*mm_want_ptrs.returnCount, mm_got.returnCount) {
+				mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameter returnCount, want: %#v, got: %#v%s\n", *mm_want_ptrs.returnCount, mm_got.returnCount, minimock.Diff(*mm_want_ptrs.returnCount, mm_got.returnCount))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListNamespacePipelineReleases.t.Errorf("RepositoryMock.ListNamespacePipelineReleases got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.results
+		mm_results := mmListNamespacePipelineReleases.ListNamespacePipelineReleasesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListNamespaceSecrets.t.Fatal("No results are set for the RepositoryMock.ListNamespaceSecrets")
+			mmListNamespacePipelineReleases.t.Fatal("No results are set for the RepositoryMock.ListNamespacePipelineReleases")
 		}
-		return (*mm_results).spa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
 	}
-	if mmListNamespaceSecrets.funcListNamespaceSecrets != nil {
-		return mmListNamespaceSecrets.funcListNamespaceSecrets(ctx, ownerPermalink, pageSize, pageToken, filter)
+	if mmListNamespacePipelineReleases.funcListNamespacePipelineReleases != nil {
+		return mmListNamespacePipelineReleases.funcListNamespacePipelineReleases(ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
 	}
-	mmListNamespaceSecrets.t.Fatalf("Unexpected call to RepositoryMock.ListNamespaceSecrets. %v %v %v %v %v", ctx, ownerPermalink, pageSize, pageToken, filter)
+	mmListNamespacePipelineReleases.t.Fatalf("Unexpected call to RepositoryMock.ListNamespacePipelineReleases. %v %v %v %v %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, pageSize, pageToken, isBasicView, filter, showDeleted, returnCount)
 	return
 }
 
-// ListNamespaceSecretsAfterCounter returns a count of finished RepositoryMock.ListNamespaceSecrets invocations
-func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecretsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespaceSecrets.afterListNamespaceSecretsCounter)
+// ListNamespacePipelineReleasesAfterCounter returns a count of finished RepositoryMock.ListNamespacePipelineReleases invocations
+func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleasesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.afterListNamespacePipelineReleasesCounter)
 }
 
-// ListNamespaceSecretsBeforeCounter returns a count of RepositoryMock.ListNamespaceSecrets invocations
-func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecretsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListNamespaceSecrets.beforeListNamespaceSecretsCounter)
+// ListNamespacePipelineReleasesBeforeCounter returns a count of RepositoryMock.ListNamespacePipelineReleases invocations
+func (mmListNamespacePipelineReleases *RepositoryMock) ListNamespacePipelineReleasesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelineReleases.beforeListNamespacePipelineReleasesCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespaceSecrets.
+// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespacePipelineReleases.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Calls() []*RepositoryMockListNamespaceSecretsParams {
-	mmListNamespaceSecrets.mutex.RLock()
+func (mmListNamespacePipelineReleases *mRepositoryMockListNamespacePipelineReleases) Calls() []*RepositoryMockListNamespacePipelineReleasesParams {
+	mmListNamespacePipelineReleases.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListNamespaceSecretsParams, len(mmListNamespaceSecrets.callArgs))
-	copy(argCopy, mmListNamespaceSecrets.callArgs)
+	argCopy := make([]*RepositoryMockListNamespacePipelineReleasesParams, len(mmListNamespacePipelineReleases.callArgs))
+	copy(argCopy, mmListNamespacePipelineReleases.callArgs)
 
-	mmListNamespaceSecrets.mutex.RUnlock()
+	mmListNamespacePipelineReleases.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListNamespaceSecretsDone returns true if the count of the ListNamespaceSecrets invocations corresponds
+// MinimockListNamespacePipelineReleasesDone returns true if the count of the ListNamespacePipelineReleases invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListNamespaceSecretsDone() bool {
-	if m.ListNamespaceSecretsMock.optional {
+func (m *RepositoryMock) MinimockListNamespacePipelineReleasesDone() bool {
+	if m.ListNamespacePipelineReleasesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListNamespaceSecretsMock.expectations {
+	for _, e := range m.ListNamespacePipelineReleasesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListNamespaceSecretsMock.invocationsDone()
+	return m.ListNamespacePipelineReleasesMock.invocationsDone()
 }
 
-// MinimockListNamespaceSecretsInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListNamespaceSecretsInspect() {
-	for _, e := range m.ListNamespaceSecretsMock.expectations {
+// MinimockListNamespacePipelineReleasesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListNamespacePipelineReleasesInspect() {
+	for _, e := range m.ListNamespacePipelineReleasesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespaceSecrets with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineReleases with params: %#v", *e.params)
 		}
 	}
 
-	afterListNamespaceSecretsCounter := mm_atomic.LoadUint64(&m.afterListNamespaceSecretsCounter)
+	afterListNamespacePipelineReleasesCounter := mm_atomic.LoadUint64(&m.afterListNamespacePipelineReleasesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListNamespaceSecretsMock.defaultExpectation != nil && afterListNamespaceSecretsCounter < 1 {
-		if m.ListNamespaceSecretsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListNamespaceSecrets")
+	if m.ListNamespacePipelineReleasesMock.defaultExpectation != nil && afterListNamespacePipelineReleasesCounter < 1 {
+		if m.ListNamespacePipelineReleasesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineReleases")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListNamespaceSecrets with params: %#v", *m.ListNamespaceSecretsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineReleases with params: %#v", *m.ListNamespacePipelineReleasesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListNamespaceSecrets != nil && afterListNamespaceSecretsCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListNamespaceSecrets")
+	if m.funcListNamespacePipelineReleases != nil && afterListNamespacePipelineReleasesCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineReleases")
 	}
 
-	if !m.ListNamespaceSecretsMock.invocationsDone() && afterListNamespaceSecretsCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespaceSecrets but found %d calls",
-			mm_atomic.LoadUint64(&m.ListNamespaceSecretsMock.expectedInvocations), afterListNamespaceSecretsCounter)
+	if !m.ListNamespacePipelineReleasesMock.invocationsDone() && afterListNamespacePipelineReleasesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespacePipelineReleases but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespacePipelineReleasesMock.expectedInvocations), afterListNamespacePipelineReleasesCounter)
 	}
 }
 
-type mRepositoryMockListPipelineTags struct {
+type mRepositoryMockListNamespacePipelineRuns struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListPipelineTagsExpectation
-	expectations       []*RepositoryMockListPipelineTagsExpectation
+	defaultExpectation *RepositoryMockListNamespacePipelineRunsExpectation
+	expectations       []*RepositoryMockListNamespacePipelineRunsExpectation
 
-	callArgs []*RepositoryMockListPipelineTagsParams
+	callArgs []*RepositoryMockListNamespacePipelineRunsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListPipelineTagsExpectation specifies expectation struct of the Repository.ListPipelineTags
-type RepositoryMockListPipelineTagsExpectation struct {
+// RepositoryMockListNamespacePipelineRunsExpectation specifies expectation struct of the Repository.ListNamespacePipelineRuns
+type RepositoryMockListNamespacePipelineRunsExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListPipelineTagsParams
-	paramPtrs *RepositoryMockListPipelineTagsParamPtrs
-	results   *RepositoryMockListPipelineTagsResults
+	params    *RepositoryMockListNamespacePipelineRunsParams
+	paramPtrs *RepositoryMockListNamespacePipelineRunsParamPtrs
+	results   *RepositoryMockListNamespacePipelineRunsResults
 	Counter   uint64
 }
 
-// RepositoryMockListPipelineTagsParams contains parameters of the Repository.ListPipelineTags
-type RepositoryMockListPipelineTagsParams struct {
-	ctx         context.Context
-	pipelineUID uuid.UUID
+// RepositoryMockListNamespacePipelineRunsParams contains parameters of the Repository.ListNamespacePipelineRuns
+type RepositoryMockListNamespacePipelineRunsParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	pageSize       int64
+	pageToken      string
+	filter         filtering.Filter
 }
 
-// RepositoryMockListPipelineTagsParamPtrs contains pointers to parameters of the Repository.ListPipelineTags
-type RepositoryMockListPipelineTagsParamPtrs struct {
-	ctx         *context.Context
-	pipelineUID *uuid.UUID
+// RepositoryMockListNamespacePipelineRunsParamPtrs contains pointers to parameters of the Repository.ListNamespacePipelineRuns
+type RepositoryMockListNamespacePipelineRunsParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	pageSize       *int64
+	pageToken      *string
+	filter         *filtering.Filter
 }
 
-// RepositoryMockListPipelineTagsResults contains results of the Repository.ListPipelineTags
-type RepositoryMockListPipelineTagsResults struct {
-	ta1 []datamodel.Tag
-	err error
+// RepositoryMockListNamespacePipelineRunsResults contains results of the Repository.ListNamespacePipelineRuns
+type RepositoryMockListNamespacePipelineRunsResults struct {
+	ppa1 []*datamodel.PipelineRun
+	i1   int64
+	s1   string
+	err  error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -9273,332 +9701,412 @@ type RepositoryMockListPipelineTagsResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Optional() *mRepositoryMockListPipelineTags {
-	mmListPipelineTags.optional = true
-	return mmListPipelineTags
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Optional() *mRepositoryMockListNamespacePipelineRuns {
+	mmListNamespacePipelineRuns.optional = true
+	return mmListNamespacePipelineRuns
 }
 
-// Expect sets up expected params for Repository.ListPipelineTags
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Expect(ctx context.Context, pipelineUID uuid.UUID) *mRepositoryMockListPipelineTags {
-	if mmListPipelineTags.mock.funcListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+// Expect sets up expected params for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Expect(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	if mmListPipelineTags.defaultExpectation == nil {
-		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
 	}
 
-	if mmListPipelineTags.defaultExpectation.paramPtrs != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by ExpectParams functions")
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by ExpectParams functions")
 	}
 
-	mmListPipelineTags.defaultExpectation.params = &RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
-	for _, e := range mmListPipelineTags.expectations {
-		if minimock.Equal(e.params, mmListPipelineTags.defaultExpectation.params) {
-			mmListPipelineTags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelineTags.defaultExpectation.params)
+	mmListNamespacePipelineRuns.defaultExpectation.params = &RepositoryMockListNamespacePipelineRunsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+	for _, e := range mmListNamespacePipelineRuns.expectations {
+		if minimock.Equal(e.params, mmListNamespacePipelineRuns.defaultExpectation.params) {
+			mmListNamespacePipelineRuns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespacePipelineRuns.defaultExpectation.params)
 		}
 	}
 
-	return mmListPipelineTags
+	return mmListNamespacePipelineRuns
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelineTags
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelineTags {
-	if mmListPipelineTags.mock.funcListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	if mmListPipelineTags.defaultExpectation == nil {
-		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
 	}
 
-	if mmListPipelineTags.defaultExpectation.params != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Expect")
+	if mmListNamespacePipelineRuns.defaultExpectation.params != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Expect")
 	}
 
-	if mmListPipelineTags.defaultExpectation.paramPtrs == nil {
-		mmListPipelineTags.defaultExpectation.paramPtrs = &RepositoryMockListPipelineTagsParamPtrs{}
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineRunsParamPtrs{}
 	}
-	mmListPipelineTags.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNamespacePipelineRuns.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListPipelineTags
+	return mmListNamespacePipelineRuns
 }
 
-// ExpectPipelineUIDParam2 sets up expected param pipelineUID for Repository.ListPipelineTags
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) ExpectPipelineUIDParam2(pipelineUID uuid.UUID) *mRepositoryMockListPipelineTags {
-	if mmListPipelineTags.mock.funcListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	if mmListPipelineTags.defaultExpectation == nil {
-		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
 	}
 
-	if mmListPipelineTags.defaultExpectation.params != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Expect")
+	if mmListNamespacePipelineRuns.defaultExpectation.params != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Expect")
 	}
 
-	if mmListPipelineTags.defaultExpectation.paramPtrs == nil {
-		mmListPipelineTags.defaultExpectation.paramPtrs = &RepositoryMockListPipelineTagsParamPtrs{}
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineRunsParamPtrs{}
 	}
-	mmListPipelineTags.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+	mmListNamespacePipelineRuns.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmListPipelineTags
+	return mmListNamespacePipelineRuns
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelineTags
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Inspect(f func(ctx context.Context, pipelineUID uuid.UUID)) *mRepositoryMockListPipelineTags {
-	if mmListPipelineTags.mock.inspectFuncListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelineTags")
+// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	mmListPipelineTags.mock.inspectFuncListPipelineTags = f
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
+	}
 
-	return mmListPipelineTags
+	if mmListNamespacePipelineRuns.defaultExpectation.params != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineRunsParamPtrs{}
+	}
+	mmListNamespacePipelineRuns.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListNamespacePipelineRuns
 }
 
-// Return sets up results that will be returned by Repository.ListPipelineTags
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Return(ta1 []datamodel.Tag, err error) *RepositoryMock {
-	if mmListPipelineTags.mock.funcListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	if mmListPipelineTags.defaultExpectation == nil {
-		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{mock: mmListPipelineTags.mock}
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
 	}
-	mmListPipelineTags.defaultExpectation.results = &RepositoryMockListPipelineTagsResults{ta1, err}
-	return mmListPipelineTags.mock
+
+	if mmListNamespacePipelineRuns.defaultExpectation.params != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineRunsParamPtrs{}
+	}
+	mmListNamespacePipelineRuns.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListNamespacePipelineRuns
 }
 
-// Set uses given function f to mock the Repository.ListPipelineTags method
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Set(f func(ctx context.Context, pipelineUID uuid.UUID) (ta1 []datamodel.Tag, err error)) *RepositoryMock {
-	if mmListPipelineTags.defaultExpectation != nil {
-		mmListPipelineTags.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelineTags method")
+// ExpectFilterParam5 sets up expected param filter for Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	if len(mmListPipelineTags.expectations) > 0 {
-		mmListPipelineTags.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelineTags method")
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{}
 	}
 
-	mmListPipelineTags.mock.funcListPipelineTags = f
-	return mmListPipelineTags.mock
+	if mmListNamespacePipelineRuns.defaultExpectation.params != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelineRunsParamPtrs{}
+	}
+	mmListNamespacePipelineRuns.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListNamespacePipelineRuns
 }
 
-// When sets expectation for the Repository.ListPipelineTags which will trigger the result defined by the following
+// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Inspect(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter)) *mRepositoryMockListNamespacePipelineRuns {
+	if mmListNamespacePipelineRuns.mock.inspectFuncListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespacePipelineRuns")
+	}
+
+	mmListNamespacePipelineRuns.mock.inspectFuncListNamespacePipelineRuns = f
+
+	return mmListNamespacePipelineRuns
+}
+
+// Return sets up results that will be returned by Repository.ListNamespacePipelineRuns
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Return(ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
+	}
+
+	if mmListNamespacePipelineRuns.defaultExpectation == nil {
+		mmListNamespacePipelineRuns.defaultExpectation = &RepositoryMockListNamespacePipelineRunsExpectation{mock: mmListNamespacePipelineRuns.mock}
+	}
+	mmListNamespacePipelineRuns.defaultExpectation.results = &RepositoryMockListNamespacePipelineRunsResults{ppa1, i1, s1, err}
+	return mmListNamespacePipelineRuns.mock
+}
+
+// Set uses given function f to mock the Repository.ListNamespacePipelineRuns method
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Set(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListNamespacePipelineRuns.defaultExpectation != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespacePipelineRuns method")
+	}
+
+	if len(mmListNamespacePipelineRuns.expectations) > 0 {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespacePipelineRuns method")
+	}
+
+	mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns = f
+	return mmListNamespacePipelineRuns.mock
+}
+
+// When sets expectation for the Repository.ListNamespacePipelineRuns which will trigger the result defined by the following
 // Then helper
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) When(ctx context.Context, pipelineUID uuid.UUID) *RepositoryMockListPipelineTagsExpectation {
-	if mmListPipelineTags.mock.funcListPipelineTags != nil {
-		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) When(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *RepositoryMockListNamespacePipelineRunsExpectation {
+	if mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.mock.t.Fatalf("RepositoryMock.ListNamespacePipelineRuns mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListPipelineTagsExpectation{
-		mock:   mmListPipelineTags.mock,
-		params: &RepositoryMockListPipelineTagsParams{ctx, pipelineUID},
+	expectation := &RepositoryMockListNamespacePipelineRunsExpectation{
+		mock:   mmListNamespacePipelineRuns.mock,
+		params: &RepositoryMockListNamespacePipelineRunsParams{ctx, ownerPermalink, pageSize, pageToken, filter},
 	}
-	mmListPipelineTags.expectations = append(mmListPipelineTags.expectations, expectation)
+	mmListNamespacePipelineRuns.expectations = append(mmListNamespacePipelineRuns.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListPipelineTags return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListPipelineTagsExpectation) Then(ta1 []datamodel.Tag, err error) *RepositoryMock {
-	e.results = &RepositoryMockListPipelineTagsResults{ta1, err}
+// Then sets up Repository.ListNamespacePipelineRuns return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListNamespacePipelineRunsExpectation) Then(ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListNamespacePipelineRunsResults{ppa1, i1, s1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListPipelineTags should be invoked
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Times(n uint64) *mRepositoryMockListPipelineTags {
+// Times sets number of times Repository.ListNamespacePipelineRuns should be invoked
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Times(n uint64) *mRepositoryMockListNamespacePipelineRuns {
 	if n == 0 {
-		mmListPipelineTags.mock.t.Fatalf("Times of RepositoryMock.ListPipelineTags mock can not be zero")
+		mmListNamespacePipelineRuns.mock.t.Fatalf("Times of RepositoryMock.ListNamespacePipelineRuns mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListPipelineTags.expectedInvocations, n)
-	return mmListPipelineTags
+	mm_atomic.StoreUint64(&mmListNamespacePipelineRuns.expectedInvocations, n)
+	return mmListNamespacePipelineRuns
 }
 
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) invocationsDone() bool {
-	if len(mmListPipelineTags.expectations) == 0 && mmListPipelineTags.defaultExpectation == nil && mmListPipelineTags.mock.funcListPipelineTags == nil {
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) invocationsDone() bool {
+	if len(mmListNamespacePipelineRuns.expectations) == 0 && mmListNamespacePipelineRuns.defaultExpectation == nil && mmListNamespacePipelineRuns.mock.funcListNamespacePipelineRuns == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListPipelineTags.mock.afterListPipelineTagsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelineTags.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineRuns.mock.afterListNamespacePipelineRunsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelineRuns.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListPipelineTags implements repository.Repository
-func (mmListPipelineTags *RepositoryMock) ListPipelineTags(ctx context.Context, pipelineUID uuid.UUID) (ta1 []datamodel.Tag, err error) {
-	mm_atomic.AddUint64(&mmListPipelineTags.beforeListPipelineTagsCounter, 1)
-	defer mm_atomic.AddUint64(&mmListPipelineTags.afterListPipelineTagsCounter, 1)
+// ListNamespacePipelineRuns implements repository.Repository
+func (mmListNamespacePipelineRuns *RepositoryMock) ListNamespacePipelineRuns(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListNamespacePipelineRuns.beforeListNamespacePipelineRunsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespacePipelineRuns.afterListNamespacePipelineRunsCounter, 1)
 
-	if mmListPipelineTags.inspectFuncListPipelineTags != nil {
-		mmListPipelineTags.inspectFuncListPipelineTags(ctx, pipelineUID)
+	if mmListNamespacePipelineRuns.inspectFuncListNamespacePipelineRuns != nil {
+		mmListNamespacePipelineRuns.inspectFuncListNamespacePipelineRuns(ctx, ownerPermalink, pageSize, pageToken, filter)
 	}
 
-	mm_params := RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
+	mm_params := RepositoryMockListNamespacePipelineRunsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
 
 	// Record call args
-	mmListPipelineTags.ListPipelineTagsMock.mutex.Lock()
-	mmListPipelineTags.ListPipelineTagsMock.callArgs = append(mmListPipelineTags.ListPipelineTagsMock.callArgs, &mm_params)
-	mmListPipelineTags.ListPipelineTagsMock.mutex.Unlock()
+	mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.mutex.Lock()
+	mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.callArgs = append(mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.callArgs, &mm_params)
+	mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.mutex.Unlock()
 
-	for _, e := range mmListPipelineTags.ListPipelineTagsMock.expectations {
+	for _, e := range mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ta1, e.results.err
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
 		}
 	}
 
-	if mmListPipelineTags.ListPipelineTagsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.Counter, 1)
-		mm_want := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.params
-		mm_want_ptrs := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.paramPtrs
+	if mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
+		mm_got := RepositoryMockListNamespacePipelineRunsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
-				mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListNamespacePipelineRuns.t.Errorf("RepositoryMock.ListNamespacePipelineRuns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.results
+		mm_results := mmListNamespacePipelineRuns.ListNamespacePipelineRunsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListPipelineTags.t.Fatal("No results are set for the RepositoryMock.ListPipelineTags")
+			mmListNamespacePipelineRuns.t.Fatal("No results are set for the RepositoryMock.ListNamespacePipelineRuns")
 		}
-		return (*mm_results).ta1, (*mm_results).err
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
 	}
-	if mmListPipelineTags.funcListPipelineTags != nil {
-		return mmListPipelineTags.funcListPipelineTags(ctx, pipelineUID)
+	if mmListNamespacePipelineRuns.funcListNamespacePipelineRuns != nil {
+		return mmListNamespacePipelineRuns.funcListNamespacePipelineRuns(ctx, ownerPermalink, pageSize, pageToken, filter)
 	}
-	mmListPipelineTags.t.Fatalf("Unexpected call to RepositoryMock.ListPipelineTags. %v %v", ctx, pipelineUID)
+	mmListNamespacePipelineRuns.t.Fatalf("Unexpected call to RepositoryMock.ListNamespacePipelineRuns. %v %v %v %v %v", ctx, ownerPermalink, pageSize, pageToken, filter)
 	return
 }
 
-// ListPipelineTagsAfterCounter returns a count of finished RepositoryMock.ListPipelineTags invocations
-func (mmListPipelineTags *RepositoryMock) ListPipelineTagsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelineTags.afterListPipelineTagsCounter)
+// ListNamespacePipelineRunsAfterCounter returns a count of finished RepositoryMock.ListNamespacePipelineRuns invocations
+func (mmListNamespacePipelineRuns *RepositoryMock) ListNamespacePipelineRunsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelineRuns.afterListNamespacePipelineRunsCounter)
 }
 
-// ListPipelineTagsBeforeCounter returns a count of RepositoryMock.ListPipelineTags invocations
-func (mmListPipelineTags *RepositoryMock) ListPipelineTagsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelineTags.beforeListPipelineTagsCounter)
+// ListNamespacePipelineRunsBeforeCounter returns a count of RepositoryMock.ListNamespacePipelineRuns invocations
+func (mmListNamespacePipelineRuns *RepositoryMock) ListNamespacePipelineRunsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelineRuns.beforeListNamespacePipelineRunsCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelineTags.
+// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespacePipelineRuns.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListPipelineTags *mRepositoryMockListPipelineTags) Calls() []*RepositoryMockListPipelineTagsParams {
-	mmListPipelineTags.mutex.RLock()
+func (mmListNamespacePipelineRuns *mRepositoryMockListNamespacePipelineRuns) Calls() []*RepositoryMockListNamespacePipelineRunsParams {
+	mmListNamespacePipelineRuns.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListPipelineTagsParams, len(mmListPipelineTags.callArgs))
-	copy(argCopy, mmListPipelineTags.callArgs)
+	argCopy := make([]*RepositoryMockListNamespacePipelineRunsParams, len(mmListNamespacePipelineRuns.callArgs))
+	copy(argCopy, mmListNamespacePipelineRuns.callArgs)
 
-	mmListPipelineTags.mutex.RUnlock()
+	mmListNamespacePipelineRuns.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListPipelineTagsDone returns true if the count of the ListPipelineTags invocations corresponds
+// MinimockListNamespacePipelineRunsDone returns true if the count of the ListNamespacePipelineRuns invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListPipelineTagsDone() bool {
-	if m.ListPipelineTagsMock.optional {
+func (m *RepositoryMock) MinimockListNamespacePipelineRunsDone() bool {
+	if m.ListNamespacePipelineRunsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListPipelineTagsMock.expectations {
+	for _, e := range m.ListNamespacePipelineRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListPipelineTagsMock.invocationsDone()
+	return m.ListNamespacePipelineRunsMock.invocationsDone()
 }
 
-// MinimockListPipelineTagsInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListPipelineTagsInspect() {
-	for _, e := range m.ListPipelineTagsMock.expectations {
+// MinimockListNamespacePipelineRunsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListNamespacePipelineRunsInspect() {
+	for _, e := range m.ListNamespacePipelineRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelineTags with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineRuns with params: %#v", *e.params)
 		}
 	}
 
-	afterListPipelineTagsCounter := mm_atomic.LoadUint64(&m.afterListPipelineTagsCounter)
+	afterListNamespacePipelineRunsCounter := mm_atomic.LoadUint64(&m.afterListNamespacePipelineRunsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListPipelineTagsMock.defaultExpectation != nil && afterListPipelineTagsCounter < 1 {
-		if m.ListPipelineTagsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListPipelineTags")
+	if m.ListNamespacePipelineRunsMock.defaultExpectation != nil && afterListNamespacePipelineRunsCounter < 1 {
+		if m.ListNamespacePipelineRunsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineRuns")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelineTags with params: %#v", *m.ListPipelineTagsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelineRuns with params: %#v", *m.ListNamespacePipelineRunsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListPipelineTags != nil && afterListPipelineTagsCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListPipelineTags")
+	if m.funcListNamespacePipelineRuns != nil && afterListNamespacePipelineRunsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListNamespacePipelineRuns")
 	}
 
-	if !m.ListPipelineTagsMock.invocationsDone() && afterListPipelineTagsCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelineTags but found %d calls",
-			mm_atomic.LoadUint64(&m.ListPipelineTagsMock.expectedInvocations), afterListPipelineTagsCounter)
+	if !m.ListNamespacePipelineRunsMock.invocationsDone() && afterListNamespacePipelineRunsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespacePipelineRuns but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespacePipelineRunsMock.expectedInvocations), afterListNamespacePipelineRunsCounter)
 	}
 }
 
-type mRepositoryMockListPipelines struct {
+type mRepositoryMockListNamespacePipelines struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListPipelinesExpectation
-	expectations       []*RepositoryMockListPipelinesExpectation
+	defaultExpectation *RepositoryMockListNamespacePipelinesExpectation
+	expectations       []*RepositoryMockListNamespacePipelinesExpectation
 
-	callArgs []*RepositoryMockListPipelinesParams
+	callArgs []*RepositoryMockListNamespacePipelinesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListPipelinesExpectation specifies expectation struct of the Repository.ListPipelines
-type RepositoryMockListPipelinesExpectation struct {
+// RepositoryMockListNamespacePipelinesExpectation specifies expectation struct of the Repository.ListNamespacePipelines
+type RepositoryMockListNamespacePipelinesExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListPipelinesParams
-	paramPtrs *RepositoryMockListPipelinesParamPtrs
-	results   *RepositoryMockListPipelinesResults
+	params    *RepositoryMockListNamespacePipelinesParams
+	paramPtrs *RepositoryMockListNamespacePipelinesParamPtrs
+	results   *RepositoryMockListNamespacePipelinesResults
 	Counter   uint64
 }
 
-// RepositoryMockListPipelinesParams contains parameters of the Repository.ListPipelines
-type RepositoryMockListPipelinesParams struct {
-	ctx           context.Context
-	pageSize      int64
-	pageToken     string
-	isBasicView   bool
-	filter        filtering.Filter
-	uidAllowList  []uuid.UUID
-	showDeleted   bool
-	embedReleases bool
-	order         ordering.OrderBy
+// RepositoryMockListNamespacePipelinesParams contains parameters of the Repository.ListNamespacePipelines
+type RepositoryMockListNamespacePipelinesParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	pageSize       int64
+	pageToken      string
+	isBasicView    bool
+	filter         filtering.Filter
+	uidAllowList   []uuid.UUID
+	showDeleted    bool
+	embedReleases  bool
+	order          ordering.OrderBy
 }
 
-// RepositoryMockListPipelinesParamPtrs contains pointers to parameters of the Repository.ListPipelines
-type RepositoryMockListPipelinesParamPtrs struct {
-	ctx           *context.Context
-	pageSize      *int64
-	pageToken     *string
-	isBasicView   *bool
-	filter        *filtering.Filter
-	uidAllowList  *[]uuid.UUID
-	showDeleted   *bool
-	embedReleases *bool
-	order         *ordering.OrderBy
+// RepositoryMockListNamespacePipelinesParamPtrs contains pointers to parameters of the Repository.ListNamespacePipelines
+type RepositoryMockListNamespacePipelinesParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	pageSize       *int64
+	pageToken      *string
+	isBasicView    *bool
+	filter         *filtering.Filter
+	uidAllowList   *[]uuid.UUID
+	showDeleted    *bool
+	embedReleases  *bool
+	order          *ordering.OrderBy
 }
 
-// RepositoryMockListPipelinesResults contains results of the Repository.ListPipelines
-type RepositoryMockListPipelinesResults struct {
+// RepositoryMockListNamespacePipelinesResults contains results of the Repository.ListNamespacePipelines
+type RepositoryMockListNamespacePipelinesResults struct {
 	ppa1 []*datamodel.Pipeline
 	i1   int64
 	s1   string
@@ -9610,514 +10118,3581 @@ type RepositoryMockListPipelinesResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListPipelines *mRepositoryMockListPipelines) Optional() *mRepositoryMockListPipelines {
-	mmListPipelines.optional = true
-	return mmListPipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Optional() *mRepositoryMockListNamespacePipelines {
+	mmListNamespacePipelines.optional = true
+	return mmListNamespacePipelines
 }
 
-// Expect sets up expected params for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) Expect(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// Expect sets up expected params for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Expect(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by ExpectParams functions")
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by ExpectParams functions")
 	}
 
-	mmListPipelines.defaultExpectation.params = &RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
-	for _, e := range mmListPipelines.expectations {
-		if minimock.Equal(e.params, mmListPipelines.defaultExpectation.params) {
-			mmListPipelines.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelines.defaultExpectation.params)
+	mmListNamespacePipelines.defaultExpectation.params = &RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+	for _, e := range mmListNamespacePipelines.expectations {
+		if minimock.Equal(e.params, mmListNamespacePipelines.defaultExpectation.params) {
+			mmListNamespacePipelines.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespacePipelines.defaultExpectation.params)
 		}
 	}
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectPageSizeParam2 sets up expected param pageSize for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectPageSizeParam2(pageSize int64) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.pageSize = &pageSize
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectPageTokenParam3 sets up expected param pageToken for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectPageTokenParam3(pageToken string) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.pageToken = &pageToken
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.pageSize = &pageSize
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectIsBasicViewParam4 sets up expected param isBasicView for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectIsBasicViewParam4(isBasicView bool) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.isBasicView = &isBasicView
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.pageToken = &pageToken
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectFilterParam5 sets up expected param filter for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectIsBasicViewParam5 sets up expected param isBasicView for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectIsBasicViewParam5(isBasicView bool) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.filter = &filter
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.isBasicView = &isBasicView
 
-	return mmListPipelines
+	return mmListNamespacePipelines
 }
 
-// ExpectUidAllowListParam6 sets up expected param uidAllowList for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectUidAllowListParam6(uidAllowList []uuid.UUID) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectFilterParam6 sets up expected param filter for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectFilterParam6(filter filtering.Filter) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	}
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListNamespacePipelines
+}
+
+// ExpectUidAllowListParam7 sets up expected param uidAllowList for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectUidAllowListParam7(uidAllowList []uuid.UUID) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	}
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.uidAllowList = &uidAllowList
+
+	return mmListNamespacePipelines
+}
+
+// ExpectShowDeletedParam8 sets up expected param showDeleted for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectShowDeletedParam8(showDeleted bool) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	}
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+
+	return mmListNamespacePipelines
+}
+
+// ExpectEmbedReleasesParam9 sets up expected param embedReleases for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectEmbedReleasesParam9(embedReleases bool) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	}
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.embedReleases = &embedReleases
+
+	return mmListNamespacePipelines
+}
+
+// ExpectOrderParam10 sets up expected param order for Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) ExpectOrderParam10(order ordering.OrderBy) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{}
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.params != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Expect")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation.paramPtrs == nil {
+		mmListNamespacePipelines.defaultExpectation.paramPtrs = &RepositoryMockListNamespacePipelinesParamPtrs{}
+	}
+	mmListNamespacePipelines.defaultExpectation.paramPtrs.order = &order
+
+	return mmListNamespacePipelines
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Inspect(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy)) *mRepositoryMockListNamespacePipelines {
+	if mmListNamespacePipelines.mock.inspectFuncListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespacePipelines")
+	}
+
+	mmListNamespacePipelines.mock.inspectFuncListNamespacePipelines = f
+
+	return mmListNamespacePipelines
+}
+
+// Return sets up results that will be returned by Repository.ListNamespacePipelines
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	if mmListNamespacePipelines.defaultExpectation == nil {
+		mmListNamespacePipelines.defaultExpectation = &RepositoryMockListNamespacePipelinesExpectation{mock: mmListNamespacePipelines.mock}
+	}
+	mmListNamespacePipelines.defaultExpectation.results = &RepositoryMockListNamespacePipelinesResults{ppa1, i1, s1, err}
+	return mmListNamespacePipelines.mock
+}
+
+// Set uses given function f to mock the Repository.ListNamespacePipelines method
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Set(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListNamespacePipelines.defaultExpectation != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespacePipelines method")
+	}
+
+	if len(mmListNamespacePipelines.expectations) > 0 {
+		mmListNamespacePipelines.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespacePipelines method")
+	}
+
+	mmListNamespacePipelines.mock.funcListNamespacePipelines = f
+	return mmListNamespacePipelines.mock
+}
+
+// When sets expectation for the Repository.ListNamespacePipelines which will trigger the result defined by the following
+// Then helper
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) When(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *RepositoryMockListNamespacePipelinesExpectation {
+	if mmListNamespacePipelines.mock.funcListNamespacePipelines != nil {
+		mmListNamespacePipelines.mock.t.Fatalf("RepositoryMock.ListNamespacePipelines mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListNamespacePipelinesExpectation{
+		mock:   mmListNamespacePipelines.mock,
+		params: &RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order},
+	}
+	mmListNamespacePipelines.expectations = append(mmListNamespacePipelines.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListNamespacePipelines return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListNamespacePipelinesExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListNamespacePipelinesResults{ppa1, i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListNamespacePipelines should be invoked
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Times(n uint64) *mRepositoryMockListNamespacePipelines {
+	if n == 0 {
+		mmListNamespacePipelines.mock.t.Fatalf("Times of RepositoryMock.ListNamespacePipelines mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNamespacePipelines.expectedInvocations, n)
+	return mmListNamespacePipelines
+}
+
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) invocationsDone() bool {
+	if len(mmListNamespacePipelines.expectations) == 0 && mmListNamespacePipelines.defaultExpectation == nil && mmListNamespacePipelines.mock.funcListNamespacePipelines == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelines.mock.afterListNamespacePipelinesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespacePipelines.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNamespacePipelines implements repository.Repository
+func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelines(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListNamespacePipelines.beforeListNamespacePipelinesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespacePipelines.afterListNamespacePipelinesCounter, 1)
+
+	if mmListNamespacePipelines.inspectFuncListNamespacePipelines != nil {
+		mmListNamespacePipelines.inspectFuncListNamespacePipelines(ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	}
+
+	mm_params := RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+
+	// Record call args
+	mmListNamespacePipelines.ListNamespacePipelinesMock.mutex.Lock()
+	mmListNamespacePipelines.ListNamespacePipelinesMock.callArgs = append(mmListNamespacePipelines.ListNamespacePipelinesMock.callArgs, &mm_params)
+	mmListNamespacePipelines.ListNamespacePipelinesMock.mutex.Unlock()
+
+	for _, e := range mmListNamespacePipelines.ListNamespacePipelinesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListNamespacePipelinesParams{ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.uidAllowList != nil && !minimock.Equal(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter uidAllowList, want: %#v, got: %#v%s\n", *mm_want_ptrs.uidAllowList, mm_got.uidAllowList, minimock.Diff(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList))
+			}
+
+			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+			}
+
+			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
+			}
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameter order, want: %#v, got: %#v%s\n", *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNamespacePipelines.t.Errorf("RepositoryMock.ListNamespacePipelines got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNamespacePipelines.ListNamespacePipelinesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNamespacePipelines.t.Fatal("No results are set for the RepositoryMock.ListNamespacePipelines")
+		}
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmListNamespacePipelines.funcListNamespacePipelines != nil {
+		return mmListNamespacePipelines.funcListNamespacePipelines(ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	}
+	mmListNamespacePipelines.t.Fatalf("Unexpected call to RepositoryMock.ListNamespacePipelines. %v %v %v %v %v %v %v %v %v %v", ctx, ownerPermalink, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	return
+}
+
+// ListNamespacePipelinesAfterCounter returns a count of finished RepositoryMock.ListNamespacePipelines invocations
+func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelinesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelines.afterListNamespacePipelinesCounter)
+}
+
+// ListNamespacePipelinesBeforeCounter returns a count of RepositoryMock.ListNamespacePipelines invocations
+func (mmListNamespacePipelines *RepositoryMock) ListNamespacePipelinesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespacePipelines.beforeListNamespacePipelinesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespacePipelines.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNamespacePipelines *mRepositoryMockListNamespacePipelines) Calls() []*RepositoryMockListNamespacePipelinesParams {
+	mmListNamespacePipelines.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListNamespacePipelinesParams, len(mmListNamespacePipelines.callArgs))
+	copy(argCopy, mmListNamespacePipelines.callArgs)
+
+	mmListNamespacePipelines.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNamespacePipelinesDone returns true if the count of the ListNamespacePipelines invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListNamespacePipelinesDone() bool {
+	if m.ListNamespacePipelinesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNamespacePipelinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNamespacePipelinesMock.invocationsDone()
+}
+
+// MinimockListNamespacePipelinesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListNamespacePipelinesInspect() {
+	for _, e := range m.ListNamespacePipelinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelines with params: %#v", *e.params)
+		}
+	}
+
+	afterListNamespacePipelinesCounter := mm_atomic.LoadUint64(&m.afterListNamespacePipelinesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNamespacePipelinesMock.defaultExpectation != nil && afterListNamespacePipelinesCounter < 1 {
+		if m.ListNamespacePipelinesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListNamespacePipelines")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespacePipelines with params: %#v", *m.ListNamespacePipelinesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNamespacePipelines != nil && afterListNamespacePipelinesCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListNamespacePipelines")
+	}
+
+	if !m.ListNamespacePipelinesMock.invocationsDone() && afterListNamespacePipelinesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespacePipelines but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespacePipelinesMock.expectedInvocations), afterListNamespacePipelinesCounter)
+	}
+}
+
+type mRepositoryMockListNamespaceSecrets struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListNamespaceSecretsExpectation
+	expectations       []*RepositoryMockListNamespaceSecretsExpectation
+
+	callArgs []*RepositoryMockListNamespaceSecretsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListNamespaceSecretsExpectation specifies expectation struct of the Repository.ListNamespaceSecrets
+type RepositoryMockListNamespaceSecretsExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListNamespaceSecretsParams
+	paramPtrs *RepositoryMockListNamespaceSecretsParamPtrs
+	results   *RepositoryMockListNamespaceSecretsResults
+	Counter   uint64
+}
+
+// RepositoryMockListNamespaceSecretsParams contains parameters of the Repository.ListNamespaceSecrets
+type RepositoryMockListNamespaceSecretsParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	pageSize       int64
+	pageToken      string
+	filter         filtering.Filter
+}
+
+// RepositoryMockListNamespaceSecretsParamPtrs contains pointers to parameters of the Repository.ListNamespaceSecrets
+type RepositoryMockListNamespaceSecretsParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	pageSize       *int64
+	pageToken      *string
+	filter         *filtering.Filter
+}
+
+// RepositoryMockListNamespaceSecretsResults contains results of the Repository.ListNamespaceSecrets
+type RepositoryMockListNamespaceSecretsResults struct {
+	spa1 []*datamodel.Secret
+	i1   int64
+	s1   string
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Optional() *mRepositoryMockListNamespaceSecrets {
+	mmListNamespaceSecrets.optional = true
+	return mmListNamespaceSecrets
+}
+
+// Expect sets up expected params for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Expect(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by ExpectParams functions")
+	}
+
+	mmListNamespaceSecrets.defaultExpectation.params = &RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+	for _, e := range mmListNamespaceSecrets.expectations {
+		if minimock.Equal(e.params, mmListNamespaceSecrets.defaultExpectation.params) {
+			mmListNamespaceSecrets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListNamespaceSecrets.defaultExpectation.params)
+		}
+	}
+
+	return mmListNamespaceSecrets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.params != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
+		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	}
+	mmListNamespaceSecrets.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListNamespaceSecrets
+}
+
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.params != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
+		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	}
+	mmListNamespaceSecrets.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+
+	return mmListNamespaceSecrets
+}
+
+// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.params != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
+		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	}
+	mmListNamespaceSecrets.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListNamespaceSecrets
+}
+
+// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.params != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
+		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	}
+	mmListNamespaceSecrets.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListNamespaceSecrets
+}
+
+// ExpectFilterParam5 sets up expected param filter for Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{}
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.params != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Expect")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation.paramPtrs == nil {
+		mmListNamespaceSecrets.defaultExpectation.paramPtrs = &RepositoryMockListNamespaceSecretsParamPtrs{}
+	}
+	mmListNamespaceSecrets.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListNamespaceSecrets
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Inspect(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter)) *mRepositoryMockListNamespaceSecrets {
+	if mmListNamespaceSecrets.mock.inspectFuncListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListNamespaceSecrets")
+	}
+
+	mmListNamespaceSecrets.mock.inspectFuncListNamespaceSecrets = f
+
+	return mmListNamespaceSecrets
+}
+
+// Return sets up results that will be returned by Repository.ListNamespaceSecrets
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Return(spa1 []*datamodel.Secret, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	if mmListNamespaceSecrets.defaultExpectation == nil {
+		mmListNamespaceSecrets.defaultExpectation = &RepositoryMockListNamespaceSecretsExpectation{mock: mmListNamespaceSecrets.mock}
+	}
+	mmListNamespaceSecrets.defaultExpectation.results = &RepositoryMockListNamespaceSecretsResults{spa1, i1, s1, err}
+	return mmListNamespaceSecrets.mock
+}
+
+// Set uses given function f to mock the Repository.ListNamespaceSecrets method
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Set(f func(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (spa1 []*datamodel.Secret, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListNamespaceSecrets.defaultExpectation != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("Default expectation is already set for the Repository.ListNamespaceSecrets method")
+	}
+
+	if len(mmListNamespaceSecrets.expectations) > 0 {
+		mmListNamespaceSecrets.mock.t.Fatalf("Some expectations are already set for the Repository.ListNamespaceSecrets method")
+	}
+
+	mmListNamespaceSecrets.mock.funcListNamespaceSecrets = f
+	return mmListNamespaceSecrets.mock
+}
+
+// When sets expectation for the Repository.ListNamespaceSecrets which will trigger the result defined by the following
+// Then helper
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) When(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) *RepositoryMockListNamespaceSecretsExpectation {
+	if mmListNamespaceSecrets.mock.funcListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.mock.t.Fatalf("RepositoryMock.ListNamespaceSecrets mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListNamespaceSecretsExpectation{
+		mock:   mmListNamespaceSecrets.mock,
+		params: &RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter},
+	}
+	mmListNamespaceSecrets.expectations = append(mmListNamespaceSecrets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListNamespaceSecrets return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListNamespaceSecretsExpectation) Then(spa1 []*datamodel.Secret, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListNamespaceSecretsResults{spa1, i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListNamespaceSecrets should be invoked
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Times(n uint64) *mRepositoryMockListNamespaceSecrets {
+	if n == 0 {
+		mmListNamespaceSecrets.mock.t.Fatalf("Times of RepositoryMock.ListNamespaceSecrets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListNamespaceSecrets.expectedInvocations, n)
+	return mmListNamespaceSecrets
+}
+
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) invocationsDone() bool {
+	if len(mmListNamespaceSecrets.expectations) == 0 && mmListNamespaceSecrets.defaultExpectation == nil && mmListNamespaceSecrets.mock.funcListNamespaceSecrets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListNamespaceSecrets.mock.afterListNamespaceSecretsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListNamespaceSecrets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListNamespaceSecrets implements repository.Repository
+func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecrets(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) (spa1 []*datamodel.Secret, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListNamespaceSecrets.beforeListNamespaceSecretsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListNamespaceSecrets.afterListNamespaceSecretsCounter, 1)
+
+	if mmListNamespaceSecrets.inspectFuncListNamespaceSecrets != nil {
+		mmListNamespaceSecrets.inspectFuncListNamespaceSecrets(ctx, ownerPermalink, pageSize, pageToken, filter)
+	}
+
+	mm_params := RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+
+	// Record call args
+	mmListNamespaceSecrets.ListNamespaceSecretsMock.mutex.Lock()
+	mmListNamespaceSecrets.ListNamespaceSecretsMock.callArgs = append(mmListNamespaceSecrets.ListNamespaceSecretsMock.callArgs, &mm_params)
+	mmListNamespaceSecrets.ListNamespaceSecretsMock.mutex.Unlock()
+
+	for _, e := range mmListNamespaceSecrets.ListNamespaceSecretsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.params
+		mm_want_ptrs := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListNamespaceSecretsParams{ctx, ownerPermalink, pageSize, pageToken, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListNamespaceSecrets.t.Errorf("RepositoryMock.ListNamespaceSecrets got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListNamespaceSecrets.ListNamespaceSecretsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListNamespaceSecrets.t.Fatal("No results are set for the RepositoryMock.ListNamespaceSecrets")
+		}
+		return (*mm_results).spa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmListNamespaceSecrets.funcListNamespaceSecrets != nil {
+		return mmListNamespaceSecrets.funcListNamespaceSecrets(ctx, ownerPermalink, pageSize, pageToken, filter)
+	}
+	mmListNamespaceSecrets.t.Fatalf("Unexpected call to RepositoryMock.ListNamespaceSecrets. %v %v %v %v %v", ctx, ownerPermalink, pageSize, pageToken, filter)
+	return
+}
+
+// ListNamespaceSecretsAfterCounter returns a count of finished RepositoryMock.ListNamespaceSecrets invocations
+func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecretsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaceSecrets.afterListNamespaceSecretsCounter)
+}
+
+// ListNamespaceSecretsBeforeCounter returns a count of RepositoryMock.ListNamespaceSecrets invocations
+func (mmListNamespaceSecrets *RepositoryMock) ListNamespaceSecretsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListNamespaceSecrets.beforeListNamespaceSecretsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListNamespaceSecrets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListNamespaceSecrets *mRepositoryMockListNamespaceSecrets) Calls() []*RepositoryMockListNamespaceSecretsParams {
+	mmListNamespaceSecrets.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListNamespaceSecretsParams, len(mmListNamespaceSecrets.callArgs))
+	copy(argCopy, mmListNamespaceSecrets.callArgs)
+
+	mmListNamespaceSecrets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListNamespaceSecretsDone returns true if the count of the ListNamespaceSecrets invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListNamespaceSecretsDone() bool {
+	if m.ListNamespaceSecretsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListNamespaceSecretsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListNamespaceSecretsMock.invocationsDone()
+}
+
+// MinimockListNamespaceSecretsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListNamespaceSecretsInspect() {
+	for _, e := range m.ListNamespaceSecretsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespaceSecrets with params: %#v", *e.params)
+		}
+	}
+
+	afterListNamespaceSecretsCounter := mm_atomic.LoadUint64(&m.afterListNamespaceSecretsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListNamespaceSecretsMock.defaultExpectation != nil && afterListNamespaceSecretsCounter < 1 {
+		if m.ListNamespaceSecretsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListNamespaceSecrets")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListNamespaceSecrets with params: %#v", *m.ListNamespaceSecretsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListNamespaceSecrets != nil && afterListNamespaceSecretsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListNamespaceSecrets")
+	}
+
+	if !m.ListNamespaceSecretsMock.invocationsDone() && afterListNamespaceSecretsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListNamespaceSecrets but found %d calls",
+			mm_atomic.LoadUint64(&m.ListNamespaceSecretsMock.expectedInvocations), afterListNamespaceSecretsCounter)
+	}
+}
+
+type mRepositoryMockListPipelineRuns struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPipelineRunsExpectation
+	expectations       []*RepositoryMockListPipelineRunsExpectation
+
+	callArgs []*RepositoryMockListPipelineRunsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListPipelineRunsExpectation specifies expectation struct of the Repository.ListPipelineRuns
+type RepositoryMockListPipelineRunsExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListPipelineRunsParams
+	paramPtrs *RepositoryMockListPipelineRunsParamPtrs
+	results   *RepositoryMockListPipelineRunsResults
+	Counter   uint64
+}
+
+// RepositoryMockListPipelineRunsParams contains parameters of the Repository.ListPipelineRuns
+type RepositoryMockListPipelineRunsParams struct {
+	ctx         context.Context
+	pipelineUID uuid.UUID
+	pageSize    int64
+	pageToken   string
+	filter      filtering.Filter
+}
+
+// RepositoryMockListPipelineRunsParamPtrs contains pointers to parameters of the Repository.ListPipelineRuns
+type RepositoryMockListPipelineRunsParamPtrs struct {
+	ctx         *context.Context
+	pipelineUID *uuid.UUID
+	pageSize    *int64
+	pageToken   *string
+	filter      *filtering.Filter
+}
+
+// RepositoryMockListPipelineRunsResults contains results of the Repository.ListPipelineRuns
+type RepositoryMockListPipelineRunsResults struct {
+	ppa1 []*datamodel.PipelineRun
+	i1   int64
+	s1   string
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Optional() *mRepositoryMockListPipelineRuns {
+	mmListPipelineRuns.optional = true
+	return mmListPipelineRuns
+}
+
+// Expect sets up expected params for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Expect(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by ExpectParams functions")
+	}
+
+	mmListPipelineRuns.defaultExpectation.params = &RepositoryMockListPipelineRunsParams{ctx, pipelineUID, pageSize, pageToken, filter}
+	for _, e := range mmListPipelineRuns.expectations {
+		if minimock.Equal(e.params, mmListPipelineRuns.defaultExpectation.params) {
+			mmListPipelineRuns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelineRuns.defaultExpectation.params)
+		}
+	}
+
+	return mmListPipelineRuns
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.params != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Expect")
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListPipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListPipelineRunsParamPtrs{}
+	}
+	mmListPipelineRuns.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPipelineRuns
+}
+
+// ExpectPipelineUIDParam2 sets up expected param pipelineUID for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) ExpectPipelineUIDParam2(pipelineUID uuid.UUID) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.params != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Expect")
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListPipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListPipelineRunsParamPtrs{}
+	}
+	mmListPipelineRuns.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+
+	return mmListPipelineRuns
+}
+
+// ExpectPageSizeParam3 sets up expected param pageSize for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) ExpectPageSizeParam3(pageSize int64) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.params != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Expect")
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListPipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListPipelineRunsParamPtrs{}
+	}
+	mmListPipelineRuns.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListPipelineRuns
+}
+
+// ExpectPageTokenParam4 sets up expected param pageToken for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) ExpectPageTokenParam4(pageToken string) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.params != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Expect")
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListPipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListPipelineRunsParamPtrs{}
+	}
+	mmListPipelineRuns.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListPipelineRuns
+}
+
+// ExpectFilterParam5 sets up expected param filter for Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{}
+	}
+
+	if mmListPipelineRuns.defaultExpectation.params != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Expect")
+	}
+
+	if mmListPipelineRuns.defaultExpectation.paramPtrs == nil {
+		mmListPipelineRuns.defaultExpectation.paramPtrs = &RepositoryMockListPipelineRunsParamPtrs{}
+	}
+	mmListPipelineRuns.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListPipelineRuns
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Inspect(f func(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter)) *mRepositoryMockListPipelineRuns {
+	if mmListPipelineRuns.mock.inspectFuncListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelineRuns")
+	}
+
+	mmListPipelineRuns.mock.inspectFuncListPipelineRuns = f
+
+	return mmListPipelineRuns
+}
+
+// Return sets up results that will be returned by Repository.ListPipelineRuns
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Return(ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	if mmListPipelineRuns.defaultExpectation == nil {
+		mmListPipelineRuns.defaultExpectation = &RepositoryMockListPipelineRunsExpectation{mock: mmListPipelineRuns.mock}
+	}
+	mmListPipelineRuns.defaultExpectation.results = &RepositoryMockListPipelineRunsResults{ppa1, i1, s1, err}
+	return mmListPipelineRuns.mock
+}
+
+// Set uses given function f to mock the Repository.ListPipelineRuns method
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Set(f func(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListPipelineRuns.defaultExpectation != nil {
+		mmListPipelineRuns.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelineRuns method")
+	}
+
+	if len(mmListPipelineRuns.expectations) > 0 {
+		mmListPipelineRuns.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelineRuns method")
+	}
+
+	mmListPipelineRuns.mock.funcListPipelineRuns = f
+	return mmListPipelineRuns.mock
+}
+
+// When sets expectation for the Repository.ListPipelineRuns which will trigger the result defined by the following
+// Then helper
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) When(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) *RepositoryMockListPipelineRunsExpectation {
+	if mmListPipelineRuns.mock.funcListPipelineRuns != nil {
+		mmListPipelineRuns.mock.t.Fatalf("RepositoryMock.ListPipelineRuns mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPipelineRunsExpectation{
+		mock:   mmListPipelineRuns.mock,
+		params: &RepositoryMockListPipelineRunsParams{ctx, pipelineUID, pageSize, pageToken, filter},
+	}
+	mmListPipelineRuns.expectations = append(mmListPipelineRuns.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPipelineRuns return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPipelineRunsExpectation) Then(ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPipelineRunsResults{ppa1, i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPipelineRuns should be invoked
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Times(n uint64) *mRepositoryMockListPipelineRuns {
+	if n == 0 {
+		mmListPipelineRuns.mock.t.Fatalf("Times of RepositoryMock.ListPipelineRuns mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPipelineRuns.expectedInvocations, n)
+	return mmListPipelineRuns
+}
+
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) invocationsDone() bool {
+	if len(mmListPipelineRuns.expectations) == 0 && mmListPipelineRuns.defaultExpectation == nil && mmListPipelineRuns.mock.funcListPipelineRuns == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPipelineRuns.mock.afterListPipelineRunsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelineRuns.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPipelineRuns implements repository.Repository
+func (mmListPipelineRuns *RepositoryMock) ListPipelineRuns(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) (ppa1 []*datamodel.PipelineRun, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListPipelineRuns.beforeListPipelineRunsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPipelineRuns.afterListPipelineRunsCounter, 1)
+
+	if mmListPipelineRuns.inspectFuncListPipelineRuns != nil {
+		mmListPipelineRuns.inspectFuncListPipelineRuns(ctx, pipelineUID, pageSize, pageToken, filter)
+	}
+
+	mm_params := RepositoryMockListPipelineRunsParams{ctx, pipelineUID, pageSize, pageToken, filter}
+
+	// Record call args
+	mmListPipelineRuns.ListPipelineRunsMock.mutex.Lock()
+	mmListPipelineRuns.ListPipelineRunsMock.callArgs = append(mmListPipelineRuns.ListPipelineRunsMock.callArgs, &mm_params)
+	mmListPipelineRuns.ListPipelineRunsMock.mutex.Unlock()
+
+	for _, e := range mmListPipelineRuns.ListPipelineRunsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmListPipelineRuns.ListPipelineRunsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPipelineRuns.ListPipelineRunsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPipelineRuns.ListPipelineRunsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPipelineRuns.ListPipelineRunsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPipelineRunsParams{ctx, pipelineUID, pageSize, pageToken, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
+				mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPipelineRuns.t.Errorf("RepositoryMock.ListPipelineRuns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPipelineRuns.ListPipelineRunsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPipelineRuns.t.Fatal("No results are set for the RepositoryMock.ListPipelineRuns")
+		}
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmListPipelineRuns.funcListPipelineRuns != nil {
+		return mmListPipelineRuns.funcListPipelineRuns(ctx, pipelineUID, pageSize, pageToken, filter)
+	}
+	mmListPipelineRuns.t.Fatalf("Unexpected call to RepositoryMock.ListPipelineRuns. %v %v %v %v %v", ctx, pipelineUID, pageSize, pageToken, filter)
+	return
+}
+
+// ListPipelineRunsAfterCounter returns a count of finished RepositoryMock.ListPipelineRuns invocations
+func (mmListPipelineRuns *RepositoryMock) ListPipelineRunsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelineRuns.afterListPipelineRunsCounter)
+}
+
+// ListPipelineRunsBeforeCounter returns a count of RepositoryMock.ListPipelineRuns invocations
+func (mmListPipelineRuns *RepositoryMock) ListPipelineRunsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelineRuns.beforeListPipelineRunsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelineRuns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPipelineRuns *mRepositoryMockListPipelineRuns) Calls() []*RepositoryMockListPipelineRunsParams {
+	mmListPipelineRuns.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPipelineRunsParams, len(mmListPipelineRuns.callArgs))
+	copy(argCopy, mmListPipelineRuns.callArgs)
+
+	mmListPipelineRuns.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPipelineRunsDone returns true if the count of the ListPipelineRuns invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPipelineRunsDone() bool {
+	if m.ListPipelineRunsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPipelineRunsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPipelineRunsMock.invocationsDone()
+}
+
+// MinimockListPipelineRunsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPipelineRunsInspect() {
+	for _, e := range m.ListPipelineRunsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelineRuns with params: %#v", *e.params)
+		}
+	}
+
+	afterListPipelineRunsCounter := mm_atomic.LoadUint64(&m.afterListPipelineRunsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPipelineRunsMock.defaultExpectation != nil && afterListPipelineRunsCounter < 1 {
+		if m.ListPipelineRunsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListPipelineRuns")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelineRuns with params: %#v", *m.ListPipelineRunsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPipelineRuns != nil && afterListPipelineRunsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListPipelineRuns")
+	}
+
+	if !m.ListPipelineRunsMock.invocationsDone() && afterListPipelineRunsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelineRuns but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPipelineRunsMock.expectedInvocations), afterListPipelineRunsCounter)
+	}
+}
+
+type mRepositoryMockListPipelineTags struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPipelineTagsExpectation
+	expectations       []*RepositoryMockListPipelineTagsExpectation
+
+	callArgs []*RepositoryMockListPipelineTagsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListPipelineTagsExpectation specifies expectation struct of the Repository.ListPipelineTags
+type RepositoryMockListPipelineTagsExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListPipelineTagsParams
+	paramPtrs *RepositoryMockListPipelineTagsParamPtrs
+	results   *RepositoryMockListPipelineTagsResults
+	Counter   uint64
+}
+
+// RepositoryMockListPipelineTagsParams contains parameters of the Repository.ListPipelineTags
+type RepositoryMockListPipelineTagsParams struct {
+	ctx         context.Context
+	pipelineUID uuid.UUID
+}
+
+// RepositoryMockListPipelineTagsParamPtrs contains pointers to parameters of the Repository.ListPipelineTags
+type RepositoryMockListPipelineTagsParamPtrs struct {
+	ctx         *context.Context
+	pipelineUID *uuid.UUID
+}
+
+// RepositoryMockListPipelineTagsResults contains results of the Repository.ListPipelineTags
+type RepositoryMockListPipelineTagsResults struct {
+	ta1 []datamodel.Tag
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Optional() *mRepositoryMockListPipelineTags {
+	mmListPipelineTags.optional = true
+	return mmListPipelineTags
+}
+
+// Expect sets up expected params for Repository.ListPipelineTags
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Expect(ctx context.Context, pipelineUID uuid.UUID) *mRepositoryMockListPipelineTags {
+	if mmListPipelineTags.mock.funcListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+	}
+
+	if mmListPipelineTags.defaultExpectation == nil {
+		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	}
+
+	if mmListPipelineTags.defaultExpectation.paramPtrs != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by ExpectParams functions")
+	}
+
+	mmListPipelineTags.defaultExpectation.params = &RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
+	for _, e := range mmListPipelineTags.expectations {
+		if minimock.Equal(e.params, mmListPipelineTags.defaultExpectation.params) {
+			mmListPipelineTags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelineTags.defaultExpectation.params)
+		}
+	}
+
+	return mmListPipelineTags
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelineTags
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelineTags {
+	if mmListPipelineTags.mock.funcListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+	}
+
+	if mmListPipelineTags.defaultExpectation == nil {
+		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	}
+
+	if mmListPipelineTags.defaultExpectation.params != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Expect")
+	}
+
+	if mmListPipelineTags.defaultExpectation.paramPtrs == nil {
+		mmListPipelineTags.defaultExpectation.paramPtrs = &RepositoryMockListPipelineTagsParamPtrs{}
+	}
+	mmListPipelineTags.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPipelineTags
+}
+
+// ExpectPipelineUIDParam2 sets up expected param pipelineUID for Repository.ListPipelineTags
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) ExpectPipelineUIDParam2(pipelineUID uuid.UUID) *mRepositoryMockListPipelineTags {
+	if mmListPipelineTags.mock.funcListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+	}
+
+	if mmListPipelineTags.defaultExpectation == nil {
+		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{}
+	}
+
+	if mmListPipelineTags.defaultExpectation.params != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Expect")
+	}
+
+	if mmListPipelineTags.defaultExpectation.paramPtrs == nil {
+		mmListPipelineTags.defaultExpectation.paramPtrs = &RepositoryMockListPipelineTagsParamPtrs{}
+	}
+	mmListPipelineTags.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+
+	return mmListPipelineTags
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelineTags
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Inspect(f func(ctx context.Context, pipelineUID uuid.UUID)) *mRepositoryMockListPipelineTags {
+	if mmListPipelineTags.mock.inspectFuncListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelineTags")
+	}
+
+	mmListPipelineTags.mock.inspectFuncListPipelineTags = f
+
+	return mmListPipelineTags
+}
+
+// Return sets up results that will be returned by Repository.ListPipelineTags
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Return(ta1 []datamodel.Tag, err error) *RepositoryMock {
+	if mmListPipelineTags.mock.funcListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+	}
+
+	if mmListPipelineTags.defaultExpectation == nil {
+		mmListPipelineTags.defaultExpectation = &RepositoryMockListPipelineTagsExpectation{mock: mmListPipelineTags.mock}
+	}
+	mmListPipelineTags.defaultExpectation.results = &RepositoryMockListPipelineTagsResults{ta1, err}
+	return mmListPipelineTags.mock
+}
+
+// Set uses given function f to mock the Repository.ListPipelineTags method
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Set(f func(ctx context.Context, pipelineUID uuid.UUID) (ta1 []datamodel.Tag, err error)) *RepositoryMock {
+	if mmListPipelineTags.defaultExpectation != nil {
+		mmListPipelineTags.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelineTags method")
+	}
+
+	if len(mmListPipelineTags.expectations) > 0 {
+		mmListPipelineTags.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelineTags method")
+	}
+
+	mmListPipelineTags.mock.funcListPipelineTags = f
+	return mmListPipelineTags.mock
+}
+
+// When sets expectation for the Repository.ListPipelineTags which will trigger the result defined by the following
+// Then helper
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) When(ctx context.Context, pipelineUID uuid.UUID) *RepositoryMockListPipelineTagsExpectation {
+	if mmListPipelineTags.mock.funcListPipelineTags != nil {
+		mmListPipelineTags.mock.t.Fatalf("RepositoryMock.ListPipelineTags mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPipelineTagsExpectation{
+		mock:   mmListPipelineTags.mock,
+		params: &RepositoryMockListPipelineTagsParams{ctx, pipelineUID},
+	}
+	mmListPipelineTags.expectations = append(mmListPipelineTags.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPipelineTags return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPipelineTagsExpectation) Then(ta1 []datamodel.Tag, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPipelineTagsResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPipelineTags should be invoked
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Times(n uint64) *mRepositoryMockListPipelineTags {
+	if n == 0 {
+		mmListPipelineTags.mock.t.Fatalf("Times of RepositoryMock.ListPipelineTags mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPipelineTags.expectedInvocations, n)
+	return mmListPipelineTags
+}
+
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) invocationsDone() bool {
+	if len(mmListPipelineTags.expectations) == 0 && mmListPipelineTags.defaultExpectation == nil && mmListPipelineTags.mock.funcListPipelineTags == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPipelineTags.mock.afterListPipelineTagsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelineTags.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPipelineTags implements repository.Repository
+func (mmListPipelineTags *RepositoryMock) ListPipelineTags(ctx context.Context, pipelineUID uuid.UUID) (ta1 []datamodel.Tag, err error) {
+	mm_atomic.AddUint64(&mmListPipelineTags.beforeListPipelineTagsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPipelineTags.afterListPipelineTagsCounter, 1)
+
+	if mmListPipelineTags.inspectFuncListPipelineTags != nil {
+		mmListPipelineTags.inspectFuncListPipelineTags(ctx, pipelineUID)
+	}
+
+	mm_params := RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
+
+	// Record call args
+	mmListPipelineTags.ListPipelineTagsMock.mutex.Lock()
+	mmListPipelineTags.ListPipelineTagsMock.callArgs = append(mmListPipelineTags.ListPipelineTagsMock.callArgs, &mm_params)
+	mmListPipelineTags.ListPipelineTagsMock.mutex.Unlock()
+
+	for _, e := range mmListPipelineTags.ListPipelineTagsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmListPipelineTags.ListPipelineTagsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPipelineTagsParams{ctx, pipelineUID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
+				mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPipelineTags.t.Errorf("RepositoryMock.ListPipelineTags got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPipelineTags.ListPipelineTagsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPipelineTags.t.Fatal("No results are set for the RepositoryMock.ListPipelineTags")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmListPipelineTags.funcListPipelineTags != nil {
+		return mmListPipelineTags.funcListPipelineTags(ctx, pipelineUID)
+	}
+	mmListPipelineTags.t.Fatalf("Unexpected call to RepositoryMock.ListPipelineTags. %v %v", ctx, pipelineUID)
+	return
+}
+
+// ListPipelineTagsAfterCounter returns a count of finished RepositoryMock.ListPipelineTags invocations
+func (mmListPipelineTags *RepositoryMock) ListPipelineTagsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelineTags.afterListPipelineTagsCounter)
+}
+
+// ListPipelineTagsBeforeCounter returns a count of RepositoryMock.ListPipelineTags invocations
+func (mmListPipelineTags *RepositoryMock) ListPipelineTagsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelineTags.beforeListPipelineTagsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelineTags.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPipelineTags *mRepositoryMockListPipelineTags) Calls() []*RepositoryMockListPipelineTagsParams {
+	mmListPipelineTags.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPipelineTagsParams, len(mmListPipelineTags.callArgs))
+	copy(argCopy, mmListPipelineTags.callArgs)
+
+	mmListPipelineTags.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPipelineTagsDone returns true if the count of the ListPipelineTags invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPipelineTagsDone() bool {
+	if m.ListPipelineTagsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPipelineTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPipelineTagsMock.invocationsDone()
+}
+
+// MinimockListPipelineTagsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPipelineTagsInspect() {
+	for _, e := range m.ListPipelineTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelineTags with params: %#v", *e.params)
+		}
+	}
+
+	afterListPipelineTagsCounter := mm_atomic.LoadUint64(&m.afterListPipelineTagsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPipelineTagsMock.defaultExpectation != nil && afterListPipelineTagsCounter < 1 {
+		if m.ListPipelineTagsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListPipelineTags")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelineTags with params: %#v", *m.ListPipelineTagsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPipelineTags != nil && afterListPipelineTagsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListPipelineTags")
+	}
+
+	if !m.ListPipelineTagsMock.invocationsDone() && afterListPipelineTagsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelineTags but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPipelineTagsMock.expectedInvocations), afterListPipelineTagsCounter)
+	}
+}
+
+type mRepositoryMockListPipelines struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPipelinesExpectation
+	expectations       []*RepositoryMockListPipelinesExpectation
+
+	callArgs []*RepositoryMockListPipelinesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListPipelinesExpectation specifies expectation struct of the Repository.ListPipelines
+type RepositoryMockListPipelinesExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListPipelinesParams
+	paramPtrs *RepositoryMockListPipelinesParamPtrs
+	results   *RepositoryMockListPipelinesResults
+	Counter   uint64
+}
+
+// RepositoryMockListPipelinesParams contains parameters of the Repository.ListPipelines
+type RepositoryMockListPipelinesParams struct {
+	ctx           context.Context
+	pageSize      int64
+	pageToken     string
+	isBasicView   bool
+	filter        filtering.Filter
+	uidAllowList  []uuid.UUID
+	showDeleted   bool
+	embedReleases bool
+	order         ordering.OrderBy
+}
+
+// RepositoryMockListPipelinesParamPtrs contains pointers to parameters of the Repository.ListPipelines
+type RepositoryMockListPipelinesParamPtrs struct {
+	ctx           *context.Context
+	pageSize      *int64
+	pageToken     *string
+	isBasicView   *bool
+	filter        *filtering.Filter
+	uidAllowList  *[]uuid.UUID
+	showDeleted   *bool
+	embedReleases *bool
+	order         *ordering.OrderBy
+}
+
+// RepositoryMockListPipelinesResults contains results of the Repository.ListPipelines
+type RepositoryMockListPipelinesResults struct {
+	ppa1 []*datamodel.Pipeline
+	i1   int64
+	s1   string
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPipelines *mRepositoryMockListPipelines) Optional() *mRepositoryMockListPipelines {
+	mmListPipelines.optional = true
+	return mmListPipelines
+}
+
+// Expect sets up expected params for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) Expect(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by ExpectParams functions")
+	}
+
+	mmListPipelines.defaultExpectation.params = &RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+	for _, e := range mmListPipelines.expectations {
+		if minimock.Equal(e.params, mmListPipelines.defaultExpectation.params) {
+			mmListPipelines.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelines.defaultExpectation.params)
+		}
+	}
+
+	return mmListPipelines
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPipelines
+}
+
+// ExpectPageSizeParam2 sets up expected param pageSize for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectPageSizeParam2(pageSize int64) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListPipelines
+}
+
+// ExpectPageTokenParam3 sets up expected param pageToken for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectPageTokenParam3(pageToken string) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListPipelines
+}
+
+// ExpectIsBasicViewParam4 sets up expected param isBasicView for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectIsBasicViewParam4(isBasicView bool) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.isBasicView = &isBasicView
+
+	return mmListPipelines
+}
+
+// ExpectFilterParam5 sets up expected param filter for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListPipelines
+}
+
+// ExpectUidAllowListParam6 sets up expected param uidAllowList for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectUidAllowListParam6(uidAllowList []uuid.UUID) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.uidAllowList = &uidAllowList
+
+	return mmListPipelines
+}
+
+// ExpectShowDeletedParam7 sets up expected param showDeleted for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectShowDeletedParam7(showDeleted bool) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+
+	return mmListPipelines
+}
+
+// ExpectEmbedReleasesParam8 sets up expected param embedReleases for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectEmbedReleasesParam8(embedReleases bool) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.embedReleases = &embedReleases
+
+	return mmListPipelines
+}
+
+// ExpectOrderParam9 sets up expected param order for Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) ExpectOrderParam9(order ordering.OrderBy) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	}
+
+	if mmListPipelines.defaultExpectation.params != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	}
+
+	if mmListPipelines.defaultExpectation.paramPtrs == nil {
+		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	}
+	mmListPipelines.defaultExpectation.paramPtrs.order = &order
+
+	return mmListPipelines
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) Inspect(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy)) *mRepositoryMockListPipelines {
+	if mmListPipelines.mock.inspectFuncListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelines")
+	}
+
+	mmListPipelines.mock.inspectFuncListPipelines = f
+
+	return mmListPipelines
+}
+
+// Return sets up results that will be returned by Repository.ListPipelines
+func (mmListPipelines *mRepositoryMockListPipelines) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	if mmListPipelines.defaultExpectation == nil {
+		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{mock: mmListPipelines.mock}
+	}
+	mmListPipelines.defaultExpectation.results = &RepositoryMockListPipelinesResults{ppa1, i1, s1, err}
+	return mmListPipelines.mock
+}
+
+// Set uses given function f to mock the Repository.ListPipelines method
+func (mmListPipelines *mRepositoryMockListPipelines) Set(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListPipelines.defaultExpectation != nil {
+		mmListPipelines.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelines method")
+	}
+
+	if len(mmListPipelines.expectations) > 0 {
+		mmListPipelines.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelines method")
+	}
+
+	mmListPipelines.mock.funcListPipelines = f
+	return mmListPipelines.mock
+}
+
+// When sets expectation for the Repository.ListPipelines which will trigger the result defined by the following
+// Then helper
+func (mmListPipelines *mRepositoryMockListPipelines) When(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *RepositoryMockListPipelinesExpectation {
+	if mmListPipelines.mock.funcListPipelines != nil {
+		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPipelinesExpectation{
+		mock:   mmListPipelines.mock,
+		params: &RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order},
+	}
+	mmListPipelines.expectations = append(mmListPipelines.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPipelines return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPipelinesExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPipelinesResults{ppa1, i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPipelines should be invoked
+func (mmListPipelines *mRepositoryMockListPipelines) Times(n uint64) *mRepositoryMockListPipelines {
+	if n == 0 {
+		mmListPipelines.mock.t.Fatalf("Times of RepositoryMock.ListPipelines mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPipelines.expectedInvocations, n)
+	return mmListPipelines
+}
+
+func (mmListPipelines *mRepositoryMockListPipelines) invocationsDone() bool {
+	if len(mmListPipelines.expectations) == 0 && mmListPipelines.defaultExpectation == nil && mmListPipelines.mock.funcListPipelines == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPipelines.mock.afterListPipelinesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelines.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPipelines implements repository.Repository
+func (mmListPipelines *RepositoryMock) ListPipelines(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListPipelines.beforeListPipelinesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPipelines.afterListPipelinesCounter, 1)
+
+	if mmListPipelines.inspectFuncListPipelines != nil {
+		mmListPipelines.inspectFuncListPipelines(ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	}
+
+	mm_params := RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+
+	// Record call args
+	mmListPipelines.ListPipelinesMock.mutex.Lock()
+	mmListPipelines.ListPipelinesMock.callArgs = append(mmListPipelines.ListPipelinesMock.callArgs, &mm_params)
+	mmListPipelines.ListPipelinesMock.mutex.Unlock()
+
+	for _, e := range mmListPipelines.ListPipelinesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmListPipelines.ListPipelinesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPipelines.ListPipelinesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPipelines.ListPipelinesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPipelines.ListPipelinesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.uidAllowList != nil && !minimock.Equal(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter uidAllowList, want: %#v, got: %#v%s\n", *mm_want_ptrs.uidAllowList, mm_got.uidAllowList, minimock.Diff(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList))
+			}
+
+			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+			}
+
+			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
+			}
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter order, want: %#v, got: %#v%s\n", *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPipelines.ListPipelinesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPipelines.t.Fatal("No results are set for the RepositoryMock.ListPipelines")
+		}
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmListPipelines.funcListPipelines != nil {
+		return mmListPipelines.funcListPipelines(ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	}
+	mmListPipelines.t.Fatalf("Unexpected call to RepositoryMock.ListPipelines. %v %v %v %v %v %v %v %v %v", ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	return
+}
+
+// ListPipelinesAfterCounter returns a count of finished RepositoryMock.ListPipelines invocations
+func (mmListPipelines *RepositoryMock) ListPipelinesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelines.afterListPipelinesCounter)
+}
+
+// ListPipelinesBeforeCounter returns a count of RepositoryMock.ListPipelines invocations
+func (mmListPipelines *RepositoryMock) ListPipelinesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelines.beforeListPipelinesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelines.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPipelines *mRepositoryMockListPipelines) Calls() []*RepositoryMockListPipelinesParams {
+	mmListPipelines.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPipelinesParams, len(mmListPipelines.callArgs))
+	copy(argCopy, mmListPipelines.callArgs)
+
+	mmListPipelines.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPipelinesDone returns true if the count of the ListPipelines invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPipelinesDone() bool {
+	if m.ListPipelinesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPipelinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPipelinesMock.invocationsDone()
+}
+
+// MinimockListPipelinesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPipelinesInspect() {
+	for _, e := range m.ListPipelinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelines with params: %#v", *e.params)
+		}
+	}
+
+	afterListPipelinesCounter := mm_atomic.LoadUint64(&m.afterListPipelinesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPipelinesMock.defaultExpectation != nil && afterListPipelinesCounter < 1 {
+		if m.ListPipelinesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListPipelines")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelines with params: %#v", *m.ListPipelinesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPipelines != nil && afterListPipelinesCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListPipelines")
+	}
+
+	if !m.ListPipelinesMock.invocationsDone() && afterListPipelinesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelines but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPipelinesMock.expectedInvocations), afterListPipelinesCounter)
+	}
+}
+
+type mRepositoryMockListPipelinesAdmin struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPipelinesAdminExpectation
+	expectations       []*RepositoryMockListPipelinesAdminExpectation
+
+	callArgs []*RepositoryMockListPipelinesAdminParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListPipelinesAdminExpectation specifies expectation struct of the Repository.ListPipelinesAdmin
+type RepositoryMockListPipelinesAdminExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListPipelinesAdminParams
+	paramPtrs *RepositoryMockListPipelinesAdminParamPtrs
+	results   *RepositoryMockListPipelinesAdminResults
+	Counter   uint64
+}
+
+// RepositoryMockListPipelinesAdminParams contains parameters of the Repository.ListPipelinesAdmin
+type RepositoryMockListPipelinesAdminParams struct {
+	ctx           context.Context
+	pageSize      int64
+	pageToken     string
+	isBasicView   bool
+	filter        filtering.Filter
+	showDeleted   bool
+	embedReleases bool
+}
+
+// RepositoryMockListPipelinesAdminParamPtrs contains pointers to parameters of the Repository.ListPipelinesAdmin
+type RepositoryMockListPipelinesAdminParamPtrs struct {
+	ctx           *context.Context
+	pageSize      *int64
+	pageToken     *string
+	isBasicView   *bool
+	filter        *filtering.Filter
+	showDeleted   *bool
+	embedReleases *bool
+}
+
+// RepositoryMockListPipelinesAdminResults contains results of the Repository.ListPipelinesAdmin
+type RepositoryMockListPipelinesAdminResults struct {
+	ppa1 []*datamodel.Pipeline
+	i1   int64
+	s1   string
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Optional() *mRepositoryMockListPipelinesAdmin {
+	mmListPipelinesAdmin.optional = true
+	return mmListPipelinesAdmin
+}
+
+// Expect sets up expected params for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Expect(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by ExpectParams functions")
+	}
+
+	mmListPipelinesAdmin.defaultExpectation.params = &RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
+	for _, e := range mmListPipelinesAdmin.expectations {
+		if minimock.Equal(e.params, mmListPipelinesAdmin.defaultExpectation.params) {
+			mmListPipelinesAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelinesAdmin.defaultExpectation.params)
+		}
+	}
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectPageSizeParam2 sets up expected param pageSize for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectPageSizeParam2(pageSize int64) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.pageSize = &pageSize
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectPageTokenParam3 sets up expected param pageToken for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectPageTokenParam3(pageToken string) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.pageToken = &pageToken
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectIsBasicViewParam4 sets up expected param isBasicView for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectIsBasicViewParam4(isBasicView bool) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.isBasicView = &isBasicView
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectFilterParam5 sets up expected param filter for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectShowDeletedParam6 sets up expected param showDeleted for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectShowDeletedParam6(showDeleted bool) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+
+	return mmListPipelinesAdmin
+}
+
+// ExpectEmbedReleasesParam7 sets up expected param embedReleases for Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectEmbedReleasesParam7(embedReleases bool) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.params != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
+		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	}
+	mmListPipelinesAdmin.defaultExpectation.paramPtrs.embedReleases = &embedReleases
+
+	return mmListPipelinesAdmin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Inspect(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool)) *mRepositoryMockListPipelinesAdmin {
+	if mmListPipelinesAdmin.mock.inspectFuncListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelinesAdmin")
+	}
+
+	mmListPipelinesAdmin.mock.inspectFuncListPipelinesAdmin = f
+
+	return mmListPipelinesAdmin
+}
+
+// Return sets up results that will be returned by Repository.ListPipelinesAdmin
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	if mmListPipelinesAdmin.defaultExpectation == nil {
+		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{mock: mmListPipelinesAdmin.mock}
+	}
+	mmListPipelinesAdmin.defaultExpectation.results = &RepositoryMockListPipelinesAdminResults{ppa1, i1, s1, err}
+	return mmListPipelinesAdmin.mock
+}
+
+// Set uses given function f to mock the Repository.ListPipelinesAdmin method
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Set(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
+	if mmListPipelinesAdmin.defaultExpectation != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelinesAdmin method")
+	}
+
+	if len(mmListPipelinesAdmin.expectations) > 0 {
+		mmListPipelinesAdmin.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelinesAdmin method")
+	}
+
+	mmListPipelinesAdmin.mock.funcListPipelinesAdmin = f
+	return mmListPipelinesAdmin.mock
+}
+
+// When sets expectation for the Repository.ListPipelinesAdmin which will trigger the result defined by the following
+// Then helper
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) When(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) *RepositoryMockListPipelinesAdminExpectation {
+	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPipelinesAdminExpectation{
+		mock:   mmListPipelinesAdmin.mock,
+		params: &RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases},
+	}
+	mmListPipelinesAdmin.expectations = append(mmListPipelinesAdmin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPipelinesAdmin return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPipelinesAdminExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPipelinesAdminResults{ppa1, i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPipelinesAdmin should be invoked
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Times(n uint64) *mRepositoryMockListPipelinesAdmin {
+	if n == 0 {
+		mmListPipelinesAdmin.mock.t.Fatalf("Times of RepositoryMock.ListPipelinesAdmin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPipelinesAdmin.expectedInvocations, n)
+	return mmListPipelinesAdmin
+}
+
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) invocationsDone() bool {
+	if len(mmListPipelinesAdmin.expectations) == 0 && mmListPipelinesAdmin.defaultExpectation == nil && mmListPipelinesAdmin.mock.funcListPipelinesAdmin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPipelinesAdmin.mock.afterListPipelinesAdminCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelinesAdmin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPipelinesAdmin implements repository.Repository
+func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdmin(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmListPipelinesAdmin.beforeListPipelinesAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPipelinesAdmin.afterListPipelinesAdminCounter, 1)
+
+	if mmListPipelinesAdmin.inspectFuncListPipelinesAdmin != nil {
+		mmListPipelinesAdmin.inspectFuncListPipelinesAdmin(ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	}
+
+	mm_params := RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
+
+	// Record call args
+	mmListPipelinesAdmin.ListPipelinesAdminMock.mutex.Lock()
+	mmListPipelinesAdmin.ListPipelinesAdminMock.callArgs = append(mmListPipelinesAdmin.ListPipelinesAdminMock.callArgs, &mm_params)
+	mmListPipelinesAdmin.ListPipelinesAdminMock.mutex.Unlock()
+
+	for _, e := range mmListPipelinesAdmin.ListPipelinesAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.params
+		mm_want_ptrs := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
+			}
+
+			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
+			}
+
+			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+			}
+
+			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
+				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPipelinesAdmin.t.Fatal("No results are set for the RepositoryMock.ListPipelinesAdmin")
+		}
+		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmListPipelinesAdmin.funcListPipelinesAdmin != nil {
+		return mmListPipelinesAdmin.funcListPipelinesAdmin(ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	}
+	mmListPipelinesAdmin.t.Fatalf("Unexpected call to RepositoryMock.ListPipelinesAdmin. %v %v %v %v %v %v %v", ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	return
+}
+
+// ListPipelinesAdminAfterCounter returns a count of finished RepositoryMock.ListPipelinesAdmin invocations
+func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelinesAdmin.afterListPipelinesAdminCounter)
+}
+
+// ListPipelinesAdminBeforeCounter returns a count of RepositoryMock.ListPipelinesAdmin invocations
+func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPipelinesAdmin.beforeListPipelinesAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelinesAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Calls() []*RepositoryMockListPipelinesAdminParams {
+	mmListPipelinesAdmin.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPipelinesAdminParams, len(mmListPipelinesAdmin.callArgs))
+	copy(argCopy, mmListPipelinesAdmin.callArgs)
+
+	mmListPipelinesAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPipelinesAdminDone returns true if the count of the ListPipelinesAdmin invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPipelinesAdminDone() bool {
+	if m.ListPipelinesAdminMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPipelinesAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPipelinesAdminMock.invocationsDone()
+}
+
+// MinimockListPipelinesAdminInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPipelinesAdminInspect() {
+	for _, e := range m.ListPipelinesAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelinesAdmin with params: %#v", *e.params)
+		}
+	}
+
+	afterListPipelinesAdminCounter := mm_atomic.LoadUint64(&m.afterListPipelinesAdminCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPipelinesAdminMock.defaultExpectation != nil && afterListPipelinesAdminCounter < 1 {
+		if m.ListPipelinesAdminMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListPipelinesAdmin")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPipelinesAdmin with params: %#v", *m.ListPipelinesAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPipelinesAdmin != nil && afterListPipelinesAdminCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListPipelinesAdmin")
+	}
+
+	if !m.ListPipelinesAdminMock.invocationsDone() && afterListPipelinesAdminCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelinesAdmin but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPipelinesAdminMock.expectedInvocations), afterListPipelinesAdminCounter)
+	}
+}
+
+type mRepositoryMockPinUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockPinUserExpectation
+	expectations       []*RepositoryMockPinUserExpectation
+
+	callArgs []*RepositoryMockPinUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockPinUserExpectation specifies expectation struct of the Repository.PinUser
+type RepositoryMockPinUserExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockPinUserParams
+	paramPtrs *RepositoryMockPinUserParamPtrs
+
+	Counter uint64
+}
+
+// RepositoryMockPinUserParams contains parameters of the Repository.PinUser
+type RepositoryMockPinUserParams struct {
+	ctx   context.Context
+	table string
+}
+
+// RepositoryMockPinUserParamPtrs contains pointers to parameters of the Repository.PinUser
+type RepositoryMockPinUserParamPtrs struct {
+	ctx   *context.Context
+	table *string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinUser *mRepositoryMockPinUser) Optional() *mRepositoryMockPinUser {
+	mmPinUser.optional = true
+	return mmPinUser
+}
+
+// Expect sets up expected params for Repository.PinUser
+func (mmPinUser *mRepositoryMockPinUser) Expect(ctx context.Context, table string) *mRepositoryMockPinUser {
+	if mmPinUser.mock.funcPinUser != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+	}
+
+	if mmPinUser.defaultExpectation == nil {
+		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	}
+
+	if mmPinUser.defaultExpectation.paramPtrs != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by ExpectParams functions")
+	}
+
+	mmPinUser.defaultExpectation.params = &RepositoryMockPinUserParams{ctx, table}
+	for _, e := range mmPinUser.expectations {
+		if minimock.Equal(e.params, mmPinUser.defaultExpectation.params) {
+			mmPinUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinUser.defaultExpectation.params)
+		}
+	}
+
+	return mmPinUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.PinUser
+func (mmPinUser *mRepositoryMockPinUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockPinUser {
+	if mmPinUser.mock.funcPinUser != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+	}
+
+	if mmPinUser.defaultExpectation == nil {
+		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	}
+
+	if mmPinUser.defaultExpectation.params != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Expect")
+	}
+
+	if mmPinUser.defaultExpectation.paramPtrs == nil {
+		mmPinUser.defaultExpectation.paramPtrs = &RepositoryMockPinUserParamPtrs{}
+	}
+	mmPinUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPinUser
+}
+
+// ExpectTableParam2 sets up expected param table for Repository.PinUser
+func (mmPinUser *mRepositoryMockPinUser) ExpectTableParam2(table string) *mRepositoryMockPinUser {
+	if mmPinUser.mock.funcPinUser != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+	}
+
+	if mmPinUser.defaultExpectation == nil {
+		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	}
+
+	if mmPinUser.defaultExpectation.params != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Expect")
+	}
+
+	if mmPinUser.defaultExpectation.paramPtrs == nil {
+		mmPinUser.defaultExpectation.paramPtrs = &RepositoryMockPinUserParamPtrs{}
+	}
+	mmPinUser.defaultExpectation.paramPtrs.table = &table
+
+	return mmPinUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.PinUser
+func (mmPinUser *mRepositoryMockPinUser) Inspect(f func(ctx context.Context, table string)) *mRepositoryMockPinUser {
+	if mmPinUser.mock.inspectFuncPinUser != nil {
+		mmPinUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.PinUser")
+	}
+
+	mmPinUser.mock.inspectFuncPinUser = f
+
+	return mmPinUser
+}
+
+// Return sets up results that will be returned by Repository.PinUser
+func (mmPinUser *mRepositoryMockPinUser) Return() *RepositoryMock {
+	if mmPinUser.mock.funcPinUser != nil {
+		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+	}
+
+	if mmPinUser.defaultExpectation == nil {
+		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{mock: mmPinUser.mock}
+	}
+
+	return mmPinUser.mock
+}
+
+// Set uses given function f to mock the Repository.PinUser method
+func (mmPinUser *mRepositoryMockPinUser) Set(f func(ctx context.Context, table string)) *RepositoryMock {
+	if mmPinUser.defaultExpectation != nil {
+		mmPinUser.mock.t.Fatalf("Default expectation is already set for the Repository.PinUser method")
+	}
+
+	if len(mmPinUser.expectations) > 0 {
+		mmPinUser.mock.t.Fatalf("Some expectations are already set for the Repository.PinUser method")
+	}
+
+	mmPinUser.mock.funcPinUser = f
+	return mmPinUser.mock
+}
+
+// Times sets number of times Repository.PinUser should be invoked
+func (mmPinUser *mRepositoryMockPinUser) Times(n uint64) *mRepositoryMockPinUser {
+	if n == 0 {
+		mmPinUser.mock.t.Fatalf("Times of RepositoryMock.PinUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinUser.expectedInvocations, n)
+	return mmPinUser
+}
+
+func (mmPinUser *mRepositoryMockPinUser) invocationsDone() bool {
+	if len(mmPinUser.expectations) == 0 && mmPinUser.defaultExpectation == nil && mmPinUser.mock.funcPinUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinUser.mock.afterPinUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinUser implements repository.Repository
+func (mmPinUser *RepositoryMock) PinUser(ctx context.Context, table string) {
+	mm_atomic.AddUint64(&mmPinUser.beforePinUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinUser.afterPinUserCounter, 1)
+
+	if mmPinUser.inspectFuncPinUser != nil {
+		mmPinUser.inspectFuncPinUser(ctx, table)
+	}
+
+	mm_params := RepositoryMockPinUserParams{ctx, table}
+
+	// Record call args
+	mmPinUser.PinUserMock.mutex.Lock()
+	mmPinUser.PinUserMock.callArgs = append(mmPinUser.PinUserMock.callArgs, &mm_params)
+	mmPinUser.PinUserMock.mutex.Unlock()
+
+	for _, e := range mmPinUser.PinUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmPinUser.PinUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinUser.PinUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinUser.PinUserMock.defaultExpectation.params
+		mm_want_ptrs := mmPinUser.PinUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockPinUserParams{ctx, table}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.table != nil && !minimock.Equal(*mm_want_ptrs.table, mm_got.table) {
+				mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameter table, want: %#v, got: %#v%s\n", *mm_want_ptrs.table, mm_got.table, minimock.Diff(*mm_want_ptrs.table, mm_got.table))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmPinUser.funcPinUser != nil {
+		mmPinUser.funcPinUser(ctx, table)
+		return
+	}
+	mmPinUser.t.Fatalf("Unexpected call to RepositoryMock.PinUser. %v %v", ctx, table)
+
+}
+
+// PinUserAfterCounter returns a count of finished RepositoryMock.PinUser invocations
+func (mmPinUser *RepositoryMock) PinUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinUser.afterPinUserCounter)
+}
+
+// PinUserBeforeCounter returns a count of RepositoryMock.PinUser invocations
+func (mmPinUser *RepositoryMock) PinUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinUser.beforePinUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.PinUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinUser *mRepositoryMockPinUser) Calls() []*RepositoryMockPinUserParams {
+	mmPinUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockPinUserParams, len(mmPinUser.callArgs))
+	copy(argCopy, mmPinUser.callArgs)
+
+	mmPinUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinUserDone returns true if the count of the PinUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockPinUserDone() bool {
+	if m.PinUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinUserMock.invocationsDone()
+}
+
+// MinimockPinUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockPinUserInspect() {
+	for _, e := range m.PinUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.PinUser with params: %#v", *e.params)
+		}
+	}
+
+	afterPinUserCounter := mm_atomic.LoadUint64(&m.afterPinUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinUserMock.defaultExpectation != nil && afterPinUserCounter < 1 {
+		if m.PinUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.PinUser")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.PinUser with params: %#v", *m.PinUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinUser != nil && afterPinUserCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.PinUser")
+	}
+
+	if !m.PinUserMock.invocationsDone() && afterPinUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.PinUser but found %d calls",
+			mm_atomic.LoadUint64(&m.PinUserMock.expectedInvocations), afterPinUserCounter)
+	}
+}
+
+type mRepositoryMockTranspileFilter struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockTranspileFilterExpectation
+	expectations       []*RepositoryMockTranspileFilterExpectation
+
+	callArgs []*RepositoryMockTranspileFilterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockTranspileFilterExpectation specifies expectation struct of the Repository.TranspileFilter
+type RepositoryMockTranspileFilterExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockTranspileFilterParams
+	paramPtrs *RepositoryMockTranspileFilterParamPtrs
+	results   *RepositoryMockTranspileFilterResults
+	Counter   uint64
+}
+
+// RepositoryMockTranspileFilterParams contains parameters of the Repository.TranspileFilter
+type RepositoryMockTranspileFilterParams struct {
+	f1 filtering.Filter
+}
+
+// RepositoryMockTranspileFilterParamPtrs contains pointers to parameters of the Repository.TranspileFilter
+type RepositoryMockTranspileFilterParamPtrs struct {
+	f1 *filtering.Filter
+}
+
+// RepositoryMockTranspileFilterResults contains results of the Repository.TranspileFilter
+type RepositoryMockTranspileFilterResults struct {
+	ep1 *clause.Expr
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Optional() *mRepositoryMockTranspileFilter {
+	mmTranspileFilter.optional = true
+	return mmTranspileFilter
+}
+
+// Expect sets up expected params for Repository.TranspileFilter
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Expect(f1 filtering.Filter) *mRepositoryMockTranspileFilter {
+	if mmTranspileFilter.mock.funcTranspileFilter != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+	}
+
+	if mmTranspileFilter.defaultExpectation == nil {
+		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{}
+	}
+
+	if mmTranspileFilter.defaultExpectation.paramPtrs != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by ExpectParams functions")
+	}
+
+	mmTranspileFilter.defaultExpectation.params = &RepositoryMockTranspileFilterParams{f1}
+	for _, e := range mmTranspileFilter.expectations {
+		if minimock.Equal(e.params, mmTranspileFilter.defaultExpectation.params) {
+			mmTranspileFilter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTranspileFilter.defaultExpectation.params)
+		}
+	}
+
+	return mmTranspileFilter
+}
+
+// ExpectF1Param1 sets up expected param f1 for Repository.TranspileFilter
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) ExpectF1Param1(f1 filtering.Filter) *mRepositoryMockTranspileFilter {
+	if mmTranspileFilter.mock.funcTranspileFilter != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+	}
+
+	if mmTranspileFilter.defaultExpectation == nil {
+		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{}
+	}
+
+	if mmTranspileFilter.defaultExpectation.params != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Expect")
+	}
+
+	if mmTranspileFilter.defaultExpectation.paramPtrs == nil {
+		mmTranspileFilter.defaultExpectation.paramPtrs = &RepositoryMockTranspileFilterParamPtrs{}
+	}
+	mmTranspileFilter.defaultExpectation.paramPtrs.f1 = &f1
+
+	return mmTranspileFilter
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.TranspileFilter
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Inspect(f func(f1 filtering.Filter)) *mRepositoryMockTranspileFilter {
+	if mmTranspileFilter.mock.inspectFuncTranspileFilter != nil {
+		mmTranspileFilter.mock.t.Fatalf("Inspect function is already set for RepositoryMock.TranspileFilter")
+	}
+
+	mmTranspileFilter.mock.inspectFuncTranspileFilter = f
+
+	return mmTranspileFilter
+}
+
+// Return sets up results that will be returned by Repository.TranspileFilter
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Return(ep1 *clause.Expr, err error) *RepositoryMock {
+	if mmTranspileFilter.mock.funcTranspileFilter != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+	}
+
+	if mmTranspileFilter.defaultExpectation == nil {
+		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{mock: mmTranspileFilter.mock}
+	}
+	mmTranspileFilter.defaultExpectation.results = &RepositoryMockTranspileFilterResults{ep1, err}
+	return mmTranspileFilter.mock
+}
+
+// Set uses given function f to mock the Repository.TranspileFilter method
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Set(f func(f1 filtering.Filter) (ep1 *clause.Expr, err error)) *RepositoryMock {
+	if mmTranspileFilter.defaultExpectation != nil {
+		mmTranspileFilter.mock.t.Fatalf("Default expectation is already set for the Repository.TranspileFilter method")
+	}
+
+	if len(mmTranspileFilter.expectations) > 0 {
+		mmTranspileFilter.mock.t.Fatalf("Some expectations are already set for the Repository.TranspileFilter method")
+	}
+
+	mmTranspileFilter.mock.funcTranspileFilter = f
+	return mmTranspileFilter.mock
+}
+
+// When sets expectation for the Repository.TranspileFilter which will trigger the result defined by the following
+// Then helper
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) When(f1 filtering.Filter) *RepositoryMockTranspileFilterExpectation {
+	if mmTranspileFilter.mock.funcTranspileFilter != nil {
+		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockTranspileFilterExpectation{
+		mock:   mmTranspileFilter.mock,
+		params: &RepositoryMockTranspileFilterParams{f1},
+	}
+	mmTranspileFilter.expectations = append(mmTranspileFilter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.TranspileFilter return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockTranspileFilterExpectation) Then(ep1 *clause.Expr, err error) *RepositoryMock {
+	e.results = &RepositoryMockTranspileFilterResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.TranspileFilter should be invoked
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Times(n uint64) *mRepositoryMockTranspileFilter {
+	if n == 0 {
+		mmTranspileFilter.mock.t.Fatalf("Times of RepositoryMock.TranspileFilter mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTranspileFilter.expectedInvocations, n)
+	return mmTranspileFilter
+}
+
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) invocationsDone() bool {
+	if len(mmTranspileFilter.expectations) == 0 && mmTranspileFilter.defaultExpectation == nil && mmTranspileFilter.mock.funcTranspileFilter == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTranspileFilter.mock.afterTranspileFilterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTranspileFilter.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TranspileFilter implements repository.Repository
+func (mmTranspileFilter *RepositoryMock) TranspileFilter(f1 filtering.Filter) (ep1 *clause.Expr, err error) {
+	mm_atomic.AddUint64(&mmTranspileFilter.beforeTranspileFilterCounter, 1)
+	defer mm_atomic.AddUint64(&mmTranspileFilter.afterTranspileFilterCounter, 1)
+
+	if mmTranspileFilter.inspectFuncTranspileFilter != nil {
+		mmTranspileFilter.inspectFuncTranspileFilter(f1)
+	}
+
+	mm_params := RepositoryMockTranspileFilterParams{f1}
+
+	// Record call args
+	mmTranspileFilter.TranspileFilterMock.mutex.Lock()
+	mmTranspileFilter.TranspileFilterMock.callArgs = append(mmTranspileFilter.TranspileFilterMock.callArgs, &mm_params)
+	mmTranspileFilter.TranspileFilterMock.mutex.Unlock()
+
+	for _, e := range mmTranspileFilter.TranspileFilterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmTranspileFilter.TranspileFilterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTranspileFilter.TranspileFilterMock.defaultExpectation.Counter, 1)
+		mm_want := mmTranspileFilter.TranspileFilterMock.defaultExpectation.params
+		mm_want_ptrs := mmTranspileFilter.TranspileFilterMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockTranspileFilterParams{f1}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.f1 != nil && !minimock.Equal(*mm_want_ptrs.f1, mm_got.f1) {
+				mmTranspileFilter.t.Errorf("RepositoryMock.TranspileFilter got unexpected parameter f1, want: %#v, got: %#v%s\n", *mm_want_ptrs.f1, mm_got.f1, minimock.Diff(*mm_want_ptrs.f1, mm_got.f1))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTranspileFilter.t.Errorf("RepositoryMock.TranspileFilter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTranspileFilter.TranspileFilterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTranspileFilter.t.Fatal("No results are set for the RepositoryMock.TranspileFilter")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmTranspileFilter.funcTranspileFilter != nil {
+		return mmTranspileFilter.funcTranspileFilter(f1)
+	}
+	mmTranspileFilter.t.Fatalf("Unexpected call to RepositoryMock.TranspileFilter. %v", f1)
+	return
+}
+
+// TranspileFilterAfterCounter returns a count of finished RepositoryMock.TranspileFilter invocations
+func (mmTranspileFilter *RepositoryMock) TranspileFilterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTranspileFilter.afterTranspileFilterCounter)
+}
+
+// TranspileFilterBeforeCounter returns a count of RepositoryMock.TranspileFilter invocations
+func (mmTranspileFilter *RepositoryMock) TranspileFilterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTranspileFilter.beforeTranspileFilterCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.TranspileFilter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTranspileFilter *mRepositoryMockTranspileFilter) Calls() []*RepositoryMockTranspileFilterParams {
+	mmTranspileFilter.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockTranspileFilterParams, len(mmTranspileFilter.callArgs))
+	copy(argCopy, mmTranspileFilter.callArgs)
+
+	mmTranspileFilter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTranspileFilterDone returns true if the count of the TranspileFilter invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockTranspileFilterDone() bool {
+	if m.TranspileFilterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TranspileFilterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TranspileFilterMock.invocationsDone()
+}
+
+// MinimockTranspileFilterInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockTranspileFilterInspect() {
+	for _, e := range m.TranspileFilterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.TranspileFilter with params: %#v", *e.params)
+		}
+	}
+
+	afterTranspileFilterCounter := mm_atomic.LoadUint64(&m.afterTranspileFilterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TranspileFilterMock.defaultExpectation != nil && afterTranspileFilterCounter < 1 {
+		if m.TranspileFilterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.TranspileFilter")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.TranspileFilter with params: %#v", *m.TranspileFilterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTranspileFilter != nil && afterTranspileFilterCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.TranspileFilter")
+	}
+
+	if !m.TranspileFilterMock.invocationsDone() && afterTranspileFilterCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.TranspileFilter but found %d calls",
+			mm_atomic.LoadUint64(&m.TranspileFilterMock.expectedInvocations), afterTranspileFilterCounter)
+	}
+}
+
+type mRepositoryMockUpdateNamespacePipelineByUID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateNamespacePipelineByUIDExpectation
+	expectations       []*RepositoryMockUpdateNamespacePipelineByUIDExpectation
+
+	callArgs []*RepositoryMockUpdateNamespacePipelineByUIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockUpdateNamespacePipelineByUIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineByUID
+type RepositoryMockUpdateNamespacePipelineByUIDExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockUpdateNamespacePipelineByUIDParams
+	paramPtrs *RepositoryMockUpdateNamespacePipelineByUIDParamPtrs
+	results   *RepositoryMockUpdateNamespacePipelineByUIDResults
+	Counter   uint64
+}
+
+// RepositoryMockUpdateNamespacePipelineByUIDParams contains parameters of the Repository.UpdateNamespacePipelineByUID
+type RepositoryMockUpdateNamespacePipelineByUIDParams struct {
+	ctx      context.Context
+	uid      uuid.UUID
+	pipeline *datamodel.Pipeline
+}
+
+// RepositoryMockUpdateNamespacePipelineByUIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineByUID
+type RepositoryMockUpdateNamespacePipelineByUIDParamPtrs struct {
+	ctx      *context.Context
+	uid      *uuid.UUID
+	pipeline **datamodel.Pipeline
+}
+
+// RepositoryMockUpdateNamespacePipelineByUIDResults contains results of the Repository.UpdateNamespacePipelineByUID
+type RepositoryMockUpdateNamespacePipelineByUIDResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Optional() *mRepositoryMockUpdateNamespacePipelineByUID {
+	mmUpdateNamespacePipelineByUID.optional = true
+	return mmUpdateNamespacePipelineByUID
+}
+
+// Expect sets up expected params for Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Expect(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) *mRepositoryMockUpdateNamespacePipelineByUID {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+	}
+
+	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by ExpectParams functions")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	mmUpdateNamespacePipelineByUID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
+	for _, e := range mmUpdateNamespacePipelineByUID.expectations {
+		if minimock.Equal(e.params, mmUpdateNamespacePipelineByUID.defaultExpectation.params) {
+			mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineByUID.defaultExpectation.params)
+		}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.uidAllowList = &uidAllowList
 
-	return mmListPipelines
+	return mmUpdateNamespacePipelineByUID
 }
 
-// ExpectShowDeletedParam7 sets up expected param showDeleted for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectShowDeletedParam7(showDeleted bool) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineByUID {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListPipelines
+	return mmUpdateNamespacePipelineByUID
 }
 
-// ExpectEmbedReleasesParam8 sets up expected param embedReleases for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectEmbedReleasesParam8(embedReleases bool) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectUidParam2 sets up expected param uid for Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectUidParam2(uid uuid.UUID) *mRepositoryMockUpdateNamespacePipelineByUID {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.embedReleases = &embedReleases
+	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.uid = &uid
 
-	return mmListPipelines
+	return mmUpdateNamespacePipelineByUID
 }
 
-// ExpectOrderParam9 sets up expected param order for Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) ExpectOrderParam9(order ordering.OrderBy) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// ExpectPipelineParam3 sets up expected param pipeline for Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectPipelineParam3(pipeline *datamodel.Pipeline) *mRepositoryMockUpdateNamespacePipelineByUID {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
 	}
 
-	if mmListPipelines.defaultExpectation.params != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Expect")
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
 	}
 
-	if mmListPipelines.defaultExpectation.paramPtrs == nil {
-		mmListPipelines.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesParamPtrs{}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
 	}
-	mmListPipelines.defaultExpectation.paramPtrs.order = &order
+	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.pipeline = &pipeline
 
-	return mmListPipelines
+	return mmUpdateNamespacePipelineByUID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) Inspect(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy)) *mRepositoryMockListPipelines {
-	if mmListPipelines.mock.inspectFuncListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelines")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Inspect(f func(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline)) *mRepositoryMockUpdateNamespacePipelineByUID {
+	if mmUpdateNamespacePipelineByUID.mock.inspectFuncUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineByUID")
 	}
 
-	mmListPipelines.mock.inspectFuncListPipelines = f
+	mmUpdateNamespacePipelineByUID.mock.inspectFuncUpdateNamespacePipelineByUID = f
 
-	return mmListPipelines
+	return mmUpdateNamespacePipelineByUID
 }
 
-// Return sets up results that will be returned by Repository.ListPipelines
-func (mmListPipelines *mRepositoryMockListPipelines) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdateNamespacePipelineByUID
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Return(err error) *RepositoryMock {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
 	}
 
-	if mmListPipelines.defaultExpectation == nil {
-		mmListPipelines.defaultExpectation = &RepositoryMockListPipelinesExpectation{mock: mmListPipelines.mock}
+	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{mock: mmUpdateNamespacePipelineByUID.mock}
 	}
-	mmListPipelines.defaultExpectation.results = &RepositoryMockListPipelinesResults{ppa1, i1, s1, err}
-	return mmListPipelines.mock
+	mmUpdateNamespacePipelineByUID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineByUIDResults{err}
+	return mmUpdateNamespacePipelineByUID.mock
 }
 
-// Set uses given function f to mock the Repository.ListPipelines method
-func (mmListPipelines *mRepositoryMockListPipelines) Set(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
-	if mmListPipelines.defaultExpectation != nil {
-		mmListPipelines.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelines method")
+// Set uses given function f to mock the Repository.UpdateNamespacePipelineByUID method
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Set(f func(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) (err error)) *RepositoryMock {
+	if mmUpdateNamespacePipelineByUID.defaultExpectation != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineByUID method")
 	}
 
-	if len(mmListPipelines.expectations) > 0 {
-		mmListPipelines.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelines method")
+	if len(mmUpdateNamespacePipelineByUID.expectations) > 0 {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineByUID method")
 	}
 
-	mmListPipelines.mock.funcListPipelines = f
-	return mmListPipelines.mock
+	mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID = f
+	return mmUpdateNamespacePipelineByUID.mock
 }
 
-// When sets expectation for the Repository.ListPipelines which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateNamespacePipelineByUID which will trigger the result defined by the following
 // Then helper
-func (mmListPipelines *mRepositoryMockListPipelines) When(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) *RepositoryMockListPipelinesExpectation {
-	if mmListPipelines.mock.funcListPipelines != nil {
-		mmListPipelines.mock.t.Fatalf("RepositoryMock.ListPipelines mock is already set by Set")
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) When(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) *RepositoryMockUpdateNamespacePipelineByUIDExpectation {
+	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListPipelinesExpectation{
-		mock:   mmListPipelines.mock,
-		params: &RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order},
+	expectation := &RepositoryMockUpdateNamespacePipelineByUIDExpectation{
+		mock:   mmUpdateNamespacePipelineByUID.mock,
+		params: &RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline},
 	}
-	mmListPipelines.expectations = append(mmListPipelines.expectations, expectation)
+	mmUpdateNamespacePipelineByUID.expectations = append(mmUpdateNamespacePipelineByUID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListPipelines return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListPipelinesExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	e.results = &RepositoryMockListPipelinesResults{ppa1, i1, s1, err}
+// Then sets up Repository.UpdateNamespacePipelineByUID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateNamespacePipelineByUIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateNamespacePipelineByUIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListPipelines should be invoked
-func (mmListPipelines *mRepositoryMockListPipelines) Times(n uint64) *mRepositoryMockListPipelines {
+// Times sets number of times Repository.UpdateNamespacePipelineByUID should be invoked
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineByUID {
 	if n == 0 {
-		mmListPipelines.mock.t.Fatalf("Times of RepositoryMock.ListPipelines mock can not be zero")
+		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineByUID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListPipelines.expectedInvocations, n)
-	return mmListPipelines
+	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineByUID.expectedInvocations, n)
+	return mmUpdateNamespacePipelineByUID
 }
 
-func (mmListPipelines *mRepositoryMockListPipelines) invocationsDone() bool {
-	if len(mmListPipelines.expectations) == 0 && mmListPipelines.defaultExpectation == nil && mmListPipelines.mock.funcListPipelines == nil {
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) invocationsDone() bool {
+	if len(mmUpdateNamespacePipelineByUID.expectations) == 0 && mmUpdateNamespacePipelineByUID.defaultExpectation == nil && mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListPipelines.mock.afterListPipelinesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelines.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.mock.afterUpdateNamespacePipelineByUIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListPipelines implements repository.Repository
-func (mmListPipelines *RepositoryMock) ListPipelines(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, uidAllowList []uuid.UUID, showDeleted bool, embedReleases bool, order ordering.OrderBy) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
-	mm_atomic.AddUint64(&mmListPipelines.beforeListPipelinesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListPipelines.afterListPipelinesCounter, 1)
+// UpdateNamespacePipelineByUID implements repository.Repository
+func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUID(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) (err error) {
+	mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.beforeUpdateNamespacePipelineByUIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.afterUpdateNamespacePipelineByUIDCounter, 1)
 
-	if mmListPipelines.inspectFuncListPipelines != nil {
-		mmListPipelines.inspectFuncListPipelines(ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	if mmUpdateNamespacePipelineByUID.inspectFuncUpdateNamespacePipelineByUID != nil {
+		mmUpdateNamespacePipelineByUID.inspectFuncUpdateNamespacePipelineByUID(ctx, uid, pipeline)
 	}
 
-	mm_params := RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+	mm_params := RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
 
 	// Record call args
-	mmListPipelines.ListPipelinesMock.mutex.Lock()
-	mmListPipelines.ListPipelinesMock.callArgs = append(mmListPipelines.ListPipelinesMock.callArgs, &mm_params)
-	mmListPipelines.ListPipelinesMock.mutex.Unlock()
+	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.mutex.Lock()
+	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.callArgs = append(mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.callArgs, &mm_params)
+	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.mutex.Unlock()
 
-	for _, e := range mmListPipelines.ListPipelinesMock.expectations {
+	for _, e := range mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmListPipelines.ListPipelinesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListPipelines.ListPipelinesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListPipelines.ListPipelinesMock.defaultExpectation.params
-		mm_want_ptrs := mmListPipelines.ListPipelinesMock.defaultExpectation.paramPtrs
+	if mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListPipelinesParams{ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order}
+		mm_got := RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
-			}
-
-			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
-			}
-
-			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
-			}
-
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
-			}
-
-			if mm_want_ptrs.uidAllowList != nil && !minimock.Equal(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter uidAllowList, want: %#v, got: %#v%s\n", *mm_want_ptrs.uidAllowList, mm_got.uidAllowList, minimock.Diff(*mm_want_ptrs.uidAllowList, mm_got.uidAllowList))
-			}
-
-			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
+			if mm_want_ptrs.uid != nil && !minimock.Equal(*mm_want_ptrs.uid, mm_got.uid) {
+				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter uid, want: %#v, got: %#v%s\n", *mm_want_ptrs.uid, mm_got.uid, minimock.Diff(*mm_want_ptrs.uid, mm_got.uid))
 			}
 
-			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
-				mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameter order, want: %#v, got: %#v%s\n", *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			if mm_want_ptrs.pipeline != nil && !minimock.Equal(*mm_want_ptrs.pipeline, mm_got.pipeline) {
+				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter pipeline, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipeline, mm_got.pipeline, minimock.Diff(*mm_want_ptrs.pipeline, mm_got.pipeline))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListPipelines.t.Errorf("RepositoryMock.ListPipelines got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListPipelines.ListPipelinesMock.defaultExpectation.results
+		mm_results := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListPipelines.t.Fatal("No results are set for the RepositoryMock.ListPipelines")
+			mmUpdateNamespacePipelineByUID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineByUID")
 		}
-		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmListPipelines.funcListPipelines != nil {
-		return mmListPipelines.funcListPipelines(ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	if mmUpdateNamespacePipelineByUID.funcUpdateNamespacePipelineByUID != nil {
+		return mmUpdateNamespacePipelineByUID.funcUpdateNamespacePipelineByUID(ctx, uid, pipeline)
 	}
-	mmListPipelines.t.Fatalf("Unexpected call to RepositoryMock.ListPipelines. %v %v %v %v %v %v %v %v %v", ctx, pageSize, pageToken, isBasicView, filter, uidAllowList, showDeleted, embedReleases, order)
+	mmUpdateNamespacePipelineByUID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineByUID. %v %v %v", ctx, uid, pipeline)
 	return
 }
 
-// ListPipelinesAfterCounter returns a count of finished RepositoryMock.ListPipelines invocations
-func (mmListPipelines *RepositoryMock) ListPipelinesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelines.afterListPipelinesCounter)
+// UpdateNamespacePipelineByUIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineByUID invocations
+func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.afterUpdateNamespacePipelineByUIDCounter)
 }
 
-// ListPipelinesBeforeCounter returns a count of RepositoryMock.ListPipelines invocations
-func (mmListPipelines *RepositoryMock) ListPipelinesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelines.beforeListPipelinesCounter)
+// UpdateNamespacePipelineByUIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineByUID invocations
+func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.beforeUpdateNamespacePipelineByUIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelines.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineByUID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListPipelines *mRepositoryMockListPipelines) Calls() []*RepositoryMockListPipelinesParams {
-	mmListPipelines.mutex.RLock()
+func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Calls() []*RepositoryMockUpdateNamespacePipelineByUIDParams {
+	mmUpdateNamespacePipelineByUID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListPipelinesParams, len(mmListPipelines.callArgs))
-	copy(argCopy, mmListPipelines.callArgs)
+	argCopy := make([]*RepositoryMockUpdateNamespacePipelineByUIDParams, len(mmUpdateNamespacePipelineByUID.callArgs))
+	copy(argCopy, mmUpdateNamespacePipelineByUID.callArgs)
 
-	mmListPipelines.mutex.RUnlock()
+	mmUpdateNamespacePipelineByUID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListPipelinesDone returns true if the count of the ListPipelines invocations corresponds
+// MinimockUpdateNamespacePipelineByUIDDone returns true if the count of the UpdateNamespacePipelineByUID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListPipelinesDone() bool {
-	if m.ListPipelinesMock.optional {
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineByUIDDone() bool {
+	if m.UpdateNamespacePipelineByUIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListPipelinesMock.expectations {
+	for _, e := range m.UpdateNamespacePipelineByUIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListPipelinesMock.invocationsDone()
+	return m.UpdateNamespacePipelineByUIDMock.invocationsDone()
 }
 
-// MinimockListPipelinesInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListPipelinesInspect() {
-	for _, e := range m.ListPipelinesMock.expectations {
+// MinimockUpdateNamespacePipelineByUIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineByUIDInspect() {
+	for _, e := range m.UpdateNamespacePipelineByUIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelines with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineByUID with params: %#v", *e.params)
 		}
 	}
 
-	afterListPipelinesCounter := mm_atomic.LoadUint64(&m.afterListPipelinesCounter)
+	afterUpdateNamespacePipelineByUIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineByUIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListPipelinesMock.defaultExpectation != nil && afterListPipelinesCounter < 1 {
-		if m.ListPipelinesMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListPipelines")
+	if m.UpdateNamespacePipelineByUIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineByUIDCounter < 1 {
+		if m.UpdateNamespacePipelineByUIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineByUID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelines with params: %#v", *m.ListPipelinesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineByUID with params: %#v", *m.UpdateNamespacePipelineByUIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListPipelines != nil && afterListPipelinesCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListPipelines")
+	if m.funcUpdateNamespacePipelineByUID != nil && afterUpdateNamespacePipelineByUIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineByUID")
 	}
 
-	if !m.ListPipelinesMock.invocationsDone() && afterListPipelinesCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelines but found %d calls",
-			mm_atomic.LoadUint64(&m.ListPipelinesMock.expectedInvocations), afterListPipelinesCounter)
+	if !m.UpdateNamespacePipelineByUIDMock.invocationsDone() && afterUpdateNamespacePipelineByUIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineByUID but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineByUIDMock.expectedInvocations), afterUpdateNamespacePipelineByUIDCounter)
 	}
 }
 
-type mRepositoryMockListPipelinesAdmin struct {
+type mRepositoryMockUpdateNamespacePipelineIDByID struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockListPipelinesAdminExpectation
-	expectations       []*RepositoryMockListPipelinesAdminExpectation
+	defaultExpectation *RepositoryMockUpdateNamespacePipelineIDByIDExpectation
+	expectations       []*RepositoryMockUpdateNamespacePipelineIDByIDExpectation
 
-	callArgs []*RepositoryMockListPipelinesAdminParams
+	callArgs []*RepositoryMockUpdateNamespacePipelineIDByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockListPipelinesAdminExpectation specifies expectation struct of the Repository.ListPipelinesAdmin
-type RepositoryMockListPipelinesAdminExpectation struct {
+// RepositoryMockUpdateNamespacePipelineIDByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineIDByID
+type RepositoryMockUpdateNamespacePipelineIDByIDExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockListPipelinesAdminParams
-	paramPtrs *RepositoryMockListPipelinesAdminParamPtrs
-	results   *RepositoryMockListPipelinesAdminResults
+	params    *RepositoryMockUpdateNamespacePipelineIDByIDParams
+	paramPtrs *RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs
+	results   *RepositoryMockUpdateNamespacePipelineIDByIDResults
 	Counter   uint64
 }
 
-// RepositoryMockListPipelinesAdminParams contains parameters of the Repository.ListPipelinesAdmin
-type RepositoryMockListPipelinesAdminParams struct {
-	ctx           context.Context
-	pageSize      int64
-	pageToken     string
-	isBasicView   bool
-	filter        filtering.Filter
-	showDeleted   bool
-	embedReleases bool
+// RepositoryMockUpdateNamespacePipelineIDByIDParams contains parameters of the Repository.UpdateNamespacePipelineIDByID
+type RepositoryMockUpdateNamespacePipelineIDByIDParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	id             string
+	newID          string
 }
 
-// RepositoryMockListPipelinesAdminParamPtrs contains pointers to parameters of the Repository.ListPipelinesAdmin
-type RepositoryMockListPipelinesAdminParamPtrs struct {
-	ctx           *context.Context
-	pageSize      *int64
-	pageToken     *string
-	isBasicView   *bool
-	filter        *filtering.Filter
-	showDeleted   *bool
-	embedReleases *bool
+// RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineIDByID
+type RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	id             *string
+	newID          *string
 }
 
-// RepositoryMockListPipelinesAdminResults contains results of the Repository.ListPipelinesAdmin
-type RepositoryMockListPipelinesAdminResults struct {
-	ppa1 []*datamodel.Pipeline
-	i1   int64
-	s1   string
-	err  error
+// RepositoryMockUpdateNamespacePipelineIDByIDResults contains results of the Repository.UpdateNamespacePipelineIDByID
+type RepositoryMockUpdateNamespacePipelineIDByIDResults struct {
+	err error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -10125,444 +13700,377 @@ type RepositoryMockListPipelinesAdminResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Optional() *mRepositoryMockListPipelinesAdmin {
-	mmListPipelinesAdmin.optional = true
-	return mmListPipelinesAdmin
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Optional() *mRepositoryMockUpdateNamespacePipelineIDByID {
+	mmUpdateNamespacePipelineIDByID.optional = true
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// Expect sets up expected params for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Expect(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// Expect sets up expected params for Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Expect(ctx context.Context, ownerPermalink string, id string, newID string) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by ExpectParams functions")
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by ExpectParams functions")
 	}
 
-	mmListPipelinesAdmin.defaultExpectation.params = &RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
-	for _, e := range mmListPipelinesAdmin.expectations {
-		if minimock.Equal(e.params, mmListPipelinesAdmin.defaultExpectation.params) {
-			mmListPipelinesAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPipelinesAdmin.defaultExpectation.params)
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
+	for _, e := range mmUpdateNamespacePipelineIDByID.expectations {
+		if minimock.Equal(e.params, mmUpdateNamespacePipelineIDByID.defaultExpectation.params) {
+			mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineIDByID.defaultExpectation.params)
 		}
 	}
 
-	return mmListPipelinesAdmin
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
-	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.ctx = &ctx
-
-	return mmListPipelinesAdmin
-}
-
-// ExpectPageSizeParam2 sets up expected param pageSize for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectPageSizeParam2(pageSize int64) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
-	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.pageSize = &pageSize
-
-	return mmListPipelinesAdmin
-}
-
-// ExpectPageTokenParam3 sets up expected param pageToken for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectPageTokenParam3(pageToken string) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
-	}
-
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
-	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.pageToken = &pageToken
-
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// ExpectIsBasicViewParam4 sets up expected param isBasicView for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectIsBasicViewParam4(isBasicView bool) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
 	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.isBasicView = &isBasicView
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// ExpectFilterParam5 sets up expected param filter for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectFilterParam5(filter filtering.Filter) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
 	}
-
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
 	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.filter = &filter
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// ExpectShowDeletedParam6 sets up expected param showDeleted for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectShowDeletedParam6(showDeleted bool) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// ExpectIdParam3 sets up expected param id for Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectIdParam3(id string) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
 	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.showDeleted = &showDeleted
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.id = &id
 
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// ExpectEmbedReleasesParam7 sets up expected param embedReleases for Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) ExpectEmbedReleasesParam7(embedReleases bool) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// ExpectNewIDParam4 sets up expected param newID for Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectNewIDParam4(newID string) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.params != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Expect")
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation.paramPtrs == nil {
-		mmListPipelinesAdmin.defaultExpectation.paramPtrs = &RepositoryMockListPipelinesAdminParamPtrs{}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
 	}
-	mmListPipelinesAdmin.defaultExpectation.paramPtrs.embedReleases = &embedReleases
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.newID = &newID
 
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Inspect(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool)) *mRepositoryMockListPipelinesAdmin {
-	if mmListPipelinesAdmin.mock.inspectFuncListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPipelinesAdmin")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Inspect(f func(ctx context.Context, ownerPermalink string, id string, newID string)) *mRepositoryMockUpdateNamespacePipelineIDByID {
+	if mmUpdateNamespacePipelineIDByID.mock.inspectFuncUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineIDByID")
 	}
 
-	mmListPipelinesAdmin.mock.inspectFuncListPipelinesAdmin = f
+	mmUpdateNamespacePipelineIDByID.mock.inspectFuncUpdateNamespacePipelineIDByID = f
 
-	return mmListPipelinesAdmin
+	return mmUpdateNamespacePipelineIDByID
 }
 
-// Return sets up results that will be returned by Repository.ListPipelinesAdmin
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Return(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdateNamespacePipelineIDByID
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Return(err error) *RepositoryMock {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	if mmListPipelinesAdmin.defaultExpectation == nil {
-		mmListPipelinesAdmin.defaultExpectation = &RepositoryMockListPipelinesAdminExpectation{mock: mmListPipelinesAdmin.mock}
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{mock: mmUpdateNamespacePipelineIDByID.mock}
 	}
-	mmListPipelinesAdmin.defaultExpectation.results = &RepositoryMockListPipelinesAdminResults{ppa1, i1, s1, err}
-	return mmListPipelinesAdmin.mock
+	mmUpdateNamespacePipelineIDByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineIDByIDResults{err}
+	return mmUpdateNamespacePipelineIDByID.mock
 }
 
-// Set uses given function f to mock the Repository.ListPipelinesAdmin method
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Set(f func(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error)) *RepositoryMock {
-	if mmListPipelinesAdmin.defaultExpectation != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("Default expectation is already set for the Repository.ListPipelinesAdmin method")
+// Set uses given function f to mock the Repository.UpdateNamespacePipelineIDByID method
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Set(f func(ctx context.Context, ownerPermalink string, id string, newID string) (err error)) *RepositoryMock {
+	if mmUpdateNamespacePipelineIDByID.defaultExpectation != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineIDByID method")
 	}
 
-	if len(mmListPipelinesAdmin.expectations) > 0 {
-		mmListPipelinesAdmin.mock.t.Fatalf("Some expectations are already set for the Repository.ListPipelinesAdmin method")
+	if len(mmUpdateNamespacePipelineIDByID.expectations) > 0 {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineIDByID method")
 	}
 
-	mmListPipelinesAdmin.mock.funcListPipelinesAdmin = f
-	return mmListPipelinesAdmin.mock
+	mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID = f
+	return mmUpdateNamespacePipelineIDByID.mock
 }
 
-// When sets expectation for the Repository.ListPipelinesAdmin which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateNamespacePipelineIDByID which will trigger the result defined by the following
 // Then helper
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) When(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) *RepositoryMockListPipelinesAdminExpectation {
-	if mmListPipelinesAdmin.mock.funcListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.mock.t.Fatalf("RepositoryMock.ListPipelinesAdmin mock is already set by Set")
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) When(ctx context.Context, ownerPermalink string, id string, newID string) *RepositoryMockUpdateNamespacePipelineIDByIDExpectation {
+	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockListPipelinesAdminExpectation{
-		mock:   mmListPipelinesAdmin.mock,
-		params: &RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases},
+	expectation := &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{
+		mock:   mmUpdateNamespacePipelineIDByID.mock,
+		params: &RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID},
 	}
-	mmListPipelinesAdmin.expectations = append(mmListPipelinesAdmin.expectations, expectation)
+	mmUpdateNamespacePipelineIDByID.expectations = append(mmUpdateNamespacePipelineIDByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ListPipelinesAdmin return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockListPipelinesAdminExpectation) Then(ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) *RepositoryMock {
-	e.results = &RepositoryMockListPipelinesAdminResults{ppa1, i1, s1, err}
+// Then sets up Repository.UpdateNamespacePipelineIDByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateNamespacePipelineIDByIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateNamespacePipelineIDByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ListPipelinesAdmin should be invoked
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Times(n uint64) *mRepositoryMockListPipelinesAdmin {
+// Times sets number of times Repository.UpdateNamespacePipelineIDByID should be invoked
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineIDByID {
 	if n == 0 {
-		mmListPipelinesAdmin.mock.t.Fatalf("Times of RepositoryMock.ListPipelinesAdmin mock can not be zero")
+		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineIDByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListPipelinesAdmin.expectedInvocations, n)
-	return mmListPipelinesAdmin
+	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineIDByID.expectedInvocations, n)
+	return mmUpdateNamespacePipelineIDByID
 }
 
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) invocationsDone() bool {
-	if len(mmListPipelinesAdmin.expectations) == 0 && mmListPipelinesAdmin.defaultExpectation == nil && mmListPipelinesAdmin.mock.funcListPipelinesAdmin == nil {
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) invocationsDone() bool {
+	if len(mmUpdateNamespacePipelineIDByID.expectations) == 0 && mmUpdateNamespacePipelineIDByID.defaultExpectation == nil && mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListPipelinesAdmin.mock.afterListPipelinesAdminCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListPipelinesAdmin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.mock.afterUpdateNamespacePipelineIDByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListPipelinesAdmin implements repository.Repository
-func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdmin(ctx context.Context, pageSize int64, pageToken string, isBasicView bool, filter filtering.Filter, showDeleted bool, embedReleases bool) (ppa1 []*datamodel.Pipeline, i1 int64, s1 string, err error) {
-	mm_atomic.AddUint64(&mmListPipelinesAdmin.beforeListPipelinesAdminCounter, 1)
-	defer mm_atomic.AddUint64(&mmListPipelinesAdmin.afterListPipelinesAdminCounter, 1)
+// UpdateNamespacePipelineIDByID implements repository.Repository
+func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByID(ctx context.Context, ownerPermalink string, id string, newID string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.beforeUpdateNamespacePipelineIDByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.afterUpdateNamespacePipelineIDByIDCounter, 1)
 
-	if mmListPipelinesAdmin.inspectFuncListPipelinesAdmin != nil {
-		mmListPipelinesAdmin.inspectFuncListPipelinesAdmin(ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	if mmUpdateNamespacePipelineIDByID.inspectFuncUpdateNamespacePipelineIDByID != nil {
+		mmUpdateNamespacePipelineIDByID.inspectFuncUpdateNamespacePipelineIDByID(ctx, ownerPermalink, id, newID)
 	}
 
-	mm_params := RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
+	mm_params := RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
 
 	// Record call args
-	mmListPipelinesAdmin.ListPipelinesAdminMock.mutex.Lock()
-	mmListPipelinesAdmin.ListPipelinesAdminMock.callArgs = append(mmListPipelinesAdmin.ListPipelinesAdminMock.callArgs, &mm_params)
-	mmListPipelinesAdmin.ListPipelinesAdminMock.mutex.Unlock()
+	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.mutex.Lock()
+	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.callArgs = append(mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.callArgs, &mm_params)
+	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.mutex.Unlock()
 
-	for _, e := range mmListPipelinesAdmin.ListPipelinesAdminMock.expectations {
+	for _, e := range mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ppa1, e.results.i1, e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.Counter, 1)
-		mm_want := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.params
-		mm_want_ptrs := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.paramPtrs
+	if mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockListPipelinesAdminParams{ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases}
+		mm_got := RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.pageSize != nil && !minimock.Equal(*mm_want_ptrs.pageSize, mm_got.pageSize) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter pageSize, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageSize, mm_got.pageSize, minimock.Diff(*mm_want_ptrs.pageSize, mm_got.pageSize))
-			}
-
-			if mm_want_ptrs.pageToken != nil && !minimock.Equal(*mm_want_ptrs.pageToken, mm_got.pageToken) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter pageToken, want: %#v, got: %#v%s\n", *mm_want_ptrs.pageToken, mm_got.pageToken, minimock.Diff(*mm_want_ptrs.pageToken, mm_got.pageToken))
-			}
-
-			if mm_want_ptrs.isBasicView != nil && !minimock.Equal(*mm_want_ptrs.isBasicView, mm_got.isBasicView) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter isBasicView, want: %#v, got: %#v%s\n", *mm_want_ptrs.isBasicView, mm_got.isBasicView, minimock.Diff(*mm_want_ptrs.isBasicView, mm_got.isBasicView))
+				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
 			}
 
-			if mm_want_ptrs.showDeleted != nil && !minimock.Equal(*mm_want_ptrs.showDeleted, mm_got.showDeleted) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter showDeleted, want: %#v, got: %#v%s\n", *mm_want_ptrs.showDeleted, mm_got.showDeleted, minimock.Diff(*mm_want_ptrs.showDeleted, mm_got.showDeleted))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.embedReleases != nil && !minimock.Equal(*mm_want_ptrs.embedReleases, mm_got.embedReleases) {
-				mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameter embedReleases, want: %#v, got: %#v%s\n", *mm_want_ptrs.embedReleases, mm_got.embedReleases, minimock.Diff(*mm_want_ptrs.embedReleases, mm_got.embedReleases))
+			if mm_want_ptrs.newID != nil && !minimock.Equal(*mm_want_ptrs.newID, mm_got.newID) {
+				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter newID, want: %#v, got: %#v%s\n", *mm_want_ptrs.newID, mm_got.newID, minimock.Diff(*mm_want_ptrs.newID, mm_got.newID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListPipelinesAdmin.t.Errorf("RepositoryMock.ListPipelinesAdmin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListPipelinesAdmin.ListPipelinesAdminMock.defaultExpectation.results
+		mm_results := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListPipelinesAdmin.t.Fatal("No results are set for the RepositoryMock.ListPipelinesAdmin")
+			mmUpdateNamespacePipelineIDByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineIDByID")
 		}
-		return (*mm_results).ppa1, (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmListPipelinesAdmin.funcListPipelinesAdmin != nil {
-		return mmListPipelinesAdmin.funcListPipelinesAdmin(ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	if mmUpdateNamespacePipelineIDByID.funcUpdateNamespacePipelineIDByID != nil {
+		return mmUpdateNamespacePipelineIDByID.funcUpdateNamespacePipelineIDByID(ctx, ownerPermalink, id, newID)
 	}
-	mmListPipelinesAdmin.t.Fatalf("Unexpected call to RepositoryMock.ListPipelinesAdmin. %v %v %v %v %v %v %v", ctx, pageSize, pageToken, isBasicView, filter, showDeleted, embedReleases)
+	mmUpdateNamespacePipelineIDByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineIDByID. %v %v %v %v", ctx, ownerPermalink, id, newID)
 	return
 }
 
-// ListPipelinesAdminAfterCounter returns a count of finished RepositoryMock.ListPipelinesAdmin invocations
-func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdminAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelinesAdmin.afterListPipelinesAdminCounter)
+// UpdateNamespacePipelineIDByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineIDByID invocations
+func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.afterUpdateNamespacePipelineIDByIDCounter)
 }
 
-// ListPipelinesAdminBeforeCounter returns a count of RepositoryMock.ListPipelinesAdmin invocations
-func (mmListPipelinesAdmin *RepositoryMock) ListPipelinesAdminBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListPipelinesAdmin.beforeListPipelinesAdminCounter)
+// UpdateNamespacePipelineIDByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineIDByID invocations
+func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.beforeUpdateNamespacePipelineIDByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.ListPipelinesAdmin.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineIDByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListPipelinesAdmin *mRepositoryMockListPipelinesAdmin) Calls() []*RepositoryMockListPipelinesAdminParams {
-	mmListPipelinesAdmin.mutex.RLock()
+func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Calls() []*RepositoryMockUpdateNamespacePipelineIDByIDParams {
+	mmUpdateNamespacePipelineIDByID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockListPipelinesAdminParams, len(mmListPipelinesAdmin.callArgs))
-	copy(argCopy, mmListPipelinesAdmin.callArgs)
+	argCopy := make([]*RepositoryMockUpdateNamespacePipelineIDByIDParams, len(mmUpdateNamespacePipelineIDByID.callArgs))
+	copy(argCopy, mmUpdateNamespacePipelineIDByID.callArgs)
 
-	mmListPipelinesAdmin.mutex.RUnlock()
+	mmUpdateNamespacePipelineIDByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListPipelinesAdminDone returns true if the count of the ListPipelinesAdmin invocations corresponds
+// MinimockUpdateNamespacePipelineIDByIDDone returns true if the count of the UpdateNamespacePipelineIDByID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockListPipelinesAdminDone() bool {
-	if m.ListPipelinesAdminMock.optional {
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineIDByIDDone() bool {
+	if m.UpdateNamespacePipelineIDByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListPipelinesAdminMock.expectations {
+	for _, e := range m.UpdateNamespacePipelineIDByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListPipelinesAdminMock.invocationsDone()
+	return m.UpdateNamespacePipelineIDByIDMock.invocationsDone()
 }
 
-// MinimockListPipelinesAdminInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockListPipelinesAdminInspect() {
-	for _, e := range m.ListPipelinesAdminMock.expectations {
+// MinimockUpdateNamespacePipelineIDByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineIDByIDInspect() {
+	for _, e := range m.UpdateNamespacePipelineIDByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelinesAdmin with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID with params: %#v", *e.params)
 		}
 	}
 
-	afterListPipelinesAdminCounter := mm_atomic.LoadUint64(&m.afterListPipelinesAdminCounter)
+	afterUpdateNamespacePipelineIDByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineIDByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListPipelinesAdminMock.defaultExpectation != nil && afterListPipelinesAdminCounter < 1 {
-		if m.ListPipelinesAdminMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.ListPipelinesAdmin")
+	if m.UpdateNamespacePipelineIDByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineIDByIDCounter < 1 {
+		if m.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.ListPipelinesAdmin with params: %#v", *m.ListPipelinesAdminMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID with params: %#v", *m.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListPipelinesAdmin != nil && afterListPipelinesAdminCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.ListPipelinesAdmin")
+	if m.funcUpdateNamespacePipelineIDByID != nil && afterUpdateNamespacePipelineIDByIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID")
 	}
 
-	if !m.ListPipelinesAdminMock.invocationsDone() && afterListPipelinesAdminCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.ListPipelinesAdmin but found %d calls",
-			mm_atomic.LoadUint64(&m.ListPipelinesAdminMock.expectedInvocations), afterListPipelinesAdminCounter)
+	if !m.UpdateNamespacePipelineIDByIDMock.invocationsDone() && afterUpdateNamespacePipelineIDByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineIDByID but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineIDByIDMock.expectedInvocations), afterUpdateNamespacePipelineIDByIDCounter)
 	}
 }
 
-type mRepositoryMockPinUser struct {
+type mRepositoryMockUpdateNamespacePipelineReleaseByID struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockPinUserExpectation
-	expectations       []*RepositoryMockPinUserExpectation
+	defaultExpectation *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation
+	expectations       []*RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation
 
-	callArgs []*RepositoryMockPinUserParams
+	callArgs []*RepositoryMockUpdateNamespacePipelineReleaseByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockPinUserExpectation specifies expectation struct of the Repository.PinUser
-type RepositoryMockPinUserExpectation struct {
+// RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineReleaseByID
+type RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockPinUserParams
-	paramPtrs *RepositoryMockPinUserParamPtrs
+	params    *RepositoryMockUpdateNamespacePipelineReleaseByIDParams
+	paramPtrs *RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs
+	results   *RepositoryMockUpdateNamespacePipelineReleaseByIDResults
+	Counter   uint64
+}
 
-	Counter uint64
+// RepositoryMockUpdateNamespacePipelineReleaseByIDParams contains parameters of the Repository.UpdateNamespacePipelineReleaseByID
+type RepositoryMockUpdateNamespacePipelineReleaseByIDParams struct {
+	ctx             context.Context
+	ownerPermalink  string
+	pipelineUID     uuid.UUID
+	id              string
+	pipelineRelease *datamodel.PipelineRelease
 }
 
-// RepositoryMockPinUserParams contains parameters of the Repository.PinUser
-type RepositoryMockPinUserParams struct {
-	ctx   context.Context
-	table string
+// RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineReleaseByID
+type RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs struct {
+	ctx             *context.Context
+	ownerPermalink  *string
+	pipelineUID     *uuid.UUID
+	id              *string
+	pipelineRelease **datamodel.PipelineRelease
 }
 
-// RepositoryMockPinUserParamPtrs contains pointers to parameters of the Repository.PinUser
-type RepositoryMockPinUserParamPtrs struct {
-	ctx   *context.Context
-	table *string
+// RepositoryMockUpdateNamespacePipelineReleaseByIDResults contains results of the Repository.UpdateNamespacePipelineReleaseByID
+type RepositoryMockUpdateNamespacePipelineReleaseByIDResults struct {
+	err error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -10570,590 +14078,804 @@ type RepositoryMockPinUserParamPtrs struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmPinUser *mRepositoryMockPinUser) Optional() *mRepositoryMockPinUser {
-	mmPinUser.optional = true
-	return mmPinUser
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Optional() *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	mmUpdateNamespacePipelineReleaseByID.optional = true
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// Expect sets up expected params for Repository.PinUser
-func (mmPinUser *mRepositoryMockPinUser) Expect(ctx context.Context, table string) *mRepositoryMockPinUser {
-	if mmPinUser.mock.funcPinUser != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+// Expect sets up expected params for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	if mmPinUser.defaultExpectation == nil {
-		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
 	}
 
-	if mmPinUser.defaultExpectation.paramPtrs != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by ExpectParams functions")
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by ExpectParams functions")
 	}
 
-	mmPinUser.defaultExpectation.params = &RepositoryMockPinUserParams{ctx, table}
-	for _, e := range mmPinUser.expectations {
-		if minimock.Equal(e.params, mmPinUser.defaultExpectation.params) {
-			mmPinUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinUser.defaultExpectation.params)
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
+	for _, e := range mmUpdateNamespacePipelineReleaseByID.expectations {
+		if minimock.Equal(e.params, mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params) {
+			mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params)
 		}
 	}
 
-	return mmPinUser
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.PinUser
-func (mmPinUser *mRepositoryMockPinUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockPinUser {
-	if mmPinUser.mock.funcPinUser != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	if mmPinUser.defaultExpectation == nil {
-		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
 	}
 
-	if mmPinUser.defaultExpectation.params != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Expect")
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
 	}
 
-	if mmPinUser.defaultExpectation.paramPtrs == nil {
-		mmPinUser.defaultExpectation.paramPtrs = &RepositoryMockPinUserParamPtrs{}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
 	}
-	mmPinUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmPinUser
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// ExpectTableParam2 sets up expected param table for Repository.PinUser
-func (mmPinUser *mRepositoryMockPinUser) ExpectTableParam2(table string) *mRepositoryMockPinUser {
-	if mmPinUser.mock.funcPinUser != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	if mmPinUser.defaultExpectation == nil {
-		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
 	}
 
-	if mmPinUser.defaultExpectation.params != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Expect")
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
 	}
 
-	if mmPinUser.defaultExpectation.paramPtrs == nil {
-		mmPinUser.defaultExpectation.paramPtrs = &RepositoryMockPinUserParamPtrs{}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
 	}
-	mmPinUser.defaultExpectation.paramPtrs.table = &table
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmPinUser
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.PinUser
-func (mmPinUser *mRepositoryMockPinUser) Inspect(f func(ctx context.Context, table string)) *mRepositoryMockPinUser {
-	if mmPinUser.mock.inspectFuncPinUser != nil {
-		mmPinUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.PinUser")
+// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	mmPinUser.mock.inspectFuncPinUser = f
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	}
 
-	return mmPinUser
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	}
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// Return sets up results that will be returned by Repository.PinUser
-func (mmPinUser *mRepositoryMockPinUser) Return() *RepositoryMock {
-	if mmPinUser.mock.funcPinUser != nil {
-		mmPinUser.mock.t.Fatalf("RepositoryMock.PinUser mock is already set by Set")
+// ExpectIdParam4 sets up expected param id for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectIdParam4(id string) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	if mmPinUser.defaultExpectation == nil {
-		mmPinUser.defaultExpectation = &RepositoryMockPinUserExpectation{mock: mmPinUser.mock}
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
 	}
 
-	return mmPinUser.mock
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	}
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-// Set uses given function f to mock the Repository.PinUser method
-func (mmPinUser *mRepositoryMockPinUser) Set(f func(ctx context.Context, table string)) *RepositoryMock {
-	if mmPinUser.defaultExpectation != nil {
-		mmPinUser.mock.t.Fatalf("Default expectation is already set for the Repository.PinUser method")
+// ExpectPipelineReleaseParam5 sets up expected param pipelineRelease for Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectPipelineReleaseParam5(pipelineRelease *datamodel.PipelineRelease) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	}
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.pipelineRelease = &pipelineRelease
+
+	return mmUpdateNamespacePipelineReleaseByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease)) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+	if mmUpdateNamespacePipelineReleaseByID.mock.inspectFuncUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineReleaseByID")
+	}
+
+	mmUpdateNamespacePipelineReleaseByID.mock.inspectFuncUpdateNamespacePipelineReleaseByID = f
+
+	return mmUpdateNamespacePipelineReleaseByID
+}
+
+// Return sets up results that will be returned by Repository.UpdateNamespacePipelineReleaseByID
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Return(err error) *RepositoryMock {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+	}
+
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{mock: mmUpdateNamespacePipelineReleaseByID.mock}
+	}
+	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineReleaseByIDResults{err}
+	return mmUpdateNamespacePipelineReleaseByID.mock
+}
+
+// Set uses given function f to mock the Repository.UpdateNamespacePipelineReleaseByID method
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) (err error)) *RepositoryMock {
+	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineReleaseByID method")
+	}
+
+	if len(mmUpdateNamespacePipelineReleaseByID.expectations) > 0 {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineReleaseByID method")
+	}
+
+	mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID = f
+	return mmUpdateNamespacePipelineReleaseByID.mock
+}
+
+// When sets expectation for the Repository.UpdateNamespacePipelineReleaseByID which will trigger the result defined by the following
+// Then helper
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation {
+	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
 	}
 
-	if len(mmPinUser.expectations) > 0 {
-		mmPinUser.mock.t.Fatalf("Some expectations are already set for the Repository.PinUser method")
+	expectation := &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{
+		mock:   mmUpdateNamespacePipelineReleaseByID.mock,
+		params: &RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease},
 	}
+	mmUpdateNamespacePipelineReleaseByID.expectations = append(mmUpdateNamespacePipelineReleaseByID.expectations, expectation)
+	return expectation
+}
 
-	mmPinUser.mock.funcPinUser = f
-	return mmPinUser.mock
+// Then sets up Repository.UpdateNamespacePipelineReleaseByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateNamespacePipelineReleaseByIDResults{err}
+	return e.mock
 }
 
-// Times sets number of times Repository.PinUser should be invoked
-func (mmPinUser *mRepositoryMockPinUser) Times(n uint64) *mRepositoryMockPinUser {
+// Times sets number of times Repository.UpdateNamespacePipelineReleaseByID should be invoked
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
 	if n == 0 {
-		mmPinUser.mock.t.Fatalf("Times of RepositoryMock.PinUser mock can not be zero")
+		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineReleaseByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmPinUser.expectedInvocations, n)
-	return mmPinUser
+	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineReleaseByID.expectedInvocations, n)
+	return mmUpdateNamespacePipelineReleaseByID
 }
 
-func (mmPinUser *mRepositoryMockPinUser) invocationsDone() bool {
-	if len(mmPinUser.expectations) == 0 && mmPinUser.defaultExpectation == nil && mmPinUser.mock.funcPinUser == nil {
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) invocationsDone() bool {
+	if len(mmUpdateNamespacePipelineReleaseByID.expectations) == 0 && mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil && mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmPinUser.mock.afterPinUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmPinUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.mock.afterUpdateNamespacePipelineReleaseByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// PinUser implements repository.Repository
-func (mmPinUser *RepositoryMock) PinUser(ctx context.Context, table string) {
-	mm_atomic.AddUint64(&mmPinUser.beforePinUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmPinUser.afterPinUserCounter, 1)
+// UpdateNamespacePipelineReleaseByID implements repository.Repository
+func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByID(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) (err error) {
+	mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.beforeUpdateNamespacePipelineReleaseByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.afterUpdateNamespacePipelineReleaseByIDCounter, 1)
 
-	if mmPinUser.inspectFuncPinUser != nil {
-		mmPinUser.inspectFuncPinUser(ctx, table)
+	if mmUpdateNamespacePipelineReleaseByID.inspectFuncUpdateNamespacePipelineReleaseByID != nil {
+		mmUpdateNamespacePipelineReleaseByID.inspectFuncUpdateNamespacePipelineReleaseByID(ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
 	}
 
-	mm_params := RepositoryMockPinUserParams{ctx, table}
+	mm_params := RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
 
 	// Record call args
-	mmPinUser.PinUserMock.mutex.Lock()
-	mmPinUser.PinUserMock.callArgs = append(mmPinUser.PinUserMock.callArgs, &mm_params)
-	mmPinUser.PinUserMock.mutex.Unlock()
+	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.mutex.Lock()
+	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.callArgs = append(mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.callArgs, &mm_params)
+	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.mutex.Unlock()
 
-	for _, e := range mmPinUser.PinUserMock.expectations {
+	for _, e := range mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
-	if mmPinUser.PinUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPinUser.PinUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmPinUser.PinUserMock.defaultExpectation.params
-		mm_want_ptrs := mmPinUser.PinUserMock.defaultExpectation.paramPtrs
+	if mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockPinUserParams{ctx, table}
+		mm_got := RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.table != nil && !minimock.Equal(*mm_want_ptrs.table, mm_got.table) {
-				mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameter table, want: %#v, got: %#v%s\n", *mm_want_ptrs.table, mm_got.table, minimock.Diff(*mm_want_ptrs.table, mm_got.table))
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
+
+			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
+				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.pipelineRelease != nil && !minimock.Equal(*mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease) {
+				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter pipelineRelease, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease, minimock.Diff(*mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmPinUser.t.Errorf("RepositoryMock.PinUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateNamespacePipelineReleaseByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineReleaseByID")
+		}
+		return (*mm_results).err
 	}
-	if mmPinUser.funcPinUser != nil {
-		mmPinUser.funcPinUser(ctx, table)
-		return
+	if mmUpdateNamespacePipelineReleaseByID.funcUpdateNamespacePipelineReleaseByID != nil {
+		return mmUpdateNamespacePipelineReleaseByID.funcUpdateNamespacePipelineReleaseByID(ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
 	}
-	mmPinUser.t.Fatalf("Unexpected call to RepositoryMock.PinUser. %v %v", ctx, table)
-
+	mmUpdateNamespacePipelineReleaseByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineReleaseByID. %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
+	return
 }
 
-// PinUserAfterCounter returns a count of finished RepositoryMock.PinUser invocations
-func (mmPinUser *RepositoryMock) PinUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPinUser.afterPinUserCounter)
+// UpdateNamespacePipelineReleaseByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineReleaseByID invocations
+func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.afterUpdateNamespacePipelineReleaseByIDCounter)
 }
 
-// PinUserBeforeCounter returns a count of RepositoryMock.PinUser invocations
-func (mmPinUser *RepositoryMock) PinUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPinUser.beforePinUserCounter)
+// UpdateNamespacePipelineReleaseByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineReleaseByID invocations
+func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.beforeUpdateNamespacePipelineReleaseByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.PinUser.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineReleaseByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmPinUser *mRepositoryMockPinUser) Calls() []*RepositoryMockPinUserParams {
-	mmPinUser.mutex.RLock()
+func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Calls() []*RepositoryMockUpdateNamespacePipelineReleaseByIDParams {
+	mmUpdateNamespacePipelineReleaseByID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockPinUserParams, len(mmPinUser.callArgs))
-	copy(argCopy, mmPinUser.callArgs)
+	argCopy := make([]*RepositoryMockUpdateNamespacePipelineReleaseByIDParams, len(mmUpdateNamespacePipelineReleaseByID.callArgs))
+	copy(argCopy, mmUpdateNamespacePipelineReleaseByID.callArgs)
 
-	mmPinUser.mutex.RUnlock()
+	mmUpdateNamespacePipelineReleaseByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockPinUserDone returns true if the count of the PinUser invocations corresponds
+// MinimockUpdateNamespacePipelineReleaseByIDDone returns true if the count of the UpdateNamespacePipelineReleaseByID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockPinUserDone() bool {
-	if m.PinUserMock.optional {
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseByIDDone() bool {
+	if m.UpdateNamespacePipelineReleaseByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.PinUserMock.expectations {
+	for _, e := range m.UpdateNamespacePipelineReleaseByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.PinUserMock.invocationsDone()
+	return m.UpdateNamespacePipelineReleaseByIDMock.invocationsDone()
 }
 
-// MinimockPinUserInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockPinUserInspect() {
-	for _, e := range m.PinUserMock.expectations {
+// MinimockUpdateNamespacePipelineReleaseByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseByIDInspect() {
+	for _, e := range m.UpdateNamespacePipelineReleaseByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.PinUser with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID with params: %#v", *e.params)
 		}
 	}
 
-	afterPinUserCounter := mm_atomic.LoadUint64(&m.afterPinUserCounter)
+	afterUpdateNamespacePipelineReleaseByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineReleaseByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PinUserMock.defaultExpectation != nil && afterPinUserCounter < 1 {
-		if m.PinUserMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.PinUser")
+	if m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineReleaseByIDCounter < 1 {
+		if m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.PinUser with params: %#v", *m.PinUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID with params: %#v", *m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPinUser != nil && afterPinUserCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.PinUser")
+	if m.funcUpdateNamespacePipelineReleaseByID != nil && afterUpdateNamespacePipelineReleaseByIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID")
+	}
+
+	if !m.UpdateNamespacePipelineReleaseByIDMock.invocationsDone() && afterUpdateNamespacePipelineReleaseByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineReleaseByID but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineReleaseByIDMock.expectedInvocations), afterUpdateNamespacePipelineReleaseByIDCounter)
+	}
+}
+
+type mRepositoryMockUpdateNamespacePipelineReleaseIDByID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation
+	expectations       []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation
+
+	callArgs []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineReleaseIDByID
+type RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams
+	paramPtrs *RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs
+	results   *RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults
+	Counter   uint64
+}
+
+// RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams contains parameters of the Repository.UpdateNamespacePipelineReleaseIDByID
+type RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	pipelineUID    uuid.UUID
+	id             string
+	newID          string
+}
+
+// RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineReleaseIDByID
+type RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	pipelineUID    *uuid.UUID
+	id             *string
+	newID          *string
+}
+
+// RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults contains results of the Repository.UpdateNamespacePipelineReleaseIDByID
+type RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Optional() *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	mmUpdateNamespacePipelineReleaseIDByID.optional = true
+	return mmUpdateNamespacePipelineReleaseIDByID
+}
+
+// Expect sets up expected params for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+	}
+
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	}
+
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
+	for _, e := range mmUpdateNamespacePipelineReleaseIDByID.expectations {
+		if minimock.Equal(e.params, mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params) {
+			mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateNamespacePipelineReleaseIDByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+	}
+
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	}
+
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
 	}
 
-	if !m.PinUserMock.invocationsDone() && afterPinUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.PinUser but found %d calls",
-			mm_atomic.LoadUint64(&m.PinUserMock.expectedInvocations), afterPinUserCounter)
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
 	}
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-type mRepositoryMockTranspileFilter struct {
-	optional           bool
-	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockTranspileFilterExpectation
-	expectations       []*RepositoryMockTranspileFilterExpectation
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+	}
 
-	callArgs []*RepositoryMockTranspileFilterParams
-	mutex    sync.RWMutex
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	}
 
-	expectedInvocations uint64
-}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
+	}
 
-// RepositoryMockTranspileFilterExpectation specifies expectation struct of the Repository.TranspileFilter
-type RepositoryMockTranspileFilterExpectation struct {
-	mock      *RepositoryMock
-	params    *RepositoryMockTranspileFilterParams
-	paramPtrs *RepositoryMockTranspileFilterParamPtrs
-	results   *RepositoryMockTranspileFilterResults
-	Counter   uint64
-}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
+	}
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-// RepositoryMockTranspileFilterParams contains parameters of the Repository.TranspileFilter
-type RepositoryMockTranspileFilterParams struct {
-	f1 filtering.Filter
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-// RepositoryMockTranspileFilterParamPtrs contains pointers to parameters of the Repository.TranspileFilter
-type RepositoryMockTranspileFilterParamPtrs struct {
-	f1 *filtering.Filter
-}
+// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+	}
 
-// RepositoryMockTranspileFilterResults contains results of the Repository.TranspileFilter
-type RepositoryMockTranspileFilterResults struct {
-	ep1 *clause.Expr
-	err error
-}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	}
 
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Optional() *mRepositoryMockTranspileFilter {
-	mmTranspileFilter.optional = true
-	return mmTranspileFilter
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
+	}
+
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
+	}
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
+
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-// Expect sets up expected params for Repository.TranspileFilter
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Expect(f1 filtering.Filter) *mRepositoryMockTranspileFilter {
-	if mmTranspileFilter.mock.funcTranspileFilter != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+// ExpectIdParam4 sets up expected param id for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectIdParam4(id string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
 	}
 
-	if mmTranspileFilter.defaultExpectation == nil {
-		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
 	}
 
-	if mmTranspileFilter.defaultExpectation.paramPtrs != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by ExpectParams functions")
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
 	}
 
-	mmTranspileFilter.defaultExpectation.params = &RepositoryMockTranspileFilterParams{f1}
-	for _, e := range mmTranspileFilter.expectations {
-		if minimock.Equal(e.params, mmTranspileFilter.defaultExpectation.params) {
-			mmTranspileFilter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTranspileFilter.defaultExpectation.params)
-		}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
 	}
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.id = &id
 
-	return mmTranspileFilter
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-// ExpectF1Param1 sets up expected param f1 for Repository.TranspileFilter
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) ExpectF1Param1(f1 filtering.Filter) *mRepositoryMockTranspileFilter {
-	if mmTranspileFilter.mock.funcTranspileFilter != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+// ExpectNewIDParam5 sets up expected param newID for Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectNewIDParam5(newID string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
 	}
 
-	if mmTranspileFilter.defaultExpectation == nil {
-		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
 	}
 
-	if mmTranspileFilter.defaultExpectation.params != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Expect")
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
 	}
 
-	if mmTranspileFilter.defaultExpectation.paramPtrs == nil {
-		mmTranspileFilter.defaultExpectation.paramPtrs = &RepositoryMockTranspileFilterParamPtrs{}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
 	}
-	mmTranspileFilter.defaultExpectation.paramPtrs.f1 = &f1
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.newID = &newID
 
-	return mmTranspileFilter
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.TranspileFilter
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Inspect(f func(f1 filtering.Filter)) *mRepositoryMockTranspileFilter {
-	if mmTranspileFilter.mock.inspectFuncTranspileFilter != nil {
-		mmTranspileFilter.mock.t.Fatalf("Inspect function is already set for RepositoryMock.TranspileFilter")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string)) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.inspectFuncUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
 	}
 
-	mmTranspileFilter.mock.inspectFuncTranspileFilter = f
+	mmUpdateNamespacePipelineReleaseIDByID.mock.inspectFuncUpdateNamespacePipelineReleaseIDByID = f
 
-	return mmTranspileFilter
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-// Return sets up results that will be returned by Repository.TranspileFilter
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Return(ep1 *clause.Expr, err error) *RepositoryMock {
-	if mmTranspileFilter.mock.funcTranspileFilter != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdateNamespacePipelineReleaseIDByID
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Return(err error) *RepositoryMock {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
 	}
 
-	if mmTranspileFilter.defaultExpectation == nil {
-		mmTranspileFilter.defaultExpectation = &RepositoryMockTranspileFilterExpectation{mock: mmTranspileFilter.mock}
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
+		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{mock: mmUpdateNamespacePipelineReleaseIDByID.mock}
 	}
-	mmTranspileFilter.defaultExpectation.results = &RepositoryMockTranspileFilterResults{ep1, err}
-	return mmTranspileFilter.mock
+	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults{err}
+	return mmUpdateNamespacePipelineReleaseIDByID.mock
 }
 
-// Set uses given function f to mock the Repository.TranspileFilter method
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Set(f func(f1 filtering.Filter) (ep1 *clause.Expr, err error)) *RepositoryMock {
-	if mmTranspileFilter.defaultExpectation != nil {
-		mmTranspileFilter.mock.t.Fatalf("Default expectation is already set for the Repository.TranspileFilter method")
+// Set uses given function f to mock the Repository.UpdateNamespacePipelineReleaseIDByID method
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) (err error)) *RepositoryMock {
+	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineReleaseIDByID method")
 	}
 
-	if len(mmTranspileFilter.expectations) > 0 {
-		mmTranspileFilter.mock.t.Fatalf("Some expectations are already set for the Repository.TranspileFilter method")
+	if len(mmUpdateNamespacePipelineReleaseIDByID.expectations) > 0 {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineReleaseIDByID method")
 	}
 
-	mmTranspileFilter.mock.funcTranspileFilter = f
-	return mmTranspileFilter.mock
+	mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID = f
+	return mmUpdateNamespacePipelineReleaseIDByID.mock
 }
 
-// When sets expectation for the Repository.TranspileFilter which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateNamespacePipelineReleaseIDByID which will trigger the result defined by the following
 // Then helper
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) When(f1 filtering.Filter) *RepositoryMockTranspileFilterExpectation {
-	if mmTranspileFilter.mock.funcTranspileFilter != nil {
-		mmTranspileFilter.mock.t.Fatalf("RepositoryMock.TranspileFilter mock is already set by Set")
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation {
+	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockTranspileFilterExpectation{
-		mock:   mmTranspileFilter.mock,
-		params: &RepositoryMockTranspileFilterParams{f1},
+	expectation := &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{
+		mock:   mmUpdateNamespacePipelineReleaseIDByID.mock,
+		params: &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID},
 	}
-	mmTranspileFilter.expectations = append(mmTranspileFilter.expectations, expectation)
+	mmUpdateNamespacePipelineReleaseIDByID.expectations = append(mmUpdateNamespacePipelineReleaseIDByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.TranspileFilter return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockTranspileFilterExpectation) Then(ep1 *clause.Expr, err error) *RepositoryMock {
-	e.results = &RepositoryMockTranspileFilterResults{ep1, err}
+// Then sets up Repository.UpdateNamespacePipelineReleaseIDByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.TranspileFilter should be invoked
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Times(n uint64) *mRepositoryMockTranspileFilter {
+// Times sets number of times Repository.UpdateNamespacePipelineReleaseIDByID should be invoked
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
 	if n == 0 {
-		mmTranspileFilter.mock.t.Fatalf("Times of RepositoryMock.TranspileFilter mock can not be zero")
+		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmTranspileFilter.expectedInvocations, n)
-	return mmTranspileFilter
+	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineReleaseIDByID.expectedInvocations, n)
+	return mmUpdateNamespacePipelineReleaseIDByID
 }
 
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) invocationsDone() bool {
-	if len(mmTranspileFilter.expectations) == 0 && mmTranspileFilter.defaultExpectation == nil && mmTranspileFilter.mock.funcTranspileFilter == nil {
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) invocationsDone() bool {
+	if len(mmUpdateNamespacePipelineReleaseIDByID.expectations) == 0 && mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil && mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmTranspileFilter.mock.afterTranspileFilterCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmTranspileFilter.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.mock.afterUpdateNamespacePipelineReleaseIDByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// TranspileFilter implements repository.Repository
-func (mmTranspileFilter *RepositoryMock) TranspileFilter(f1 filtering.Filter) (ep1 *clause.Expr, err error) {
-	mm_atomic.AddUint64(&mmTranspileFilter.beforeTranspileFilterCounter, 1)
-	defer mm_atomic.AddUint64(&mmTranspileFilter.afterTranspileFilterCounter, 1)
+// UpdateNamespacePipelineReleaseIDByID implements repository.Repository
+func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByID(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) (err error) {
+	mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.beforeUpdateNamespacePipelineReleaseIDByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.afterUpdateNamespacePipelineReleaseIDByIDCounter, 1)
 
-	if mmTranspileFilter.inspectFuncTranspileFilter != nil {
-		mmTranspileFilter.inspectFuncTranspileFilter(f1)
+	if mmUpdateNamespacePipelineReleaseIDByID.inspectFuncUpdateNamespacePipelineReleaseIDByID != nil {
+		mmUpdateNamespacePipelineReleaseIDByID.inspectFuncUpdateNamespacePipelineReleaseIDByID(ctx, ownerPermalink, pipelineUID, id, newID)
 	}
 
-	mm_params := RepositoryMockTranspileFilterParams{f1}
+	mm_params := RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
 
 	// Record call args
-	mmTranspileFilter.TranspileFilterMock.mutex.Lock()
-	mmTranspileFilter.TranspileFilterMock.callArgs = append(mmTranspileFilter.TranspileFilterMock.callArgs, &mm_params)
-	mmTranspileFilter.TranspileFilterMock.mutex.Unlock()
+	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.mutex.Lock()
+	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.callArgs = append(mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.callArgs, &mm_params)
+	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.mutex.Unlock()
 
-	for _, e := range mmTranspileFilter.TranspileFilterMock.expectations {
+	for _, e := range mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ep1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmTranspileFilter.TranspileFilterMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmTranspileFilter.TranspileFilterMock.defaultExpectation.Counter, 1)
-		mm_want := mmTranspileFilter.TranspileFilterMock.defaultExpectation.params
-		mm_want_ptrs := mmTranspileFilter.TranspileFilterMock.defaultExpectation.paramPtrs
+	if mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
 
-		mm_got := RepositoryMockTranspileFilterParams{f1}
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+			}
 
-		if mm_want_ptrs != nil {
+			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
+				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
+			}
 
-			if mm_want_ptrs.f1 != nil && !minimock.Equal(*mm_want_ptrs.f1, mm_got.f1) {
-				mmTranspileFilter.t.Errorf("RepositoryMock.TranspileFilter got unexpected parameter f1, want: %#v, got: %#v%s\n", *mm_want_ptrs.f1, mm_got.f1, minimock.Diff(*mm_want_ptrs.f1, mm_got.f1))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.newID != nil && !minimock.Equal(*mm_want_ptrs.newID, mm_got.newID) {
+				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter newID, want: %#v, got: %#v%s\n", *mm_want_ptrs.newID, mm_got.newID, minimock.Diff(*mm_want_ptrs.newID, mm_got.newID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmTranspileFilter.t.Errorf("RepositoryMock.TranspileFilter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmTranspileFilter.TranspileFilterMock.defaultExpectation.results
+		mm_results := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmTranspileFilter.t.Fatal("No results are set for the RepositoryMock.TranspileFilter")
+			mmUpdateNamespacePipelineReleaseIDByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
 		}
-		return (*mm_results).ep1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmTranspileFilter.funcTranspileFilter != nil {
-		return mmTranspileFilter.funcTranspileFilter(f1)
+	if mmUpdateNamespacePipelineReleaseIDByID.funcUpdateNamespacePipelineReleaseIDByID != nil {
+		return mmUpdateNamespacePipelineReleaseIDByID.funcUpdateNamespacePipelineReleaseIDByID(ctx, ownerPermalink, pipelineUID, id, newID)
 	}
-	mmTranspileFilter.t.Fatalf("Unexpected call to RepositoryMock.TranspileFilter. %v", f1)
+	mmUpdateNamespacePipelineReleaseIDByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID. %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, id, newID)
 	return
 }
 
-// TranspileFilterAfterCounter returns a count of finished RepositoryMock.TranspileFilter invocations
-func (mmTranspileFilter *RepositoryMock) TranspileFilterAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmTranspileFilter.afterTranspileFilterCounter)
+// UpdateNamespacePipelineReleaseIDByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineReleaseIDByID invocations
+func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.afterUpdateNamespacePipelineReleaseIDByIDCounter)
 }
 
-// TranspileFilterBeforeCounter returns a count of RepositoryMock.TranspileFilter invocations
-func (mmTranspileFilter *RepositoryMock) TranspileFilterBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmTranspileFilter.beforeTranspileFilterCounter)
+// UpdateNamespacePipelineReleaseIDByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineReleaseIDByID invocations
+func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.beforeUpdateNamespacePipelineReleaseIDByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.TranspileFilter.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmTranspileFilter *mRepositoryMockTranspileFilter) Calls() []*RepositoryMockTranspileFilterParams {
-	mmTranspileFilter.mutex.RLock()
+func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Calls() []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams {
+	mmUpdateNamespacePipelineReleaseIDByID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockTranspileFilterParams, len(mmTranspileFilter.callArgs))
-	copy(argCopy, mmTranspileFilter.callArgs)
+	argCopy := make([]*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams, len(mmUpdateNamespacePipelineReleaseIDByID.callArgs))
+	copy(argCopy, mmUpdateNamespacePipelineReleaseIDByID.callArgs)
 
-	mmTranspileFilter.mutex.RUnlock()
+	mmUpdateNamespacePipelineReleaseIDByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockTranspileFilterDone returns true if the count of the TranspileFilter invocations corresponds
+// MinimockUpdateNamespacePipelineReleaseIDByIDDone returns true if the count of the UpdateNamespacePipelineReleaseIDByID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockTranspileFilterDone() bool {
-	if m.TranspileFilterMock.optional {
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseIDByIDDone() bool {
+	if m.UpdateNamespacePipelineReleaseIDByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.TranspileFilterMock.expectations {
+	for _, e := range m.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.TranspileFilterMock.invocationsDone()
+	return m.UpdateNamespacePipelineReleaseIDByIDMock.invocationsDone()
 }
 
-// MinimockTranspileFilterInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockTranspileFilterInspect() {
-	for _, e := range m.TranspileFilterMock.expectations {
+// MinimockUpdateNamespacePipelineReleaseIDByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseIDByIDInspect() {
+	for _, e := range m.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.TranspileFilter with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID with params: %#v", *e.params)
 		}
 	}
 
-	afterTranspileFilterCounter := mm_atomic.LoadUint64(&m.afterTranspileFilterCounter)
+	afterUpdateNamespacePipelineReleaseIDByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineReleaseIDByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.TranspileFilterMock.defaultExpectation != nil && afterTranspileFilterCounter < 1 {
-		if m.TranspileFilterMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.TranspileFilter")
+	if m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineReleaseIDByIDCounter < 1 {
+		if m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.TranspileFilter with params: %#v", *m.TranspileFilterMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID with params: %#v", *m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcTranspileFilter != nil && afterTranspileFilterCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.TranspileFilter")
+	if m.funcUpdateNamespacePipelineReleaseIDByID != nil && afterUpdateNamespacePipelineReleaseIDByIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
 	}
 
-	if !m.TranspileFilterMock.invocationsDone() && afterTranspileFilterCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.TranspileFilter but found %d calls",
-			mm_atomic.LoadUint64(&m.TranspileFilterMock.expectedInvocations), afterTranspileFilterCounter)
+	if !m.UpdateNamespacePipelineReleaseIDByIDMock.invocationsDone() && afterUpdateNamespacePipelineReleaseIDByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineReleaseIDByID but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineReleaseIDByIDMock.expectedInvocations), afterUpdateNamespacePipelineReleaseIDByIDCounter)
 	}
 }
 
-type mRepositoryMockUpdateNamespacePipelineByUID struct {
+type mRepositoryMockUpdateNamespaceSecretByID struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateNamespacePipelineByUIDExpectation
-	expectations       []*RepositoryMockUpdateNamespacePipelineByUIDExpectation
+	defaultExpectation *RepositoryMockUpdateNamespaceSecretByIDExpectation
+	expectations       []*RepositoryMockUpdateNamespaceSecretByIDExpectation
 
-	callArgs []*RepositoryMockUpdateNamespacePipelineByUIDParams
+	callArgs []*RepositoryMockUpdateNamespaceSecretByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineByUIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineByUID
-type RepositoryMockUpdateNamespacePipelineByUIDExpectation struct {
+// RepositoryMockUpdateNamespaceSecretByIDExpectation specifies expectation struct of the Repository.UpdateNamespaceSecretByID
+type RepositoryMockUpdateNamespaceSecretByIDExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockUpdateNamespacePipelineByUIDParams
-	paramPtrs *RepositoryMockUpdateNamespacePipelineByUIDParamPtrs
-	results   *RepositoryMockUpdateNamespacePipelineByUIDResults
+	params    *RepositoryMockUpdateNamespaceSecretByIDParams
+	paramPtrs *RepositoryMockUpdateNamespaceSecretByIDParamPtrs
+	results   *RepositoryMockUpdateNamespaceSecretByIDResults
 	Counter   uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineByUIDParams contains parameters of the Repository.UpdateNamespacePipelineByUID
-type RepositoryMockUpdateNamespacePipelineByUIDParams struct {
-	ctx      context.Context
-	uid      uuid.UUID
-	pipeline *datamodel.Pipeline
+// RepositoryMockUpdateNamespaceSecretByIDParams contains parameters of the Repository.UpdateNamespaceSecretByID
+type RepositoryMockUpdateNamespaceSecretByIDParams struct {
+	ctx            context.Context
+	ownerPermalink string
+	id             string
+	secret         *datamodel.Secret
 }
 
-// RepositoryMockUpdateNamespacePipelineByUIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineByUID
-type RepositoryMockUpdateNamespacePipelineByUIDParamPtrs struct {
-	ctx      *context.Context
-	uid      *uuid.UUID
-	pipeline **datamodel.Pipeline
+// RepositoryMockUpdateNamespaceSecretByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespaceSecretByID
+type RepositoryMockUpdateNamespaceSecretByIDParamPtrs struct {
+	ctx            *context.Context
+	ownerPermalink *string
+	id             *string
+	secret         **datamodel.Secret
 }
 
-// RepositoryMockUpdateNamespacePipelineByUIDResults contains results of the Repository.UpdateNamespacePipelineByUID
-type RepositoryMockUpdateNamespacePipelineByUIDResults struct {
+// RepositoryMockUpdateNamespaceSecretByIDResults contains results of the Repository.UpdateNamespaceSecretByID
+type RepositoryMockUpdateNamespaceSecretByIDResults struct {
 	err error
 }
 
@@ -11162,1130 +14884,1038 @@ type RepositoryMockUpdateNamespacePipelineByUIDResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Optional() *mRepositoryMockUpdateNamespacePipelineByUID {
-	mmUpdateNamespacePipelineByUID.optional = true
-	return mmUpdateNamespacePipelineByUID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Optional() *mRepositoryMockUpdateNamespaceSecretByID {
+	mmUpdateNamespaceSecretByID.optional = true
+	return mmUpdateNamespaceSecretByID
 }
 
-// Expect sets up expected params for Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Expect(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) *mRepositoryMockUpdateNamespacePipelineByUID {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+// Expect sets up expected params for Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Expect(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by ExpectParams functions")
+	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateNamespacePipelineByUID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
-	for _, e := range mmUpdateNamespacePipelineByUID.expectations {
-		if minimock.Equal(e.params, mmUpdateNamespacePipelineByUID.defaultExpectation.params) {
-			mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineByUID.defaultExpectation.params)
+	mmUpdateNamespaceSecretByID.defaultExpectation.params = &RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
+	for _, e := range mmUpdateNamespaceSecretByID.expectations {
+		if minimock.Equal(e.params, mmUpdateNamespaceSecretByID.defaultExpectation.params) {
+			mmUpdateNamespaceSecretByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespaceSecretByID.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateNamespacePipelineByUID
+	return mmUpdateNamespaceSecretByID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineByUID {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
+	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
 	}
-	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespacePipelineByUID
+	return mmUpdateNamespaceSecretByID
 }
 
-// ExpectUidParam2 sets up expected param uid for Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectUidParam2(uid uuid.UUID) *mRepositoryMockUpdateNamespacePipelineByUID {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
+	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
 	}
-	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.uid = &uid
+	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
 
-	return mmUpdateNamespacePipelineByUID
+	return mmUpdateNamespaceSecretByID
 }
 
-// ExpectPipelineParam3 sets up expected param pipeline for Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) ExpectPipelineParam3(pipeline *datamodel.Pipeline) *mRepositoryMockUpdateNamespacePipelineByUID {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+// ExpectIdParam3 sets up expected param id for Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectIdParam3(id string) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Expect")
+	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineByUIDParamPtrs{}
+	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
+	}
+	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.id = &id
+
+	return mmUpdateNamespaceSecretByID
+}
+
+// ExpectSecretParam4 sets up expected param secret for Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectSecretParam4(secret *datamodel.Secret) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+	}
+
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
+	}
+
+	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
+	}
+
+	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
 	}
-	mmUpdateNamespacePipelineByUID.defaultExpectation.paramPtrs.pipeline = &pipeline
+	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.secret = &secret
 
-	return mmUpdateNamespacePipelineByUID
+	return mmUpdateNamespaceSecretByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Inspect(f func(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline)) *mRepositoryMockUpdateNamespacePipelineByUID {
-	if mmUpdateNamespacePipelineByUID.mock.inspectFuncUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineByUID")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Inspect(f func(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret)) *mRepositoryMockUpdateNamespaceSecretByID {
+	if mmUpdateNamespaceSecretByID.mock.inspectFuncUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespaceSecretByID")
 	}
 
-	mmUpdateNamespacePipelineByUID.mock.inspectFuncUpdateNamespacePipelineByUID = f
+	mmUpdateNamespaceSecretByID.mock.inspectFuncUpdateNamespaceSecretByID = f
 
-	return mmUpdateNamespacePipelineByUID
+	return mmUpdateNamespaceSecretByID
 }
 
-// Return sets up results that will be returned by Repository.UpdateNamespacePipelineByUID
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Return(err error) *RepositoryMock {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdateNamespaceSecretByID
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Return(err error) *RepositoryMock {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineByUID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineByUID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineByUIDExpectation{mock: mmUpdateNamespacePipelineByUID.mock}
+	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
+		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{mock: mmUpdateNamespaceSecretByID.mock}
 	}
-	mmUpdateNamespacePipelineByUID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineByUIDResults{err}
-	return mmUpdateNamespacePipelineByUID.mock
+	mmUpdateNamespaceSecretByID.defaultExpectation.results = &RepositoryMockUpdateNamespaceSecretByIDResults{err}
+	return mmUpdateNamespaceSecretByID.mock
 }
 
-// Set uses given function f to mock the Repository.UpdateNamespacePipelineByUID method
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Set(f func(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) (err error)) *RepositoryMock {
-	if mmUpdateNamespacePipelineByUID.defaultExpectation != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineByUID method")
+// Set uses given function f to mock the Repository.UpdateNamespaceSecretByID method
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Set(f func(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) (err error)) *RepositoryMock {
+	if mmUpdateNamespaceSecretByID.defaultExpectation != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespaceSecretByID method")
 	}
 
-	if len(mmUpdateNamespacePipelineByUID.expectations) > 0 {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineByUID method")
+	if len(mmUpdateNamespaceSecretByID.expectations) > 0 {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespaceSecretByID method")
 	}
 
-	mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID = f
-	return mmUpdateNamespacePipelineByUID.mock
+	mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID = f
+	return mmUpdateNamespaceSecretByID.mock
 }
 
-// When sets expectation for the Repository.UpdateNamespacePipelineByUID which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdateNamespaceSecretByID which will trigger the result defined by the following
 // Then helper
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) When(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) *RepositoryMockUpdateNamespacePipelineByUIDExpectation {
-	if mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineByUID mock is already set by Set")
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) When(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) *RepositoryMockUpdateNamespaceSecretByIDExpectation {
+	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpdateNamespacePipelineByUIDExpectation{
-		mock:   mmUpdateNamespacePipelineByUID.mock,
-		params: &RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline},
+	expectation := &RepositoryMockUpdateNamespaceSecretByIDExpectation{
+		mock:   mmUpdateNamespaceSecretByID.mock,
+		params: &RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret},
 	}
-	mmUpdateNamespacePipelineByUID.expectations = append(mmUpdateNamespacePipelineByUID.expectations, expectation)
+	mmUpdateNamespaceSecretByID.expectations = append(mmUpdateNamespaceSecretByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpdateNamespacePipelineByUID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpdateNamespacePipelineByUIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpdateNamespacePipelineByUIDResults{err}
+// Then sets up Repository.UpdateNamespaceSecretByID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateNamespaceSecretByIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateNamespaceSecretByIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpdateNamespacePipelineByUID should be invoked
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineByUID {
+// Times sets number of times Repository.UpdateNamespaceSecretByID should be invoked
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Times(n uint64) *mRepositoryMockUpdateNamespaceSecretByID {
 	if n == 0 {
-		mmUpdateNamespacePipelineByUID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineByUID mock can not be zero")
+		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespaceSecretByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineByUID.expectedInvocations, n)
-	return mmUpdateNamespacePipelineByUID
+	mm_atomic.StoreUint64(&mmUpdateNamespaceSecretByID.expectedInvocations, n)
+	return mmUpdateNamespaceSecretByID
 }
 
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) invocationsDone() bool {
-	if len(mmUpdateNamespacePipelineByUID.expectations) == 0 && mmUpdateNamespacePipelineByUID.defaultExpectation == nil && mmUpdateNamespacePipelineByUID.mock.funcUpdateNamespacePipelineByUID == nil {
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) invocationsDone() bool {
+	if len(mmUpdateNamespaceSecretByID.expectations) == 0 && mmUpdateNamespaceSecretByID.defaultExpectation == nil && mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.mock.afterUpdateNamespacePipelineByUIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.mock.afterUpdateNamespaceSecretByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateNamespacePipelineByUID implements repository.Repository
-func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUID(ctx context.Context, uid uuid.UUID, pipeline *datamodel.Pipeline) (err error) {
-	mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.beforeUpdateNamespacePipelineByUIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.afterUpdateNamespacePipelineByUIDCounter, 1)
+// UpdateNamespaceSecretByID implements repository.Repository
+func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByID(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) (err error) {
+	mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.beforeUpdateNamespaceSecretByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.afterUpdateNamespaceSecretByIDCounter, 1)
 
-	if mmUpdateNamespacePipelineByUID.inspectFuncUpdateNamespacePipelineByUID != nil {
-		mmUpdateNamespacePipelineByUID.inspectFuncUpdateNamespacePipelineByUID(ctx, uid, pipeline)
+	if mmUpdateNamespaceSecretByID.inspectFuncUpdateNamespaceSecretByID != nil {
+		mmUpdateNamespaceSecretByID.inspectFuncUpdateNamespaceSecretByID(ctx, ownerPermalink, id, secret)
 	}
 
-	mm_params := RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
+	mm_params := RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
 
 	// Record call args
-	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.mutex.Lock()
-	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.callArgs = append(mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.callArgs, &mm_params)
-	mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.mutex.Unlock()
+	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.mutex.Lock()
+	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.callArgs = append(mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.callArgs, &mm_params)
+	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.mutex.Unlock()
 
-	for _, e := range mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.expectations {
+	for _, e := range mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.paramPtrs
+	if mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpdateNamespacePipelineByUIDParams{ctx, uid, pipeline}
+		mm_got := RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.uid != nil && !minimock.Equal(*mm_want_ptrs.uid, mm_got.uid) {
-				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter uid, want: %#v, got: %#v%s\n", *mm_want_ptrs.uid, mm_got.uid, minimock.Diff(*mm_want_ptrs.uid, mm_got.uid))
+			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
+				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
 			}
 
-			if mm_want_ptrs.pipeline != nil && !minimock.Equal(*mm_want_ptrs.pipeline, mm_got.pipeline) {
-				mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameter pipeline, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipeline, mm_got.pipeline, minimock.Diff(*mm_want_ptrs.pipeline, mm_got.pipeline))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.secret != nil && !minimock.Equal(*mm_want_ptrs.secret, mm_got.secret) {
+				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter secret, want: %#v, got: %#v%s\n", *mm_want_ptrs.secret, mm_got.secret, minimock.Diff(*mm_want_ptrs.secret, mm_got.secret))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateNamespacePipelineByUID.t.Errorf("RepositoryMock.UpdateNamespacePipelineByUID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateNamespacePipelineByUID.UpdateNamespacePipelineByUIDMock.defaultExpectation.results
+		mm_results := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateNamespacePipelineByUID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineByUID")
+			mmUpdateNamespaceSecretByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespaceSecretByID")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdateNamespacePipelineByUID.funcUpdateNamespacePipelineByUID != nil {
-		return mmUpdateNamespacePipelineByUID.funcUpdateNamespacePipelineByUID(ctx, uid, pipeline)
+	if mmUpdateNamespaceSecretByID.funcUpdateNamespaceSecretByID != nil {
+		return mmUpdateNamespaceSecretByID.funcUpdateNamespaceSecretByID(ctx, ownerPermalink, id, secret)
 	}
-	mmUpdateNamespacePipelineByUID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineByUID. %v %v %v", ctx, uid, pipeline)
+	mmUpdateNamespaceSecretByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespaceSecretByID. %v %v %v %v", ctx, ownerPermalink, id, secret)
 	return
 }
 
-// UpdateNamespacePipelineByUIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineByUID invocations
-func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.afterUpdateNamespacePipelineByUIDCounter)
+// UpdateNamespaceSecretByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespaceSecretByID invocations
+func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.afterUpdateNamespaceSecretByIDCounter)
 }
 
-// UpdateNamespacePipelineByUIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineByUID invocations
-func (mmUpdateNamespacePipelineByUID *RepositoryMock) UpdateNamespacePipelineByUIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineByUID.beforeUpdateNamespacePipelineByUIDCounter)
+// UpdateNamespaceSecretByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespaceSecretByID invocations
+func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.beforeUpdateNamespaceSecretByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineByUID.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespaceSecretByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateNamespacePipelineByUID *mRepositoryMockUpdateNamespacePipelineByUID) Calls() []*RepositoryMockUpdateNamespacePipelineByUIDParams {
-	mmUpdateNamespacePipelineByUID.mutex.RLock()
+func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Calls() []*RepositoryMockUpdateNamespaceSecretByIDParams {
+	mmUpdateNamespaceSecretByID.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockUpdateNamespacePipelineByUIDParams, len(mmUpdateNamespacePipelineByUID.callArgs))
-	copy(argCopy, mmUpdateNamespacePipelineByUID.callArgs)
+	argCopy := make([]*RepositoryMockUpdateNamespaceSecretByIDParams, len(mmUpdateNamespaceSecretByID.callArgs))
+	copy(argCopy, mmUpdateNamespaceSecretByID.callArgs)
 
-	mmUpdateNamespacePipelineByUID.mutex.RUnlock()
+	mmUpdateNamespaceSecretByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateNamespacePipelineByUIDDone returns true if the count of the UpdateNamespacePipelineByUID invocations corresponds
+// MinimockUpdateNamespaceSecretByIDDone returns true if the count of the UpdateNamespaceSecretByID invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineByUIDDone() bool {
-	if m.UpdateNamespacePipelineByUIDMock.optional {
+func (m *RepositoryMock) MinimockUpdateNamespaceSecretByIDDone() bool {
+	if m.UpdateNamespaceSecretByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateNamespacePipelineByUIDMock.expectations {
+	for _, e := range m.UpdateNamespaceSecretByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateNamespacePipelineByUIDMock.invocationsDone()
+	return m.UpdateNamespaceSecretByIDMock.invocationsDone()
 }
 
-// MinimockUpdateNamespacePipelineByUIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineByUIDInspect() {
-	for _, e := range m.UpdateNamespacePipelineByUIDMock.expectations {
+// MinimockUpdateNamespaceSecretByIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateNamespaceSecretByIDInspect() {
+	for _, e := range m.UpdateNamespaceSecretByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineByUID with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespaceSecretByID with params: %#v", *e.params)
 		}
 	}
 
-	afterUpdateNamespacePipelineByUIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineByUIDCounter)
+	afterUpdateNamespaceSecretByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespaceSecretByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateNamespacePipelineByUIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineByUIDCounter < 1 {
-		if m.UpdateNamespacePipelineByUIDMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineByUID")
+	if m.UpdateNamespaceSecretByIDMock.defaultExpectation != nil && afterUpdateNamespaceSecretByIDCounter < 1 {
+		if m.UpdateNamespaceSecretByIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdateNamespaceSecretByID")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineByUID with params: %#v", *m.UpdateNamespacePipelineByUIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespaceSecretByID with params: %#v", *m.UpdateNamespaceSecretByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateNamespacePipelineByUID != nil && afterUpdateNamespacePipelineByUIDCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineByUID")
+	if m.funcUpdateNamespaceSecretByID != nil && afterUpdateNamespaceSecretByIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdateNamespaceSecretByID")
 	}
 
-	if !m.UpdateNamespacePipelineByUIDMock.invocationsDone() && afterUpdateNamespacePipelineByUIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineByUID but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineByUIDMock.expectedInvocations), afterUpdateNamespacePipelineByUIDCounter)
+	if !m.UpdateNamespaceSecretByIDMock.invocationsDone() && afterUpdateNamespaceSecretByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespaceSecretByID but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateNamespaceSecretByIDMock.expectedInvocations), afterUpdateNamespaceSecretByIDCounter)
 	}
 }
 
-type mRepositoryMockUpdateNamespacePipelineIDByID struct {
+type mRepositoryMockUpdatePipelineRun struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateNamespacePipelineIDByIDExpectation
-	expectations       []*RepositoryMockUpdateNamespacePipelineIDByIDExpectation
+	defaultExpectation *RepositoryMockUpdatePipelineRunExpectation
+	expectations       []*RepositoryMockUpdatePipelineRunExpectation
 
-	callArgs []*RepositoryMockUpdateNamespacePipelineIDByIDParams
+	callArgs []*RepositoryMockUpdatePipelineRunParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineIDByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineIDByID
-type RepositoryMockUpdateNamespacePipelineIDByIDExpectation struct {
+// RepositoryMockUpdatePipelineRunExpectation specifies expectation struct of the Repository.UpdatePipelineRun
+type RepositoryMockUpdatePipelineRunExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockUpdateNamespacePipelineIDByIDParams
-	paramPtrs *RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs
-	results   *RepositoryMockUpdateNamespacePipelineIDByIDResults
+	params    *RepositoryMockUpdatePipelineRunParams
+	paramPtrs *RepositoryMockUpdatePipelineRunParamPtrs
+	results   *RepositoryMockUpdatePipelineRunResults
 	Counter   uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineIDByIDParams contains parameters of the Repository.UpdateNamespacePipelineIDByID
-type RepositoryMockUpdateNamespacePipelineIDByIDParams struct {
-	ctx            context.Context
-	ownerPermalink string
-	id             string
-	newID          string
-}
-
-// RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineIDByID
-type RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs struct {
-	ctx            *context.Context
-	ownerPermalink *string
-	id             *string
-	newID          *string
-}
-
-// RepositoryMockUpdateNamespacePipelineIDByIDResults contains results of the Repository.UpdateNamespacePipelineIDByID
-type RepositoryMockUpdateNamespacePipelineIDByIDResults struct {
-	err error
-}
-
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Optional() *mRepositoryMockUpdateNamespacePipelineIDByID {
-	mmUpdateNamespacePipelineIDByID.optional = true
-	return mmUpdateNamespacePipelineIDByID
-}
-
-// Expect sets up expected params for Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Expect(ctx context.Context, ownerPermalink string, id string, newID string) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
-	}
+// RepositoryMockUpdatePipelineRunParams contains parameters of the Repository.UpdatePipelineRun
+type RepositoryMockUpdatePipelineRunParams struct {
+	ctx               context.Context
+	pipelineTriggerID string
+	run               *datamodel.PipelineRun
+}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by ExpectParams functions")
-	}
+// RepositoryMockUpdatePipelineRunParamPtrs contains pointers to parameters of the Repository.UpdatePipelineRun
+type RepositoryMockUpdatePipelineRunParamPtrs struct {
+	ctx               *context.Context
+	pipelineTriggerID *string
+	run               **datamodel.PipelineRun
+}
 
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
-	for _, e := range mmUpdateNamespacePipelineIDByID.expectations {
-		if minimock.Equal(e.params, mmUpdateNamespacePipelineIDByID.defaultExpectation.params) {
-			mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineIDByID.defaultExpectation.params)
-		}
-	}
+// RepositoryMockUpdatePipelineRunResults contains results of the Repository.UpdatePipelineRun
+type RepositoryMockUpdatePipelineRunResults struct {
+	err error
+}
 
-	return mmUpdateNamespacePipelineIDByID
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Optional() *mRepositoryMockUpdatePipelineRun {
+	mmUpdatePipelineRun.optional = true
+	return mmUpdatePipelineRun
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+// Expect sets up expected params for Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Expect(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) *mRepositoryMockUpdatePipelineRun {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
+	if mmUpdatePipelineRun.defaultExpectation == nil {
+		mmUpdatePipelineRun.defaultExpectation = &RepositoryMockUpdatePipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
+	if mmUpdatePipelineRun.defaultExpectation.paramPtrs != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by ExpectParams functions")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
+	mmUpdatePipelineRun.defaultExpectation.params = &RepositoryMockUpdatePipelineRunParams{ctx, pipelineTriggerID, run}
+	for _, e := range mmUpdatePipelineRun.expectations {
+		if minimock.Equal(e.params, mmUpdatePipelineRun.defaultExpectation.params) {
+			mmUpdatePipelineRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePipelineRun.defaultExpectation.params)
+		}
 	}
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespacePipelineIDByID
+	return mmUpdatePipelineRun
 }
 
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdatePipelineRun {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
+	if mmUpdatePipelineRun.defaultExpectation == nil {
+		mmUpdatePipelineRun.defaultExpectation = &RepositoryMockUpdatePipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
+	if mmUpdatePipelineRun.defaultExpectation.params != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
+	if mmUpdatePipelineRun.defaultExpectation.paramPtrs == nil {
+		mmUpdatePipelineRun.defaultExpectation.paramPtrs = &RepositoryMockUpdatePipelineRunParamPtrs{}
 	}
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+	mmUpdatePipelineRun.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespacePipelineIDByID
+	return mmUpdatePipelineRun
 }
 
-// ExpectIdParam3 sets up expected param id for Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectIdParam3(id string) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+// ExpectPipelineTriggerIDParam2 sets up expected param pipelineTriggerID for Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) ExpectPipelineTriggerIDParam2(pipelineTriggerID string) *mRepositoryMockUpdatePipelineRun {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
+	if mmUpdatePipelineRun.defaultExpectation == nil {
+		mmUpdatePipelineRun.defaultExpectation = &RepositoryMockUpdatePipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
+	if mmUpdatePipelineRun.defaultExpectation.params != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
+	if mmUpdatePipelineRun.defaultExpectation.paramPtrs == nil {
+		mmUpdatePipelineRun.defaultExpectation.paramPtrs = &RepositoryMockUpdatePipelineRunParamPtrs{}
 	}
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePipelineRun.defaultExpectation.paramPtrs.pipelineTriggerID = &pipelineTriggerID
 
-	return mmUpdateNamespacePipelineIDByID
+	return mmUpdatePipelineRun
 }
 
-// ExpectNewIDParam4 sets up expected param newID for Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) ExpectNewIDParam4(newID string) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+// ExpectRunParam3 sets up expected param run for Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) ExpectRunParam3(run *datamodel.PipelineRun) *mRepositoryMockUpdatePipelineRun {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{}
+	if mmUpdatePipelineRun.defaultExpectation == nil {
+		mmUpdatePipelineRun.defaultExpectation = &RepositoryMockUpdatePipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Expect")
+	if mmUpdatePipelineRun.defaultExpectation.params != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineIDByIDParamPtrs{}
+	if mmUpdatePipelineRun.defaultExpectation.paramPtrs == nil {
+		mmUpdatePipelineRun.defaultExpectation.paramPtrs = &RepositoryMockUpdatePipelineRunParamPtrs{}
 	}
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.paramPtrs.newID = &newID
+	mmUpdatePipelineRun.defaultExpectation.paramPtrs.run = &run
 
-	return mmUpdateNamespacePipelineIDByID
+	return mmUpdatePipelineRun
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Inspect(f func(ctx context.Context, ownerPermalink string, id string, newID string)) *mRepositoryMockUpdateNamespacePipelineIDByID {
-	if mmUpdateNamespacePipelineIDByID.mock.inspectFuncUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineIDByID")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Inspect(f func(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun)) *mRepositoryMockUpdatePipelineRun {
+	if mmUpdatePipelineRun.mock.inspectFuncUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdatePipelineRun")
 	}
 
-	mmUpdateNamespacePipelineIDByID.mock.inspectFuncUpdateNamespacePipelineIDByID = f
+	mmUpdatePipelineRun.mock.inspectFuncUpdatePipelineRun = f
 
-	return mmUpdateNamespacePipelineIDByID
+	return mmUpdatePipelineRun
 }
 
-// Return sets up results that will be returned by Repository.UpdateNamespacePipelineIDByID
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Return(err error) *RepositoryMock {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpdatePipelineRun
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Return(err error) *RepositoryMock {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{mock: mmUpdateNamespacePipelineIDByID.mock}
+	if mmUpdatePipelineRun.defaultExpectation == nil {
+		mmUpdatePipelineRun.defaultExpectation = &RepositoryMockUpdatePipelineRunExpectation{mock: mmUpdatePipelineRun.mock}
 	}
-	mmUpdateNamespacePipelineIDByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineIDByIDResults{err}
-	return mmUpdateNamespacePipelineIDByID.mock
+	mmUpdatePipelineRun.defaultExpectation.results = &RepositoryMockUpdatePipelineRunResults{err}
+	return mmUpdatePipelineRun.mock
 }
 
-// Set uses given function f to mock the Repository.UpdateNamespacePipelineIDByID method
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Set(f func(ctx context.Context, ownerPermalink string, id string, newID string) (err error)) *RepositoryMock {
-	if mmUpdateNamespacePipelineIDByID.defaultExpectation != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineIDByID method")
+// Set uses given function f to mock the Repository.UpdatePipelineRun method
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Set(f func(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) (err error)) *RepositoryMock {
+	if mmUpdatePipelineRun.defaultExpectation != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("Default expectation is already set for the Repository.UpdatePipelineRun method")
 	}
 
-	if len(mmUpdateNamespacePipelineIDByID.expectations) > 0 {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineIDByID method")
+	if len(mmUpdatePipelineRun.expectations) > 0 {
+		mmUpdatePipelineRun.mock.t.Fatalf("Some expectations are already set for the Repository.UpdatePipelineRun method")
 	}
 
-	mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID = f
-	return mmUpdateNamespacePipelineIDByID.mock
+	mmUpdatePipelineRun.mock.funcUpdatePipelineRun = f
+	return mmUpdatePipelineRun.mock
 }
 
-// When sets expectation for the Repository.UpdateNamespacePipelineIDByID which will trigger the result defined by the following
+// When sets expectation for the Repository.UpdatePipelineRun which will trigger the result defined by the following
 // Then helper
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) When(ctx context.Context, ownerPermalink string, id string, newID string) *RepositoryMockUpdateNamespacePipelineIDByIDExpectation {
-	if mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineIDByID mock is already set by Set")
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) When(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) *RepositoryMockUpdatePipelineRunExpectation {
+	if mmUpdatePipelineRun.mock.funcUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.mock.t.Fatalf("RepositoryMock.UpdatePipelineRun mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpdateNamespacePipelineIDByIDExpectation{
-		mock:   mmUpdateNamespacePipelineIDByID.mock,
-		params: &RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID},
+	expectation := &RepositoryMockUpdatePipelineRunExpectation{
+		mock:   mmUpdatePipelineRun.mock,
+		params: &RepositoryMockUpdatePipelineRunParams{ctx, pipelineTriggerID, run},
 	}
-	mmUpdateNamespacePipelineIDByID.expectations = append(mmUpdateNamespacePipelineIDByID.expectations, expectation)
+	mmUpdatePipelineRun.expectations = append(mmUpdatePipelineRun.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpdateNamespacePipelineIDByID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpdateNamespacePipelineIDByIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpdateNamespacePipelineIDByIDResults{err}
+// Then sets up Repository.UpdatePipelineRun return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdatePipelineRunExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdatePipelineRunResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpdateNamespacePipelineIDByID should be invoked
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineIDByID {
+// Times sets number of times Repository.UpdatePipelineRun should be invoked
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Times(n uint64) *mRepositoryMockUpdatePipelineRun {
 	if n == 0 {
-		mmUpdateNamespacePipelineIDByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineIDByID mock can not be zero")
+		mmUpdatePipelineRun.mock.t.Fatalf("Times of RepositoryMock.UpdatePipelineRun mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineIDByID.expectedInvocations, n)
-	return mmUpdateNamespacePipelineIDByID
+	mm_atomic.StoreUint64(&mmUpdatePipelineRun.expectedInvocations, n)
+	return mmUpdatePipelineRun
 }
 
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) invocationsDone() bool {
-	if len(mmUpdateNamespacePipelineIDByID.expectations) == 0 && mmUpdateNamespacePipelineIDByID.defaultExpectation == nil && mmUpdateNamespacePipelineIDByID.mock.funcUpdateNamespacePipelineIDByID == nil {
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) invocationsDone() bool {
+	if len(mmUpdatePipelineRun.expectations) == 0 && mmUpdatePipelineRun.defaultExpectation == nil && mmUpdatePipelineRun.mock.funcUpdatePipelineRun == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.mock.afterUpdateNamespacePipelineIDByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePipelineRun.mock.afterUpdatePipelineRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePipelineRun.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateNamespacePipelineIDByID implements repository.Repository
-func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByID(ctx context.Context, ownerPermalink string, id string, newID string) (err error) {
-	mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.beforeUpdateNamespacePipelineIDByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.afterUpdateNamespacePipelineIDByIDCounter, 1)
+// UpdatePipelineRun implements repository.Repository
+func (mmUpdatePipelineRun *RepositoryMock) UpdatePipelineRun(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePipelineRun.beforeUpdatePipelineRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePipelineRun.afterUpdatePipelineRunCounter, 1)
 
-	if mmUpdateNamespacePipelineIDByID.inspectFuncUpdateNamespacePipelineIDByID != nil {
-		mmUpdateNamespacePipelineIDByID.inspectFuncUpdateNamespacePipelineIDByID(ctx, ownerPermalink, id, newID)
+	if mmUpdatePipelineRun.inspectFuncUpdatePipelineRun != nil {
+		mmUpdatePipelineRun.inspectFuncUpdatePipelineRun(ctx, pipelineTriggerID, run)
 	}
 
-	mm_params := RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
+	mm_params := RepositoryMockUpdatePipelineRunParams{ctx, pipelineTriggerID, run}
 
 	// Record call args
-	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.mutex.Lock()
-	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.callArgs = append(mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.callArgs, &mm_params)
-	mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.mutex.Unlock()
+	mmUpdatePipelineRun.UpdatePipelineRunMock.mutex.Lock()
+	mmUpdatePipelineRun.UpdatePipelineRunMock.callArgs = append(mmUpdatePipelineRun.UpdatePipelineRunMock.callArgs, &mm_params)
+	mmUpdatePipelineRun.UpdatePipelineRunMock.mutex.Unlock()
 
-	for _, e := range mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.expectations {
+	for _, e := range mmUpdatePipelineRun.UpdatePipelineRunMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.paramPtrs
+	if mmUpdatePipelineRun.UpdatePipelineRunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePipelineRun.UpdatePipelineRunMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePipelineRun.UpdatePipelineRunMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePipelineRun.UpdatePipelineRunMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpdateNamespacePipelineIDByIDParams{ctx, ownerPermalink, id, newID}
+		mm_got := RepositoryMockUpdatePipelineRunParams{ctx, pipelineTriggerID, run}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
+				mmUpdatePipelineRun.t.Errorf("RepositoryMock.UpdatePipelineRun got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.pipelineTriggerID != nil && !minimock.Equal(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID) {
+				mmUpdatePipelineRun.t.Errorf("RepositoryMock.UpdatePipelineRun got unexpected parameter pipelineTriggerID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID, minimock.Diff(*mm_want_ptrs.pipelineTriggerID, mm_got.pipelineTriggerID))
 			}
 
-			if mm_want_ptrs.newID != nil && !minimock.Equal(*mm_want_ptrs.newID, mm_got.newID) {
-				mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameter newID, want: %#v, got: %#v%s\n", *mm_want_ptrs.newID, mm_got.newID, minimock.Diff(*mm_want_ptrs.newID, mm_got.newID))
+			if mm_want_ptrs.run != nil && !minimock.Equal(*mm_want_ptrs.run, mm_got.run) {
+				mmUpdatePipelineRun.t.Errorf("RepositoryMock.UpdatePipelineRun got unexpected parameter run, want: %#v, got: %#v%s\n", *mm_want_ptrs.run, mm_got.run, minimock.Diff(*mm_want_ptrs.run, mm_got.run))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateNamespacePipelineIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineIDByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePipelineRun.t.Errorf("RepositoryMock.UpdatePipelineRun got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateNamespacePipelineIDByID.UpdateNamespacePipelineIDByIDMock.defaultExpectation.results
+		mm_results := mmUpdatePipelineRun.UpdatePipelineRunMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateNamespacePipelineIDByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineIDByID")
-		}
-		return (*mm_results).err
-	}
-	if mmUpdateNamespacePipelineIDByID.funcUpdateNamespacePipelineIDByID != nil {
-		return mmUpdateNamespacePipelineIDByID.funcUpdateNamespacePipelineIDByID(ctx, ownerPermalink, id, newID)
-	}
-	mmUpdateNamespacePipelineIDByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineIDByID. %v %v %v %v", ctx, ownerPermalink, id, newID)
-	return
-}
-
-// UpdateNamespacePipelineIDByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineIDByID invocations
-func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.afterUpdateNamespacePipelineIDByIDCounter)
-}
-
-// UpdateNamespacePipelineIDByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineIDByID invocations
-func (mmUpdateNamespacePipelineIDByID *RepositoryMock) UpdateNamespacePipelineIDByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineIDByID.beforeUpdateNamespacePipelineIDByIDCounter)
-}
-
-// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineIDByID.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateNamespacePipelineIDByID *mRepositoryMockUpdateNamespacePipelineIDByID) Calls() []*RepositoryMockUpdateNamespacePipelineIDByIDParams {
-	mmUpdateNamespacePipelineIDByID.mutex.RLock()
-
-	argCopy := make([]*RepositoryMockUpdateNamespacePipelineIDByIDParams, len(mmUpdateNamespacePipelineIDByID.callArgs))
-	copy(argCopy, mmUpdateNamespacePipelineIDByID.callArgs)
-
-	mmUpdateNamespacePipelineIDByID.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockUpdateNamespacePipelineIDByIDDone returns true if the count of the UpdateNamespacePipelineIDByID invocations corresponds
-// the number of defined expectations
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineIDByIDDone() bool {
-	if m.UpdateNamespacePipelineIDByIDMock.optional {
-		// Optional methods provide '0 or more' call count restriction.
-		return true
-	}
-
-	for _, e := range m.UpdateNamespacePipelineIDByIDMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	return m.UpdateNamespacePipelineIDByIDMock.invocationsDone()
-}
-
-// MinimockUpdateNamespacePipelineIDByIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineIDByIDInspect() {
-	for _, e := range m.UpdateNamespacePipelineIDByIDMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID with params: %#v", *e.params)
-		}
-	}
-
-	afterUpdateNamespacePipelineIDByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineIDByIDCounter)
-	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateNamespacePipelineIDByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineIDByIDCounter < 1 {
-		if m.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID")
-		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID with params: %#v", *m.UpdateNamespacePipelineIDByIDMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateNamespacePipelineIDByID != nil && afterUpdateNamespacePipelineIDByIDCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineIDByID")
-	}
-
-	if !m.UpdateNamespacePipelineIDByIDMock.invocationsDone() && afterUpdateNamespacePipelineIDByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineIDByID but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineIDByIDMock.expectedInvocations), afterUpdateNamespacePipelineIDByIDCounter)
-	}
-}
-
-type mRepositoryMockUpdateNamespacePipelineReleaseByID struct {
-	optional           bool
-	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation
-	expectations       []*RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation
-
-	callArgs []*RepositoryMockUpdateNamespacePipelineReleaseByIDParams
-	mutex    sync.RWMutex
-
-	expectedInvocations uint64
-}
-
-// RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineReleaseByID
-type RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation struct {
-	mock      *RepositoryMock
-	params    *RepositoryMockUpdateNamespacePipelineReleaseByIDParams
-	paramPtrs *RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs
-	results   *RepositoryMockUpdateNamespacePipelineReleaseByIDResults
-	Counter   uint64
-}
-
-// RepositoryMockUpdateNamespacePipelineReleaseByIDParams contains parameters of the Repository.UpdateNamespacePipelineReleaseByID
-type RepositoryMockUpdateNamespacePipelineReleaseByIDParams struct {
-	ctx             context.Context
-	ownerPermalink  string
-	pipelineUID     uuid.UUID
-	id              string
-	pipelineRelease *datamodel.PipelineRelease
-}
-
-// RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineReleaseByID
-type RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs struct {
-	ctx             *context.Context
-	ownerPermalink  *string
-	pipelineUID     *uuid.UUID
-	id              *string
-	pipelineRelease **datamodel.PipelineRelease
+			mmUpdatePipelineRun.t.Fatal("No results are set for the RepositoryMock.UpdatePipelineRun")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePipelineRun.funcUpdatePipelineRun != nil {
+		return mmUpdatePipelineRun.funcUpdatePipelineRun(ctx, pipelineTriggerID, run)
+	}
+	mmUpdatePipelineRun.t.Fatalf("Unexpected call to RepositoryMock.UpdatePipelineRun. %v %v %v", ctx, pipelineTriggerID, run)
+	return
 }
 
-// RepositoryMockUpdateNamespacePipelineReleaseByIDResults contains results of the Repository.UpdateNamespacePipelineReleaseByID
-type RepositoryMockUpdateNamespacePipelineReleaseByIDResults struct {
-	err error
+// UpdatePipelineRunAfterCounter returns a count of finished RepositoryMock.UpdatePipelineRun invocations
+func (mmUpdatePipelineRun *RepositoryMock) UpdatePipelineRunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePipelineRun.afterUpdatePipelineRunCounter)
 }
 
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Optional() *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	mmUpdateNamespacePipelineReleaseByID.optional = true
-	return mmUpdateNamespacePipelineReleaseByID
+// UpdatePipelineRunBeforeCounter returns a count of RepositoryMock.UpdatePipelineRun invocations
+func (mmUpdatePipelineRun *RepositoryMock) UpdatePipelineRunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePipelineRun.beforeUpdatePipelineRunCounter)
 }
 
-// Expect sets up expected params for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
-	}
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdatePipelineRun.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePipelineRun *mRepositoryMockUpdatePipelineRun) Calls() []*RepositoryMockUpdatePipelineRunParams {
+	mmUpdatePipelineRun.mutex.RLock()
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
-	}
+	argCopy := make([]*RepositoryMockUpdatePipelineRunParams, len(mmUpdatePipelineRun.callArgs))
+	copy(argCopy, mmUpdatePipelineRun.callArgs)
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by ExpectParams functions")
+	mmUpdatePipelineRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePipelineRunDone returns true if the count of the UpdatePipelineRun invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdatePipelineRunDone() bool {
+	if m.UpdatePipelineRunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
-	for _, e := range mmUpdateNamespacePipelineReleaseByID.expectations {
-		if minimock.Equal(e.params, mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params) {
-			mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params)
+	for _, e := range m.UpdatePipelineRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
 		}
 	}
 
-	return mmUpdateNamespacePipelineReleaseByID
+	return m.UpdatePipelineRunMock.invocationsDone()
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+// MinimockUpdatePipelineRunInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdatePipelineRunInspect() {
+	for _, e := range m.UpdatePipelineRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePipelineRun with params: %#v", *e.params)
+		}
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	afterUpdatePipelineRunCounter := mm_atomic.LoadUint64(&m.afterUpdatePipelineRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePipelineRunMock.defaultExpectation != nil && afterUpdatePipelineRunCounter < 1 {
+		if m.UpdatePipelineRunMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpdatePipelineRun")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePipelineRun with params: %#v", *m.UpdatePipelineRunMock.defaultExpectation.params)
+		}
 	}
-
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePipelineRun != nil && afterUpdatePipelineRunCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpdatePipelineRun")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	if !m.UpdatePipelineRunMock.invocationsDone() && afterUpdatePipelineRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdatePipelineRun but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePipelineRunMock.expectedInvocations), afterUpdatePipelineRunCounter)
 	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.ctx = &ctx
+}
 
-	return mmUpdateNamespacePipelineReleaseByID
+type mRepositoryMockUpsertComponentDefinition struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpsertComponentDefinitionExpectation
+	expectations       []*RepositoryMockUpsertComponentDefinitionExpectation
+
+	callArgs []*RepositoryMockUpsertComponentDefinitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
 }
 
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
-	}
+// RepositoryMockUpsertComponentDefinitionExpectation specifies expectation struct of the Repository.UpsertComponentDefinition
+type RepositoryMockUpsertComponentDefinitionExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockUpsertComponentDefinitionParams
+	paramPtrs *RepositoryMockUpsertComponentDefinitionParamPtrs
+	results   *RepositoryMockUpsertComponentDefinitionResults
+	Counter   uint64
+}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
-	}
+// RepositoryMockUpsertComponentDefinitionParams contains parameters of the Repository.UpsertComponentDefinition
+type RepositoryMockUpsertComponentDefinitionParams struct {
+	ctx context.Context
+	cp1 *pb.ComponentDefinition
+}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
-	}
+// RepositoryMockUpsertComponentDefinitionParamPtrs contains pointers to parameters of the Repository.UpsertComponentDefinition
+type RepositoryMockUpsertComponentDefinitionParamPtrs struct {
+	ctx *context.Context
+	cp1 **pb.ComponentDefinition
+}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
-	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
+// RepositoryMockUpsertComponentDefinitionResults contains results of the Repository.UpsertComponentDefinition
+type RepositoryMockUpsertComponentDefinitionResults struct {
+	err error
+}
 
-	return mmUpdateNamespacePipelineReleaseByID
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Optional() *mRepositoryMockUpsertComponentDefinition {
+	mmUpsertComponentDefinition.optional = true
+	return mmUpsertComponentDefinition
 }
 
-// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+// Expect sets up expected params for Repository.UpsertComponentDefinition
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Expect(ctx context.Context, cp1 *pb.ComponentDefinition) *mRepositoryMockUpsertComponentDefinition {
+	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	if mmUpsertComponentDefinition.defaultExpectation == nil {
+		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by ExpectParams functions")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	mmUpsertComponentDefinition.defaultExpectation.params = &RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
+	for _, e := range mmUpsertComponentDefinition.expectations {
+		if minimock.Equal(e.params, mmUpsertComponentDefinition.defaultExpectation.params) {
+			mmUpsertComponentDefinition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertComponentDefinition.defaultExpectation.params)
+		}
 	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
 
-	return mmUpdateNamespacePipelineReleaseByID
+	return mmUpsertComponentDefinition
 }
 
-// ExpectIdParam4 sets up expected param id for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectIdParam4(id string) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertComponentDefinition
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertComponentDefinition {
+	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	if mmUpsertComponentDefinition.defaultExpectation == nil {
+		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	if mmUpsertComponentDefinition.defaultExpectation.params != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs == nil {
+		mmUpsertComponentDefinition.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentDefinitionParamPtrs{}
 	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.id = &id
+	mmUpsertComponentDefinition.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespacePipelineReleaseByID
+	return mmUpsertComponentDefinition
 }
 
-// ExpectPipelineReleaseParam5 sets up expected param pipelineRelease for Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) ExpectPipelineReleaseParam5(pipelineRelease *datamodel.PipelineRelease) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+// ExpectCp1Param2 sets up expected param cp1 for Repository.UpsertComponentDefinition
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) ExpectCp1Param2(cp1 *pb.ComponentDefinition) *mRepositoryMockUpsertComponentDefinition {
+	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{}
+	if mmUpsertComponentDefinition.defaultExpectation == nil {
+		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Expect")
+	if mmUpsertComponentDefinition.defaultExpectation.params != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseByIDParamPtrs{}
+	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs == nil {
+		mmUpsertComponentDefinition.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentDefinitionParamPtrs{}
 	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.paramPtrs.pipelineRelease = &pipelineRelease
+	mmUpsertComponentDefinition.defaultExpectation.paramPtrs.cp1 = &cp1
 
-	return mmUpdateNamespacePipelineReleaseByID
+	return mmUpsertComponentDefinition
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease)) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
-	if mmUpdateNamespacePipelineReleaseByID.mock.inspectFuncUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineReleaseByID")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpsertComponentDefinition
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Inspect(f func(ctx context.Context, cp1 *pb.ComponentDefinition)) *mRepositoryMockUpsertComponentDefinition {
+	if mmUpsertComponentDefinition.mock.inspectFuncUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertComponentDefinition")
 	}
 
-	mmUpdateNamespacePipelineReleaseByID.mock.inspectFuncUpdateNamespacePipelineReleaseByID = f
+	mmUpsertComponentDefinition.mock.inspectFuncUpsertComponentDefinition = f
 
-	return mmUpdateNamespacePipelineReleaseByID
+	return mmUpsertComponentDefinition
 }
 
-// Return sets up results that will be returned by Repository.UpdateNamespacePipelineReleaseByID
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Return(err error) *RepositoryMock {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpsertComponentDefinition
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Return(err error) *RepositoryMock {
+	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{mock: mmUpdateNamespacePipelineReleaseByID.mock}
+	if mmUpsertComponentDefinition.defaultExpectation == nil {
+		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{mock: mmUpsertComponentDefinition.mock}
 	}
-	mmUpdateNamespacePipelineReleaseByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineReleaseByIDResults{err}
-	return mmUpdateNamespacePipelineReleaseByID.mock
+	mmUpsertComponentDefinition.defaultExpectation.results = &RepositoryMockUpsertComponentDefinitionResults{err}
+	return mmUpsertComponentDefinition.mock
 }
 
-// Set uses given function f to mock the Repository.UpdateNamespacePipelineReleaseByID method
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) (err error)) *RepositoryMock {
-	if mmUpdateNamespacePipelineReleaseByID.defaultExpectation != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineReleaseByID method")
+// Set uses given function f to mock the Repository.UpsertComponentDefinition method
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Set(f func(ctx context.Context, cp1 *pb.ComponentDefinition) (err error)) *RepositoryMock {
+	if mmUpsertComponentDefinition.defaultExpectation != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertComponentDefinition method")
 	}
 
-	if len(mmUpdateNamespacePipelineReleaseByID.expectations) > 0 {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineReleaseByID method")
+	if len(mmUpsertComponentDefinition.expectations) > 0 {
+		mmUpsertComponentDefinition.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertComponentDefinition method")
 	}
 
-	mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID = f
-	return mmUpdateNamespacePipelineReleaseByID.mock
+	mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition = f
+	return mmUpsertComponentDefinition.mock
 }
 
-// When sets expectation for the Repository.UpdateNamespacePipelineReleaseByID which will trigger the result defined by the following
+// When sets expectation for the Repository.UpsertComponentDefinition which will trigger the result defined by the following
 // Then helper
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation {
-	if mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseByID mock is already set by Set")
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) When(ctx context.Context, cp1 *pb.ComponentDefinition) *RepositoryMockUpsertComponentDefinitionExpectation {
+	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation{
-		mock:   mmUpdateNamespacePipelineReleaseByID.mock,
-		params: &RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease},
+	expectation := &RepositoryMockUpsertComponentDefinitionExpectation{
+		mock:   mmUpsertComponentDefinition.mock,
+		params: &RepositoryMockUpsertComponentDefinitionParams{ctx, cp1},
 	}
-	mmUpdateNamespacePipelineReleaseByID.expectations = append(mmUpdateNamespacePipelineReleaseByID.expectations, expectation)
+	mmUpsertComponentDefinition.expectations = append(mmUpsertComponentDefinition.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpdateNamespacePipelineReleaseByID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpdateNamespacePipelineReleaseByIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpdateNamespacePipelineReleaseByIDResults{err}
+// Then sets up Repository.UpsertComponentDefinition return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpsertComponentDefinitionExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpsertComponentDefinitionResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpdateNamespacePipelineReleaseByID should be invoked
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineReleaseByID {
+// Times sets number of times Repository.UpsertComponentDefinition should be invoked
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Times(n uint64) *mRepositoryMockUpsertComponentDefinition {
 	if n == 0 {
-		mmUpdateNamespacePipelineReleaseByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineReleaseByID mock can not be zero")
+		mmUpsertComponentDefinition.mock.t.Fatalf("Times of RepositoryMock.UpsertComponentDefinition mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineReleaseByID.expectedInvocations, n)
-	return mmUpdateNamespacePipelineReleaseByID
+	mm_atomic.StoreUint64(&mmUpsertComponentDefinition.expectedInvocations, n)
+	return mmUpsertComponentDefinition
 }
 
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) invocationsDone() bool {
-	if len(mmUpdateNamespacePipelineReleaseByID.expectations) == 0 && mmUpdateNamespacePipelineReleaseByID.defaultExpectation == nil && mmUpdateNamespacePipelineReleaseByID.mock.funcUpdateNamespacePipelineReleaseByID == nil {
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) invocationsDone() bool {
+	if len(mmUpsertComponentDefinition.expectations) == 0 && mmUpsertComponentDefinition.defaultExpectation == nil && mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.mock.afterUpdateNamespacePipelineReleaseByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertComponentDefinition.mock.afterUpsertComponentDefinitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertComponentDefinition.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateNamespacePipelineReleaseByID implements repository.Repository
-func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByID(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, pipelineRelease *datamodel.PipelineRelease) (err error) {
-	mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.beforeUpdateNamespacePipelineReleaseByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.afterUpdateNamespacePipelineReleaseByIDCounter, 1)
+// UpsertComponentDefinition implements repository.Repository
+func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinition(ctx context.Context, cp1 *pb.ComponentDefinition) (err error) {
+	mm_atomic.AddUint64(&mmUpsertComponentDefinition.beforeUpsertComponentDefinitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertComponentDefinition.afterUpsertComponentDefinitionCounter, 1)
 
-	if mmUpdateNamespacePipelineReleaseByID.inspectFuncUpdateNamespacePipelineReleaseByID != nil {
-		mmUpdateNamespacePipelineReleaseByID.inspectFuncUpdateNamespacePipelineReleaseByID(ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
+	if mmUpsertComponentDefinition.inspectFuncUpsertComponentDefinition != nil {
+		mmUpsertComponentDefinition.inspectFuncUpsertComponentDefinition(ctx, cp1)
 	}
 
-	mm_params := RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
+	mm_params := RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
 
 	// Record call args
-	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.mutex.Lock()
-	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.callArgs = append(mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.callArgs, &mm_params)
-	mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.mutex.Unlock()
+	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.mutex.Lock()
+	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.callArgs = append(mmUpsertComponentDefinition.UpsertComponentDefinitionMock.callArgs, &mm_params)
+	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.mutex.Unlock()
 
-	for _, e := range mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.expectations {
+	for _, e := range mmUpsertComponentDefinition.UpsertComponentDefinitionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.paramPtrs
+	if mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpdateNamespacePipelineReleaseByIDParams{ctx, ownerPermalink, pipelineUID, id, pipelineRelease}
+		mm_got := RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
-			}
-
-			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
-				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.pipelineRelease != nil && !minimock.Equal(*mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease) {
-				mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameter pipelineRelease, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease, minimock.Diff(*mm_want_ptrs.pipelineRelease, mm_got.pipelineRelease))
+			if mm_want_ptrs.cp1 != nil && !minimock.Equal(*mm_want_ptrs.cp1, mm_got.cp1) {
+				mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameter cp1, want: %#v, got: %#v%s\n", *mm_want_ptrs.cp1, mm_got.cp1, minimock.Diff(*mm_want_ptrs.cp1, mm_got.cp1))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateNamespacePipelineReleaseByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateNamespacePipelineReleaseByID.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.results
+		mm_results := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateNamespacePipelineReleaseByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineReleaseByID")
+			mmUpsertComponentDefinition.t.Fatal("No results are set for the RepositoryMock.UpsertComponentDefinition")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdateNamespacePipelineReleaseByID.funcUpdateNamespacePipelineReleaseByID != nil {
-		return mmUpdateNamespacePipelineReleaseByID.funcUpdateNamespacePipelineReleaseByID(ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
+	if mmUpsertComponentDefinition.funcUpsertComponentDefinition != nil {
+		return mmUpsertComponentDefinition.funcUpsertComponentDefinition(ctx, cp1)
 	}
-	mmUpdateNamespacePipelineReleaseByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineReleaseByID. %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, id, pipelineRelease)
+	mmUpsertComponentDefinition.t.Fatalf("Unexpected call to RepositoryMock.UpsertComponentDefinition. %v %v", ctx, cp1)
 	return
 }
 
-// UpdateNamespacePipelineReleaseByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineReleaseByID invocations
-func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.afterUpdateNamespacePipelineReleaseByIDCounter)
+// UpsertComponentDefinitionAfterCounter returns a count of finished RepositoryMock.UpsertComponentDefinition invocations
+func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertComponentDefinition.afterUpsertComponentDefinitionCounter)
 }
 
-// UpdateNamespacePipelineReleaseByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineReleaseByID invocations
-func (mmUpdateNamespacePipelineReleaseByID *RepositoryMock) UpdateNamespacePipelineReleaseByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseByID.beforeUpdateNamespacePipelineReleaseByIDCounter)
+// UpsertComponentDefinitionBeforeCounter returns a count of RepositoryMock.UpsertComponentDefinition invocations
+func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertComponentDefinition.beforeUpsertComponentDefinitionCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineReleaseByID.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpsertComponentDefinition.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateNamespacePipelineReleaseByID *mRepositoryMockUpdateNamespacePipelineReleaseByID) Calls() []*RepositoryMockUpdateNamespacePipelineReleaseByIDParams {
-	mmUpdateNamespacePipelineReleaseByID.mutex.RLock()
+func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Calls() []*RepositoryMockUpsertComponentDefinitionParams {
+	mmUpsertComponentDefinition.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockUpdateNamespacePipelineReleaseByIDParams, len(mmUpdateNamespacePipelineReleaseByID.callArgs))
-	copy(argCopy, mmUpdateNamespacePipelineReleaseByID.callArgs)
+	argCopy := make([]*RepositoryMockUpsertComponentDefinitionParams, len(mmUpsertComponentDefinition.callArgs))
+	copy(argCopy, mmUpsertComponentDefinition.callArgs)
 
-	mmUpdateNamespacePipelineReleaseByID.mutex.RUnlock()
+	mmUpsertComponentDefinition.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateNamespacePipelineReleaseByIDDone returns true if the count of the UpdateNamespacePipelineReleaseByID invocations corresponds
+// MinimockUpsertComponentDefinitionDone returns true if the count of the UpsertComponentDefinition invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseByIDDone() bool {
-	if m.UpdateNamespacePipelineReleaseByIDMock.optional {
+func (m *RepositoryMock) MinimockUpsertComponentDefinitionDone() bool {
+	if m.UpsertComponentDefinitionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateNamespacePipelineReleaseByIDMock.expectations {
+	for _, e := range m.UpsertComponentDefinitionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateNamespacePipelineReleaseByIDMock.invocationsDone()
+	return m.UpsertComponentDefinitionMock.invocationsDone()
 }
 
-// MinimockUpdateNamespacePipelineReleaseByIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseByIDInspect() {
-	for _, e := range m.UpdateNamespacePipelineReleaseByIDMock.expectations {
+// MinimockUpsertComponentDefinitionInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpsertComponentDefinitionInspect() {
+	for _, e := range m.UpsertComponentDefinitionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentDefinition with params: %#v", *e.params)
 		}
 	}
 
-	afterUpdateNamespacePipelineReleaseByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineReleaseByIDCounter)
+	afterUpsertComponentDefinitionCounter := mm_atomic.LoadUint64(&m.afterUpsertComponentDefinitionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineReleaseByIDCounter < 1 {
-		if m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID")
+	if m.UpsertComponentDefinitionMock.defaultExpectation != nil && afterUpsertComponentDefinitionCounter < 1 {
+		if m.UpsertComponentDefinitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpsertComponentDefinition")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID with params: %#v", *m.UpdateNamespacePipelineReleaseByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentDefinition with params: %#v", *m.UpsertComponentDefinitionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateNamespacePipelineReleaseByID != nil && afterUpdateNamespacePipelineReleaseByIDCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseByID")
+	if m.funcUpsertComponentDefinition != nil && afterUpsertComponentDefinitionCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpsertComponentDefinition")
 	}
 
-	if !m.UpdateNamespacePipelineReleaseByIDMock.invocationsDone() && afterUpdateNamespacePipelineReleaseByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineReleaseByID but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineReleaseByIDMock.expectedInvocations), afterUpdateNamespacePipelineReleaseByIDCounter)
+	if !m.UpsertComponentDefinitionMock.invocationsDone() && afterUpsertComponentDefinitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertComponentDefinition but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertComponentDefinitionMock.expectedInvocations), afterUpsertComponentDefinitionCounter)
 	}
 }
 
-type mRepositoryMockUpdateNamespacePipelineReleaseIDByID struct {
+type mRepositoryMockUpsertComponentRuns struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation
-	expectations       []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation
+	defaultExpectation *RepositoryMockUpsertComponentRunsExpectation
+	expectations       []*RepositoryMockUpsertComponentRunsExpectation
 
-	callArgs []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams
+	callArgs []*RepositoryMockUpsertComponentRunsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation specifies expectation struct of the Repository.UpdateNamespacePipelineReleaseIDByID
-type RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation struct {
+// RepositoryMockUpsertComponentRunsExpectation specifies expectation struct of the Repository.UpsertComponentRuns
+type RepositoryMockUpsertComponentRunsExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams
-	paramPtrs *RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs
-	results   *RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults
+	params    *RepositoryMockUpsertComponentRunsParams
+	paramPtrs *RepositoryMockUpsertComponentRunsParamPtrs
+	results   *RepositoryMockUpsertComponentRunsResults
 	Counter   uint64
 }
 
-// RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams contains parameters of the Repository.UpdateNamespacePipelineReleaseIDByID
-type RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams struct {
-	ctx            context.Context
-	ownerPermalink string
-	pipelineUID    uuid.UUID
-	id             string
-	newID          string
+// RepositoryMockUpsertComponentRunsParams contains parameters of the Repository.UpsertComponentRuns
+type RepositoryMockUpsertComponentRunsParams struct {
+	ctx  context.Context
+	runs []*datamodel.ComponentRun
 }
 
-// RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespacePipelineReleaseIDByID
-type RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs struct {
-	ctx            *context.Context
-	ownerPermalink *string
-	pipelineUID    *uuid.UUID
-	id             *string
-	newID          *string
+// RepositoryMockUpsertComponentRunsParamPtrs contains pointers to parameters of the Repository.UpsertComponentRuns
+type RepositoryMockUpsertComponentRunsParamPtrs struct {
+	ctx  *context.Context
+	runs *[]*datamodel.ComponentRun
 }
 
-// RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults contains results of the Repository.UpdateNamespacePipelineReleaseIDByID
-type RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults struct {
+// RepositoryMockUpsertComponentRunsResults contains results of the Repository.UpsertComponentRuns
+type RepositoryMockUpsertComponentRunsResults struct {
 	err error
 }
 
@@ -12294,400 +15924,318 @@ type RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Optional() *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	mmUpdateNamespacePipelineReleaseIDByID.optional = true
-	return mmUpdateNamespacePipelineReleaseIDByID
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Optional() *mRepositoryMockUpsertComponentRuns {
+	mmUpsertComponentRuns.optional = true
+	return mmUpsertComponentRuns
 }
 
-// Expect sets up expected params for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Expect(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+// Expect sets up expected params for Repository.UpsertComponentRuns
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Expect(ctx context.Context, runs []*datamodel.ComponentRun) *mRepositoryMockUpsertComponentRuns {
+	if mmUpsertComponentRuns.mock.funcUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	if mmUpsertComponentRuns.defaultExpectation == nil {
+		mmUpsertComponentRuns.defaultExpectation = &RepositoryMockUpsertComponentRunsExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by ExpectParams functions")
+	if mmUpsertComponentRuns.defaultExpectation.paramPtrs != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
-	for _, e := range mmUpdateNamespacePipelineReleaseIDByID.expectations {
-		if minimock.Equal(e.params, mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params) {
-			mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params)
+	mmUpsertComponentRuns.defaultExpectation.params = &RepositoryMockUpsertComponentRunsParams{ctx, runs}
+	for _, e := range mmUpsertComponentRuns.expectations {
+		if minimock.Equal(e.params, mmUpsertComponentRuns.defaultExpectation.params) {
+			mmUpsertComponentRuns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertComponentRuns.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateNamespacePipelineReleaseIDByID
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
-	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.ctx = &ctx
-
-	return mmUpdateNamespacePipelineReleaseIDByID
-}
-
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
-	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
-
-	return mmUpdateNamespacePipelineReleaseIDByID
-}
-
-// ExpectPipelineUIDParam3 sets up expected param pipelineUID for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectPipelineUIDParam3(pipelineUID uuid.UUID) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
-	}
-
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
-	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.pipelineUID = &pipelineUID
-
-	return mmUpdateNamespacePipelineReleaseIDByID
+	return mmUpsertComponentRuns
 }
 
-// ExpectIdParam4 sets up expected param id for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectIdParam4(id string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertComponentRuns
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertComponentRuns {
+	if mmUpsertComponentRuns.mock.funcUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	if mmUpsertComponentRuns.defaultExpectation == nil {
+		mmUpsertComponentRuns.defaultExpectation = &RepositoryMockUpsertComponentRunsExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
+	if mmUpsertComponentRuns.defaultExpectation.params != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
+	if mmUpsertComponentRuns.defaultExpectation.paramPtrs == nil {
+		mmUpsertComponentRuns.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentRunsParamPtrs{}
 	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.id = &id
+	mmUpsertComponentRuns.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespacePipelineReleaseIDByID
+	return mmUpsertComponentRuns
 }
 
-// ExpectNewIDParam5 sets up expected param newID for Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) ExpectNewIDParam5(newID string) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+// ExpectRunsParam2 sets up expected param runs for Repository.UpsertComponentRuns
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) ExpectRunsParam2(runs []*datamodel.ComponentRun) *mRepositoryMockUpsertComponentRuns {
+	if mmUpsertComponentRuns.mock.funcUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{}
+	if mmUpsertComponentRuns.defaultExpectation == nil {
+		mmUpsertComponentRuns.defaultExpectation = &RepositoryMockUpsertComponentRunsExpectation{}
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.params != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Expect")
+	if mmUpsertComponentRuns.defaultExpectation.params != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Expect")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParamPtrs{}
+	if mmUpsertComponentRuns.defaultExpectation.paramPtrs == nil {
+		mmUpsertComponentRuns.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentRunsParamPtrs{}
 	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.paramPtrs.newID = &newID
+	mmUpsertComponentRuns.defaultExpectation.paramPtrs.runs = &runs
 
-	return mmUpdateNamespacePipelineReleaseIDByID
+	return mmUpsertComponentRuns
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Inspect(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string)) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.inspectFuncUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpsertComponentRuns
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Inspect(f func(ctx context.Context, runs []*datamodel.ComponentRun)) *mRepositoryMockUpsertComponentRuns {
+	if mmUpsertComponentRuns.mock.inspectFuncUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertComponentRuns")
 	}
 
-	mmUpdateNamespacePipelineReleaseIDByID.mock.inspectFuncUpdateNamespacePipelineReleaseIDByID = f
+	mmUpsertComponentRuns.mock.inspectFuncUpsertComponentRuns = f
 
-	return mmUpdateNamespacePipelineReleaseIDByID
+	return mmUpsertComponentRuns
 }
 
-// Return sets up results that will be returned by Repository.UpdateNamespacePipelineReleaseIDByID
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Return(err error) *RepositoryMock {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpsertComponentRuns
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Return(err error) *RepositoryMock {
+	if mmUpsertComponentRuns.mock.funcUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Set")
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil {
-		mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{mock: mmUpdateNamespacePipelineReleaseIDByID.mock}
+	if mmUpsertComponentRuns.defaultExpectation == nil {
+		mmUpsertComponentRuns.defaultExpectation = &RepositoryMockUpsertComponentRunsExpectation{mock: mmUpsertComponentRuns.mock}
 	}
-	mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation.results = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults{err}
-	return mmUpdateNamespacePipelineReleaseIDByID.mock
+	mmUpsertComponentRuns.defaultExpectation.results = &RepositoryMockUpsertComponentRunsResults{err}
+	return mmUpsertComponentRuns.mock
 }
 
-// Set uses given function f to mock the Repository.UpdateNamespacePipelineReleaseIDByID method
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Set(f func(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) (err error)) *RepositoryMock {
-	if mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespacePipelineReleaseIDByID method")
+// Set uses given function f to mock the Repository.UpsertComponentRuns method
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Set(f func(ctx context.Context, runs []*datamodel.ComponentRun) (err error)) *RepositoryMock {
+	if mmUpsertComponentRuns.defaultExpectation != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertComponentRuns method")
 	}
 
-	if len(mmUpdateNamespacePipelineReleaseIDByID.expectations) > 0 {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespacePipelineReleaseIDByID method")
+	if len(mmUpsertComponentRuns.expectations) > 0 {
+		mmUpsertComponentRuns.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertComponentRuns method")
 	}
 
-	mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID = f
-	return mmUpdateNamespacePipelineReleaseIDByID.mock
+	mmUpsertComponentRuns.mock.funcUpsertComponentRuns = f
+	return mmUpsertComponentRuns.mock
 }
 
-// When sets expectation for the Repository.UpdateNamespacePipelineReleaseIDByID which will trigger the result defined by the following
+// When sets expectation for the Repository.UpsertComponentRuns which will trigger the result defined by the following
 // Then helper
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) When(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation {
-	if mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock is already set by Set")
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) When(ctx context.Context, runs []*datamodel.ComponentRun) *RepositoryMockUpsertComponentRunsExpectation {
+	if mmUpsertComponentRuns.mock.funcUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.mock.t.Fatalf("RepositoryMock.UpsertComponentRuns mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation{
-		mock:   mmUpdateNamespacePipelineReleaseIDByID.mock,
-		params: &RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID},
+	expectation := &RepositoryMockUpsertComponentRunsExpectation{
+		mock:   mmUpsertComponentRuns.mock,
+		params: &RepositoryMockUpsertComponentRunsParams{ctx, runs},
 	}
-	mmUpdateNamespacePipelineReleaseIDByID.expectations = append(mmUpdateNamespacePipelineReleaseIDByID.expectations, expectation)
+	mmUpsertComponentRuns.expectations = append(mmUpsertComponentRuns.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpdateNamespacePipelineReleaseIDByID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpdateNamespacePipelineReleaseIDByIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpdateNamespacePipelineReleaseIDByIDResults{err}
+// Then sets up Repository.UpsertComponentRuns return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpsertComponentRunsExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpsertComponentRunsResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpdateNamespacePipelineReleaseIDByID should be invoked
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Times(n uint64) *mRepositoryMockUpdateNamespacePipelineReleaseIDByID {
+// Times sets number of times Repository.UpsertComponentRuns should be invoked
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Times(n uint64) *mRepositoryMockUpsertComponentRuns {
 	if n == 0 {
-		mmUpdateNamespacePipelineReleaseIDByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespacePipelineReleaseIDByID mock can not be zero")
+		mmUpsertComponentRuns.mock.t.Fatalf("Times of RepositoryMock.UpsertComponentRuns mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateNamespacePipelineReleaseIDByID.expectedInvocations, n)
-	return mmUpdateNamespacePipelineReleaseIDByID
+	mm_atomic.StoreUint64(&mmUpsertComponentRuns.expectedInvocations, n)
+	return mmUpsertComponentRuns
 }
 
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) invocationsDone() bool {
-	if len(mmUpdateNamespacePipelineReleaseIDByID.expectations) == 0 && mmUpdateNamespacePipelineReleaseIDByID.defaultExpectation == nil && mmUpdateNamespacePipelineReleaseIDByID.mock.funcUpdateNamespacePipelineReleaseIDByID == nil {
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) invocationsDone() bool {
+	if len(mmUpsertComponentRuns.expectations) == 0 && mmUpsertComponentRuns.defaultExpectation == nil && mmUpsertComponentRuns.mock.funcUpsertComponentRuns == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.mock.afterUpdateNamespacePipelineReleaseIDByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertComponentRuns.mock.afterUpsertComponentRunsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertComponentRuns.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateNamespacePipelineReleaseIDByID implements repository.Repository
-func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByID(ctx context.Context, ownerPermalink string, pipelineUID uuid.UUID, id string, newID string) (err error) {
-	mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.beforeUpdateNamespacePipelineReleaseIDByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.afterUpdateNamespacePipelineReleaseIDByIDCounter, 1)
+// UpsertComponentRuns implements repository.Repository
+func (mmUpsertComponentRuns *RepositoryMock) UpsertComponentRuns(ctx context.Context, runs []*datamodel.ComponentRun) (err error) {
+	mm_atomic.AddUint64(&mmUpsertComponentRuns.beforeUpsertComponentRunsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertComponentRuns.afterUpsertComponentRunsCounter, 1)
 
-	if mmUpdateNamespacePipelineReleaseIDByID.inspectFuncUpdateNamespacePipelineReleaseIDByID != nil {
-		mmUpdateNamespacePipelineReleaseIDByID.inspectFuncUpdateNamespacePipelineReleaseIDByID(ctx, ownerPermalink, pipelineUID, id, newID)
+	if mmUpsertComponentRuns.inspectFuncUpsertComponentRuns != nil {
+		mmUpsertComponentRuns.inspectFuncUpsertComponentRuns(ctx, runs)
 	}
 
-	mm_params := RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
+	mm_params := RepositoryMockUpsertComponentRunsParams{ctx, runs}
 
 	// Record call args
-	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.mutex.Lock()
-	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.callArgs = append(mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.callArgs, &mm_params)
-	mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.mutex.Unlock()
+	mmUpsertComponentRuns.UpsertComponentRunsMock.mutex.Lock()
+	mmUpsertComponentRuns.UpsertComponentRunsMock.callArgs = append(mmUpsertComponentRuns.UpsertComponentRunsMock.callArgs, &mm_params)
+	mmUpsertComponentRuns.UpsertComponentRunsMock.mutex.Unlock()
 
-	for _, e := range mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
+	for _, e := range mmUpsertComponentRuns.UpsertComponentRunsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.paramPtrs
+	if mmUpsertComponentRuns.UpsertComponentRunsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertComponentRuns.UpsertComponentRunsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertComponentRuns.UpsertComponentRunsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertComponentRuns.UpsertComponentRunsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams{ctx, ownerPermalink, pipelineUID, id, newID}
+		mm_got := RepositoryMockUpsertComponentRunsParams{ctx, runs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
-			}
-
-			if mm_want_ptrs.pipelineUID != nil && !minimock.Equal(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID) {
-				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter pipelineUID, want: %#v, got: %#v%s\n", *mm_want_ptrs.pipelineUID, mm_got.pipelineUID, minimock.Diff(*mm_want_ptrs.pipelineUID, mm_got.pipelineUID))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpsertComponentRuns.t.Errorf("RepositoryMock.UpsertComponentRuns got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.newID != nil && !minimock.Equal(*mm_want_ptrs.newID, mm_got.newID) {
-				mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameter newID, want: %#v, got: %#v%s\n", *mm_want_ptrs.newID, mm_got.newID, minimock.Diff(*mm_want_ptrs.newID, mm_got.newID))
+			if mm_want_ptrs.runs != nil && !minimock.Equal(*mm_want_ptrs.runs, mm_got.runs) {
+				mmUpsertComponentRuns.t.Errorf("RepositoryMock.UpsertComponentRuns got unexpected parameter runs, want: %#v, got: %#v%s\n", *mm_want_ptrs.runs, mm_got.runs, minimock.Diff(*mm_want_ptrs.runs, mm_got.runs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateNamespacePipelineReleaseIDByID.t.Errorf("RepositoryMock.UpdateNamespacePipelineReleaseIDByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpsertComponentRuns.t.Errorf("RepositoryMock.UpsertComponentRuns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateNamespacePipelineReleaseIDByID.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.results
+		mm_results := mmUpsertComponentRuns.UpsertComponentRunsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateNamespacePipelineReleaseIDByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
+			mmUpsertComponentRuns.t.Fatal("No results are set for the RepositoryMock.UpsertComponentRuns")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdateNamespacePipelineReleaseIDByID.funcUpdateNamespacePipelineReleaseIDByID != nil {
-		return mmUpdateNamespacePipelineReleaseIDByID.funcUpdateNamespacePipelineReleaseIDByID(ctx, ownerPermalink, pipelineUID, id, newID)
+	if mmUpsertComponentRuns.funcUpsertComponentRuns != nil {
+		return mmUpsertComponentRuns.funcUpsertComponentRuns(ctx, runs)
 	}
-	mmUpdateNamespacePipelineReleaseIDByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID. %v %v %v %v %v", ctx, ownerPermalink, pipelineUID, id, newID)
+	mmUpsertComponentRuns.t.Fatalf("Unexpected call to RepositoryMock.UpsertComponentRuns. %v %v", ctx, runs)
 	return
 }
 
-// UpdateNamespacePipelineReleaseIDByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespacePipelineReleaseIDByID invocations
-func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.afterUpdateNamespacePipelineReleaseIDByIDCounter)
+// UpsertComponentRunsAfterCounter returns a count of finished RepositoryMock.UpsertComponentRuns invocations
+func (mmUpsertComponentRuns *RepositoryMock) UpsertComponentRunsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertComponentRuns.afterUpsertComponentRunsCounter)
 }
 
-// UpdateNamespacePipelineReleaseIDByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespacePipelineReleaseIDByID invocations
-func (mmUpdateNamespacePipelineReleaseIDByID *RepositoryMock) UpdateNamespacePipelineReleaseIDByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespacePipelineReleaseIDByID.beforeUpdateNamespacePipelineReleaseIDByIDCounter)
+// UpsertComponentRunsBeforeCounter returns a count of RepositoryMock.UpsertComponentRuns invocations
+func (mmUpsertComponentRuns *RepositoryMock) UpsertComponentRunsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertComponentRuns.beforeUpsertComponentRunsCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpsertComponentRuns.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateNamespacePipelineReleaseIDByID *mRepositoryMockUpdateNamespacePipelineReleaseIDByID) Calls() []*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams {
-	mmUpdateNamespacePipelineReleaseIDByID.mutex.RLock()
+func (mmUpsertComponentRuns *mRepositoryMockUpsertComponentRuns) Calls() []*RepositoryMockUpsertComponentRunsParams {
+	mmUpsertComponentRuns.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockUpdateNamespacePipelineReleaseIDByIDParams, len(mmUpdateNamespacePipelineReleaseIDByID.callArgs))
-	copy(argCopy, mmUpdateNamespacePipelineReleaseIDByID.callArgs)
+	argCopy := make([]*RepositoryMockUpsertComponentRunsParams, len(mmUpsertComponentRuns.callArgs))
+	copy(argCopy, mmUpsertComponentRuns.callArgs)
 
-	mmUpdateNamespacePipelineReleaseIDByID.mutex.RUnlock()
+	mmUpsertComponentRuns.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateNamespacePipelineReleaseIDByIDDone returns true if the count of the UpdateNamespacePipelineReleaseIDByID invocations corresponds
+// MinimockUpsertComponentRunsDone returns true if the count of the UpsertComponentRuns invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseIDByIDDone() bool {
-	if m.UpdateNamespacePipelineReleaseIDByIDMock.optional {
+func (m *RepositoryMock) MinimockUpsertComponentRunsDone() bool {
+	if m.UpsertComponentRunsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
+	for _, e := range m.UpsertComponentRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateNamespacePipelineReleaseIDByIDMock.invocationsDone()
+	return m.UpsertComponentRunsMock.invocationsDone()
 }
 
-// MinimockUpdateNamespacePipelineReleaseIDByIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpdateNamespacePipelineReleaseIDByIDInspect() {
-	for _, e := range m.UpdateNamespacePipelineReleaseIDByIDMock.expectations {
+// MinimockUpsertComponentRunsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpsertComponentRunsInspect() {
+	for _, e := range m.UpsertComponentRunsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentRuns with params: %#v", *e.params)
 		}
 	}
 
-	afterUpdateNamespacePipelineReleaseIDByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespacePipelineReleaseIDByIDCounter)
+	afterUpsertComponentRunsCounter := mm_atomic.LoadUint64(&m.afterUpsertComponentRunsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation != nil && afterUpdateNamespacePipelineReleaseIDByIDCounter < 1 {
-		if m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
+	if m.UpsertComponentRunsMock.defaultExpectation != nil && afterUpsertComponentRunsCounter < 1 {
+		if m.UpsertComponentRunsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpsertComponentRuns")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID with params: %#v", *m.UpdateNamespacePipelineReleaseIDByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentRuns with params: %#v", *m.UpsertComponentRunsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateNamespacePipelineReleaseIDByID != nil && afterUpdateNamespacePipelineReleaseIDByIDCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpdateNamespacePipelineReleaseIDByID")
+	if m.funcUpsertComponentRuns != nil && afterUpsertComponentRunsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpsertComponentRuns")
 	}
 
-	if !m.UpdateNamespacePipelineReleaseIDByIDMock.invocationsDone() && afterUpdateNamespacePipelineReleaseIDByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespacePipelineReleaseIDByID but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateNamespacePipelineReleaseIDByIDMock.expectedInvocations), afterUpdateNamespacePipelineReleaseIDByIDCounter)
+	if !m.UpsertComponentRunsMock.invocationsDone() && afterUpsertComponentRunsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertComponentRuns but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertComponentRunsMock.expectedInvocations), afterUpsertComponentRunsCounter)
 	}
 }
 
-type mRepositoryMockUpdateNamespaceSecretByID struct {
+type mRepositoryMockUpsertPipelineRun struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateNamespaceSecretByIDExpectation
-	expectations       []*RepositoryMockUpdateNamespaceSecretByIDExpectation
+	defaultExpectation *RepositoryMockUpsertPipelineRunExpectation
+	expectations       []*RepositoryMockUpsertPipelineRunExpectation
 
-	callArgs []*RepositoryMockUpdateNamespaceSecretByIDParams
+	callArgs []*RepositoryMockUpsertPipelineRunParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockUpdateNamespaceSecretByIDExpectation specifies expectation struct of the Repository.UpdateNamespaceSecretByID
-type RepositoryMockUpdateNamespaceSecretByIDExpectation struct {
+// RepositoryMockUpsertPipelineRunExpectation specifies expectation struct of the Repository.UpsertPipelineRun
+type RepositoryMockUpsertPipelineRunExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockUpdateNamespaceSecretByIDParams
-	paramPtrs *RepositoryMockUpdateNamespaceSecretByIDParamPtrs
-	results   *RepositoryMockUpdateNamespaceSecretByIDResults
+	params    *RepositoryMockUpsertPipelineRunParams
+	paramPtrs *RepositoryMockUpsertPipelineRunParamPtrs
+	results   *RepositoryMockUpsertPipelineRunResults
 	Counter   uint64
 }
 
-// RepositoryMockUpdateNamespaceSecretByIDParams contains parameters of the Repository.UpdateNamespaceSecretByID
-type RepositoryMockUpdateNamespaceSecretByIDParams struct {
-	ctx            context.Context
-	ownerPermalink string
-	id             string
-	secret         *datamodel.Secret
+// RepositoryMockUpsertPipelineRunParams contains parameters of the Repository.UpsertPipelineRun
+type RepositoryMockUpsertPipelineRunParams struct {
+	ctx context.Context
+	run *datamodel.PipelineRun
 }
 
-// RepositoryMockUpdateNamespaceSecretByIDParamPtrs contains pointers to parameters of the Repository.UpdateNamespaceSecretByID
-type RepositoryMockUpdateNamespaceSecretByIDParamPtrs struct {
-	ctx            *context.Context
-	ownerPermalink *string
-	id             *string
-	secret         **datamodel.Secret
+// RepositoryMockUpsertPipelineRunParamPtrs contains pointers to parameters of the Repository.UpsertPipelineRun
+type RepositoryMockUpsertPipelineRunParamPtrs struct {
+	ctx *context.Context
+	run **datamodel.PipelineRun
 }
 
-// RepositoryMockUpdateNamespaceSecretByIDResults contains results of the Repository.UpdateNamespaceSecretByID
-type RepositoryMockUpdateNamespaceSecretByIDResults struct {
+// RepositoryMockUpsertPipelineRunResults contains results of the Repository.UpsertPipelineRun
+type RepositoryMockUpsertPipelineRunResults struct {
 	err error
 }
 
@@ -12696,370 +16244,318 @@ type RepositoryMockUpdateNamespaceSecretByIDResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Optional() *mRepositoryMockUpdateNamespaceSecretByID {
-	mmUpdateNamespaceSecretByID.optional = true
-	return mmUpdateNamespaceSecretByID
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Optional() *mRepositoryMockUpsertPipelineRun {
+	mmUpsertPipelineRun.optional = true
+	return mmUpsertPipelineRun
 }
 
-// Expect sets up expected params for Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Expect(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+// Expect sets up expected params for Repository.UpsertPipelineRun
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Expect(ctx context.Context, run *datamodel.PipelineRun) *mRepositoryMockUpsertPipelineRun {
+	if mmUpsertPipelineRun.mock.funcUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
+	if mmUpsertPipelineRun.defaultExpectation == nil {
+		mmUpsertPipelineRun.defaultExpectation = &RepositoryMockUpsertPipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by ExpectParams functions")
+	if mmUpsertPipelineRun.defaultExpectation.paramPtrs != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateNamespaceSecretByID.defaultExpectation.params = &RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
-	for _, e := range mmUpdateNamespaceSecretByID.expectations {
-		if minimock.Equal(e.params, mmUpdateNamespaceSecretByID.defaultExpectation.params) {
-			mmUpdateNamespaceSecretByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateNamespaceSecretByID.defaultExpectation.params)
+	mmUpsertPipelineRun.defaultExpectation.params = &RepositoryMockUpsertPipelineRunParams{ctx, run}
+	for _, e := range mmUpsertPipelineRun.expectations {
+		if minimock.Equal(e.params, mmUpsertPipelineRun.defaultExpectation.params) {
+			mmUpsertPipelineRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertPipelineRun.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateNamespaceSecretByID
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
-	}
-	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.ctx = &ctx
-
-	return mmUpdateNamespaceSecretByID
-}
-
-// ExpectOwnerPermalinkParam2 sets up expected param ownerPermalink for Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectOwnerPermalinkParam2(ownerPermalink string) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
-	}
-
-	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
-	}
-	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.ownerPermalink = &ownerPermalink
-
-	return mmUpdateNamespaceSecretByID
+	return mmUpsertPipelineRun
 }
 
-// ExpectIdParam3 sets up expected param id for Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectIdParam3(id string) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertPipelineRun
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertPipelineRun {
+	if mmUpsertPipelineRun.mock.funcUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
+	if mmUpsertPipelineRun.defaultExpectation == nil {
+		mmUpsertPipelineRun.defaultExpectation = &RepositoryMockUpsertPipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
+	if mmUpsertPipelineRun.defaultExpectation.params != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Expect")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
+	if mmUpsertPipelineRun.defaultExpectation.paramPtrs == nil {
+		mmUpsertPipelineRun.defaultExpectation.paramPtrs = &RepositoryMockUpsertPipelineRunParamPtrs{}
 	}
-	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.id = &id
+	mmUpsertPipelineRun.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpdateNamespaceSecretByID
+	return mmUpsertPipelineRun
 }
 
-// ExpectSecretParam4 sets up expected param secret for Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) ExpectSecretParam4(secret *datamodel.Secret) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+// ExpectRunParam2 sets up expected param run for Repository.UpsertPipelineRun
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) ExpectRunParam2(run *datamodel.PipelineRun) *mRepositoryMockUpsertPipelineRun {
+	if mmUpsertPipelineRun.mock.funcUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{}
+	if mmUpsertPipelineRun.defaultExpectation == nil {
+		mmUpsertPipelineRun.defaultExpectation = &RepositoryMockUpsertPipelineRunExpectation{}
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation.params != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Expect")
+	if mmUpsertPipelineRun.defaultExpectation.params != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Expect")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs = &RepositoryMockUpdateNamespaceSecretByIDParamPtrs{}
+	if mmUpsertPipelineRun.defaultExpectation.paramPtrs == nil {
+		mmUpsertPipelineRun.defaultExpectation.paramPtrs = &RepositoryMockUpsertPipelineRunParamPtrs{}
 	}
-	mmUpdateNamespaceSecretByID.defaultExpectation.paramPtrs.secret = &secret
+	mmUpsertPipelineRun.defaultExpectation.paramPtrs.run = &run
 
-	return mmUpdateNamespaceSecretByID
+	return mmUpsertPipelineRun
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Inspect(f func(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret)) *mRepositoryMockUpdateNamespaceSecretByID {
-	if mmUpdateNamespaceSecretByID.mock.inspectFuncUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateNamespaceSecretByID")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpsertPipelineRun
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Inspect(f func(ctx context.Context, run *datamodel.PipelineRun)) *mRepositoryMockUpsertPipelineRun {
+	if mmUpsertPipelineRun.mock.inspectFuncUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertPipelineRun")
 	}
 
-	mmUpdateNamespaceSecretByID.mock.inspectFuncUpdateNamespaceSecretByID = f
+	mmUpsertPipelineRun.mock.inspectFuncUpsertPipelineRun = f
 
-	return mmUpdateNamespaceSecretByID
+	return mmUpsertPipelineRun
 }
 
-// Return sets up results that will be returned by Repository.UpdateNamespaceSecretByID
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Return(err error) *RepositoryMock {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpsertPipelineRun
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Return(err error) *RepositoryMock {
+	if mmUpsertPipelineRun.mock.funcUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Set")
 	}
 
-	if mmUpdateNamespaceSecretByID.defaultExpectation == nil {
-		mmUpdateNamespaceSecretByID.defaultExpectation = &RepositoryMockUpdateNamespaceSecretByIDExpectation{mock: mmUpdateNamespaceSecretByID.mock}
+	if mmUpsertPipelineRun.defaultExpectation == nil {
+		mmUpsertPipelineRun.defaultExpectation = &RepositoryMockUpsertPipelineRunExpectation{mock: mmUpsertPipelineRun.mock}
 	}
-	mmUpdateNamespaceSecretByID.defaultExpectation.results = &RepositoryMockUpdateNamespaceSecretByIDResults{err}
-	return mmUpdateNamespaceSecretByID.mock
+	mmUpsertPipelineRun.defaultExpectation.results = &RepositoryMockUpsertPipelineRunResults{err}
+	return mmUpsertPipelineRun.mock
 }
-
-// Set uses given function f to mock the Repository.UpdateNamespaceSecretByID method
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Set(f func(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) (err error)) *RepositoryMock {
-	if mmUpdateNamespaceSecretByID.defaultExpectation != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateNamespaceSecretByID method")
+
+// Set uses given function f to mock the Repository.UpsertPipelineRun method
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Set(f func(ctx context.Context, run *datamodel.PipelineRun) (err error)) *RepositoryMock {
+	if mmUpsertPipelineRun.defaultExpectation != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertPipelineRun method")
 	}
 
-	if len(mmUpdateNamespaceSecretByID.expectations) > 0 {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateNamespaceSecretByID method")
+	if len(mmUpsertPipelineRun.expectations) > 0 {
+		mmUpsertPipelineRun.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertPipelineRun method")
 	}
 
-	mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID = f
-	return mmUpdateNamespaceSecretByID.mock
+	mmUpsertPipelineRun.mock.funcUpsertPipelineRun = f
+	return mmUpsertPipelineRun.mock
 }
 
-// When sets expectation for the Repository.UpdateNamespaceSecretByID which will trigger the result defined by the following
+// When sets expectation for the Repository.UpsertPipelineRun which will trigger the result defined by the following
 // Then helper
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) When(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) *RepositoryMockUpdateNamespaceSecretByIDExpectation {
-	if mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("RepositoryMock.UpdateNamespaceSecretByID mock is already set by Set")
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) When(ctx context.Context, run *datamodel.PipelineRun) *RepositoryMockUpsertPipelineRunExpectation {
+	if mmUpsertPipelineRun.mock.funcUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.mock.t.Fatalf("RepositoryMock.UpsertPipelineRun mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpdateNamespaceSecretByIDExpectation{
-		mock:   mmUpdateNamespaceSecretByID.mock,
-		params: &RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret},
+	expectation := &RepositoryMockUpsertPipelineRunExpectation{
+		mock:   mmUpsertPipelineRun.mock,
+		params: &RepositoryMockUpsertPipelineRunParams{ctx, run},
 	}
-	mmUpdateNamespaceSecretByID.expectations = append(mmUpdateNamespaceSecretByID.expectations, expectation)
+	mmUpsertPipelineRun.expectations = append(mmUpsertPipelineRun.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpdateNamespaceSecretByID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpdateNamespaceSecretByIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpdateNamespaceSecretByIDResults{err}
+// Then sets up Repository.UpsertPipelineRun return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpsertPipelineRunExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpsertPipelineRunResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpdateNamespaceSecretByID should be invoked
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Times(n uint64) *mRepositoryMockUpdateNamespaceSecretByID {
+// Times sets number of times Repository.UpsertPipelineRun should be invoked
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Times(n uint64) *mRepositoryMockUpsertPipelineRun {
 	if n == 0 {
-		mmUpdateNamespaceSecretByID.mock.t.Fatalf("Times of RepositoryMock.UpdateNamespaceSecretByID mock can not be zero")
+		mmUpsertPipelineRun.mock.t.Fatalf("Times of RepositoryMock.UpsertPipelineRun mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateNamespaceSecretByID.expectedInvocations, n)
-	return mmUpdateNamespaceSecretByID
+	mm_atomic.StoreUint64(&mmUpsertPipelineRun.expectedInvocations, n)
+	return mmUpsertPipelineRun
 }
 
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) invocationsDone() bool {
-	if len(mmUpdateNamespaceSecretByID.expectations) == 0 && mmUpdateNamespaceSecretByID.defaultExpectation == nil && mmUpdateNamespaceSecretByID.mock.funcUpdateNamespaceSecretByID == nil {
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) invocationsDone() bool {
+	if len(mmUpsertPipelineRun.expectations) == 0 && mmUpsertPipelineRun.defaultExpectation == nil && mmUpsertPipelineRun.mock.funcUpsertPipelineRun == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.mock.afterUpdateNamespaceSecretByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertPipelineRun.mock.afterUpsertPipelineRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertPipelineRun.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateNamespaceSecretByID implements repository.Repository
-func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByID(ctx context.Context, ownerPermalink string, id string, secret *datamodel.Secret) (err error) {
-	mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.beforeUpdateNamespaceSecretByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.afterUpdateNamespaceSecretByIDCounter, 1)
+// UpsertPipelineRun implements repository.Repository
+func (mmUpsertPipelineRun *RepositoryMock) UpsertPipelineRun(ctx context.Context, run *datamodel.PipelineRun) (err error) {
+	mm_atomic.AddUint64(&mmUpsertPipelineRun.beforeUpsertPipelineRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertPipelineRun.afterUpsertPipelineRunCounter, 1)
 
-	if mmUpdateNamespaceSecretByID.inspectFuncUpdateNamespaceSecretByID != nil {
-		mmUpdateNamespaceSecretByID.inspectFuncUpdateNamespaceSecretByID(ctx, ownerPermalink, id, secret)
+	if mmUpsertPipelineRun.inspectFuncUpsertPipelineRun != nil {
+		mmUpsertPipelineRun.inspectFuncUpsertPipelineRun(ctx, run)
 	}
 
-	mm_params := RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
+	mm_params := RepositoryMockUpsertPipelineRunParams{ctx, run}
 
 	// Record call args
-	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.mutex.Lock()
-	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.callArgs = append(mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.callArgs, &mm_params)
-	mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.mutex.Unlock()
+	mmUpsertPipelineRun.UpsertPipelineRunMock.mutex.Lock()
+	mmUpsertPipelineRun.UpsertPipelineRunMock.callArgs = append(mmUpsertPipelineRun.UpsertPipelineRunMock.callArgs, &mm_params)
+	mmUpsertPipelineRun.UpsertPipelineRunMock.mutex.Unlock()
 
-	for _, e := range mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.expectations {
+	for _, e := range mmUpsertPipelineRun.UpsertPipelineRunMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.paramPtrs
+	if mmUpsertPipelineRun.UpsertPipelineRunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertPipelineRun.UpsertPipelineRunMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertPipelineRun.UpsertPipelineRunMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertPipelineRun.UpsertPipelineRunMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpdateNamespaceSecretByIDParams{ctx, ownerPermalink, id, secret}
+		mm_got := RepositoryMockUpsertPipelineRunParams{ctx, run}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.ownerPermalink != nil && !minimock.Equal(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink) {
-				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter ownerPermalink, want: %#v, got: %#v%s\n", *mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink, minimock.Diff(*mm_want_ptrs.ownerPermalink, mm_got.ownerPermalink))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpsertPipelineRun.t.Errorf("RepositoryMock.UpsertPipelineRun got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.secret != nil && !minimock.Equal(*mm_want_ptrs.secret, mm_got.secret) {
-				mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameter secret, want: %#v, got: %#v%s\n", *mm_want_ptrs.secret, mm_got.secret, minimock.Diff(*mm_want_ptrs.secret, mm_got.secret))
+			if mm_want_ptrs.run != nil && !minimock.Equal(*mm_want_ptrs.run, mm_got.run) {
+				mmUpsertPipelineRun.t.Errorf("RepositoryMock.UpsertPipelineRun got unexpected parameter run, want: %#v, got: %#v%s\n", *mm_want_ptrs.run, mm_got.run, minimock.Diff(*mm_want_ptrs.run, mm_got.run))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateNamespaceSecretByID.t.Errorf("RepositoryMock.UpdateNamespaceSecretByID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpsertPipelineRun.t.Errorf("RepositoryMock.UpsertPipelineRun got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateNamespaceSecretByID.UpdateNamespaceSecretByIDMock.defaultExpectation.results
+		mm_results := mmUpsertPipelineRun.UpsertPipelineRunMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateNamespaceSecretByID.t.Fatal("No results are set for the RepositoryMock.UpdateNamespaceSecretByID")
+			mmUpsertPipelineRun.t.Fatal("No results are set for the RepositoryMock.UpsertPipelineRun")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdateNamespaceSecretByID.funcUpdateNamespaceSecretByID != nil {
-		return mmUpdateNamespaceSecretByID.funcUpdateNamespaceSecretByID(ctx, ownerPermalink, id, secret)
+	if mmUpsertPipelineRun.funcUpsertPipelineRun != nil {
+		return mmUpsertPipelineRun.funcUpsertPipelineRun(ctx, run)
 	}
-	mmUpdateNamespaceSecretByID.t.Fatalf("Unexpected call to RepositoryMock.UpdateNamespaceSecretByID. %v %v %v %v", ctx, ownerPermalink, id, secret)
+	mmUpsertPipelineRun.t.Fatalf("Unexpected call to RepositoryMock.UpsertPipelineRun. %v %v", ctx, run)
 	return
 }
 
-// UpdateNamespaceSecretByIDAfterCounter returns a count of finished RepositoryMock.UpdateNamespaceSecretByID invocations
-func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.afterUpdateNamespaceSecretByIDCounter)
+// UpsertPipelineRunAfterCounter returns a count of finished RepositoryMock.UpsertPipelineRun invocations
+func (mmUpsertPipelineRun *RepositoryMock) UpsertPipelineRunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertPipelineRun.afterUpsertPipelineRunCounter)
 }
 
-// UpdateNamespaceSecretByIDBeforeCounter returns a count of RepositoryMock.UpdateNamespaceSecretByID invocations
-func (mmUpdateNamespaceSecretByID *RepositoryMock) UpdateNamespaceSecretByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateNamespaceSecretByID.beforeUpdateNamespaceSecretByIDCounter)
+// UpsertPipelineRunBeforeCounter returns a count of RepositoryMock.UpsertPipelineRun invocations
+func (mmUpsertPipelineRun *RepositoryMock) UpsertPipelineRunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertPipelineRun.beforeUpsertPipelineRunCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.UpdateNamespaceSecretByID.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpsertPipelineRun.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateNamespaceSecretByID *mRepositoryMockUpdateNamespaceSecretByID) Calls() []*RepositoryMockUpdateNamespaceSecretByIDParams {
-	mmUpdateNamespaceSecretByID.mutex.RLock()
+func (mmUpsertPipelineRun *mRepositoryMockUpsertPipelineRun) Calls() []*RepositoryMockUpsertPipelineRunParams {
+	mmUpsertPipelineRun.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockUpdateNamespaceSecretByIDParams, len(mmUpdateNamespaceSecretByID.callArgs))
-	copy(argCopy, mmUpdateNamespaceSecretByID.callArgs)
+	argCopy := make([]*RepositoryMockUpsertPipelineRunParams, len(mmUpsertPipelineRun.callArgs))
+	copy(argCopy, mmUpsertPipelineRun.callArgs)
 
-	mmUpdateNamespaceSecretByID.mutex.RUnlock()
+	mmUpsertPipelineRun.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateNamespaceSecretByIDDone returns true if the count of the UpdateNamespaceSecretByID invocations corresponds
+// MinimockUpsertPipelineRunDone returns true if the count of the UpsertPipelineRun invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockUpdateNamespaceSecretByIDDone() bool {
-	if m.UpdateNamespaceSecretByIDMock.optional {
+func (m *RepositoryMock) MinimockUpsertPipelineRunDone() bool {
+	if m.UpsertPipelineRunMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateNamespaceSecretByIDMock.expectations {
+	for _, e := range m.UpsertPipelineRunMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateNamespaceSecretByIDMock.invocationsDone()
+	return m.UpsertPipelineRunMock.invocationsDone()
 }
 
-// MinimockUpdateNamespaceSecretByIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpdateNamespaceSecretByIDInspect() {
-	for _, e := range m.UpdateNamespaceSecretByIDMock.expectations {
+// MinimockUpsertPipelineRunInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpsertPipelineRunInspect() {
+	for _, e := range m.UpsertPipelineRunMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespaceSecretByID with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertPipelineRun with params: %#v", *e.params)
 		}
 	}
 
-	afterUpdateNamespaceSecretByIDCounter := mm_atomic.LoadUint64(&m.afterUpdateNamespaceSecretByIDCounter)
+	afterUpsertPipelineRunCounter := mm_atomic.LoadUint64(&m.afterUpsertPipelineRunCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateNamespaceSecretByIDMock.defaultExpectation != nil && afterUpdateNamespaceSecretByIDCounter < 1 {
-		if m.UpdateNamespaceSecretByIDMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpdateNamespaceSecretByID")
+	if m.UpsertPipelineRunMock.defaultExpectation != nil && afterUpsertPipelineRunCounter < 1 {
+		if m.UpsertPipelineRunMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpsertPipelineRun")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpdateNamespaceSecretByID with params: %#v", *m.UpdateNamespaceSecretByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertPipelineRun with params: %#v", *m.UpsertPipelineRunMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateNamespaceSecretByID != nil && afterUpdateNamespaceSecretByIDCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpdateNamespaceSecretByID")
+	if m.funcUpsertPipelineRun != nil && afterUpsertPipelineRunCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpsertPipelineRun")
 	}
 
-	if !m.UpdateNamespaceSecretByIDMock.invocationsDone() && afterUpdateNamespaceSecretByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateNamespaceSecretByID but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateNamespaceSecretByIDMock.expectedInvocations), afterUpdateNamespaceSecretByIDCounter)
+	if !m.UpsertPipelineRunMock.invocationsDone() && afterUpsertPipelineRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertPipelineRun but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertPipelineRunMock.expectedInvocations), afterUpsertPipelineRunCounter)
 	}
 }
 
-type mRepositoryMockUpsertComponentDefinition struct {
+type mRepositoryMockUpsertPipelineTriggerResult struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpsertComponentDefinitionExpectation
-	expectations       []*RepositoryMockUpsertComponentDefinitionExpectation
+	defaultExpectation *RepositoryMockUpsertPipelineTriggerResultExpectation
+	expectations       []*RepositoryMockUpsertPipelineTriggerResultExpectation
 
-	callArgs []*RepositoryMockUpsertComponentDefinitionParams
+	callArgs []*RepositoryMockUpsertPipelineTriggerResultParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockUpsertComponentDefinitionExpectation specifies expectation struct of the Repository.UpsertComponentDefinition
-type RepositoryMockUpsertComponentDefinitionExpectation struct {
+// RepositoryMockUpsertPipelineTriggerResultExpectation specifies expectation struct of the Repository.UpsertPipelineTriggerResult
+type RepositoryMockUpsertPipelineTriggerResultExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockUpsertComponentDefinitionParams
-	paramPtrs *RepositoryMockUpsertComponentDefinitionParamPtrs
-	results   *RepositoryMockUpsertComponentDefinitionResults
+	params    *RepositoryMockUpsertPipelineTriggerResultParams
+	paramPtrs *RepositoryMockUpsertPipelineTriggerResultParamPtrs
+	results   *RepositoryMockUpsertPipelineTriggerResultResults
 	Counter   uint64
 }
 
-// RepositoryMockUpsertComponentDefinitionParams contains parameters of the Repository.UpsertComponentDefinition
-type RepositoryMockUpsertComponentDefinitionParams struct {
-	ctx context.Context
-	cp1 *pb.ComponentDefinition
+// RepositoryMockUpsertPipelineTriggerResultParams contains parameters of the Repository.UpsertPipelineTriggerResult
+type RepositoryMockUpsertPipelineTriggerResultParams struct {
+	ctx    context.Context
+	result *datamodel.PipelineTriggerResult
 }
 
-// RepositoryMockUpsertComponentDefinitionParamPtrs contains pointers to parameters of the Repository.UpsertComponentDefinition
-type RepositoryMockUpsertComponentDefinitionParamPtrs struct {
-	ctx *context.Context
-	cp1 **pb.ComponentDefinition
+// RepositoryMockUpsertPipelineTriggerResultParamPtrs contains pointers to parameters of the Repository.UpsertPipelineTriggerResult
+type RepositoryMockUpsertPipelineTriggerResultParamPtrs struct {
+	ctx    *context.Context
+	result **datamodel.PipelineTriggerResult
 }
 
-// RepositoryMockUpsertComponentDefinitionResults contains results of the Repository.UpsertComponentDefinition
-type RepositoryMockUpsertComponentDefinitionResults struct {
+// RepositoryMockUpsertPipelineTriggerResultResults contains results of the Repository.UpsertPipelineTriggerResult
+type RepositoryMockUpsertPipelineTriggerResultResults struct {
 	err error
 }
 
@@ -13068,280 +16564,280 @@ type RepositoryMockUpsertComponentDefinitionResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Optional() *mRepositoryMockUpsertComponentDefinition {
-	mmUpsertComponentDefinition.optional = true
-	return mmUpsertComponentDefinition
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Optional() *mRepositoryMockUpsertPipelineTriggerResult {
+	mmUpsertPipelineTriggerResult.optional = true
+	return mmUpsertPipelineTriggerResult
 }
 
-// Expect sets up expected params for Repository.UpsertComponentDefinition
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Expect(ctx context.Context, cp1 *pb.ComponentDefinition) *mRepositoryMockUpsertComponentDefinition {
-	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
+// Expect sets up expected params for Repository.UpsertPipelineTriggerResult
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Expect(ctx context.Context, result *datamodel.PipelineTriggerResult) *mRepositoryMockUpsertPipelineTriggerResult {
+	if mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation == nil {
-		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
+	if mmUpsertPipelineTriggerResult.defaultExpectation == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation = &RepositoryMockUpsertPipelineTriggerResultExpectation{}
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by ExpectParams functions")
+	if mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by ExpectParams functions")
 	}
 
-	mmUpsertComponentDefinition.defaultExpectation.params = &RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
-	for _, e := range mmUpsertComponentDefinition.expectations {
-		if minimock.Equal(e.params, mmUpsertComponentDefinition.defaultExpectation.params) {
-			mmUpsertComponentDefinition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertComponentDefinition.defaultExpectation.params)
+	mmUpsertPipelineTriggerResult.defaultExpectation.params = &RepositoryMockUpsertPipelineTriggerResultParams{ctx, result}
+	for _, e := range mmUpsertPipelineTriggerResult.expectations {
+		if minimock.Equal(e.params, mmUpsertPipelineTriggerResult.defaultExpectation.params) {
+			mmUpsertPipelineTriggerResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertPipelineTriggerResult.defaultExpectation.params)
 		}
 	}
 
-	return mmUpsertComponentDefinition
+	return mmUpsertPipelineTriggerResult
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertComponentDefinition
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertComponentDefinition {
-	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpsertPipelineTriggerResult
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpsertPipelineTriggerResult {
+	if mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation == nil {
-		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
+	if mmUpsertPipelineTriggerResult.defaultExpectation == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation = &RepositoryMockUpsertPipelineTriggerResultExpectation{}
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation.params != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Expect")
+	if mmUpsertPipelineTriggerResult.defaultExpectation.params != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Expect")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs == nil {
-		mmUpsertComponentDefinition.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentDefinitionParamPtrs{}
+	if mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs = &RepositoryMockUpsertPipelineTriggerResultParamPtrs{}
 	}
-	mmUpsertComponentDefinition.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmUpsertComponentDefinition
+	return mmUpsertPipelineTriggerResult
 }
 
-// ExpectCp1Param2 sets up expected param cp1 for Repository.UpsertComponentDefinition
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) ExpectCp1Param2(cp1 *pb.ComponentDefinition) *mRepositoryMockUpsertComponentDefinition {
-	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
+// ExpectResultParam2 sets up expected param result for Repository.UpsertPipelineTriggerResult
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) ExpectResultParam2(result *datamodel.PipelineTriggerResult) *mRepositoryMockUpsertPipelineTriggerResult {
+	if mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation == nil {
-		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{}
+	if mmUpsertPipelineTriggerResult.defaultExpectation == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation = &RepositoryMockUpsertPipelineTriggerResultExpectation{}
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation.params != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Expect")
+	if mmUpsertPipelineTriggerResult.defaultExpectation.params != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Expect")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation.paramPtrs == nil {
-		mmUpsertComponentDefinition.defaultExpectation.paramPtrs = &RepositoryMockUpsertComponentDefinitionParamPtrs{}
+	if mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs = &RepositoryMockUpsertPipelineTriggerResultParamPtrs{}
 	}
-	mmUpsertComponentDefinition.defaultExpectation.paramPtrs.cp1 = &cp1
+	mmUpsertPipelineTriggerResult.defaultExpectation.paramPtrs.result = &result
 
-	return mmUpsertComponentDefinition
+	return mmUpsertPipelineTriggerResult
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpsertComponentDefinition
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Inspect(f func(ctx context.Context, cp1 *pb.ComponentDefinition)) *mRepositoryMockUpsertComponentDefinition {
-	if mmUpsertComponentDefinition.mock.inspectFuncUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertComponentDefinition")
+// Inspect accepts an inspector function that has same arguments as the Repository.UpsertPipelineTriggerResult
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Inspect(f func(ctx context.Context, result *datamodel.PipelineTriggerResult)) *mRepositoryMockUpsertPipelineTriggerResult {
+	if mmUpsertPipelineTriggerResult.mock.inspectFuncUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpsertPipelineTriggerResult")
 	}
 
-	mmUpsertComponentDefinition.mock.inspectFuncUpsertComponentDefinition = f
+	mmUpsertPipelineTriggerResult.mock.inspectFuncUpsertPipelineTriggerResult = f
 
-	return mmUpsertComponentDefinition
+	return mmUpsertPipelineTriggerResult
 }
 
-// Return sets up results that will be returned by Repository.UpsertComponentDefinition
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Return(err error) *RepositoryMock {
-	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
+// Return sets up results that will be returned by Repository.UpsertPipelineTriggerResult
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Return(err error) *RepositoryMock {
+	if mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Set")
 	}
 
-	if mmUpsertComponentDefinition.defaultExpectation == nil {
-		mmUpsertComponentDefinition.defaultExpectation = &RepositoryMockUpsertComponentDefinitionExpectation{mock: mmUpsertComponentDefinition.mock}
+	if mmUpsertPipelineTriggerResult.defaultExpectation == nil {
+		mmUpsertPipelineTriggerResult.defaultExpectation = &RepositoryMockUpsertPipelineTriggerResultExpectation{mock: mmUpsertPipelineTriggerResult.mock}
 	}
-	mmUpsertComponentDefinition.defaultExpectation.results = &RepositoryMockUpsertComponentDefinitionResults{err}
-	return mmUpsertComponentDefinition.mock
+	mmUpsertPipelineTriggerResult.defaultExpectation.results = &RepositoryMockUpsertPipelineTriggerResultResults{err}
+	return mmUpsertPipelineTriggerResult.mock
 }
 
-// Set uses given function f to mock the Repository.UpsertComponentDefinition method
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Set(f func(ctx context.Context, cp1 *pb.ComponentDefinition) (err error)) *RepositoryMock {
-	if mmUpsertComponentDefinition.defaultExpectation != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertComponentDefinition method")
+// Set uses given function f to mock the Repository.UpsertPipelineTriggerResult method
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Set(f func(ctx context.Context, result *datamodel.PipelineTriggerResult) (err error)) *RepositoryMock {
+	if mmUpsertPipelineTriggerResult.defaultExpectation != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("Default expectation is already set for the Repository.UpsertPipelineTriggerResult method")
 	}
 
-	if len(mmUpsertComponentDefinition.expectations) > 0 {
-		mmUpsertComponentDefinition.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertComponentDefinition method")
+	if len(mmUpsertPipelineTriggerResult.expectations) > 0 {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("Some expectations are already set for the Repository.UpsertPipelineTriggerResult method")
 	}
 
-	mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition = f
-	return mmUpsertComponentDefinition.mock
+	mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult = f
+	return mmUpsertPipelineTriggerResult.mock
 }
 
-// When sets expectation for the Repository.UpsertComponentDefinition which will trigger the result defined by the following
+// When sets expectation for the Repository.UpsertPipelineTriggerResult which will trigger the result defined by the following
 // Then helper
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) When(ctx context.Context, cp1 *pb.ComponentDefinition) *RepositoryMockUpsertComponentDefinitionExpectation {
-	if mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.mock.t.Fatalf("RepositoryMock.UpsertComponentDefinition mock is already set by Set")
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) When(ctx context.Context, result *datamodel.PipelineTriggerResult) *RepositoryMockUpsertPipelineTriggerResultExpectation {
+	if mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("RepositoryMock.UpsertPipelineTriggerResult mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockUpsertComponentDefinitionExpectation{
-		mock:   mmUpsertComponentDefinition.mock,
-		params: &RepositoryMockUpsertComponentDefinitionParams{ctx, cp1},
+	expectation := &RepositoryMockUpsertPipelineTriggerResultExpectation{
+		mock:   mmUpsertPipelineTriggerResult.mock,
+		params: &RepositoryMockUpsertPipelineTriggerResultParams{ctx, result},
 	}
-	mmUpsertComponentDefinition.expectations = append(mmUpsertComponentDefinition.expectations, expectation)
+	mmUpsertPipelineTriggerResult.expectations = append(mmUpsertPipelineTriggerResult.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.UpsertComponentDefinition return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockUpsertComponentDefinitionExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockUpsertComponentDefinitionResults{err}
+// Then sets up Repository.UpsertPipelineTriggerResult return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpsertPipelineTriggerResultExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpsertPipelineTriggerResultResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.UpsertComponentDefinition should be invoked
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Times(n uint64) *mRepositoryMockUpsertComponentDefinition {
+// Times sets number of times Repository.UpsertPipelineTriggerResult should be invoked
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Times(n uint64) *mRepositoryMockUpsertPipelineTriggerResult {
 	if n == 0 {
-		mmUpsertComponentDefinition.mock.t.Fatalf("Times of RepositoryMock.UpsertComponentDefinition mock can not be zero")
+		mmUpsertPipelineTriggerResult.mock.t.Fatalf("Times of RepositoryMock.UpsertPipelineTriggerResult mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpsertComponentDefinition.expectedInvocations, n)
-	return mmUpsertComponentDefinition
+	mm_atomic.StoreUint64(&mmUpsertPipelineTriggerResult.expectedInvocations, n)
+	return mmUpsertPipelineTriggerResult
 }
 
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) invocationsDone() bool {
-	if len(mmUpsertComponentDefinition.expectations) == 0 && mmUpsertComponentDefinition.defaultExpectation == nil && mmUpsertComponentDefinition.mock.funcUpsertComponentDefinition == nil {
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) invocationsDone() bool {
+	if len(mmUpsertPipelineTriggerResult.expectations) == 0 && mmUpsertPipelineTriggerResult.defaultExpectation == nil && mmUpsertPipelineTriggerResult.mock.funcUpsertPipelineTriggerResult == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpsertComponentDefinition.mock.afterUpsertComponentDefinitionCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertComponentDefinition.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertPipelineTriggerResult.mock.afterUpsertPipelineTriggerResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertPipelineTriggerResult.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpsertComponentDefinition implements repository.Repository
-func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinition(ctx context.Context, cp1 *pb.ComponentDefinition) (err error) {
-	mm_atomic.AddUint64(&mmUpsertComponentDefinition.beforeUpsertComponentDefinitionCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpsertComponentDefinition.afterUpsertComponentDefinitionCounter, 1)
+// UpsertPipelineTriggerResult implements repository.Repository
+func (mmUpsertPipelineTriggerResult *RepositoryMock) UpsertPipelineTriggerResult(ctx context.Context, result *datamodel.PipelineTriggerResult) (err error) {
+	mm_atomic.AddUint64(&mmUpsertPipelineTriggerResult.beforeUpsertPipelineTriggerResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertPipelineTriggerResult.afterUpsertPipelineTriggerResultCounter, 1)
 
-	if mmUpsertComponentDefinition.inspectFuncUpsertComponentDefinition != nil {
-		mmUpsertComponentDefinition.inspectFuncUpsertComponentDefinition(ctx, cp1)
+	if mmUpsertPipelineTriggerResult.inspectFuncUpsertPipelineTriggerResult != nil {
+		mmUpsertPipelineTriggerResult.inspectFuncUpsertPipelineTriggerResult(ctx, result)
 	}
 
-	mm_params := RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
+	mm_params := RepositoryMockUpsertPipelineTriggerResultParams{ctx, result}
 
 	// Record call args
-	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.mutex.Lock()
-	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.callArgs = append(mmUpsertComponentDefinition.UpsertComponentDefinitionMock.callArgs, &mm_params)
-	mmUpsertComponentDefinition.UpsertComponentDefinitionMock.mutex.Unlock()
+	mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.mutex.Lock()
+	mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.callArgs = append(mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.callArgs, &mm_params)
+	mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.mutex.Unlock()
 
-	for _, e := range mmUpsertComponentDefinition.UpsertComponentDefinitionMock.expectations {
+	for _, e := range mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.params
-		mm_want_ptrs := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.paramPtrs
+	if mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockUpsertComponentDefinitionParams{ctx, cp1}
+		mm_got := RepositoryMockUpsertPipelineTriggerResultParams{ctx, result}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpsertPipelineTriggerResult.t.Errorf("RepositoryMock.UpsertPipelineTriggerResult got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.cp1 != nil && !minimock.Equal(*mm_want_ptrs.cp1, mm_got.cp1) {
-				mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameter cp1, want: %#v, got: %#v%s\n", *mm_want_ptrs.cp1, mm_got.cp1, minimock.Diff(*mm_want_ptrs.cp1, mm_got.cp1))
+			if mm_want_ptrs.result != nil && !minimock.Equal(*mm_want_ptrs.result, mm_got.result) {
+				mmUpsertPipelineTriggerResult.t.Errorf("RepositoryMock.UpsertPipelineTriggerResult got unexpected parameter result, want: %#v, got: %#v%s\n", *mm_want_ptrs.result, mm_got.result, minimock.Diff(*mm_want_ptrs.result, mm_got.result))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpsertComponentDefinition.t.Errorf("RepositoryMock.UpsertComponentDefinition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpsertPipelineTriggerResult.t.Errorf("RepositoryMock.UpsertPipelineTriggerResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpsertComponentDefinition.UpsertComponentDefinitionMock.defaultExpectation.results
+		mm_results := mmUpsertPipelineTriggerResult.UpsertPipelineTriggerResultMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpsertComponentDefinition.t.Fatal("No results are set for the RepositoryMock.UpsertComponentDefinition")
+			mmUpsertPipelineTriggerResult.t.Fatal("No results are set for the RepositoryMock.UpsertPipelineTriggerResult")
 		}
 		return (*mm_results).err
 	}
-	if mmUpsertComponentDefinition.funcUpsertComponentDefinition != nil {
-		return mmUpsertComponentDefinition.funcUpsertComponentDefinition(ctx, cp1)
+	if mmUpsertPipelineTriggerResult.funcUpsertPipelineTriggerResult != nil {
+		return mmUpsertPipelineTriggerResult.funcUpsertPipelineTriggerResult(ctx, result)
 	}
-	mmUpsertComponentDefinition.t.Fatalf("Unexpected call to RepositoryMock.UpsertComponentDefinition. %v %v", ctx, cp1)
+	mmUpsertPipelineTriggerResult.t.Fatalf("Unexpected call to RepositoryMock.UpsertPipelineTriggerResult. %v %v", ctx, result)
 	return
 }
 
-// UpsertComponentDefinitionAfterCounter returns a count of finished RepositoryMock.UpsertComponentDefinition invocations
-func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinitionAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpsertComponentDefinition.afterUpsertComponentDefinitionCounter)
+// UpsertPipelineTriggerResultAfterCounter returns a count of finished RepositoryMock.UpsertPipelineTriggerResult invocations
+func (mmUpsertPipelineTriggerResult *RepositoryMock) UpsertPipelineTriggerResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertPipelineTriggerResult.afterUpsertPipelineTriggerResultCounter)
 }
 
-// UpsertComponentDefinitionBeforeCounter returns a count of RepositoryMock.UpsertComponentDefinition invocations
-func (mmUpsertComponentDefinition *RepositoryMock) UpsertComponentDefinitionBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpsertComponentDefinition.beforeUpsertComponentDefinitionCounter)
+// UpsertPipelineTriggerResultBeforeCounter returns a count of RepositoryMock.UpsertPipelineTriggerResult invocations
+func (mmUpsertPipelineTriggerResult *RepositoryMock) UpsertPipelineTriggerResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertPipelineTriggerResult.beforeUpsertPipelineTriggerResultCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.UpsertComponentDefinition.
+// Calls returns a list of arguments used in each call to RepositoryMock.UpsertPipelineTriggerResult.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpsertComponentDefinition *mRepositoryMockUpsertComponentDefinition) Calls() []*RepositoryMockUpsertComponentDefinitionParams {
-	mmUpsertComponentDefinition.mutex.RLock()
+func (mmUpsertPipelineTriggerResult *mRepositoryMockUpsertPipelineTriggerResult) Calls() []*RepositoryMockUpsertPipelineTriggerResultParams {
+	mmUpsertPipelineTriggerResult.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockUpsertComponentDefinitionParams, len(mmUpsertComponentDefinition.callArgs))
-	copy(argCopy, mmUpsertComponentDefinition.callArgs)
+	argCopy := make([]*RepositoryMockUpsertPipelineTriggerResultParams, len(mmUpsertPipelineTriggerResult.callArgs))
+	copy(argCopy, mmUpsertPipelineTriggerResult.callArgs)
 
-	mmUpsertComponentDefinition.mutex.RUnlock()
+	mmUpsertPipelineTriggerResult.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpsertComponentDefinitionDone returns true if the count of the UpsertComponentDefinition invocations corresponds
+// MinimockUpsertPipelineTriggerResultDone returns true if the count of the UpsertPipelineTriggerResult invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockUpsertComponentDefinitionDone() bool {
-	if m.UpsertComponentDefinitionMock.optional {
+func (m *RepositoryMock) MinimockUpsertPipelineTriggerResultDone() bool {
+	if m.UpsertPipelineTriggerResultMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpsertComponentDefinitionMock.expectations {
+	for _, e := range m.UpsertPipelineTriggerResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpsertComponentDefinitionMock.invocationsDone()
+	return m.UpsertPipelineTriggerResultMock.invocationsDone()
 }
 
-// MinimockUpsertComponentDefinitionInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockUpsertComponentDefinitionInspect() {
-	for _, e := range m.UpsertComponentDefinitionMock.expectations {
+// MinimockUpsertPipelineTriggerResultInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpsertPipelineTriggerResultInspect() {
+	for _, e := range m.UpsertPipelineTriggerResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentDefinition with params: %#v", *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertPipelineTriggerResult with params: %#v", *e.params)
 		}
 	}
 
-	afterUpsertComponentDefinitionCounter := mm_atomic.LoadUint64(&m.afterUpsertComponentDefinitionCounter)
+	afterUpsertPipelineTriggerResultCounter := mm_atomic.LoadUint64(&m.afterUpsertPipelineTriggerResultCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpsertComponentDefinitionMock.defaultExpectation != nil && afterUpsertComponentDefinitionCounter < 1 {
-		if m.UpsertComponentDefinitionMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to RepositoryMock.UpsertComponentDefinition")
+	if m.UpsertPipelineTriggerResultMock.defaultExpectation != nil && afterUpsertPipelineTriggerResultCounter < 1 {
+		if m.UpsertPipelineTriggerResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.UpsertPipelineTriggerResult")
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.UpsertComponentDefinition with params: %#v", *m.UpsertComponentDefinitionMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.UpsertPipelineTriggerResult with params: %#v", *m.UpsertPipelineTriggerResultMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpsertComponentDefinition != nil && afterUpsertComponentDefinitionCounter < 1 {
-		m.t.Error("Expected call to RepositoryMock.UpsertComponentDefinition")
+	if m.funcUpsertPipelineTriggerResult != nil && afterUpsertPipelineTriggerResultCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.UpsertPipelineTriggerResult")
 	}
 
-	if !m.UpsertComponentDefinitionMock.invocationsDone() && afterUpsertComponentDefinitionCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertComponentDefinition but found %d calls",
-			mm_atomic.LoadUint64(&m.UpsertComponentDefinitionMock.expectedInvocations), afterUpsertComponentDefinitionCounter)
+	if !m.UpsertPipelineTriggerResultMock.invocationsDone() && afterUpsertPipelineTriggerResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpsertPipelineTriggerResult but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertPipelineTriggerResultMock.expectedInvocations), afterUpsertPipelineTriggerResultCounter)
 	}
 }
 
@@ -13355,6 +16851,8 @@ func (m *RepositoryMock) MinimockFinish() {
 
 			m.MinimockCheckPinnedUserInspect()
 
+			m.MinimockClearExpiredPipelineRunDataInspect()
+
 			m.MinimockCreateNamespacePipelineInspect()
 
 			m.MinimockCreateNamespacePipelineReleaseInspect()
@@ -13389,14 +16887,24 @@ func (m *RepositoryMock) MinimockFinish() {
 
 			m.MinimockGetPipelineByUIDAdminInspect()
 
+			m.MinimockGetPipelineRunByTriggerIDInspect()
+
+			m.MinimockGetPipelineTriggerResultInspect()
+
 			m.MinimockListComponentDefinitionUIDsInspect()
 
+			m.MinimockListComponentRunsInspect()
+
 			m.MinimockListNamespacePipelineReleasesInspect()
 
+			m.MinimockListNamespacePipelineRunsInspect()
+
 			m.MinimockListNamespacePipelinesInspect()
 
 			m.MinimockListNamespaceSecretsInspect()
 
+			m.MinimockListPipelineRunsInspect()
+
 			m.MinimockListPipelineTagsInspect()
 
 			m.MinimockListPipelinesInspect()
@@ -13417,7 +16925,15 @@ func (m *RepositoryMock) MinimockFinish() {
 
 			m.MinimockUpdateNamespaceSecretByIDInspect()
 
+			m.MinimockUpdatePipelineRunInspect()
+
 			m.MinimockUpsertComponentDefinitionInspect()
+
+			m.MinimockUpsertComponentRunsInspect()
+
+			m.MinimockUpsertPipelineRunInspect()
+
+			m.MinimockUpsertPipelineTriggerResultInspect()
 		}
 	})
 }
@@ -13444,6 +16960,7 @@ func (m *RepositoryMock) minimockDone() bool {
 		m.MinimockAddPipelineClonesDone() &&
 		m.MinimockAddPipelineRunsDone() &&
 		m.MinimockCheckPinnedUserDone() &&
+		m.MinimockClearExpiredPipelineRunDataDone() &&
 		m.MinimockCreateNamespacePipelineDone() &&
 		m.MinimockCreateNamespacePipelineReleaseDone() &&
 		m.MinimockCreateNamespaceSecretDone() &&
@@ -13461,10 +16978,15 @@ func (m *RepositoryMock) minimockDone() bool {
 		m.MinimockGetPipelineByIDAdminDone() &&
 		m.MinimockGetPipelineByUIDDone() &&
 		m.MinimockGetPipelineByUIDAdminDone() &&
+		m.MinimockGetPipelineRunByTriggerIDDone() &&
+		m.MinimockGetPipelineTriggerResultDone() &&
 		m.MinimockListComponentDefinitionUIDsDone() &&
+		m.MinimockListComponentRunsDone() &&
 		m.MinimockListNamespacePipelineReleasesDone() &&
+		m.MinimockListNamespacePipelineRunsDone() &&
 		m.MinimockListNamespacePipelinesDone() &&
 		m.MinimockListNamespaceSecretsDone() &&
+		m.MinimockListPipelineRunsDone() &&
 		m.MinimockListPipelineTagsDone() &&
 		m.MinimockListPipelinesDone() &&
 		m.MinimockListPipelinesAdminDone() &&
@@ -13475,5 +16997,9 @@ func (m *RepositoryMock) minimockDone() bool {
 		m.MinimockUpdateNamespacePipelineReleaseByIDDone() &&
 		m.MinimockUpdateNamespacePipelineReleaseIDByIDDone() &&
 		m.MinimockUpdateNamespaceSecretByIDDone() &&
-		m.MinimockUpsertComponentDefinitionDone()
+		m.MinimockUpdatePipelineRunDone() &&
+		m.MinimockUpsertComponentDefinitionDone() &&
+		m.MinimockUpsertComponentRunsDone() &&
+		m.MinimockUpsertPipelineRunDone() &&
+		m.MinimockUpsertPipelineTriggerResultDone()
 }
@@ -0,0 +1,125 @@
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/instill-ai/pipeline-backend/config"
+)
+
+// The approval components write a request per batch item, which is listed
+// in the namespace of the pipeline until it's reviewed:
+// pipeline_approval:<ownerUID>:<triggerID>:<approvalID>
+const approvalKeyPrefix = "pipeline_approval"
+
+// The outputs of an approval component.
+const (
+	ApprovalOutputApproved = "approved"
+	ApprovalOutputPayload  = "payload"
+	ApprovalOutputReviewer = "reviewer"
+	ApprovalOutputComment  = "comment"
+)
+
+// ApprovalRequest is an input waiting for a review.
+type ApprovalRequest struct {
+	ID          string `json:"id"`
+	TriggerID   string `json:"triggerId"`
+	PipelineUID string `json:"pipelineUid"`
+	PipelineID  string `json:"pipelineId"`
+	ComponentID string `json:"componentId"`
+	// WorkflowID is the workflow that waits for the review and BatchIndex
+	// the index of the reviewed item in its batch.
+	WorkflowID string         `json:"workflowId"`
+	BatchIndex int            `json:"batchIndex"`
+	Payload    map[string]any `json:"payload"`
+	CreateTime time.Time      `json:"createTime"`
+	ExpireTime *time.Time     `json:"expireTime,omitempty"`
+}
+
+func approvalKey(ownerUID uuid.UUID, triggerID, approvalID string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", approvalKeyPrefix, ownerUID, triggerID, approvalID)
+}
+
+// WriteApprovalRequest stores an approval request in the namespace that owns
+// the pipeline.
+func WriteApprovalRequest(ctx context.Context, rc *redis.Client, ownerUID uuid.UUID, req *ApprovalRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return rc.Set(
+		ctx,
+		approvalKey(ownerUID, req.TriggerID, req.ID),
+		b,
+		time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout)*time.Second,
+	).Err()
+}
+
+// ListApprovalRequests returns the pending approval requests of a namespace,
+// from the oldest.
+func ListApprovalRequests(ctx context.Context, rc *redis.Client, ownerUID uuid.UUID) ([]*ApprovalRequest, error) {
+	return scanApprovalRequests(ctx, rc, fmt.Sprintf("%s:%s:*", approvalKeyPrefix, ownerUID))
+}
+
+// ListTriggerApprovalRequests returns the pending approval requests of a
+// trigger, including the ones of the pipelines it calls.
+func ListTriggerApprovalRequests(ctx context.Context, rc *redis.Client, triggerID string) ([]*ApprovalRequest, error) {
+	return scanApprovalRequests(ctx, rc, fmt.Sprintf("%s:*:%s:*", approvalKeyPrefix, triggerID))
+}
+
+// LoadApprovalRequest returns a pending approval request of a namespace.
+func LoadApprovalRequest(ctx context.Context, rc *redis.Client, ownerUID uuid.UUID, approvalID string) (*ApprovalRequest, bool, error) {
+	reqs, err := scanApprovalRequests(ctx, rc, fmt.Sprintf("%s:%s:*:%s", approvalKeyPrefix, ownerUID, approvalID))
+	if err != nil || len(reqs) == 0 {
+		return nil, false, err
+	}
+	return reqs[0], true, nil
+}
+
+// DeleteApprovalRequest removes a reviewed approval request.
+func DeleteApprovalRequest(ctx context.Context, rc *redis.Client, ownerUID uuid.UUID, triggerID, approvalID string) error {
+	return rc.Del(ctx, approvalKey(ownerUID, triggerID, approvalID)).Err()
+}
+
+// PurgeApprovalRequests removes the pending approval requests of a trigger.
+func PurgeApprovalRequests(ctx context.Context, rc *redis.Client, triggerID string) {
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s:*:%s:*", approvalKeyPrefix, triggerID), 0).Iterator()
+	for iter.Next(ctx) {
+		rc.Del(ctx, iter.Val())
+	}
+}
+
+func scanApprovalRequests(ctx context.Context, rc *redis.Client, pattern string) ([]*ApprovalRequest, error) {
+	reqs := []*ApprovalRequest{}
+	iter := rc.Scan(ctx, 0, pattern, 0).Iterator()
+	for iter.Next(ctx) {
+		b, err := rc.Get(ctx, iter.Val()).Bytes()
+		if errors.Is(err, redis.Nil) {
+			// The request was reviewed in the meantime.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		req := &ApprovalRequest{}
+		if err := json.Unmarshal(b, req); err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(reqs, func(i, j int) bool {
+		return reqs[i].CreateTime.Before(reqs[j].CreateTime)
+	})
+	return reqs, nil
+}
@@ -0,0 +1,276 @@
+package recipe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
+
+	componentbase "github.com/instill-ai/component/base"
+)
+
+// A binding is the content of a `${...}` expression in a recipe template. It
+// is composed of a reference path followed by an optional pipeline of
+// filters:
+//
+//	binding = path { "|" filter }
+//	filter  = ident [ "(" [ expr { "," expr } ] ")" ]
+//
+// Each filter is a function from the library in functions.go. The value on the
+// left of the pipe is passed as the first argument, so `${x | join(", ")}` is
+// equivalent to `join(x, ", ")`. Filter arguments are expressions of the
+// condition language and can contain references, e.g.
+// `${variable.title | default(${comp.output.title})}`.
+
+// BindingError is returned when a binding can't be parsed or evaluated. Pos
+// is the 1-based column in the binding content.
+type BindingError struct {
+	Binding string
+	Pos     int
+	Msg     string
+}
+
+func (e *BindingError) Error() string {
+	return fmt.Sprintf("binding error in ${%s} at column %d: %s", e.Binding, e.Pos, e.Msg)
+}
+
+type filterNode struct {
+	pos  int
+	name string
+	args []condNode
+}
+
+type binding struct {
+	content string
+	path    string
+	filters []*filterNode
+}
+
+// bindingEnd returns the index right after the brace that closes the binding
+// starting at s[start:], or -1 if the binding isn't terminated. Braces in
+// quoted strings are ignored and nested bindings are skipped.
+func bindingEnd(s string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start + 2; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				return i + 1
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// filterStart returns the index of the first pipe that separates the path
+// from the filters, or -1 if the binding has no filters.
+func filterStart(content string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(' || c == '{':
+			depth++
+		case c == ']' || c == ')' || c == '}':
+			depth--
+		case c == '|' && depth == 0:
+			return i
+		}
+	}
+	return -1
+}
+
+func parseBinding(content string) (*binding, error) {
+	b := &binding{content: content}
+
+	idx := filterStart(content)
+	if idx == -1 {
+		b.path = strings.TrimSpace(content)
+	} else {
+		b.path = strings.TrimSpace(content[:idx])
+	}
+	if b.path == "" {
+		return nil, &BindingError{Binding: content, Pos: 1, Msg: "empty reference"}
+	}
+	if idx == -1 {
+		return b, nil
+	}
+
+	// The filter pipeline is tokenized with the condition lexer. Positions are
+	// shifted so they're relative to the binding content.
+	toBindingErr := func(err error) error {
+		var condErr *ConditionError
+		if errors.As(err, &condErr) {
+			return &BindingError{Binding: content, Pos: condErr.Pos + idx, Msg: condErr.Msg}
+		}
+		return err
+	}
+
+	tokens, err := lexCondition(content[idx:])
+	if err != nil {
+		return nil, toBindingErr(err)
+	}
+	p := &condParser{cond: content[idx:], tokens: tokens}
+	for p.peek().kind != tkEOF {
+		if err := p.expect("|"); err != nil {
+			return nil, toBindingErr(err)
+		}
+		t := p.next()
+		if t.kind != tkIdent {
+			if t.kind == tkEOF {
+				return nil, toBindingErr(p.errorf(t.pos, "expected a filter name"))
+			}
+			return nil, toBindingErr(p.errorf(t.pos, "expected a filter name but found %q", t.val))
+		}
+		fn, ok := exprFuncs[t.val]
+		if !ok || fn.minArgs < 1 {
+			return nil, toBindingErr(p.errorf(t.pos, "unknown filter %q", t.val))
+		}
+		f := &filterNode{pos: t.pos + idx, name: t.val, args: []condNode{}}
+		if p.isPunct("(") {
+			p.next()
+			if f.args, err = p.parseList(")"); err != nil {
+				return nil, toBindingErr(err)
+			}
+		}
+		if n := len(f.args) + 1; n < fn.minArgs || (fn.maxArgs >= 0 && n > fn.maxArgs) {
+			return nil, toBindingErr(p.errorf(t.pos, "filter %q %s", t.val, fn.filterArity()))
+		}
+		b.filters = append(b.filters, f)
+	}
+
+	return b, nil
+}
+
+// eval resolves the binding against the memory of a batch item. The
+// undefined value is returned when the reference can't be resolved and no
+// filter provides a fallback.
+func (b *binding) eval(memory *Memory) (any, error) {
+	var val any = undefined
+	if b.path == SegSecret+"."+constant.GlobalSecretKey {
+		val = componentbase.SecretKeyword
+	} else if v, err := TraverseBinding(memory, b.path); err == nil {
+		val = normalizeValue(v)
+	}
+
+	e := &condEvaluator{cond: b.content, memory: memory}
+	for _, f := range b.filters {
+		fn := exprFuncs[f.name]
+		if val == undefined && !fn.acceptsUndefined {
+			return undefined, nil
+		}
+		args := []any{val}
+		for _, arg := range f.args {
+			v, err := e.eval(arg)
+			if err != nil {
+				var condErr *ConditionError
+				if errors.As(err, &condErr) {
+					return nil, &BindingError{Binding: b.content, Pos: condErr.Pos, Msg: condErr.Msg}
+				}
+				return nil, err
+			}
+			args = append(args, v)
+		}
+		v, err := fn.fn(args)
+		if err != nil {
+			return nil, &BindingError{Binding: b.content, Pos: f.pos + 1, Msg: fmt.Sprintf("%s: %s", f.name, err)}
+		}
+		val = v
+	}
+	return val, nil
+}
+
+// references returns the references used in the binding, including the ones
+// in filter arguments.
+func (b *binding) references() []string {
+	refs := []string{b.path}
+	var walk func(n condNode)
+	walk = func(n condNode) {
+		switch n := n.(type) {
+		case *refNode:
+			refs = append(refs, n.binding.references()...)
+		case *listNode:
+			for _, elem := range n.elems {
+				walk(elem)
+			}
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.x)
+			walk(n.y)
+		case *callNode:
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		case *indexNode:
+			walk(n.x)
+			walk(n.index)
+		case *selectorNode:
+			walk(n.x)
+		}
+	}
+	for _, f := range b.filters {
+		for _, arg := range f.args {
+			walk(arg)
+		}
+	}
+	return refs
+}
+
+// ResolveBinding evaluates the content of a `${...}` expression against the
+// memory of a batch item.
+func ResolveBinding(memory *Memory, content string) (any, error) {
+	b, err := parseBinding(content)
+	if err != nil {
+		return nil, err
+	}
+	v, err := b.eval(memory)
+	if err != nil {
+		return nil, err
+	}
+	if v == undefined {
+		return nil, fmt.Errorf("reference not correct: '%s'", b.path)
+	}
+	return v, nil
+}
+
+// ValidateTemplate checks the syntax of every binding in a template string.
+func ValidateTemplate(template string) error {
+	for {
+		start := strings.Index(template, "${")
+		if start == -1 {
+			return nil
+		}
+		end := bindingEnd(template, start)
+		if end == -1 {
+			return &BindingError{Binding: template[start+2:], Pos: 1, Msg: "unterminated binding"}
+		}
+		if _, err := parseBinding(template[start+2 : end-1]); err != nil {
+			return err
+		}
+		template = template[end:]
+	}
+}
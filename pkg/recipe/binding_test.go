@@ -0,0 +1,74 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestRenderInput_Filters(t *testing.T) {
+	c := qt.New(t)
+
+	memory := &Memory{
+		Variable: VariableMemory{
+			"name": "pipeline",
+			"json": `{"a": 1}`,
+		},
+		Component: map[string]*ComponentMemory{
+			"comp": {
+				Output: &ComponentIO{
+					"items": []any{"x", "y", "z"},
+					"docs":  []any{map[string]any{"id": "d1"}, map[string]any{"id": "d2"}},
+					"title": "Fallback",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		template any
+		want     any
+	}{
+		{name: "upper", template: "${variable.name | upper}", want: "PIPELINE"},
+		{name: "chained", template: "${comp.output.docs | pluck('id') | join(\",\")}", want: "d1,d2"},
+		{name: "join with braces", template: `${comp.output.items | join("}{")}`, want: "x}{y}{z"},
+		{name: "default literal", template: `${variable.missing | default("n/a")}`, want: "n/a"},
+		{name: "default reference", template: "${variable.missing | default(${comp.output.title})}", want: "Fallback"},
+		{name: "tojson", template: "${comp.output.items | tojson}", want: `["x","y","z"]`},
+		{name: "tojson missing reference", template: "${variable.missing | tojson}", want: "null"},
+		{name: "fromjson keeps type", template: "${variable.json | fromjson}", want: map[string]any{"a": float64(1)}},
+		{name: "slice keeps type", template: "${comp.output.items | slice(-2)}", want: []any{"y", "z"}},
+		{name: "interpolation", template: "Hello ${variable.name | upper}, ${comp.output.items | length} items", want: "Hello PIPELINE, 3 items"},
+		{name: "structured", template: map[string]any{"k": []any{"${comp.output.items | first}"}}, want: map[string]any{"k": []any{"x"}}},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.name, func(c *qt.C) {
+			got, err := RenderInput(tc.template, 0, memory)
+			c.Assert(err, qt.IsNil)
+			c.Check(got, qt.DeepEquals, tc.want)
+		})
+	}
+
+	c.Run("missing reference without default", func(c *qt.C) {
+		_, err := RenderInput("${variable.missing | upper}", 0, memory)
+		c.Check(err, qt.ErrorMatches, "reference not correct: 'variable.missing'")
+	})
+}
+
+func TestValidateTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	c.Check(ValidateTemplate("a ${variable.x | join(', ') | upper} b"), qt.IsNil)
+	c.Check(ValidateTemplate("${variable.x | nope}"), qt.ErrorMatches, `binding error in \${variable.x \| nope} at column 14: unknown filter "nope"`)
+	c.Check(ValidateTemplate("${variable.x | truncate}"), qt.ErrorMatches, `.*filter "truncate" expects 1 argument`)
+	c.Check(ValidateTemplate("${variable.x | default(}"), qt.ErrorMatches, `.*at column 22: unexpected end of expression`)
+}
+
+func TestFindReferenceParent(t *testing.T) {
+	c := qt.New(t)
+
+	got := FindReferenceParent(`${a.output.x | default(${b.output.y})} and ${variable.z | join("}")}`)
+	c.Check(got, qt.DeepEquals, []string{"a", "b", "variable"})
+}
@@ -0,0 +1,103 @@
+package recipe
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Cached component outputs are stored by pipeline, under the hash of what
+// produces them:
+// pipeline_cache:<pipelineUID>:<hash>
+const cacheKeyPrefix = "pipeline_cache"
+
+// CacheStatus records whether the output of a component was read from the
+// cache.
+type CacheStatus struct {
+	Key string `json:"key"`
+	Hit bool   `json:"hit"`
+}
+
+// CacheEntry holds what identifies the output of a component execution.
+type CacheEntry struct {
+	DefinitionID      string
+	DefinitionVersion string
+	Task              string
+	Setup             map[string]any
+	Input             map[string]any
+}
+
+// Hash returns the content address of the entry. When fields isn't empty,
+// only these input fields are part of the hash.
+func (e CacheEntry) Hash(fields []string) (string, error) {
+	input := e.Input
+	if len(fields) > 0 {
+		input = make(map[string]any, len(fields))
+		for _, f := range fields {
+			input[f] = e.Input[f]
+		}
+	}
+
+	// Maps are marshalled with sorted keys, so the hash doesn't depend on
+	// the order of the fields.
+	b, err := json.Marshal(map[string]any{
+		"definition": e.DefinitionID,
+		"version":    e.DefinitionVersion,
+		"task":       e.Task,
+		"setup":      e.Setup,
+		"input":      input,
+	})
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func cacheKey(pipelineUID uuid.UUID, hash string) string {
+	return fmt.Sprintf("%s:%s:%s", cacheKeyPrefix, pipelineUID, hash)
+}
+
+// LoadCachedOutput returns the cached output of a component, if any.
+func LoadCachedOutput(ctx context.Context, rc *redis.Client, pipelineUID uuid.UUID, hash string) (map[string]any, bool, error) {
+	b, err := rc.Get(ctx, cacheKey(pipelineUID, hash)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	output := map[string]any{}
+	if err := json.Unmarshal(b, &output); err != nil {
+		return nil, false, err
+	}
+	return output, true, nil
+}
+
+// WriteCachedOutput caches the output of a component for ttl.
+func WriteCachedOutput(ctx context.Context, rc *redis.Client, pipelineUID uuid.UUID, hash string, output map[string]any, ttl time.Duration) error {
+	b, err := json.Marshal(output)
+	if err != nil {
+		return err
+	}
+	return rc.Set(ctx, cacheKey(pipelineUID, hash), b, ttl).Err()
+}
+
+// PurgeCache removes the cached component outputs of a pipeline.
+func PurgeCache(ctx context.Context, rc *redis.Client, pipelineUID uuid.UUID) error {
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s:%s:*", cacheKeyPrefix, pipelineUID), 0).Iterator()
+	for iter.Next(ctx) {
+		if err := rc.Del(ctx, iter.Val()).Err(); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
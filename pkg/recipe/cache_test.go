@@ -0,0 +1,46 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCacheEntry_Hash(t *testing.T) {
+	c := qt.New(t)
+
+	entry := CacheEntry{
+		DefinitionID:      "openai",
+		DefinitionVersion: "0.1.0",
+		Task:              "TASK_TEXT_GENERATION",
+		Setup:             map[string]any{"api-key": "key"},
+		Input:             map[string]any{"prompt": "hello", "request-id": "1"},
+	}
+	hash, err := entry.Hash(nil)
+	c.Assert(err, qt.IsNil)
+	c.Check(hash, qt.HasLen, 64)
+
+	c.Run("input fields", func(c *qt.C) {
+		other := entry
+		other.Input = map[string]any{"prompt": "hello", "request-id": "2"}
+
+		h, err := other.Hash(nil)
+		c.Assert(err, qt.IsNil)
+		c.Check(h, qt.Not(qt.Equals), hash)
+
+		h1, err := entry.Hash([]string{"prompt"})
+		c.Assert(err, qt.IsNil)
+		h2, err := other.Hash([]string{"prompt"})
+		c.Assert(err, qt.IsNil)
+		c.Check(h1, qt.Equals, h2)
+	})
+
+	c.Run("definition version", func(c *qt.C) {
+		other := entry
+		other.DefinitionVersion = "0.2.0"
+
+		h, err := other.Hash(nil)
+		c.Assert(err, qt.IsNil)
+		c.Check(h, qt.Not(qt.Equals), hash)
+	})
+}
@@ -0,0 +1,934 @@
+package recipe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The condition language is a small, side-effect free expression language
+// used in the `condition` field of components. A condition is evaluated
+// against the trigger memory and the component is skipped when the result is
+// falsy.
+//
+// Grammar (lowest to highest precedence):
+//
+//	or         = and { "||" and }
+//	and        = comparison { "&&" comparison }
+//	comparison = additive [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" |
+//	                          "=~" | "!~" | "in" | "not in" ) additive ]
+//	additive   = multiplicative { ( "+" | "-" ) multiplicative }
+//	multiplicative = unary { ( "*" | "/" | "%" ) unary }
+//	unary      = ( "!" | "-" ) unary | postfix
+//	postfix    = primary { "." ident | "[" or "]" }
+//	primary    = number | string | "true" | "false" | "null" | reference |
+//	             ident "(" [ or { "," or } ] ")" | "(" or ")" |
+//	             "[" [ or { "," or } ] "]"
+//	reference  = "${" binding "}"
+//
+// The content of a reference is a binding (see binding.go), so filters can be
+// used in conditions too. The functions are defined in functions.go.
+//
+// References that can't be resolved evaluate to an undefined value, which can
+// be detected with `defined(...)`. Undefined values behave like `null` in every
+// other context.
+
+// ConditionError is returned when a condition can't be parsed or evaluated.
+// Pos is the 1-based column in the condition string where the error was
+// detected.
+type ConditionError struct {
+	Condition string
+	Pos       int
+	Msg       string
+}
+
+func (e *ConditionError) Error() string {
+	return fmt.Sprintf("condition error at column %d: %s", e.Pos, e.Msg)
+}
+
+type undefinedValue struct{}
+
+// undefined is the value of a reference that doesn't exist in the memory.
+var undefined = undefinedValue{}
+
+type condTokenKind int
+
+const (
+	tkEOF condTokenKind = iota
+	tkNumber
+	tkString
+	tkIdent
+	tkRef
+	tkPunct
+)
+
+type condToken struct {
+	kind condTokenKind
+	val  string
+	pos  int // 0-based byte offset
+}
+
+func lexCondition(cond string) ([]condToken, error) {
+	tokens := []condToken{}
+	i := 0
+	for i < len(cond) {
+		r, size := utf8.DecodeRuneInString(cond[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+
+		case strings.HasPrefix(cond[i:], "${"):
+			end := bindingEnd(cond, i)
+			if end == -1 {
+				return nil, &ConditionError{Condition: cond, Pos: i + 1, Msg: "unterminated reference"}
+			}
+			tokens = append(tokens, condToken{kind: tkRef, val: cond[i+2 : end-1], pos: i})
+			i = end
+
+		case r == '"' || r == '\'':
+			j := i + 1
+			escaped := false
+			for ; j < len(cond); j++ {
+				if escaped {
+					escaped = false
+					continue
+				}
+				if cond[j] == '\\' {
+					escaped = true
+					continue
+				}
+				if rune(cond[j]) == r {
+					break
+				}
+			}
+			if j >= len(cond) {
+				return nil, &ConditionError{Condition: cond, Pos: i + 1, Msg: "unterminated string"}
+			}
+			raw := cond[i+1 : j]
+			if r == '\'' {
+				raw = strings.ReplaceAll(raw, `\'`, `'`)
+				raw = strings.ReplaceAll(raw, `"`, `\"`)
+			}
+			s, err := strconv.Unquote(`"` + raw + `"`)
+			if err != nil {
+				return nil, &ConditionError{Condition: cond, Pos: i + 1, Msg: "invalid string literal"}
+			}
+			tokens = append(tokens, condToken{kind: tkString, val: s, pos: i})
+			i = j + 1
+
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(cond) && (unicode.IsDigit(rune(cond[j])) || cond[j] == '.' || cond[j] == 'e' || cond[j] == 'E' ||
+				((cond[j] == '+' || cond[j] == '-') && (cond[j-1] == 'e' || cond[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, condToken{kind: tkNumber, val: cond[i:j], pos: i})
+			i = j
+
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(cond) {
+				r, size := utf8.DecodeRuneInString(cond[j:])
+				if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+					break
+				}
+				j += size
+			}
+			tokens = append(tokens, condToken{kind: tkIdent, val: cond[i:j], pos: i})
+			i = j
+
+		default:
+			op := ""
+			for _, candidate := range []string{"&&", "||", "|", "==", "!=", "<=", ">=", "=~", "!~", "!", "<", ">", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", "."} {
+				if strings.HasPrefix(cond[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, &ConditionError{Condition: cond, Pos: i + 1, Msg: fmt.Sprintf("unexpected character %q", r)}
+			}
+			tokens = append(tokens, condToken{kind: tkPunct, val: op, pos: i})
+			i += len(op)
+		}
+	}
+	tokens = append(tokens, condToken{kind: tkEOF, pos: len(cond)})
+	return tokens, nil
+}
+
+type condNode interface {
+	position() int
+}
+
+type (
+	literalNode struct {
+		pos int
+		val any
+	}
+	refNode struct {
+		pos     int
+		binding *binding
+	}
+	listNode struct {
+		pos   int
+		elems []condNode
+	}
+	unaryNode struct {
+		pos int
+		op  string
+		x   condNode
+	}
+	binaryNode struct {
+		pos  int
+		op   string
+		x, y condNode
+	}
+	callNode struct {
+		pos  int
+		name string
+		args []condNode
+	}
+	indexNode struct {
+		pos   int
+		x     condNode
+		index condNode
+	}
+	selectorNode struct {
+		pos int
+		x   condNode
+		sel string
+	}
+)
+
+func (n *literalNode) position() int  { return n.pos }
+func (n *refNode) position() int      { return n.pos }
+func (n *listNode) position() int     { return n.pos }
+func (n *unaryNode) position() int    { return n.pos }
+func (n *binaryNode) position() int   { return n.pos }
+func (n *callNode) position() int     { return n.pos }
+func (n *indexNode) position() int    { return n.pos }
+func (n *selectorNode) position() int { return n.pos }
+
+type condParser struct {
+	cond   string
+	tokens []condToken
+	idx    int
+}
+
+func (p *condParser) peek() condToken {
+	return p.tokens[p.idx]
+}
+
+func (p *condParser) next() condToken {
+	t := p.tokens[p.idx]
+	if t.kind != tkEOF {
+		p.idx++
+	}
+	return t
+}
+
+func (p *condParser) isPunct(val string) bool {
+	t := p.peek()
+	return t.kind == tkPunct && t.val == val
+}
+
+func (p *condParser) isKeyword(val string) bool {
+	t := p.peek()
+	return t.kind == tkIdent && t.val == val
+}
+
+func (p *condParser) errorf(pos int, format string, a ...any) error {
+	return &ConditionError{Condition: p.cond, Pos: pos + 1, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *condParser) unexpected() error {
+	t := p.peek()
+	if t.kind == tkEOF {
+		return p.errorf(t.pos, "unexpected end of expression")
+	}
+	return p.errorf(t.pos, "unexpected token %q", t.val)
+}
+
+func (p *condParser) expect(val string) error {
+	if !p.isPunct(val) {
+		t := p.peek()
+		if t.kind == tkEOF {
+			return p.errorf(t.pos, "expected %q but reached the end of expression", val)
+		}
+		return p.errorf(t.pos, "expected %q but found %q", val, t.val)
+	}
+	p.next()
+	return nil
+}
+
+func (p *condParser) parseOr() (condNode, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("||") {
+		t := p.next()
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.val, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *condParser) parseAnd() (condNode, error) {
+	x, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("&&") {
+		t := p.next()
+		y, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.val, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *condParser) parseComparison() (condNode, error) {
+	x, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	op := ""
+	switch {
+	case t.kind == tkPunct && (t.val == "==" || t.val == "!=" || t.val == "<" || t.val == "<=" ||
+		t.val == ">" || t.val == ">=" || t.val == "=~" || t.val == "!~"):
+		op = t.val
+		p.next()
+	case p.isKeyword("in"):
+		op = "in"
+		p.next()
+	case p.isKeyword("not"):
+		p.next()
+		if !p.isKeyword("in") {
+			return nil, p.unexpected()
+		}
+		p.next()
+		op = "not in"
+	default:
+		return x, nil
+	}
+
+	y, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	if op == "=~" || op == "!~" {
+		if lit, ok := y.(*literalNode); ok {
+			s, isStr := lit.val.(string)
+			if !isStr {
+				return nil, p.errorf(lit.pos, "regular expression must be a string")
+			}
+			if _, err := regexp.Compile(s); err != nil {
+				return nil, p.errorf(lit.pos, "invalid regular expression: %s", err)
+			}
+		}
+	}
+	return &binaryNode{pos: t.pos, op: op, x: x, y: y}, nil
+}
+
+func (p *condParser) parseAdditive() (condNode, error) {
+	x, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("+") || p.isPunct("-") {
+		t := p.next()
+		y, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.val, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *condParser) parseMultiplicative() (condNode, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("*") || p.isPunct("/") || p.isPunct("%") {
+		t := p.next()
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.val, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *condParser) parseUnary() (condNode, error) {
+	if p.isPunct("!") || p.isPunct("-") {
+		t := p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{pos: t.pos, op: t.val, x: x}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *condParser) parsePostfix() (condNode, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isPunct("."):
+			t := p.next()
+			sel := p.next()
+			if sel.kind != tkIdent {
+				return nil, p.errorf(sel.pos, "expected field name after '.'")
+			}
+			x = &selectorNode{pos: t.pos, x: x, sel: sel.val}
+		case p.isPunct("["):
+			t := p.next()
+			index, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			x = &indexNode{pos: t.pos, x: x, index: index}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *condParser) parseList(closing string) ([]condNode, error) {
+	elems := []condNode{}
+	if p.isPunct(closing) {
+		p.next()
+		return elems, nil
+	}
+	for {
+		elem, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		if p.isPunct(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect(closing); err != nil {
+			return nil, err
+		}
+		return elems, nil
+	}
+}
+
+func (p *condParser) parsePrimary() (condNode, error) {
+	t := p.peek()
+	switch t.kind {
+	case tkNumber:
+		p.next()
+		f, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, p.errorf(t.pos, "invalid number %q", t.val)
+		}
+		return &literalNode{pos: t.pos, val: f}, nil
+	case tkString:
+		p.next()
+		return &literalNode{pos: t.pos, val: t.val}, nil
+	case tkRef:
+		p.next()
+		b, err := parseBinding(t.val)
+		if err != nil {
+			var bindingErr *BindingError
+			if errors.As(err, &bindingErr) {
+				return nil, p.errorf(t.pos+2+bindingErr.Pos-1, "%s", bindingErr.Msg)
+			}
+			return nil, err
+		}
+		return &refNode{pos: t.pos, binding: b}, nil
+	case tkIdent:
+		p.next()
+		switch t.val {
+		case "true":
+			return &literalNode{pos: t.pos, val: true}, nil
+		case "false":
+			return &literalNode{pos: t.pos, val: false}, nil
+		case "null":
+			return &literalNode{pos: t.pos, val: nil}, nil
+		}
+		if !p.isPunct("(") {
+			return nil, p.errorf(t.pos, "unknown identifier %q, references must use the ${...} syntax", t.val)
+		}
+		fn, ok := exprFuncs[t.val]
+		if !ok {
+			return nil, p.errorf(t.pos, "unknown function %q", t.val)
+		}
+		p.next()
+		args, err := p.parseList(")")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+			return nil, p.errorf(t.pos, "function %q %s", t.val, fn.arity())
+		}
+		return &callNode{pos: t.pos, name: t.val, args: args}, nil
+	case tkPunct:
+		switch t.val {
+		case "(":
+			p.next()
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		case "[":
+			p.next()
+			elems, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{pos: t.pos, elems: elems}, nil
+		}
+	}
+	return nil, p.unexpected()
+}
+
+// Condition is a parsed component condition.
+type Condition struct {
+	src  string
+	root condNode
+}
+
+// ParseCondition parses a component condition. Syntax errors, unknown
+// functions, wrong arities and invalid regular expression literals are
+// reported as a *ConditionError.
+func ParseCondition(cond string) (*Condition, error) {
+	tokens, err := lexCondition(cond)
+	if err != nil {
+		return nil, err
+	}
+	p := &condParser{cond: cond, tokens: tokens}
+	if p.peek().kind == tkEOF {
+		return nil, p.errorf(0, "empty condition")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tkEOF {
+		return nil, p.unexpected()
+	}
+	return &Condition{src: cond, root: root}, nil
+}
+
+// Eval evaluates the condition against the trigger memory of a batch item and
+// returns its truthiness.
+func (c *Condition) Eval(memory *Memory) (bool, error) {
+	e := &condEvaluator{cond: c.src, memory: memory}
+	v, err := e.eval(c.root)
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// EvalCondition parses and evaluates a component condition against the trigger
+// memory of a batch item.
+func EvalCondition(cond string, memory *Memory) (bool, error) {
+	c, err := ParseCondition(cond)
+	if err != nil {
+		return false, err
+	}
+	return c.Eval(memory)
+}
+
+type condEvaluator struct {
+	cond   string
+	memory *Memory
+}
+
+func (e *condEvaluator) errorf(n condNode, format string, a ...any) error {
+	return &ConditionError{Condition: e.cond, Pos: n.position() + 1, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (e *condEvaluator) eval(n condNode) (any, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		return n.val, nil
+
+	case *refNode:
+		v, err := n.binding.eval(e.memory)
+		if err != nil {
+			var bindingErr *BindingError
+			if errors.As(err, &bindingErr) {
+				return nil, e.errorf(n, "%s", bindingErr.Error())
+			}
+			return nil, err
+		}
+		return v, nil
+
+	case *listNode:
+		l := make([]any, 0, len(n.elems))
+		for _, elem := range n.elems {
+			v, err := e.eval(elem)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		return l, nil
+
+	case *unaryNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "!":
+			return !truthy(x), nil
+		case "-":
+			f, ok := x.(float64)
+			if !ok {
+				return nil, e.errorf(n, "cannot negate %s", typeName(x))
+			}
+			return -f, nil
+		}
+
+	case *binaryNode:
+		return e.evalBinary(n)
+
+	case *callNode:
+		args := make([]any, 0, len(n.args))
+		for _, arg := range n.args {
+			v, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, v)
+		}
+		v, err := exprFuncs[n.name].fn(args)
+		if err != nil {
+			return nil, e.errorf(n, "%s: %s", n.name, err)
+		}
+		return v, nil
+
+	case *selectorNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case map[string]any:
+			v, ok := x[n.sel]
+			if !ok {
+				return undefined, nil
+			}
+			return v, nil
+		case nil, undefinedValue:
+			return undefined, nil
+		}
+		return nil, e.errorf(n, "cannot access field %q of %s", n.sel, typeName(x))
+
+	case *indexNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		index, err := e.eval(n.index)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case []any:
+			f, ok := index.(float64)
+			if !ok || f != math.Trunc(f) {
+				return nil, e.errorf(n, "list index must be an integer, got %s", typeName(index))
+			}
+			i := int(f)
+			if i < 0 {
+				i += len(x)
+			}
+			if i < 0 || i >= len(x) {
+				return undefined, nil
+			}
+			return x[i], nil
+		case map[string]any:
+			k, ok := index.(string)
+			if !ok {
+				return nil, e.errorf(n, "object key must be a string, got %s", typeName(index))
+			}
+			v, ok := x[k]
+			if !ok {
+				return undefined, nil
+			}
+			return v, nil
+		case nil, undefinedValue:
+			return undefined, nil
+		}
+		return nil, e.errorf(n, "cannot index %s", typeName(x))
+	}
+
+	return nil, e.errorf(n, "unsupported expression")
+}
+
+func (e *condEvaluator) evalBinary(n *binaryNode) (any, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logical operators short-circuit.
+	switch n.op {
+	case "&&":
+		if !truthy(x) {
+			return false, nil
+		}
+		y, err := e.eval(n.y)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(y), nil
+	case "||":
+		if truthy(x) {
+			return true, nil
+		}
+		y, err := e.eval(n.y)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(y), nil
+	}
+
+	y, err := e.eval(n.y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return valuesEqual(x, y), nil
+	case "!=":
+		return !valuesEqual(x, y), nil
+
+	case "<", "<=", ">", ">=":
+		cmp, ok := compareValues(x, y)
+		if !ok {
+			return nil, e.errorf(n, "cannot compare %s and %s with %q", typeName(x), typeName(y), n.op)
+		}
+		switch n.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+
+	case "=~", "!~":
+		s, ok := x.(string)
+		if !ok {
+			if isNullish(x) {
+				return n.op == "!~", nil
+			}
+			return nil, e.errorf(n, "cannot match %s against a regular expression", typeName(x))
+		}
+		pattern, ok := y.(string)
+		if !ok {
+			return nil, e.errorf(n.y, "regular expression must be a string, got %s", typeName(y))
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, e.errorf(n.y, "invalid regular expression: %s", err)
+		}
+		return re.MatchString(s) == (n.op == "=~"), nil
+
+	case "in", "not in":
+		found, err := contains(y, x)
+		if err != nil {
+			return nil, e.errorf(n, "%s", err)
+		}
+		return found == (n.op == "in"), nil
+
+	case "+":
+		if xs, ok := x.(string); ok {
+			if ys, ok := y.(string); ok {
+				return xs + ys, nil
+			}
+		}
+		fallthrough
+	case "-", "*", "/", "%":
+		xf, xok := x.(float64)
+		yf, yok := y.(float64)
+		if !xok || !yok {
+			return nil, e.errorf(n, "operator %q is not defined for %s and %s", n.op, typeName(x), typeName(y))
+		}
+		switch n.op {
+		case "+":
+			return xf + yf, nil
+		case "-":
+			return xf - yf, nil
+		case "*":
+			return xf * yf, nil
+		case "/":
+			if yf == 0 {
+				return nil, e.errorf(n, "division by zero")
+			}
+			return xf / yf, nil
+		default:
+			if yf == 0 {
+				return nil, e.errorf(n, "division by zero")
+			}
+			return math.Mod(xf, yf), nil
+		}
+	}
+
+	return nil, e.errorf(n, "unsupported operator %q", n.op)
+}
+
+// normalizeValue converts the numeric types that can be found in the memory
+// into float64 so values can be compared consistently.
+func normalizeValue(v any) any {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case []any:
+		l := make([]any, len(v))
+		for i := range v {
+			l[i] = normalizeValue(v[i])
+		}
+		return l
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k := range v {
+			m[k] = normalizeValue(v[k])
+		}
+		return m
+	}
+	return v
+}
+
+func isNullish(v any) bool {
+	return v == nil || v == undefined
+}
+
+func truthy(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+	return false
+}
+
+func valuesEqual(x, y any) bool {
+	if isNullish(x) || isNullish(y) {
+		return isNullish(x) && isNullish(y)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func compareValues(x, y any) (int, bool) {
+	switch x := x.(type) {
+	case float64:
+		if y, ok := y.(float64); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+	case string:
+		if y, ok := y.(string); ok {
+			return strings.Compare(x, y), true
+		}
+	}
+	return 0, false
+}
+
+func contains(container, elem any) (bool, error) {
+	switch c := container.(type) {
+	case []any:
+		for _, v := range c {
+			if valuesEqual(v, elem) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		s, ok := elem.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot search %s in a string", typeName(elem))
+		}
+		return strings.Contains(c, s), nil
+	case map[string]any:
+		k, ok := elem.(string)
+		if !ok {
+			return false, fmt.Errorf("object keys are strings, got %s", typeName(elem))
+		}
+		_, found := c[k]
+		return found, nil
+	case nil, undefinedValue:
+		return false, nil
+	}
+	return false, fmt.Errorf("cannot search in %s", typeName(container))
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case undefinedValue:
+		return "undefined"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
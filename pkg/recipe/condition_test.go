@@ -0,0 +1,89 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestEvalCondition(t *testing.T) {
+	c := qt.New(t)
+
+	memory := &Memory{
+		Variable: VariableMemory{
+			"name":  "Hello World",
+			"count": 3,
+			"tags":  []any{"a", "b"},
+		},
+		Component: map[string]*ComponentMemory{
+			"comp": {
+				Output: &ComponentIO{
+					"score": 0.8,
+					"items": []any{map[string]any{"id": "x"}},
+				},
+				Status: &ComponentStatus{Completed: true},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		cond string
+		want bool
+	}{
+		{name: "comparison", cond: "${variable.count} > 2", want: true},
+		{name: "mixed numbers", cond: "${variable.count} == 3.0", want: true},
+		{name: "logical", cond: "${comp.output.score} >= 0.5 && !(${variable.count} < 1)", want: true},
+		{name: "arithmetic", cond: "${variable.count} * 2 + 1 == 7", want: true},
+		{name: "membership", cond: `"b" in ${variable.tags}`, want: true},
+		{name: "not in", cond: `"c" not in ${variable.tags}`, want: true},
+		{name: "list literal", cond: `${variable.count} in [1, 2, 3]`, want: true},
+		{name: "string functions", cond: `startsWith(lower(${variable.name}), "hello") && len(${variable.tags}) == 2`, want: true},
+		{name: "contains", cond: `contains(${variable.name}, 'World')`, want: true},
+		{name: "regex", cond: `${variable.name} =~ "^Hello\\s"`, want: true},
+		{name: "index", cond: `${comp.output.items}[0].id == "x"`, want: true},
+		{name: "undefined reference", cond: "defined(${comp.output.missing})", want: false},
+		{name: "null check", cond: "isNull(${comp.output.missing}) && ${comp.output.missing} == null", want: true},
+		{name: "truthiness", cond: "${variable.name}", want: true},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.name, func(c *qt.C) {
+			got, err := EvalCondition(tc.cond, memory)
+			c.Assert(err, qt.IsNil)
+			c.Check(got, qt.Equals, tc.want)
+		})
+	}
+}
+
+func TestParseCondition_Errors(t *testing.T) {
+	c := qt.New(t)
+
+	testCases := []struct {
+		cond    string
+		wantErr string
+	}{
+		{cond: "${variable.a} ==", wantErr: "condition error at column 17: unexpected end of expression"},
+		{cond: "${variable.a} == 1)", wantErr: `condition error at column 19: unexpected token "\)"`},
+		{cond: "foo(${variable.a})", wantErr: `condition error at column 1: unknown function "foo"`},
+		{cond: "len(1, 2)", wantErr: `condition error at column 1: function "len" expects 1 argument`},
+		{cond: `${variable.a} =~ "("`, wantErr: "condition error at column 18: invalid regular expression: .*"},
+		{cond: "${variable.a", wantErr: "condition error at column 1: unterminated reference"},
+		{cond: "a == 1", wantErr: `condition error at column 1: unknown identifier "a".*`},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.cond, func(c *qt.C) {
+			_, err := ParseCondition(tc.cond)
+			c.Check(err, qt.ErrorMatches, tc.wantErr)
+		})
+	}
+}
+
+func TestEvalCondition_TypeErrors(t *testing.T) {
+	c := qt.New(t)
+
+	memory := &Memory{Variable: VariableMemory{"s": "text"}}
+	_, err := EvalCondition("${variable.s} > 1", memory)
+	c.Check(err, qt.ErrorMatches, `condition error at column 15: cannot compare string and number with ">"`)
+}
@@ -1,24 +1,19 @@
 package recipe
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"reflect"
 	"slices"
-	"strconv"
 	"strings"
 
-	"go/ast"
-	"go/token"
-
 	"github.com/PaesslerAG/jsonpath"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 
-	"github.com/instill-ai/pipeline-backend/pkg/constant"
 	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
 
-	componentbase "github.com/instill-ai/component/base"
 	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
 )
 
@@ -65,7 +60,7 @@ type dag struct {
 	compsIdx         map[string]int
 	prerequisitesMap map[string][]string
 	uf               *unionFind
-	ancestorsMap     map[string][]string
+	parentsMap       map[string][]string
 }
 
 func NewDAG(compMap datamodel.ComponentMap) *dag {
@@ -76,22 +71,37 @@ func NewDAG(compMap datamodel.ComponentMap) *dag {
 		compMap:          compMap,
 		uf:               uf,
 		prerequisitesMap: map[string][]string{},
-		ancestorsMap:     map[string][]string{},
+		parentsMap:       map[string][]string{},
 	}
 }
 
 func (d *dag) AddEdge(from string, to string) {
 	d.prerequisitesMap[from] = append(d.prerequisitesMap[from], to)
 	d.uf.Union(d.compsIdx[from], d.compsIdx[to])
-	if d.ancestorsMap[to] == nil {
-		d.ancestorsMap[to] = []string{}
-	}
-	d.ancestorsMap[to] = append(d.ancestorsMap[to], from)
-	d.ancestorsMap[to] = append(d.ancestorsMap[to], d.ancestorsMap[from]...)
+	d.parentsMap[to] = append(d.parentsMap[to], from)
 }
 
+// GetUpstreamCompIDs returns the components a component depends on, directly
+// or transitively, sorted by ID. The edges can be added in any order.
 func (d *dag) GetUpstreamCompIDs(id string) []string {
-	return d.ancestorsMap[id]
+	visited := map[string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		for _, parentID := range d.parentsMap[id] {
+			if !visited[parentID] {
+				visited[parentID] = true
+				visit(parentID)
+			}
+		}
+	}
+	visit(id)
+
+	upstreamIDs := make([]string, 0, len(visited))
+	for upstreamID := range visited {
+		upstreamIDs = append(upstreamIDs, upstreamID)
+	}
+	slices.Sort(upstreamIDs)
+	return upstreamIDs
 }
 
 type topologicalSortNode struct {
@@ -151,12 +161,174 @@ func (d *dag) TopologicalSort() ([]datamodel.ComponentMap, error) {
 	}
 
 	if count < len(d.compMap) {
+		if cycles := d.Cycles(); len(cycles) > 0 {
+			return nil, cycles[0]
+		}
 		return nil, fmt.Errorf("not a valid dag")
 	}
 
 	return ans, nil
 }
 
+// CycleError describes a dependency cycle between components. Path starts
+// and ends with the same component ID. When the cycle is formed by nested
+// components, Scope holds the path to their group from the outermost one:
+// the ID of an iterator, or `<switchID>.cases.<index>` for a switch case.
+type CycleError struct {
+	Path  []string
+	Scope []string
+}
+
+func (e *CycleError) Error() string {
+	if len(e.Scope) > 0 {
+		return fmt.Sprintf("dependency cycle in %s: %s", strings.Join(e.Scope, "."), strings.Join(e.Path, " -> "))
+	}
+	return fmt.Sprintf("dependency cycle: %s", strings.Join(e.Path, " -> "))
+}
+
+// Cycles returns one cycle for every strongly connected component of the
+// graph that isn't a single component without a self-reference. The result
+// is sorted by the first component ID of each path.
+func (d *dag) Cycles() []*CycleError {
+	ids := make([]string, 0, len(d.compMap))
+	for id := range d.compMap {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	successors := map[string][]string{}
+	for from, tos := range d.prerequisitesMap {
+		tos = slices.Clone(tos)
+		slices.Sort(tos)
+		successors[from] = slices.Compact(tos)
+	}
+
+	// Tarjan's strongly connected components algorithm.
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	sccs := [][]string{}
+
+	var strongConnect func(v string)
+	strongConnect = func(v string) {
+		index[v] = len(index)
+		lowlink[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range successors[v] {
+			if _, visited := index[w]; !visited {
+				strongConnect(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			scc := []string{}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				scc = append(scc, w)
+				if w == v {
+					break
+				}
+			}
+			sccs = append(sccs, scc)
+		}
+	}
+	for _, id := range ids {
+		if _, visited := index[id]; !visited {
+			strongConnect(id)
+		}
+	}
+
+	cycles := []*CycleError{}
+	for _, scc := range sccs {
+		start := slices.Min(scc)
+		if len(scc) == 1 && !slices.Contains(successors[start], start) {
+			continue
+		}
+		cycles = append(cycles, &CycleError{Path: shortestCycle(start, scc, successors)})
+	}
+	slices.SortFunc(cycles, func(a, b *CycleError) int {
+		return strings.Compare(a.Path[0], b.Path[0])
+	})
+	return cycles
+}
+
+// shortestCycle finds the shortest path from start back to itself within a
+// strongly connected component.
+func shortestCycle(start string, scc []string, successors map[string][]string) []string {
+	if slices.Contains(successors[start], start) {
+		return []string{start, start}
+	}
+
+	prev := map[string]string{}
+	q := []string{start}
+	for len(q) > 0 {
+		v := q[0]
+		q = q[1:]
+		for _, w := range successors[v] {
+			if !slices.Contains(scc, w) {
+				continue
+			}
+			if w == start {
+				path := []string{start}
+				for n := v; n != start; n = prev[n] {
+					path = append(path, n)
+				}
+				path = append(path, start)
+				slices.Reverse(path)
+				return path
+			}
+			if _, seen := prev[w]; !seen {
+				prev[w] = v
+				q = append(q, w)
+			}
+		}
+	}
+	return []string{start, start}
+}
+
+// FindCycles returns the dependency cycles of a recipe's components,
+// including the cycles formed by the components nested in iterators and
+// switch cases.
+func FindCycles(compMap datamodel.ComponentMap) []*CycleError {
+	return findCycles(compMap, nil)
+}
+
+func findCycles(compMap datamodel.ComponentMap, scope []string) []*CycleError {
+	d, err := GenerateDAG(compMap)
+	if err != nil {
+		return nil
+	}
+	cycles := d.Cycles()
+	for _, c := range cycles {
+		c.Scope = scope
+	}
+
+	ids := make([]string, 0, len(compMap))
+	for id := range compMap {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		switch compMap[id].Type {
+		case datamodel.Iterator:
+			cycles = append(cycles, findCycles(compMap[id].Component, append(slices.Clone(scope), id))...)
+		case datamodel.Switch:
+			for idx, c := range compMap[id].Cases {
+				cycles = append(cycles, findCycles(c.Component, append(slices.Clone(scope), fmt.Sprintf("%s.cases.%d", id, idx)))...)
+			}
+		}
+	}
+	return cycles
+}
+
 func splitFunc(s rune) bool {
 	return s == '.' || s == '['
 }
@@ -213,19 +385,10 @@ func RenderInput(inputTemplate any, dataIndex int, memory *Memory) (any, error)
 
 	switch input := inputTemplate.(type) {
 	case string:
-		if strings.HasPrefix(input, "${") && strings.HasSuffix(input, "}") && strings.Count(input, "${") == 1 {
-			input = input[2:]
-			input = input[:len(input)-1]
-			input = strings.TrimSpace(input)
-			if input == SegSecret+"."+constant.GlobalSecretKey {
-				return componentbase.SecretKeyword, nil
-			}
-
-			val, err := TraverseBinding(memory, input)
-			if err != nil {
-				return nil, err
-			}
-			return val, nil
+		// A template made of a single binding keeps the type of the
+		// referenced value.
+		if strings.HasPrefix(input, "${") && bindingEnd(input, 0) == len(input) {
+			return ResolveBinding(memory, input[2:len(input)-1])
 		}
 
 		val := ""
@@ -237,14 +400,13 @@ func RenderInput(inputTemplate any, dataIndex int, memory *Memory) (any, error)
 			}
 			val += input[:startIdx]
 			input = input[startIdx:]
-			endIdx := strings.Index(input, "}")
+			endIdx := bindingEnd(input, 0)
 			if endIdx == -1 {
 				val += input
 				break
 			}
 
-			ref := strings.TrimSpace(input[2:endIdx])
-			v, err := TraverseBinding(memory, ref)
+			v, err := ResolveBinding(memory, input[2:endIdx-1])
 			if err != nil {
 				return nil, err
 			}
@@ -259,7 +421,7 @@ func RenderInput(inputTemplate any, dataIndex int, memory *Memory) (any, error)
 				}
 				val += string(b)
 			}
-			input = input[endIdx+1:]
+			input = input[endIdx:]
 		}
 		return val, nil
 
@@ -289,371 +451,145 @@ func RenderInput(inputTemplate any, dataIndex int, memory *Memory) (any, error)
 	}
 }
 
-func EvalCondition(expr ast.Expr, value map[string]any) (any, error) {
-	switch e := (expr).(type) {
-	case *ast.UnaryExpr:
-		xRes, err := EvalCondition(e.X, value)
-		if err != nil {
-			return nil, err
-		}
+func GenerateDAG(componentMap datamodel.ComponentMap) (*dag, error) {
 
-		switch e.Op {
-		case token.NOT: // !
-			switch xVal := xRes.(type) {
-			case bool:
-				return !xVal, nil
-			}
-		case token.SUB: // -
-			switch xVal := xRes.(type) {
-			case int64:
-				return -xVal, nil
-			case float64:
-				return -xVal, nil
-			}
-		}
-	case *ast.BinaryExpr:
+	graph := NewDAG(componentMap)
 
-		xRes, err := EvalCondition(e.X, value)
-		if err != nil {
-			return nil, err
-		}
-		yRes, err := EvalCondition(e.Y, value)
-		if err != nil {
-			return nil, err
+	for id, component := range componentMap {
+		for _, upstreamID := range componentParents(id, component) {
+			if _, ok := componentMap[upstreamID]; ok {
+				graph.AddEdge(upstreamID, id)
+			}
 		}
+	}
 
-		switch e.Op {
-		case token.LAND: // &&
-
-			xBool := false
-			yBool := false
-			switch xVal := xRes.(type) {
-			case int64, float64:
-				xBool = (xVal != 0)
-			case string:
-				xBool = (xVal != "")
-			case bool:
-				xBool = xVal
-			}
-			switch yVal := yRes.(type) {
-			case int64, float64:
-				yBool = (yVal != 0)
-			case string:
-				yBool = (yVal != "")
-			case bool:
-				yBool = yVal
-			}
-			return xBool && yBool, nil
-		case token.LOR: // ||
-
-			xBool := false
-			yBool := false
-			switch xVal := xRes.(type) {
-			case int64, float64:
-				xBool = (xVal != 0)
-			case string:
-				xBool = (xVal != "")
-			case bool:
-				xBool = xVal
-			}
-			switch yVal := yRes.(type) {
-			case int64, float64:
-				yBool = (yVal != 0)
-			case string:
-				yBool = (yVal != "")
-			case bool:
-				yBool = yVal
-			}
-			return xBool || yBool, nil
-
-		case token.EQL: // ==
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal == yVal, nil
-				case float64:
-					return float64(xVal) == yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal == float64(yVal), nil
-				case float64:
-					return xVal == yVal, nil
-				}
-			}
-			return reflect.DeepEqual(xRes, yRes), nil
-		case token.NEQ: // !=
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal != yVal, nil
-				case float64:
-					return float64(xVal) != yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal != float64(yVal), nil
-				case float64:
-					return xVal != yVal, nil
-				}
-			}
-			return !reflect.DeepEqual(xRes, yRes), nil
-
-		case token.LSS: // <
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal < yVal, nil
-				case float64:
-					return float64(xVal) < yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal < float64(yVal), nil
-				case float64:
-					return xVal < yVal, nil
-				}
-			}
-		case token.GTR: // >
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal > yVal, nil
-				case float64:
-					return float64(xVal) > yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal > float64(yVal), nil
-				case float64:
-					return xVal > yVal, nil
-				}
-			}
+	return graph, nil
+}
 
-		case token.LEQ: // <=
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal <= yVal, nil
-				case float64:
-					return float64(xVal) <= yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal <= float64(yVal), nil
-				case float64:
-					return xVal <= yVal, nil
-				}
-			}
-		case token.GEQ: // >=
-			switch xVal := xRes.(type) {
-			case int64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal >= yVal, nil
-				case float64:
-					return float64(xVal) >= yVal, nil
-				}
-			case float64:
-				switch yVal := yRes.(type) {
-				case int64:
-					return xVal >= float64(yVal), nil
-				case float64:
-					return xVal >= yVal, nil
-				}
+// componentParents returns the first segment of every reference of a
+// component. The references of the components nested in an iterator or a
+// switch case, at any depth, are included when they point outside of their
+// group, so the iterator or the switch runs after everything its nested
+// components depend on.
+func componentParents(id string, component *datamodel.Component) []string {
+	parents := FindTemplateReferenceParent(component.Input)
+	switch component.Type {
+	case datamodel.Iterator:
+		// The condition of an iterator filters its elements and can
+		// reference them.
+		for _, ref := range FindReferenceParent(component.Condition) {
+			if ref != id {
+				parents = append(parents, ref)
 			}
-
-		}
-
-	case *ast.ParenExpr:
-		return EvalCondition(e.X, value)
-	case *ast.SelectorExpr:
-		v, err := EvalCondition(e.X, value)
-		if err != nil {
-			return nil, err
-		}
-		// Convert InputsMemory and ComponentItemMemory into map[string]any.
-		// Ignore error handling here since all of them are JSON data.
-		b, _ := json.Marshal(v)
-		m := map[string]any{}
-		_ = json.Unmarshal(b, &m)
-		return m[e.Sel.String()], nil
-	case *ast.BasicLit:
-		if e.Kind == token.INT {
-			return strconv.ParseInt(e.Value, 10, 64)
-		}
-		if e.Kind == token.FLOAT {
-			return strconv.ParseFloat(e.Value, 64)
-		}
-		if e.Kind == token.STRING {
-			return e.Value[1 : len(e.Value)-1], nil
 		}
-		return e.Value, nil
-	case *ast.Ident:
-		if e.Name == "true" {
-			return true, nil
-		}
-		if e.Name == "false" {
-			return false, nil
+		return append(parents, groupParents(id, component.Component, component.OutputElements)...)
+	case datamodel.Switch:
+		parents = append(parents, FindReferenceParent(component.Condition)...)
+		for _, c := range component.Cases {
+			parents = append(parents, FindReferenceParent(c.Condition)...)
+			parents = append(parents, groupParents(id, c.Component, c.Output)...)
 		}
+		return parents
+	}
+	if component.OnError != nil {
+		parents = append(parents, FindTemplateReferenceParent(component.OnError.Output)...)
+	}
+	return append(parents, FindReferenceParent(component.Condition)...)
+}
 
-		return value[e.Name], nil
+// groupParents returns the references of a group of nested components and
+// of its output templates that point outside of the group.
+func groupParents(id string, comps datamodel.ComponentMap, output map[string]string) []string {
+	isInner := func(ref string) bool {
+		_, ok := comps[ref]
+		return ok || ref == id
+	}
 
-	case *ast.IndexExpr:
-		v, err := EvalCondition(e.X, value)
-		if err != nil {
-			return nil, err
-		}
-		switch idxVal := e.Index.(type) {
-		case *ast.BasicLit:
-			// handle arr[index]
-			if idxVal.Kind == token.INT {
-				index, err := strconv.Atoi(idxVal.Value)
-				if err != nil {
-					return nil, err
-				}
-				return v.([]any)[index], nil
+	parents := []string{}
+	for nestedID, nestedComponent := range comps {
+		for _, ref := range componentParents(nestedID, nestedComponent) {
+			if !isInner(ref) {
+				parents = append(parents, ref)
 			}
-			// handle obj[key]
-			if idxVal.Kind == token.STRING {
-				// key: remove ""
-				key := idxVal.Value[1 : len(idxVal.Value)-1]
-				return v.(map[string]any)[key], nil
+		}
+	}
+	for _, v := range output {
+		for _, ref := range FindReferenceParent(v) {
+			if !isInner(ref) {
+				parents = append(parents, ref)
 			}
 		}
-
 	}
-	return false, fmt.Errorf("condition error")
+	return parents
 }
 
-func SanitizeCondition(cond string) (string, map[string]string, map[string]string) {
-	varMapping := map[string]string{}
-	revVarMapping := map[string]string{}
-	varNameIdx := 0
+// FindReferenceParent returns the first segment of every reference in a
+// template string, including the references used in filter arguments.
+func FindReferenceParent(input string) []string {
+	upstreams := []string{}
 	for {
-		leftIdx := strings.Index(cond, "${")
-		if leftIdx == -1 {
+		startIdx := strings.Index(input, "${")
+		if startIdx == -1 {
 			break
 		}
-		rightIdx := strings.Index(cond, "}")
-
-		left := cond[:leftIdx]
-		v := cond[leftIdx+2 : rightIdx]
-		right := cond[rightIdx+1:]
-
-		srcName := strings.Split(strings.TrimSpace(v), ".")[0]
-		if varName, ok := revVarMapping[srcName]; ok {
-			varMapping[varName] = srcName
-			revVarMapping[srcName] = varName
-			cond = left + strings.ReplaceAll(v, srcName, varName) + right
-		} else {
-			varName := fmt.Sprintf("var%d", varNameIdx)
-			varMapping[varName] = srcName
-			revVarMapping[srcName] = varName
-			varNameIdx++
-			cond = left + strings.ReplaceAll(v, srcName, varName) + right
-		}
-
-	}
-
-	return cond, varMapping, revVarMapping
-}
-
-func GenerateDAG(componentMap datamodel.ComponentMap) (*dag, error) {
-
-	componentIDMap := make(map[string]bool)
-
-	for id := range componentMap {
-		componentIDMap[id] = true
-		switch componentMap[id].Type {
-		case datamodel.Iterator:
-			for nestedID := range componentMap[id].Component {
-				componentIDMap[nestedID] = true
-			}
-		}
-	}
-
-	graph := NewDAG(componentMap)
-
-	for id, component := range componentMap {
-
-		parents := []string{}
-
-		if component.Condition != "" {
-			parents = append(parents, FindReferenceParent(component.Condition)...)
+		input = input[startIdx:]
+		endIdx := bindingEnd(input, 0)
+		if endIdx == -1 {
+			break
 		}
-
-		switch component.Type {
-		default:
-			template, _ := json.Marshal(component.Input)
-			parents = append(parents, FindReferenceParent(string(template))...)
-		case datamodel.Iterator:
-			parents = append(parents, FindReferenceParent(component.Input.(string))...)
-			nestedComponentIDs := []string{id}
-			for nestedID := range component.Component {
-				nestedComponentIDs = append(nestedComponentIDs, nestedID)
-			}
-			for _, nestedComponent := range component.Component {
-
-				if nestedComponent.Condition != "" {
-					nestedParent := FindReferenceParent(nestedComponent.Condition)
-					for idx := range nestedParent {
-						if !slices.Contains(nestedComponentIDs, nestedParent[idx]) {
-							parents = append(parents, nestedParent[idx])
-						}
-					}
-				}
-
-				switch nestedComponent.Type {
-				case "default":
-				default:
-					template, _ := json.Marshal(nestedComponent.Input)
-					nestedParent := FindReferenceParent(string(template))
-					for idx := range nestedParent {
-						if !slices.Contains(nestedComponentIDs, nestedParent[idx]) {
-							parents = append(parents, nestedParent[idx])
-						}
-					}
-				}
-			}
+		content := input[2 : endIdx-1]
+		refs := []string{content}
+		if b, err := parseBinding(content); err == nil {
+			refs = b.references()
 		}
-
-		for _, upstreamID := range parents {
-			if _, ok := componentIDMap[upstreamID]; ok {
-				graph.AddEdge(upstreamID, id)
-			}
-
+		for _, ref := range refs {
+			upstreams = append(upstreams, strings.Split(strings.TrimSpace(ref), ".")[0])
 		}
+		input = input[endIdx:]
 	}
-
-	return graph, nil
+	return upstreams
 }
 
-func FindReferenceParent(input string) []string {
-	upstreams := []string{}
+// FindReferences returns the path of every reference in a template string or
+// condition, including the references used in filter arguments. Bindings
+// with syntax errors are ignored.
+func FindReferences(input string) []string {
+	refs := []string{}
 	for {
 		startIdx := strings.Index(input, "${")
 		if startIdx == -1 {
 			break
 		}
 		input = input[startIdx:]
-		endIdx := strings.Index(input, "}")
+		endIdx := bindingEnd(input, 0)
 		if endIdx == -1 {
 			break
 		}
-		ref := strings.TrimSpace(input[2:endIdx])
-		upstreams = append(upstreams, strings.Split(ref, ".")[0])
-		input = input[endIdx+1:]
+		if b, err := parseBinding(input[2 : endIdx-1]); err == nil {
+			for _, ref := range b.references() {
+				refs = append(refs, strings.TrimSpace(ref))
+			}
+		}
+		input = input[endIdx:]
+	}
+	return refs
+}
+
+// FindTemplateReferenceParent walks a structured template (e.g. a component
+// input) and returns the first segment of every reference in its strings.
+func FindTemplateReferenceParent(template any) []string {
+	upstreams := []string{}
+	switch t := template.(type) {
+	case string:
+		upstreams = append(upstreams, FindReferenceParent(t)...)
+	case map[string]any:
+		for _, v := range t {
+			upstreams = append(upstreams, FindTemplateReferenceParent(v)...)
+		}
+	case []any:
+		for _, v := range t {
+			upstreams = append(upstreams, FindTemplateReferenceParent(v)...)
+		}
 	}
 	return upstreams
 }
@@ -668,6 +604,7 @@ func GenerateTraces(comps datamodel.ComponentMap, memory []*Memory) (map[string]
 		inputs := make([]*structpb.Struct, batchSize)
 		outputs := make([]*structpb.Struct, batchSize)
 		traceStatuses := make([]pb.Trace_Status, batchSize)
+		var traceErrors []any
 
 		for dataIdx := range batchSize {
 			m, ok := memory[dataIdx].Component[compID]
@@ -675,7 +612,15 @@ func GenerateTraces(comps datamodel.ComponentMap, memory []*Memory) (map[string]
 				// Skip this iteration if compID is not present in memory
 				continue
 			}
-			if m.Status.Completed {
+			if m.Status.Errored {
+				// A component that fell back to a static output is still
+				// reported as errored.
+				traceStatuses[dataIdx] = pb.Trace_STATUS_ERROR
+				traceErrors = append(traceErrors, map[string]any{
+					"index":   dataIdx,
+					"message": m.Error,
+				})
+			} else if m.Status.Completed {
 				traceStatuses[dataIdx] = pb.Trace_STATUS_COMPLETED
 			} else if m.Status.Skipped {
 				traceStatuses[dataIdx] = pb.Trace_STATUS_SKIPPED
@@ -717,7 +662,200 @@ func GenerateTraces(comps datamodel.ComponentMap, memory []*Memory) (map[string]
 			Inputs:   inputs,
 			Outputs:  outputs,
 		}
+		if len(traceErrors) > 0 {
+			traceError, err := structpb.NewStruct(map[string]any{"errors": traceErrors})
+			if err != nil {
+				return nil, err
+			}
+			trace[compID].Error = traceError
+		}
+
+		trace[compID].ComputeTimeInSeconds = float32(computeTime(compID, memory).Seconds())
+	}
+
+	return trace, nil
+}
+
+// GenerateNestedTraces returns the traces of the components nested in
+// iterators and switches, at any depth. The trace of a component nested in an
+// iterator is keyed by the path to its iteration, e.g. `iter[0].comp` for the
+// component `comp` of the iterator `iter` in the first batch item, and holds
+// one entry per element. The trace of a component of a switch case is keyed
+// the same way, e.g. `switch[0].comp`, and holds a single entry.
+func GenerateNestedTraces(ctx context.Context, rc *redis.Client, workflowID string, comps datamodel.ComponentMap, batchSize int) (map[string]*pb.Trace, error) {
+	return generateNestedTraces(ctx, rc, workflowID, "", comps, batchSize)
+}
+
+func generateNestedTraces(ctx context.Context, rc *redis.Client, workflowID, prefix string, comps datamodel.ComponentMap, batchSize int) (map[string]*pb.Trace, error) {
+	traces := map[string]*pb.Trace{}
+	for id, comp := range comps {
+		var err error
+		switch comp.Type {
+		case datamodel.Iterator:
+			err = generateIteratorTraces(ctx, rc, workflowID, prefix, id, comp, batchSize, traces)
+		case datamodel.Switch:
+			err = generateSwitchTraces(ctx, rc, workflowID, prefix, id, comp, batchSize, traces)
+		case datamodel.SubPipeline:
+			err = generateSubPipelineTraces(ctx, rc, workflowID, prefix, id, comp, batchSize, traces)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return traces, nil
+}
+
+func generateIteratorTraces(ctx context.Context, rc *redis.Client, workflowID, prefix, id string, comp *datamodel.Component, batchSize int, traces map[string]*pb.Trace) error {
+	nestedIDs := make([]string, 0, len(comp.Component))
+	for nestedID := range comp.Component {
+		nestedIDs = append(nestedIDs, nestedID)
+	}
+
+	for batchIdx := range batchSize {
+		childWorkflowID := IterationWorkflowID(workflowID, batchIdx, id)
+		memory, err := LoadIterationMemory(ctx, rc, childWorkflowID, id, nestedIDs)
+		if err != nil {
+			return err
+		}
+		iterPrefix := fmt.Sprintf("%s%s[%d].", prefix, id, batchIdx)
+
+		elementTrace, err := generateElementTrace(id, memory)
+		if err != nil {
+			return err
+		}
+		traces[strings.TrimSuffix(iterPrefix, ".")] = elementTrace
+
+		if err := addGroupTraces(ctx, rc, childWorkflowID, iterPrefix, comp.Component, memory, traces); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateSwitchTraces(ctx context.Context, rc *redis.Client, workflowID, prefix, id string, comp *datamodel.Component, batchSize int, traces map[string]*pb.Trace) error {
+	for batchIdx := range batchSize {
+		childWorkflowID := SwitchWorkflowID(workflowID, batchIdx, id)
+
+		// Only the components of the matching case have a memory.
+		for _, c := range comp.Cases {
+			nestedIDs := make([]string, 0, len(c.Component))
+			for nestedID := range c.Component {
+				nestedIDs = append(nestedIDs, nestedID)
+			}
+			memory, err := LoadSwitchMemory(ctx, rc, childWorkflowID, nestedIDs)
+			if err != nil {
+				return err
+			}
+			if len(memory.Component) == 0 {
+				continue
+			}
+
+			casePrefix := fmt.Sprintf("%s%s[%d].", prefix, id, batchIdx)
+			if err := addGroupTraces(ctx, rc, childWorkflowID, casePrefix, c.Component, []*Memory{memory}, traces); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func generateSubPipelineTraces(ctx context.Context, rc *redis.Client, workflowID, prefix, id string, comp *datamodel.Component, batchSize int, traces map[string]*pb.Trace) error {
+	if comp.Callee == nil || comp.Callee.Recipe == nil {
+		return nil
+	}
+	for batchIdx := range batchSize {
+		childWorkflowID := SubPipelineWorkflowID(workflowID, batchIdx, id)
+
+		// The callee isn't triggered for the skipped batch items.
+		memory, err := LoadMemoryByTriggerID(ctx, rc, childWorkflowID)
+		if err != nil {
+			return err
+		}
+		if len(memory) == 0 {
+			continue
+		}
+
+		calleePrefix := fmt.Sprintf("%s%s[%d].", prefix, id, batchIdx)
+		if err := addGroupTraces(ctx, rc, childWorkflowID, calleePrefix, comp.Callee.Recipe.Component, memory, traces); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addGroupTraces adds the traces of a group of nested components, and of
+// the groups nested in them, to traces.
+func addGroupTraces(ctx context.Context, rc *redis.Client, childWorkflowID, prefix string, comps datamodel.ComponentMap, memory []*Memory, traces map[string]*pb.Trace) error {
+	nestedTraces, err := GenerateTraces(comps, memory)
+	if err != nil {
+		return err
+	}
+	for nestedID, trace := range nestedTraces {
+		traces[prefix+nestedID] = trace
 	}
 
+	deeperTraces, err := generateNestedTraces(ctx, rc, childWorkflowID, prefix, comps, len(memory))
+	if err != nil {
+		return err
+	}
+	for k, trace := range deeperTraces {
+		traces[k] = trace
+	}
+	return nil
+}
+
+// generateElementTrace returns the trace of the elements of an iteration,
+// with the status and the value of each element. The messages of the failed
+// elements are listed in the trace error.
+func generateElementTrace(iteratorID string, memory []*Memory) (*pb.Trace, error) {
+	trace := &pb.Trace{
+		Statuses: make([]pb.Trace_Status, len(memory)),
+		Inputs:   make([]*structpb.Struct, len(memory)),
+	}
+	errs := []any{}
+	for idx := range memory {
+		m, ok := memory[idx].Component[iteratorID]
+		if !ok {
+			continue
+		}
+		switch {
+		case m.Status == nil:
+			trace.Statuses[idx] = pb.Trace_STATUS_UNSPECIFIED
+		case m.Status.Errored:
+			trace.Statuses[idx] = pb.Trace_STATUS_ERROR
+			errs = append(errs, map[string]any{"index": idx, "message": m.Error})
+		case m.Status.Skipped:
+			trace.Statuses[idx] = pb.Trace_STATUS_SKIPPED
+		case m.Status.Completed:
+			trace.Statuses[idx] = pb.Trace_STATUS_COMPLETED
+		}
+
+		input, err := structpb.NewStruct(map[string]any{"element": normalizeElement(m.Element)})
+		if err != nil {
+			return nil, err
+		}
+		trace.Inputs[idx] = input
+	}
+	if len(errs) > 0 {
+		traceErr, err := structpb.NewStruct(map[string]any{"elements": errs})
+		if err != nil {
+			return nil, err
+		}
+		trace.Error = traceErr
+	}
 	return trace, nil
 }
+
+// normalizeElement converts an element to the types supported by structpb.
+func normalizeElement(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var n any
+	if err := json.Unmarshal(b, &n); err != nil {
+		return nil
+	}
+	return n
+}
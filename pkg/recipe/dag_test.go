@@ -0,0 +1,209 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+
+	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
+)
+
+func TestTopologicalSort_Cycle(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"a": {Type: "openai", Input: map[string]any{"x": "${c.output.x}"}},
+		"b": {Type: "openai", Input: map[string]any{"x": "${a.output.x}"}},
+		"c": {Type: "openai", Input: map[string]any{"x": "${b.output.x}"}},
+		"d": {Type: "openai", Input: map[string]any{"x": "${c.output.x}"}},
+	}
+
+	d, err := GenerateDAG(comps)
+	c.Assert(err, qt.IsNil)
+	_, err = d.TopologicalSort()
+	c.Check(err, qt.ErrorMatches, "dependency cycle: a -> b -> c -> a")
+
+	var cycleErr *CycleError
+	c.Assert(err, qt.ErrorAs, &cycleErr)
+	c.Check(cycleErr.Path, qt.DeepEquals, []string{"a", "b", "c", "a"})
+}
+
+func TestFindCycles(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"self": {Type: "openai", Condition: "${self.output.done} == false"},
+		"a":    {Type: "openai", Input: map[string]any{"x": "${iter.output.x}"}},
+		"iter": {
+			Type:  datamodel.Iterator,
+			Input: "${variable.list}",
+			Component: datamodel.ComponentMap{
+				// Nested components depend on the upstream components of the
+				// iterator.
+				"n1": {Type: "openai", Input: map[string]any{"x": "${a.output.x} ${n2.output.y}"}},
+				"n2": {Type: "openai", Input: map[string]any{"y": "${n1.output.x}"}},
+			},
+			OutputElements: map[string]string{"x": "${n1.output.x}"},
+		},
+	}
+
+	cycles := FindCycles(comps)
+	got := make([]string, len(cycles))
+	for i, cycle := range cycles {
+		got[i] = cycle.Error()
+	}
+	c.Check(got, qt.DeepEquals, []string{
+		"dependency cycle: a -> iter -> a",
+		"dependency cycle: self -> self",
+		"dependency cycle in iter: n1 -> n2 -> n1",
+	})
+}
+
+func TestGenerateDAG_NestedIterators(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"docs":  {Type: "openai"},
+		"model": {Type: "openai"},
+		"pages": {
+			Type:  datamodel.Iterator,
+			Input: "${docs.output.documents}",
+			Component: datamodel.ComponentMap{
+				"split": {Type: "text", Input: map[string]any{"text": "${pages.element.text}"}},
+				"chunks": {
+					Type:  datamodel.Iterator,
+					Input: "${split.output.chunks}",
+					Component: datamodel.ComponentMap{
+						"embed": {
+							Type: "openai",
+							// References the element of both iterators and a
+							// top-level component.
+							Input: map[string]any{"text": "${chunks.element} ${pages.element.title} ${model.output.name}"},
+						},
+					},
+					OutputElements: map[string]string{"vector": "${embed.output.vector}"},
+				},
+			},
+			OutputElements: map[string]string{"vectors": "${chunks.output.vector}"},
+		},
+	}
+
+	d, err := GenerateDAG(comps)
+	c.Assert(err, qt.IsNil)
+	c.Check(d.GetUpstreamCompIDs("pages"), qt.ContentEquals, []string{"docs", "model"})
+
+	groups, err := d.TopologicalSort()
+	c.Assert(err, qt.IsNil)
+	c.Assert(groups, qt.HasLen, 2)
+	c.Check(groups[1], qt.HasLen, 1)
+
+	nested, err := GenerateDAG(comps["pages"].Component)
+	c.Assert(err, qt.IsNil)
+	c.Check(nested.GetUpstreamCompIDs("chunks"), qt.DeepEquals, []string{"split"})
+}
+
+func TestGenerateElementTrace(t *testing.T) {
+	c := qt.New(t)
+
+	memory := []*Memory{
+		{Component: map[string]*ComponentMemory{"iter": {Element: "a", Status: &ComponentStatus{Started: true, Completed: true}}}},
+		{Component: map[string]*ComponentMemory{"iter": {Element: "b", Status: &ComponentStatus{Started: true, Errored: true}, Error: "Component llm failed to execute."}}},
+		{Component: map[string]*ComponentMemory{"iter": {Element: "c", Status: &ComponentStatus{Started: true, Skipped: true}}}},
+	}
+
+	trace, err := generateElementTrace("iter", memory)
+	c.Assert(err, qt.IsNil)
+	c.Check(trace.Statuses, qt.DeepEquals, []pb.Trace_Status{pb.Trace_STATUS_COMPLETED, pb.Trace_STATUS_ERROR, pb.Trace_STATUS_SKIPPED})
+	c.Check(trace.Inputs[1].AsMap(), qt.DeepEquals, map[string]any{"element": "b"})
+	c.Check(trace.Error.AsMap(), qt.DeepEquals, map[string]any{
+		"elements": []any{map[string]any{"index": float64(1), "message": "Component llm failed to execute."}},
+	})
+}
+
+func TestGenerateDAG_Switch(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"lang":  {Type: "openai"},
+		"model": {Type: "openai"},
+		"route": {
+			Type: datamodel.Switch,
+			Cases: []*datamodel.SwitchCase{
+				{
+					Condition: `${lang.output.code} == "en"`,
+					Component: datamodel.ComponentMap{
+						"en": {Type: "openai", Input: map[string]any{"model": "${model.output.name}"}},
+					},
+					Output: map[string]string{"text": "${en.output.text}"},
+				},
+				{
+					Component: datamodel.ComponentMap{
+						"other":  {Type: "openai", Input: map[string]any{"x": "${other2.output.x}"}},
+						"other2": {Type: "openai", Input: map[string]any{"x": "${other.output.x}"}},
+					},
+					Output: map[string]string{"text": "${other.output.text}"},
+				},
+			},
+		},
+		"after": {Type: "openai", Input: map[string]any{"text": "${route.output.text}"}},
+	}
+
+	d, err := GenerateDAG(comps)
+	c.Assert(err, qt.IsNil)
+	c.Check(d.GetUpstreamCompIDs("route"), qt.ContentEquals, []string{"lang", "model"})
+	c.Check(d.GetUpstreamCompIDs("after"), qt.ContentEquals, []string{"route", "lang", "model"})
+
+	cycles := FindCycles(comps)
+	c.Assert(cycles, qt.HasLen, 1)
+	c.Check(cycles[0].Error(), qt.Equals, "dependency cycle in route.cases.1: other -> other2 -> other")
+}
+
+func TestGenerateTraces_Errored(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"llm": {Type: "openai"},
+	}
+	memory := []*Memory{
+		{Component: map[string]*ComponentMemory{"llm": {
+			Input:  &ComponentIO{},
+			Output: &ComponentIO{"texts": []any{"hello"}},
+			Status: &ComponentStatus{Started: true, Completed: true},
+		}}},
+		{Component: map[string]*ComponentMemory{"llm": {
+			Input:  &ComponentIO{},
+			Output: &ComponentIO{"texts": []any{"fallback"}},
+			Status: &ComponentStatus{Started: true, Completed: true, Errored: true},
+			Error:  "rate limited",
+		}}},
+	}
+
+	traces, err := GenerateTraces(comps, memory)
+	c.Assert(err, qt.IsNil)
+	c.Check(traces["llm"].Statuses, qt.DeepEquals, []pb.Trace_Status{pb.Trace_STATUS_COMPLETED, pb.Trace_STATUS_ERROR})
+	c.Check(traces["llm"].Outputs[1].AsMap(), qt.DeepEquals, map[string]any{"texts": []any{"fallback"}})
+	c.Check(traces["llm"].Error.AsMap(), qt.DeepEquals, map[string]any{
+		"errors": []any{map[string]any{"index": float64(1), "message": "rate limited"}},
+	})
+}
+
+func TestGenerateDAG_OnErrorFallback(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"a": {Type: "openai"},
+		"b": {Type: "openai", OnError: &datamodel.OnErrorPolicy{
+			Action: datamodel.OnErrorFallback,
+			Output: map[string]any{"texts": "${a.output.texts}"},
+		}},
+	}
+
+	d, err := GenerateDAG(comps)
+	c.Assert(err, qt.IsNil)
+	order, err := d.TopologicalSort()
+	c.Assert(err, qt.IsNil)
+	c.Check(order, qt.HasLen, 2)
+	c.Check(order[0], qt.HasLen, 1)
+}
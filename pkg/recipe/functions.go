@@ -0,0 +1,434 @@
+package recipe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// This file holds the function library available in component conditions and
+// as filters in `${...}` bindings. Functions are sandboxed: they are pure, they
+// only see their arguments and they can't access the rest of the memory, the
+// network or the file system.
+//
+// | Function                 | Description                                                  |
+// |--------------------------|--------------------------------------------------------------|
+// | defined(x)               | false if x is a reference that can't be resolved             |
+// | isNull(x)                | true if x is null or undefined                               |
+// | isEmpty(x)               | true if x is null, undefined, "", [] or {}                   |
+// | default(x, fallback)     | fallback if x is null, undefined or "", x otherwise          |
+// | len(x), length(x)        | number of characters, elements or keys                       |
+// | contains(x, y)           | substring, list element or object key membership             |
+// | startsWith(s, prefix)    | prefix check                                                 |
+// | endsWith(s, suffix)      | suffix check                                                 |
+// | matches(s, regex)        | RE2 regular expression match                                 |
+// | lower(s), upper(s)       | case conversion                                              |
+// | trim(s)                  | removes leading and trailing white space                     |
+// | truncate(s, n)           | keeps the first n characters of s                            |
+// | replace(s, old, new)     | replaces every occurrence of old with new                    |
+// | split(s, sep)            | splits s into a list of strings                              |
+// | join(list, sep = "")     | concatenates the elements of a list                          |
+// | first(list), last(list)  | first or last element of a list, null if empty               |
+// | slice(x, start, end = n) | sub-list or sub-string, negative indexes count from the end  |
+// | pluck(list, field)       | list with the field of each object in list                   |
+// | keys(obj), values(obj)   | sorted keys of an object or the values in key order          |
+// | tojson(x)                | JSON encoding of x                                           |
+// | fromjson(s)              | value decoded from a JSON string                             |
+// | tostring(x)              | string representation of x                                   |
+// | tonumber(x)              | number parsed from x                                         |
+
+type exprFunc struct {
+	minArgs int
+	maxArgs int // -1 for variadic functions
+	// acceptsUndefined indicates the function can receive an unresolved
+	// reference as its input in a filter pipeline.
+	acceptsUndefined bool
+	fn               func(args []any) (any, error)
+}
+
+func (f exprFunc) arity() string {
+	return arityString(f.minArgs, f.maxArgs)
+}
+
+// filterArity describes the arguments of the function when it's used as a
+// filter, i.e. without the piped value.
+func (f exprFunc) filterArity() string {
+	maxArgs := f.maxArgs
+	if maxArgs > 0 {
+		maxArgs--
+	}
+	return arityString(f.minArgs-1, maxArgs)
+}
+
+func arityString(minArgs, maxArgs int) string {
+	switch {
+	case minArgs == maxArgs && minArgs == 0:
+		return "expects no arguments"
+	case minArgs == maxArgs && minArgs == 1:
+		return "expects 1 argument"
+	case minArgs == maxArgs:
+		return fmt.Sprintf("expects %d arguments", minArgs)
+	case maxArgs < 0:
+		return fmt.Sprintf("expects at least %d arguments", minArgs)
+	}
+	return fmt.Sprintf("expects between %d and %d arguments", minArgs, maxArgs)
+}
+
+func stringArgs(args []any) ([]string, error) {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		v, ok := arg.(string)
+		if !ok {
+			if isNullish(arg) {
+				continue
+			}
+			return nil, fmt.Errorf("argument %d must be a string, got %s", i+1, typeName(arg))
+		}
+		s[i] = v
+	}
+	return s, nil
+}
+
+func intArg(args []any, i int) (int, error) {
+	f, ok := args[i].(float64)
+	if !ok || f != math.Trunc(f) {
+		return 0, fmt.Errorf("argument %d must be an integer, got %s", i+1, typeName(args[i]))
+	}
+	return int(f), nil
+}
+
+func listArg(args []any, i int) ([]any, error) {
+	switch l := args[i].(type) {
+	case []any:
+		return l, nil
+	case nil, undefinedValue:
+		return []any{}, nil
+	}
+	return nil, fmt.Errorf("argument %d must be a list, got %s", i+1, typeName(args[i]))
+}
+
+func objectArg(args []any, i int) (map[string]any, error) {
+	switch m := args[i].(type) {
+	case map[string]any:
+		return m, nil
+	case nil, undefinedValue:
+		return map[string]any{}, nil
+	}
+	return nil, fmt.Errorf("argument %d must be an object, got %s", i+1, typeName(args[i]))
+}
+
+// toString converts a value into its template representation: strings are
+// kept as they are and other values are JSON-encoded.
+func toString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case nil, undefinedValue:
+		return "", nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// sliceBounds normalizes the optional start and end arguments of slice.
+func sliceBounds(args []any, length int) (int, int, error) {
+	start, err := intArg(args, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	end := length
+	if len(args) > 2 {
+		if end, err = intArg(args, 2); err != nil {
+			return 0, 0, err
+		}
+	}
+	if start < 0 {
+		start += length
+	}
+	if end < 0 {
+		end += length
+	}
+	start = max(0, min(start, length))
+	end = max(start, min(end, length))
+	return start, end, nil
+}
+
+func lenFunc(args []any) (any, error) {
+	switch v := args[0].(type) {
+	case string:
+		return float64(utf8.RuneCountInString(v)), nil
+	case []any:
+		return float64(len(v)), nil
+	case map[string]any:
+		return float64(len(v)), nil
+	case nil, undefinedValue:
+		return float64(0), nil
+	}
+	return nil, fmt.Errorf("length of %s is not defined", typeName(args[0]))
+}
+
+var exprFuncs = map[string]exprFunc{
+	"defined": {minArgs: 1, maxArgs: 1, acceptsUndefined: true, fn: func(args []any) (any, error) {
+		return args[0] != undefined, nil
+	}},
+	"isNull": {minArgs: 1, maxArgs: 1, acceptsUndefined: true, fn: func(args []any) (any, error) {
+		return isNullish(args[0]), nil
+	}},
+	"isEmpty": {minArgs: 1, maxArgs: 1, acceptsUndefined: true, fn: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return v == "", nil
+		case []any:
+			return len(v) == 0, nil
+		case map[string]any:
+			return len(v) == 0, nil
+		}
+		return isNullish(args[0]), nil
+	}},
+	"default": {minArgs: 2, maxArgs: 2, acceptsUndefined: true, fn: func(args []any) (any, error) {
+		if isNullish(args[0]) || args[0] == "" {
+			return args[1], nil
+		}
+		return args[0], nil
+	}},
+	"len":    {minArgs: 1, maxArgs: 1, fn: lenFunc},
+	"length": {minArgs: 1, maxArgs: 1, fn: lenFunc},
+	"contains": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		return contains(args[0], args[1])
+	}},
+	"startsWith": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.HasPrefix(s[0], s[1]), nil
+	}},
+	"endsWith": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.HasSuffix(s[0], s[1]), nil
+	}},
+	"matches": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(s[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return re.MatchString(s[0]), nil
+	}},
+	"lower": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToLower(s[0]), nil
+	}},
+	"upper": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToUpper(s[0]), nil
+	}},
+	"trim": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.TrimSpace(s[0]), nil
+	}},
+	"truncate": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args[:1])
+		if err != nil {
+			return nil, err
+		}
+		n, err := intArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		runes := []rune(s[0])
+		if n < 0 || n >= len(runes) {
+			return s[0], nil
+		}
+		return string(runes[:n]), nil
+	}},
+	"replace": {minArgs: 3, maxArgs: 3, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ReplaceAll(s[0], s[1], s[2]), nil
+	}},
+	"split": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		parts := strings.Split(s[0], s[1])
+		l := make([]any, len(parts))
+		for i := range parts {
+			l[i] = parts[i]
+		}
+		return l, nil
+	}},
+	"join": {minArgs: 1, maxArgs: 2, fn: func(args []any) (any, error) {
+		l, err := listArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		sep := ""
+		if len(args) > 1 {
+			s, err := stringArgs(args[1:])
+			if err != nil {
+				return nil, err
+			}
+			sep = s[0]
+		}
+		parts := make([]string, len(l))
+		for i := range l {
+			if parts[i], err = toString(l[i]); err != nil {
+				return nil, err
+			}
+		}
+		return strings.Join(parts, sep), nil
+	}},
+	"first": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		l, err := listArg(args, 0)
+		if err != nil || len(l) == 0 {
+			return nil, err
+		}
+		return l[0], nil
+	}},
+	"last": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		l, err := listArg(args, 0)
+		if err != nil || len(l) == 0 {
+			return nil, err
+		}
+		return l[len(l)-1], nil
+	}},
+	"slice": {minArgs: 2, maxArgs: 3, fn: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			runes := []rune(v)
+			start, end, err := sliceBounds(args, len(runes))
+			if err != nil {
+				return nil, err
+			}
+			return string(runes[start:end]), nil
+		case []any:
+			start, end, err := sliceBounds(args, len(v))
+			if err != nil {
+				return nil, err
+			}
+			return v[start:end], nil
+		}
+		return nil, fmt.Errorf("cannot slice %s", typeName(args[0]))
+	}},
+	"pluck": {minArgs: 2, maxArgs: 2, fn: func(args []any) (any, error) {
+		l, err := listArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		field, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("argument 2 must be a string, got %s", typeName(args[1]))
+		}
+		values := make([]any, len(l))
+		for i := range l {
+			if m, ok := l[i].(map[string]any); ok {
+				values[i] = m[field]
+			}
+		}
+		return values, nil
+	}},
+	"keys": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		m, err := objectArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		l := make([]any, len(keys))
+		for i := range keys {
+			l[i] = keys[i]
+		}
+		return l, nil
+	}},
+	"values": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		m, err := objectArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		l := make([]any, len(keys))
+		for i := range keys {
+			l[i] = m[keys[i]]
+		}
+		return l, nil
+	}},
+	// An unresolved reference is rendered as null.
+	"tojson": {minArgs: 1, maxArgs: 1, acceptsUndefined: true, fn: func(args []any) (any, error) {
+		if args[0] == undefined {
+			return "null", nil
+		}
+		b, err := json.Marshal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}},
+	"fromjson": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		s, err := stringArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		var v any
+		if err := json.Unmarshal([]byte(s[0]), &v); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		return v, nil
+	}},
+	"tostring": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		return toString(args[0])
+	}},
+	"tonumber": {minArgs: 1, maxArgs: 1, fn: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case float64:
+			return v, nil
+		case bool:
+			if v {
+				return float64(1), nil
+			}
+			return float64(0), nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", v)
+			}
+			return f, nil
+		}
+		return nil, fmt.Errorf("cannot convert %s to a number", typeName(args[0]))
+	}},
+}
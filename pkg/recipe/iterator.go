@@ -0,0 +1,232 @@
+package recipe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// An iterator input is either:
+//   - a reference to a list, e.g. `${split.output.chunks}`, whose elements are
+//     iterated in order.
+//   - a reference to an object, e.g. `${variable.files}`, whose entries are
+//     iterated in key order. Each element is an object with the `key` and
+//     `value` of the entry.
+//   - a range of integers, e.g. `range(0, ${variable.n})` or
+//     `range(10, 0, -2)`. The start is inclusive and the end exclusive. The
+//     arguments are integer literals or bindings that resolve to integers.
+
+const (
+	rangePrefix = "range("
+
+	// MaxRangeSize is the maximum number of elements a range can produce.
+	MaxRangeSize = 10000
+)
+
+// IteratorInputError is returned when the input of an iterator can't be
+// iterated over.
+type IteratorInputError struct {
+	Msg string
+}
+
+func (e *IteratorInputError) Error() string {
+	return e.Msg
+}
+
+func iteratorInputErrorf(format string, a ...any) error {
+	return &IteratorInputError{Msg: fmt.Sprintf(format, a...)}
+}
+
+// rangeArgs returns the arguments of a range expression. The second value
+// is false if the input isn't a range.
+func rangeArgs(input string) ([]string, bool, error) {
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, rangePrefix) {
+		return nil, false, nil
+	}
+	if !strings.HasSuffix(input, ")") {
+		return nil, true, iteratorInputErrorf("range is missing its closing parenthesis")
+	}
+
+	// Commas in bindings (e.g. in filter arguments) don't separate the range
+	// arguments.
+	body := input[len(rangePrefix) : len(input)-1]
+	args := []string{}
+	start := 0
+	for i := 0; i < len(body); i++ {
+		switch {
+		case strings.HasPrefix(body[i:], "${"):
+			end := bindingEnd(body, i)
+			if end == -1 {
+				return nil, true, iteratorInputErrorf("unterminated binding in range")
+			}
+			i = end - 1
+		case body[i] == ',':
+			args = append(args, strings.TrimSpace(body[start:i]))
+			start = i + 1
+		}
+	}
+	args = append(args, strings.TrimSpace(body[start:]))
+
+	if len(args) < 2 || len(args) > 3 {
+		return nil, true, iteratorInputErrorf("range takes 2 or 3 arguments, got %d", len(args))
+	}
+	for i, arg := range args {
+		switch {
+		case arg == "":
+			return nil, true, iteratorInputErrorf("range argument %d is empty", i+1)
+		case strings.HasPrefix(arg, "${"):
+			if bindingEnd(arg, 0) != len(arg) {
+				return nil, true, iteratorInputErrorf("range argument %d must be a single binding or an integer", i+1)
+			}
+		default:
+			if _, err := strconv.Atoi(arg); err != nil {
+				return nil, true, iteratorInputErrorf("range argument %d must be a single binding or an integer, got %q", i+1, arg)
+			}
+		}
+	}
+	return args, true, nil
+}
+
+// ValidateIteratorInput checks the syntax of an iterator input.
+func ValidateIteratorInput(input any) error {
+	s, ok := input.(string)
+	if !ok {
+		return iteratorInputErrorf("iterator input must be a reference or a range, got %s", typeName(normalizeValue(input)))
+	}
+	args, isRange, err := rangeArgs(s)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return ValidateTemplate(s)
+	}
+	for _, arg := range args {
+		if err := ValidateTemplate(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterationElements renders the input of an iterator against the memory of
+// a batch item and returns the elements to iterate over.
+func IterationElements(input any, memory *Memory) ([]any, error) {
+	if err := ValidateIteratorInput(input); err != nil {
+		return nil, err
+	}
+
+	args, isRange, _ := rangeArgs(input.(string))
+	if isRange {
+		return rangeElements(args, memory)
+	}
+
+	v, err := RenderInput(input, 0, memory)
+	if err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case []any:
+		return v, nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		elems := make([]any, len(keys))
+		for i, k := range keys {
+			elems[i] = map[string]any{"key": k, "value": v[k]}
+		}
+		return elems, nil
+	case nil:
+		return []any{}, nil
+	}
+	return nil, iteratorInputErrorf("iterator input must resolve to a list or an object, got %s", typeName(normalizeValue(v)))
+}
+
+func rangeElements(args []string, memory *Memory) ([]any, error) {
+	bounds := make([]int, len(args))
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "${") {
+			// The literals are checked when the range is parsed.
+			bounds[i], _ = strconv.Atoi(arg)
+			continue
+		}
+
+		v, err := ResolveBinding(memory, arg[2:len(arg)-1])
+		if err != nil {
+			return nil, err
+		}
+		f, ok := normalizeValue(v).(float64)
+		if !ok || f != math.Trunc(f) {
+			return nil, iteratorInputErrorf("range argument %d must be an integer, got %s", i+1, typeName(normalizeValue(v)))
+		}
+		bounds[i] = int(f)
+	}
+
+	start, end, step := bounds[0], bounds[1], 1
+	if len(bounds) == 3 {
+		step = bounds[2]
+	}
+	if step == 0 {
+		return nil, iteratorInputErrorf("range step can't be 0")
+	}
+
+	size := 0
+	if step > 0 && end > start {
+		size = (end - start + step - 1) / step
+	} else if step < 0 && end < start {
+		size = (start - end - step - 1) / -step
+	}
+	if size > MaxRangeSize {
+		return nil, iteratorInputErrorf("range produces %d elements, the maximum is %d", size, MaxRangeSize)
+	}
+
+	elems := make([]any, size)
+	for i := range size {
+		elems[i] = start + i*step
+	}
+	return elems, nil
+}
+
+// FilterElements returns the elements that satisfy the condition of an
+// iterator. The condition is evaluated with the element exposed as
+// `${<iteratorID>.element}`.
+func FilterElements(iteratorID, condition string, elements []any, memory *Memory) ([]any, error) {
+	if condition == "" {
+		return elements, nil
+	}
+	cond, err := ParseCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	if memory.Component == nil {
+		memory.Component = map[string]*ComponentMemory{}
+	}
+	prev, hadPrev := memory.Component[iteratorID]
+	defer func() {
+		if hadPrev {
+			memory.Component[iteratorID] = prev
+		} else {
+			delete(memory.Component, iteratorID)
+		}
+	}()
+
+	filtered := []any{}
+	for _, elem := range elements {
+		memory.Component[iteratorID] = &ComponentMemory{Element: elem}
+		ok, err := cond.Eval(memory)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, elem)
+		}
+	}
+	return filtered, nil
+}
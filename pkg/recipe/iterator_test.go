@@ -0,0 +1,80 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestIterationElements(t *testing.T) {
+	c := qt.New(t)
+
+	memory := &Memory{
+		Variable: VariableMemory{
+			"n":     float64(3),
+			"half":  1.5,
+			"list":  []any{"a", "b"},
+			"files": map[string]any{"b.txt": float64(2), "a.txt": float64(1)},
+			"text":  "abc",
+		},
+		Component: map[string]*ComponentMemory{},
+	}
+
+	testCases := []struct {
+		name    string
+		input   any
+		want    []any
+		wantErr string
+	}{
+		{name: "list", input: "${variable.list}", want: []any{"a", "b"}},
+		{
+			name:  "object",
+			input: "${variable.files}",
+			want: []any{
+				map[string]any{"key": "a.txt", "value": float64(1)},
+				map[string]any{"key": "b.txt", "value": float64(2)},
+			},
+		},
+		{name: "range", input: "range(0, ${variable.n})", want: []any{0, 1, 2}},
+		{name: "range with step", input: "range(10, 3, -3)", want: []any{10, 7, 4}},
+		{name: "empty range", input: "range(${variable.n}, 0)", want: []any{}},
+		{name: "string", input: "${variable.text}", wantErr: "iterator input must resolve to a list or an object, got string"},
+		{name: "not a string", input: []any{"a"}, wantErr: "iterator input must be a reference or a range, got list"},
+		{name: "range arity", input: "range(3)", wantErr: "range takes 2 or 3 arguments, got 1"},
+		{name: "range literal", input: "range(0, n)", wantErr: `range argument 2 must be a single binding or an integer, got "n"`},
+		{name: "range float", input: "range(0, ${variable.half})", wantErr: "range argument 2 must be an integer, got number"},
+		{name: "range step", input: "range(0, 3, 0)", wantErr: "range step can't be 0"},
+		{name: "range size", input: "range(0, 1000000)", wantErr: "range produces 1000000 elements, the maximum is 10000"},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.name, func(c *qt.C) {
+			got, err := IterationElements(tc.input, memory)
+			if tc.wantErr != "" {
+				c.Check(err, qt.ErrorMatches, tc.wantErr)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Check(got, qt.DeepEquals, tc.want)
+		})
+	}
+}
+
+func TestFilterElements(t *testing.T) {
+	c := qt.New(t)
+
+	memory := &Memory{
+		Variable:  VariableMemory{"min": float64(2)},
+		Component: map[string]*ComponentMemory{},
+	}
+	elems := []any{
+		map[string]any{"key": "a", "value": float64(1)},
+		map[string]any{"key": "b", "value": float64(2)},
+		map[string]any{"key": "c", "value": float64(3)},
+	}
+
+	got, err := FilterElements("iter", "${iter.element.value} >= ${variable.min}", elems, memory)
+	c.Assert(err, qt.IsNil)
+	c.Check(got, qt.DeepEquals, elems[1:])
+	c.Check(memory.Component, qt.HasLen, 0)
+}
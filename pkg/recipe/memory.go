@@ -3,7 +3,9 @@ package recipe
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +23,8 @@ const (
 	SegOwner     = "owner_permalink"
 	SegComponent = "component"
 	SegIteration = "iterations"
+	SegCase      = "case"
+	SegPipeline  = "pipeline"
 
 	redisKeyPrefix = "pipeline_trigger"
 )
@@ -34,8 +38,29 @@ const (
 // pipeline_trigger:<workflowID>:<batchIdx>:components:<compID>
 
 // For the child pipeline in the iterator:
-// pipeline_trigger:<workflowID>:components:<compID>:recipe
-// pipeline_trigger:<workflowID>:components:<compID>:iterations:<iter>:components:<iterCompID>
+// pipeline_trigger:<workflowID>:<compID>:recipe
+// pipeline_trigger:<workflowID>:<batchIdx>:component:<compID>:iterations:<elemIdx>:component:<iterCompID>
+//
+// The child workflow of an iterator has the ID
+// <workflowID>:<batchIdx>:component:<compID>:iterations, so the keys of a
+// nested iterator follow the same layout with the child workflow ID as the
+// workflow ID.
+
+// For the child pipeline of a switch case:
+// pipeline_trigger:<workflowID>:<compID>:<caseIdx>:recipe
+// pipeline_trigger:<workflowID>:<batchIdx>:component:<compID>:case:0:component:<caseCompID>
+//
+// The case of each batch item runs in a child workflow with the ID
+// <workflowID>:<batchIdx>:component:<compID>:case and a single batch item.
+
+// For the pipeline called by a sub-pipeline component:
+// pipeline_trigger:<workflowID>:<batchIdx>:component:<compID>:pipeline:recipe
+// pipeline_trigger:<workflowID>:<batchIdx>:component:<compID>:pipeline:0:variable
+// pipeline_trigger:<workflowID>:<batchIdx>:component:<compID>:pipeline:0:component:<calleeCompID>
+//
+// The callee of each batch item is triggered as a child workflow with the ID
+// <workflowID>:<batchIdx>:component:<compID>:pipeline and a single batch
+// item.
 
 type Memory struct {
 	Variable  VariableMemory              `json:"variable"`
@@ -50,6 +75,9 @@ type ComponentMemory struct {
 	Output  *ComponentIO     `json:"output"`
 	Element any              `json:"element"` // for iterator
 	Status  *ComponentStatus `json:"status"`
+	Error   string           `json:"error,omitempty"`
+	Cache   *CacheStatus     `json:"cache,omitempty"`
+	Run     *ComponentRun    `json:"run,omitempty"`
 }
 
 type BatchMemoryKey struct {
@@ -66,6 +94,7 @@ type ComponentStatus struct {
 	Started   bool `json:"started"`
 	Completed bool `json:"completed"`
 	Skipped   bool `json:"skipped"`
+	Errored   bool `json:"errored"`
 }
 
 func Write(ctx context.Context, rc *redis.Client, triggerID string, recipe *datamodel.Recipe, batchMemory []*Memory, ownerPermalink string) (*BatchMemoryKey, error) {
@@ -231,13 +260,90 @@ func Purge(ctx context.Context, rc *redis.Client, pipelineTriggerID string) {
 	}
 }
 
-func WriteComponentMemory(ctx context.Context, rc *redis.Client, key string, compID string, compsMem []*ComponentMemory) error {
+// IterationWorkflowID returns the ID of the child workflow that runs the
+// iterations of an iterator for a batch item.
+func IterationWorkflowID(workflowID string, batchIdx int, iteratorID string) string {
+	return fmt.Sprintf("%s:%d:%s:%s:%s", workflowID, batchIdx, SegComponent, iteratorID, SegIteration)
+}
+
+// LoadIterationMemory loads the component memory of every iteration of an
+// iterator child workflow. The components that didn't run in an iteration are
+// left out of its memory.
+func LoadIterationMemory(ctx context.Context, rc *redis.Client, childWorkflowID string, iteratorID string, compIDs []string) ([]*Memory, error) {
+	size := getIterationSize(ctx, rc, childWorkflowID, iteratorID)
+	memory := make([]*Memory, size)
+	for idx := range size {
+		m, err := loadComponentsMemory(ctx, rc, childWorkflowID, idx, append([]string{iteratorID}, compIDs...))
+		if err != nil {
+			return nil, err
+		}
+		memory[idx] = m
+	}
+	return memory, nil
+}
+
+// SwitchWorkflowID returns the ID of the child workflow that runs the
+// matching case of a switch for a batch item.
+func SwitchWorkflowID(workflowID string, batchIdx int, switchID string) string {
+	return fmt.Sprintf("%s:%d:%s:%s:%s", workflowID, batchIdx, SegComponent, switchID, SegCase)
+}
+
+// SwitchCaseRecipeKey returns the key of the recipe that holds the
+// components of a switch case.
+func SwitchCaseRecipeKey(workflowID string, switchID string, caseIdx int) string {
+	return fmt.Sprintf("%s:%s:%d:%s", workflowID, switchID, caseIdx, SegRecipe)
+}
+
+// SubPipelineWorkflowID returns the ID of the child workflow that triggers
+// the pipeline called by a sub-pipeline component for a batch item.
+func SubPipelineWorkflowID(workflowID string, batchIdx int, compID string) string {
+	return fmt.Sprintf("%s:%d:%s:%s:%s", workflowID, batchIdx, SegComponent, compID, SegPipeline)
+}
+
+// LoadComponentMemory loads the memory of a component for a batch item.
+func LoadComponentMemory(ctx context.Context, rc *redis.Client, workflowID string, batchIdx int, compID string) (*ComponentMemory, error) {
+	m := &ComponentMemory{}
+	if err := loadData(ctx, rc, fmt.Sprintf("%s:%d:%s:%s", workflowID, batchIdx, SegComponent, compID), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LoadSwitchMemory loads the component memory of a switch child workflow.
+// The components that didn't run are left out of the memory.
+func LoadSwitchMemory(ctx context.Context, rc *redis.Client, childWorkflowID string, compIDs []string) (*Memory, error) {
+	return loadComponentsMemory(ctx, rc, childWorkflowID, 0, compIDs)
+}
+
+func loadComponentsMemory(ctx context.Context, rc *redis.Client, workflowID string, batchIdx int, compIDs []string) (*Memory, error) {
+	memory := &Memory{
+		Variable:  make(VariableMemory),
+		Secret:    make(SecretMemory),
+		Component: make(map[string]*ComponentMemory),
+	}
+	for _, compID := range compIDs {
+		m := ComponentMemory{}
+		err := loadData(ctx, rc, fmt.Sprintf("%s:%d:%s:%s", workflowID, batchIdx, SegComponent, compID), &m)
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		memory.Component[compID] = &m
+	}
+	return memory, nil
+}
+
+// WriteComponentMemory writes the memory of a component for the batch items
+// starting at batchOffset.
+func WriteComponentMemory(ctx context.Context, rc *redis.Client, key string, compID string, batchOffset int, compsMem []*ComponentMemory) error {
 	for idx, compMem := range compsMem {
 		b, err := json.Marshal(compMem)
 		if err != nil {
 			return err
 		}
-		if err := writeData(ctx, rc, fmt.Sprintf("%s:%d:%s:%s", key, idx, SegComponent, compID), b); err != nil {
+		if err := writeData(ctx, rc, fmt.Sprintf("%s:%d:%s:%s", key, batchOffset+idx, SegComponent, compID), b); err != nil {
 			return err
 		}
 	}
@@ -269,23 +375,54 @@ func loadData(ctx context.Context, rc *redis.Client, key string, target any) err
 	return nil
 }
 
+// getBatchSize counts the batch items of a trigger from their variable
+// memory.
 func getBatchSize(ctx context.Context, rc *redis.Client, key string) int {
-	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s:%s:*:%s", redisKeyPrefix, key, SegVariable), 0).Iterator()
+	prefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, key)
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s*:%s", prefix, SegVariable), 0).Iterator()
 	batchSize := 0
 	for iter.Next(ctx) {
-		batchSize += 1
+		// The variables of the sub-pipelines called by the trigger also match
+		// the pattern.
+		if len(strings.Split(strings.TrimPrefix(iter.Val(), prefix), ":")) == 2 {
+			batchSize += 1
+		}
 	}
 	return batchSize
 }
 
+// getIterationSize counts the elements of an iteration from the element
+// memory the iterator writes for each of them.
+func getIterationSize(ctx context.Context, rc *redis.Client, childWorkflowID string, iteratorID string) int {
+	prefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, childWorkflowID)
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s*:%s:%s", prefix, SegComponent, iteratorID), 0).Iterator()
+	size := 0
+	for iter.Next(ctx) {
+		// Keys of nested iterations also match the pattern, only the element
+		// keys of this iteration are counted.
+		keySplits := strings.Split(strings.TrimPrefix(iter.Val(), prefix), ":")
+		if len(keySplits) != 3 {
+			continue
+		}
+		if _, err := strconv.Atoi(keySplits[0]); err == nil {
+			size++
+		}
+	}
+	return size
+}
+
 func getCompIDs(ctx context.Context, rc *redis.Client, key string) []string {
-	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s:%s:*:%s:*", redisKeyPrefix, key, SegComponent), 0).Iterator()
+	prefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, key)
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s*:%s:*", prefix, SegComponent), 0).Iterator()
 	compIDMap := map[string]bool{}
 	for iter.Next(ctx) {
-		key := iter.Val()
-		keySplits := strings.Split(key, ":")
-		compID := keySplits[4]
-		compIDMap[compID] = true
+		// Only <batchIdx>:component:<compID> keys hold the memory of the
+		// trigger components, the other keys belong to nested workflows.
+		keySplits := strings.Split(strings.TrimPrefix(iter.Val(), prefix), ":")
+		if len(keySplits) != 3 || keySplits[1] != SegComponent {
+			continue
+		}
+		compIDMap[keySplits[2]] = true
 	}
 	compIDs := []string{}
 	for k := range compIDMap {
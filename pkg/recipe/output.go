@@ -0,0 +1,121 @@
+package recipe
+
+import (
+	"context"
+	"slices"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+
+	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
+)
+
+// GenerateOutputs renders the outputs of a trigger from its memory. The
+// traces of the components are returned in the metadata when returnTraces is
+// set, or when batch items failed in isolation, for the failed components.
+func GenerateOutputs(ctx context.Context, rc *redis.Client, pipelineTriggerID string, r *datamodel.Recipe, returnTraces bool) ([]*structpb.Struct, *pb.TriggerMetadata, error) {
+
+	memory, err := LoadMemoryByTriggerID(ctx, rc, pipelineTriggerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipelineOutputs := make([]*structpb.Struct, len(memory))
+	itemErrors := batchItemErrors(r.Component, memory)
+
+	for idx := range memory {
+		pipelineOutput := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		if _, ok := itemErrors[idx]; ok {
+			// The items that failed in isolation have no output.
+			pipelineOutputs[idx] = pipelineOutput
+			continue
+		}
+		for k, v := range r.Output {
+			o, err := RenderInput(v.Value, idx, memory[idx])
+			if err != nil {
+				if !referencesErroredComponent(v.Value, memory[idx]) {
+					return nil, nil, err
+				}
+				// The output of a component that failed with a continue
+				// policy is missing.
+				o = nil
+			}
+			structVal, err := structpb.NewValue(o)
+			if err != nil {
+				return nil, nil, err
+			}
+			pipelineOutput.Fields[k] = structVal
+
+		}
+		pipelineOutputs[idx] = pipelineOutput
+	}
+
+	var metadata *pb.TriggerMetadata
+	if returnTraces {
+		traces, err := GenerateTraces(r.Component, memory)
+		if err != nil {
+			return nil, nil, err
+		}
+		nestedTraces, err := GenerateNestedTraces(ctx, rc, pipelineTriggerID, r.Component, len(memory))
+		if err != nil {
+			return nil, nil, err
+		}
+		for k, trace := range nestedTraces {
+			traces[k] = trace
+		}
+		metadata = &pb.TriggerMetadata{
+			Traces: traces,
+		}
+	} else if len(itemErrors) > 0 {
+		// The errors of the items that failed in isolation are always
+		// reported, through the traces of the failed components.
+		failedComps := datamodel.ComponentMap{}
+		for _, compIDs := range itemErrors {
+			for _, compID := range compIDs {
+				failedComps[compID] = r.Component[compID]
+			}
+		}
+		traces, err := GenerateTraces(failedComps, memory)
+		if err != nil {
+			return nil, nil, err
+		}
+		metadata = &pb.TriggerMetadata{
+			Traces: traces,
+		}
+	}
+	return pipelineOutputs, metadata, nil
+}
+
+// batchItemErrors returns the batch items that failed in isolation, with the
+// IDs of the components that failed for each of them. The errors handled by
+// an on-error policy don't fail the item.
+func batchItemErrors(comps datamodel.ComponentMap, memory []*Memory) map[int][]string {
+	itemErrors := map[int][]string{}
+	for idx, m := range memory {
+		for compID, comp := range comps {
+			if comp.OnError != nil && comp.OnError.Action != datamodel.OnErrorFail {
+				continue
+			}
+			compMem, ok := m.Component[compID]
+			if ok && compMem.Status != nil && compMem.Status.Errored {
+				itemErrors[idx] = append(itemErrors[idx], compID)
+			}
+		}
+		slices.Sort(itemErrors[idx])
+	}
+	return itemErrors
+}
+
+// referencesErroredComponent returns whether a template references a
+// component that failed and has no output.
+func referencesErroredComponent(template string, memory *Memory) bool {
+	for _, ref := range FindReferenceParent(template) {
+		comp, ok := memory.Component[ref]
+		if ok && comp.Status != nil && comp.Status.Errored && !comp.Status.Completed {
+			return true
+		}
+	}
+	return false
+}
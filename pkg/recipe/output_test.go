@@ -0,0 +1,31 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+)
+
+func TestBatchItemErrors(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"llm":   {Type: "openai"},
+		"image": {Type: "openai", OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorContinue}},
+	}
+	item := func(llm, image ComponentStatus) *Memory {
+		return &Memory{Component: map[string]*ComponentMemory{
+			"llm":   {Status: &llm},
+			"image": {Status: &image},
+		}}
+	}
+	memory := []*Memory{
+		item(ComponentStatus{Started: true, Completed: true}, ComponentStatus{Started: true, Completed: true}),
+		item(ComponentStatus{Started: true, Errored: true}, ComponentStatus{Skipped: true}),
+		item(ComponentStatus{Started: true, Completed: true}, ComponentStatus{Started: true, Errored: true}),
+	}
+
+	c.Check(batchItemErrors(comps, memory), qt.DeepEquals, map[int][]string{1: {"llm"}})
+}
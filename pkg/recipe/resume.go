@@ -0,0 +1,103 @@
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+)
+
+const segRetained = "retained"
+
+// RetainedTriggerID returns the key under which the memory of a failed
+// trigger is kept after it's purged. The memory functions that take a trigger
+// ID can read the retained memory through it.
+func RetainedTriggerID(triggerID string) string {
+	return fmt.Sprintf("%s:%s", segRetained, triggerID)
+}
+
+// RetainMemory copies the memory of a trigger so it outlives the purge of
+// the trigger memory for the retention period. The secrets aren't retained,
+// their memory is kept empty.
+func RetainMemory(ctx context.Context, rc *redis.Client, triggerID string, retention time.Duration) error {
+	prefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, triggerID)
+	retainedPrefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, RetainedTriggerID(triggerID))
+
+	iter := rc.Scan(ctx, 0, prefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		b := []byte("{}")
+		if !strings.HasSuffix(key, ":"+SegSecret) {
+			var err error
+			if b, err = rc.Get(ctx, key).Bytes(); err != nil {
+				return err
+			}
+		}
+		if err := rc.Set(ctx, retainedPrefix+strings.TrimPrefix(key, prefix), b, retention).Err(); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// CopyComponentMemory copies the memory of components, including the memory
+// of their nested workflows, from a trigger to another one.
+func CopyComponentMemory(ctx context.Context, rc *redis.Client, fromTriggerID, toTriggerID string, compIDs []string) error {
+	prefix := fmt.Sprintf("%s:%s:", redisKeyPrefix, fromTriggerID)
+
+	iter := rc.Scan(ctx, 0, fmt.Sprintf("%s*:%s:*", prefix, SegComponent), 0).Iterator()
+	for iter.Next(ctx) {
+		// The keys are <batchIdx>:component:<compID>[:<nested key>].
+		rel := strings.TrimPrefix(iter.Val(), prefix)
+		keySplits := strings.SplitN(rel, ":", 4)
+		if len(keySplits) < 3 || keySplits[1] != SegComponent || !slices.Contains(compIDs, keySplits[2]) {
+			continue
+		}
+
+		b, err := rc.Get(ctx, iter.Val()).Bytes()
+		if err != nil {
+			return err
+		}
+		if err := writeData(ctx, rc, fmt.Sprintf("%s:%s", toTriggerID, rel), b); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// ResumableComponents returns the components of a trigger that don't have to
+// be executed again when it's resumed: the components that completed or were
+// skipped for every batch item, and whose upstream components are resumable
+// too. The rest of the components are the failed ones and everything
+// downstream of them.
+func ResumableComponents(compMap datamodel.ComponentMap, memory []*Memory) ([]string, error) {
+	d, err := GenerateDAG(compMap)
+	if err != nil {
+		return nil, err
+	}
+
+	done := func(compID string) bool {
+		for _, m := range memory {
+			compMem, ok := m.Component[compID]
+			if !ok || compMem.Status == nil || !(compMem.Status.Completed || compMem.Status.Skipped) {
+				return false
+			}
+		}
+		return true
+	}
+
+	compIDs := []string{}
+	for compID := range compMap {
+		if done(compID) && !slices.ContainsFunc(d.GetUpstreamCompIDs(compID), func(id string) bool { return !done(id) }) {
+			compIDs = append(compIDs, compID)
+		}
+	}
+	slices.Sort(compIDs)
+
+	return compIDs, nil
+}
@@ -0,0 +1,87 @@
+package recipe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+)
+
+func TestResumableComponents(t *testing.T) {
+	c := qt.New(t)
+
+	comps := datamodel.ComponentMap{
+		"a": {Type: "openai"},
+		"b": {Type: "openai", Input: map[string]any{"x": "${a.output.x}"}},
+		"c": {Type: "openai", Input: map[string]any{"x": "${b.output.x}"}},
+		"d": {Type: "openai", Input: map[string]any{"x": "${b.output.x}"}},
+		"e": {Type: "openai"},
+		"f": {Type: "openai", Condition: "${e.output.done}"},
+	}
+
+	completed := &ComponentMemory{Status: &ComponentStatus{Started: true, Completed: true}}
+	skipped := &ComponentMemory{Status: &ComponentStatus{Skipped: true}}
+	errored := &ComponentMemory{Status: &ComponentStatus{Started: true, Errored: true}}
+	memory := []*Memory{
+		{Component: map[string]*ComponentMemory{"a": completed, "b": completed, "d": completed, "e": completed, "f": skipped}},
+		// b fails for a batch item, so it runs again with its downstream
+		// components, even d that completed.
+		{Component: map[string]*ComponentMemory{"a": completed, "b": errored, "d": completed, "e": completed, "f": completed}},
+	}
+
+	got, err := ResumableComponents(comps, memory)
+	c.Assert(err, qt.IsNil)
+	c.Check(got, qt.DeepEquals, []string{"a", "e", "f"})
+}
+
+func TestRetainMemory(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	rc, mock := redismock.NewClientMock()
+	mock.ExpectScan(0, "pipeline_trigger:trigger:*", 0).SetVal([]string{
+		"pipeline_trigger:trigger:recipe",
+		"pipeline_trigger:trigger:0:secret",
+		"pipeline_trigger:trigger:0:component:iter:iteration:1:component:llm",
+	}, 0)
+	mock.ExpectGet("pipeline_trigger:trigger:recipe").SetVal(`{"version":"v1beta"}`)
+	mock.ExpectSet("pipeline_trigger:retained:trigger:recipe", []byte(`{"version":"v1beta"}`), time.Hour).SetVal("OK")
+	// The secrets aren't read, their retained memory is empty.
+	mock.ExpectSet("pipeline_trigger:retained:trigger:0:secret", []byte("{}"), time.Hour).SetVal("OK")
+	mock.ExpectGet("pipeline_trigger:trigger:0:component:iter:iteration:1:component:llm").SetVal(`{"status":{"completed":true}}`)
+	mock.ExpectSet("pipeline_trigger:retained:trigger:0:component:iter:iteration:1:component:llm", []byte(`{"status":{"completed":true}}`), time.Hour).SetVal("OK")
+
+	err := RetainMemory(ctx, rc, "trigger", time.Hour)
+	c.Assert(err, qt.IsNil)
+	c.Check(mock.ExpectationsWereMet(), qt.IsNil)
+}
+
+func TestCopyComponentMemory(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	rc, mock := redismock.NewClientMock()
+	mock.ExpectScan(0, "pipeline_trigger:retained:trigger:*:component:*", 0).SetVal([]string{
+		"pipeline_trigger:retained:trigger:0:component:a",
+		// The memory of the nested workflows of a component is copied with
+		// it.
+		"pipeline_trigger:retained:trigger:0:component:iter:iteration:1:component:llm",
+		// The components that aren't copied, even when their nested
+		// components have the ID of a copied component.
+		"pipeline_trigger:retained:trigger:1:component:b",
+		"pipeline_trigger:retained:trigger:1:component:b:iteration:0:component:a",
+	}, 0)
+	mock.ExpectGet("pipeline_trigger:retained:trigger:0:component:a").SetVal(`{"output":{"x":1}}`)
+	mock.ExpectSet("pipeline_trigger:new:0:component:a", []byte(`{"output":{"x":1}}`), 0).SetVal("OK")
+	mock.ExpectGet("pipeline_trigger:retained:trigger:0:component:iter:iteration:1:component:llm").SetVal(`{"output":{"y":2}}`)
+	mock.ExpectSet("pipeline_trigger:new:0:component:iter:iteration:1:component:llm", []byte(`{"output":{"y":2}}`), 0).SetVal("OK")
+
+	err := CopyComponentMemory(ctx, rc, RetainedTriggerID("trigger"), "new", []string{"a", "iter"})
+	c.Assert(err, qt.IsNil)
+	c.Check(mock.ExpectationsWereMet(), qt.IsNil)
+}
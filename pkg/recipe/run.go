@@ -0,0 +1,58 @@
+package recipe
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ComponentRun records the execution of a component for a batch item.
+type ComponentRun struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Attempts  int32     `json:"attempts"`
+	// The sizes of the input and the output are the length of their JSON
+	// representation.
+	InputSize  int64  `json:"inputSize"`
+	OutputSize int64  `json:"outputSize"`
+	ErrorType  string `json:"errorType,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Duration returns the execution time of the component.
+func (r *ComponentRun) Duration() time.Duration {
+	return r.EndTime.Sub(r.StartTime)
+}
+
+// ComponentPath returns the path of a component in the traces of a trigger
+// from the ID of the workflow that runs it. The components of the trigger
+// workflow keep their ID, and the nested ones are prefixed with the path to
+// their group, e.g. `iter[0].comp` for the component `comp` of the iterator
+// `iter` in the first batch item.
+func ComponentPath(triggerID, workflowID, compID string) string {
+	rest := strings.TrimPrefix(strings.TrimPrefix(workflowID, triggerID), ":")
+	if rest == "" {
+		return compID
+	}
+
+	// Each group adds the segments <batchIdx>:component:<groupID>:<kind>.
+	var prefix strings.Builder
+	segs := strings.Split(rest, ":")
+	for len(segs) >= 4 && segs[1] == SegComponent {
+		fmt.Fprintf(&prefix, "%s[%s].", segs[2], segs[0])
+		segs = segs[4:]
+	}
+	return prefix.String() + compID
+}
+
+// computeTime returns the longest execution time of a component across the
+// batch items.
+func computeTime(compID string, memory []*Memory) time.Duration {
+	var d time.Duration
+	for _, m := range memory {
+		if compMem, ok := m.Component[compID]; ok && compMem.Run != nil {
+			d = max(d, compMem.Run.Duration())
+		}
+	}
+	return d
+}
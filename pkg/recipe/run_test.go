@@ -0,0 +1,59 @@
+package recipe
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+)
+
+func TestComponentPath(t *testing.T) {
+	c := qt.New(t)
+
+	testcases := []struct {
+		workflowID string
+		want       string
+	}{
+		{workflowID: "trigger", want: "comp"},
+		{workflowID: IterationWorkflowID("trigger", 1, "iter"), want: "iter[1].comp"},
+		{workflowID: SwitchWorkflowID(IterationWorkflowID("trigger", 0, "iter"), 2, "route"), want: "iter[0].route[2].comp"},
+	}
+
+	for _, tc := range testcases {
+		c.Check(ComponentPath("trigger", tc.workflowID, "comp"), qt.Equals, tc.want)
+	}
+}
+
+func TestGenerateTraces_Runs(t *testing.T) {
+	c := qt.New(t)
+
+	start := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	comps := datamodel.ComponentMap{
+		"llm": {Type: "openai"},
+	}
+	memory := []*Memory{
+		{Component: map[string]*ComponentMemory{"llm": {
+			Input:  &ComponentIO{},
+			Output: &ComponentIO{},
+			Status: &ComponentStatus{Started: true, Completed: true},
+			Run:    &ComponentRun{StartTime: start, EndTime: start.Add(2 * time.Second), Attempts: 2, InputSize: 10, OutputSize: 20},
+		}}},
+		{Component: map[string]*ComponentMemory{"llm": {
+			Input:  &ComponentIO{},
+			Output: &ComponentIO{},
+			Status: &ComponentStatus{Started: true, Errored: true},
+			Error:  "rate limited",
+			Run:    &ComponentRun{StartTime: start, EndTime: start.Add(time.Second), Attempts: 1, ErrorType: "ComponentActivityError", Error: "rate limited"},
+		}}},
+	}
+
+	traces, err := GenerateTraces(comps, memory)
+	c.Assert(err, qt.IsNil)
+	c.Check(traces["llm"].ComputeTimeInSeconds, qt.Equals, float32(2))
+
+	// The timings of the runs are only exposed in the component runs, the
+	// traces hold the components alone.
+	c.Check(traces, qt.HasLen, 1)
+}
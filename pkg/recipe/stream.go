@@ -0,0 +1,105 @@
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/instill-ai/pipeline-backend/config"
+)
+
+// The workflows of the streamed triggers publish their progress in a Redis
+// stream, which is read by the service that streams the trigger results:
+// pipeline_stream:<triggerID>
+const streamKeyPrefix = "pipeline_stream"
+
+// The types of the stream events.
+const (
+	// StreamEventComponent is published when a component completes. Its
+	// memory is written at that point.
+	StreamEventComponent = "component"
+	// StreamEventIteration is published when a chunk of the elements of an
+	// iterator completes.
+	StreamEventIteration = "iteration"
+)
+
+// streamEventField is the field of the stream entries that holds the event.
+const streamEventField = "event"
+
+// StreamEvent is the progress of a streamed trigger.
+type StreamEvent struct {
+	Type        string `json:"type"`
+	ComponentID string `json:"componentId"`
+	// The iteration events hold the number of elements of a batch item that
+	// are completed, out of its total.
+	BatchIndex int `json:"batchIndex,omitempty"`
+	Completed  int `json:"completed,omitempty"`
+	Total      int `json:"total,omitempty"`
+}
+
+// StreamEntry is an event read from the stream of a trigger, with its ID in
+// the stream.
+type StreamEntry struct {
+	ID    string
+	Event *StreamEvent
+}
+
+func streamKey(triggerID string) string {
+	return fmt.Sprintf("%s:%s", streamKeyPrefix, triggerID)
+}
+
+// PublishStreamEvent appends an event to the stream of a trigger.
+func PublishStreamEvent(ctx context.Context, rc *redis.Client, triggerID string, ev *StreamEvent) error {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	key := streamKey(triggerID)
+	pipe := rc.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		Values: map[string]any{streamEventField: string(b)},
+	})
+	pipe.Expire(ctx, key, time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout)*time.Second)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// ReadStreamEvents returns the events of a trigger published after lastID,
+// which is "0" to read from the start. It waits up to block for new events,
+// or doesn't wait if block is negative.
+func ReadStreamEvents(ctx context.Context, rc *redis.Client, triggerID, lastID string, block time.Duration) ([]StreamEntry, error) {
+	streams, err := rc.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{streamKey(triggerID), lastID},
+		Block:   block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []StreamEntry{}
+	for _, s := range streams {
+		for _, msg := range s.Messages {
+			raw, _ := msg.Values[streamEventField].(string)
+			ev := &StreamEvent{}
+			if err := json.Unmarshal([]byte(raw), ev); err != nil {
+				return nil, err
+			}
+			entries = append(entries, StreamEntry{ID: msg.ID, Event: ev})
+		}
+	}
+	return entries, nil
+}
+
+// PurgeStream removes the stream of a trigger.
+func PurgeStream(ctx context.Context, rc *redis.Client, triggerID string) {
+	rc.Del(ctx, streamKey(triggerID))
+}
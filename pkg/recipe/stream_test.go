@@ -0,0 +1,42 @@
+package recipe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestReadStreamEvents(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	rc, mock := redismock.NewClientMock()
+	args := &redis.XReadArgs{Streams: []string{"pipeline_stream:trigger", "0"}, Block: time.Second}
+
+	mock.ExpectXRead(args).SetVal([]redis.XStream{{
+		Stream: "pipeline_stream:trigger",
+		Messages: []redis.XMessage{
+			{ID: "1-0", Values: map[string]any{"event": `{"type":"component","componentId":"llm"}`}},
+			{ID: "2-0", Values: map[string]any{"event": `{"type":"iteration","componentId":"iter","batchIndex":1,"completed":2,"total":4}`}},
+		},
+	}})
+	entries, err := ReadStreamEvents(ctx, rc, "trigger", "0", time.Second)
+	c.Assert(err, qt.IsNil)
+	c.Check(entries, qt.DeepEquals, []StreamEntry{
+		{ID: "1-0", Event: &StreamEvent{Type: StreamEventComponent, ComponentID: "llm"}},
+		{ID: "2-0", Event: &StreamEvent{Type: StreamEventIteration, ComponentID: "iter", BatchIndex: 1, Completed: 2, Total: 4}},
+	})
+
+	// No event was published in time.
+	mock.ExpectXRead(args).RedisNil()
+	entries, err = ReadStreamEvents(ctx, rc, "trigger", "0", time.Second)
+	c.Assert(err, qt.IsNil)
+	c.Check(entries, qt.HasLen, 0)
+
+	c.Check(mock.ExpectationsWereMet(), qt.IsNil)
+}
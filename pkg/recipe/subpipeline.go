@@ -0,0 +1,44 @@
+package recipe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxSubPipelineDepth is the maximum number of nested sub-pipeline calls in
+// a trigger.
+const MaxSubPipelineDepth = 5
+
+// PipelineReference identifies the pipeline, or the pipeline release, called
+// by a sub-pipeline component. It's written as
+// `namespace/pipeline[@release]`.
+type PipelineReference struct {
+	NamespaceID string
+	PipelineID  string
+	ReleaseID   string
+}
+
+func (r PipelineReference) String() string {
+	if r.ReleaseID == "" {
+		return r.NamespaceID + "/" + r.PipelineID
+	}
+	return r.NamespaceID + "/" + r.PipelineID + "@" + r.ReleaseID
+}
+
+// ParsePipelineReference parses the reference of a sub-pipeline component.
+func ParsePipelineReference(ref string) (PipelineReference, error) {
+	if strings.ContainsAny(ref, " \t\n${}") {
+		return PipelineReference{}, fmt.Errorf("invalid pipeline reference %q, expected namespace/pipeline[@release]", ref)
+	}
+
+	nsID, pipeline, ok := strings.Cut(ref, "/")
+	if !ok || nsID == "" || strings.Contains(pipeline, "/") {
+		return PipelineReference{}, fmt.Errorf("invalid pipeline reference %q, expected namespace/pipeline[@release]", ref)
+	}
+	pipelineID, releaseID, hasRelease := strings.Cut(pipeline, "@")
+	if pipelineID == "" || (hasRelease && (releaseID == "" || strings.Contains(releaseID, "@"))) {
+		return PipelineReference{}, fmt.Errorf("invalid pipeline reference %q, expected namespace/pipeline[@release]", ref)
+	}
+
+	return PipelineReference{NamespaceID: nsID, PipelineID: pipelineID, ReleaseID: releaseID}, nil
+}
@@ -0,0 +1,40 @@
+package recipe
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestParsePipelineReference(t *testing.T) {
+	c := qt.New(t)
+
+	testCases := []struct {
+		ref     string
+		want    PipelineReference
+		wantErr bool
+	}{
+		{ref: "acme/summarize", want: PipelineReference{NamespaceID: "acme", PipelineID: "summarize"}},
+		{ref: "acme/summarize@v1.0.0", want: PipelineReference{NamespaceID: "acme", PipelineID: "summarize", ReleaseID: "v1.0.0"}},
+		{ref: "summarize", wantErr: true},
+		{ref: "/summarize", wantErr: true},
+		{ref: "acme/", wantErr: true},
+		{ref: "acme/summarize@", wantErr: true},
+		{ref: "acme/sum/marize", wantErr: true},
+		{ref: "acme/summarize@v1@v2", wantErr: true},
+		{ref: "acme/${variable.pipeline}", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.ref, func(c *qt.C) {
+			got, err := ParsePipelineReference(tc.ref)
+			if tc.wantErr {
+				c.Check(err, qt.ErrorMatches, `invalid pipeline reference ".*", expected namespace/pipeline\[@release\]`)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Check(got, qt.Equals, tc.want)
+			c.Check(got.String(), qt.Equals, tc.ref)
+		})
+	}
+}
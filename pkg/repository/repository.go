@@ -81,6 +81,17 @@ type Repository interface {
 	DeletePipelineTags(ctx context.Context, pipelineUID uuid.UUID, tagNames []string) error
 	ListPipelineTags(ctx context.Context, pipelineUID uuid.UUID) ([]datamodel.Tag, error)
 
+	UpsertPipelineRun(ctx context.Context, run *datamodel.PipelineRun) error
+	UpdatePipelineRun(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) error
+	ClearExpiredPipelineRunData(ctx context.Context, limit int) (int64, error)
+	GetPipelineRunByTriggerID(ctx context.Context, pipelineTriggerID string) (*datamodel.PipelineRun, error)
+	ListPipelineRuns(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) ([]*datamodel.PipelineRun, int64, string, error)
+	ListNamespacePipelineRuns(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) ([]*datamodel.PipelineRun, int64, string, error)
+	UpsertComponentRuns(ctx context.Context, runs []*datamodel.ComponentRun) error
+	ListComponentRuns(ctx context.Context, pipelineTriggerIDs []string) ([]*datamodel.ComponentRun, error)
+	UpsertPipelineTriggerResult(ctx context.Context, result *datamodel.PipelineTriggerResult) error
+	GetPipelineTriggerResult(ctx context.Context, pipelineTriggerID string) (*datamodel.PipelineTriggerResult, error)
+
 	// TODO this function can remain unexported once connector and operator
 	// definition lists are removed.
 	TranspileFilter(filtering.Filter) (*clause.Expr, error)
@@ -984,3 +995,188 @@ func (r *repository) AddPipelineClones(ctx context.Context, pipelineUID uuid.UUI
 
 	return nil
 }
+
+// UpsertPipelineRun records the start of a pipeline run. A run that is
+// started again, e.g. when its workflow is retried, is reset.
+func (r *repository) UpsertPipelineRun(ctx context.Context, run *datamodel.PipelineRun) error {
+	db := r.db.WithContext(ctx)
+
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "pipeline_trigger_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "started_time", "completed_time", "total_duration", "error", "inputs", "outputs", "data_expire_time", "update_time"}),
+	}).Create(run).Error
+}
+
+// UpdatePipelineRun updates the non-zero fields of a pipeline run.
+func (r *repository) UpdatePipelineRun(ctx context.Context, pipelineTriggerID string, run *datamodel.PipelineRun) error {
+	db := r.db.WithContext(ctx)
+
+	result := db.Model(&datamodel.PipelineRun{}).
+		Where("pipeline_trigger_id = ?", pipelineTriggerID).
+		Updates(run)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoDataUpdated
+	}
+	return nil
+}
+
+// ClearExpiredPipelineRunData removes the inputs and outputs of at most limit
+// runs whose data expired. It returns the number of cleared runs.
+func (r *repository) ClearExpiredPipelineRunData(ctx context.Context, limit int) (int64, error) {
+	db := r.db.WithContext(ctx)
+
+	expired := db.Model(&datamodel.PipelineRun{}).
+		Select("uid").
+		Where("data_expire_time < ?", time.Now()).
+		Limit(limit)
+	result := db.Model(&datamodel.PipelineRun{}).
+		Where("uid IN (?)", expired).
+		Updates(map[string]any{"inputs": nil, "outputs": nil, "data_expire_time": nil})
+	return result.RowsAffected, result.Error
+}
+
+func (r *repository) GetPipelineRunByTriggerID(ctx context.Context, pipelineTriggerID string) (*datamodel.PipelineRun, error) {
+	db := r.db.WithContext(ctx)
+
+	run := &datamodel.PipelineRun{}
+	if result := db.Model(run).Where("pipeline_trigger_id = ?", pipelineTriggerID).First(run); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errdomain.ErrNotFound
+		}
+		return nil, result.Error
+	}
+	return run, nil
+}
+
+// ListPipelineRuns lists the runs of a pipeline, from the most recent.
+func (r *repository) ListPipelineRuns(ctx context.Context, pipelineUID uuid.UUID, pageSize int64, pageToken string, filter filtering.Filter) ([]*datamodel.PipelineRun, int64, string, error) {
+	return r.listPipelineRuns(ctx, "pipeline_uid = ?", pipelineUID, pageSize, pageToken, filter)
+}
+
+// ListNamespacePipelineRuns lists the runs of the pipelines of a namespace,
+// from the most recent.
+func (r *repository) ListNamespacePipelineRuns(ctx context.Context, ownerPermalink string, pageSize int64, pageToken string, filter filtering.Filter) ([]*datamodel.PipelineRun, int64, string, error) {
+	return r.listPipelineRuns(ctx, "owner = ?", ownerPermalink, pageSize, pageToken, filter)
+}
+
+func (r *repository) listPipelineRuns(ctx context.Context, where string, whereArg any, pageSize int64, pageToken string, filter filtering.Filter) (runs []*datamodel.PipelineRun, totalSize int64, nextPageToken string, err error) {
+	db := r.db.WithContext(ctx)
+
+	expr, err := r.TranspileFilter(filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	scope := func(db *gorm.DB) *gorm.DB {
+		db = db.Model(&datamodel.PipelineRun{}).Where(where, whereArg)
+		if expr != nil {
+			db = db.Where("(?)", expr)
+		}
+		return db
+	}
+
+	if result := db.Scopes(scope).Count(&totalSize); result.Error != nil {
+		return nil, 0, "", result.Error
+	}
+
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	} else if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	queryBuilder := db.Scopes(scope).Order("create_time DESC, uid DESC").Limit(int(pageSize))
+	if pageToken != "" {
+		createTime, uid, err := paginate.DecodeToken(pageToken)
+		if err != nil {
+			return nil, 0, "", newPageTokenErr(err)
+		}
+		queryBuilder = queryBuilder.Where("(create_time,uid) < (?::timestamp, ?)", createTime, uid)
+	}
+
+	if result := queryBuilder.Find(&runs); result.Error != nil {
+		return nil, 0, "", result.Error
+	}
+
+	if len(runs) > 0 {
+		last := runs[len(runs)-1]
+		lastItem := &datamodel.PipelineRun{}
+		if result := db.Scopes(scope).Order("create_time ASC, uid ASC").Limit(1).Find(lastItem); result.Error != nil {
+			return nil, 0, "", result.Error
+		}
+		if lastItem.UID != last.UID {
+			nextPageToken = paginate.EncodeToken(last.CreateTime, last.UID.String())
+		}
+	}
+
+	return runs, totalSize, nextPageToken, nil
+}
+
+// UpsertComponentRuns records the executions of a component. The execution of
+// a retried component replaces its previous attempt.
+func (r *repository) UpsertComponentRuns(ctx context.Context, runs []*datamodel.ComponentRun) error {
+	if len(runs) == 0 {
+		return nil
+	}
+
+	db := r.db.WithContext(ctx)
+
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "pipeline_trigger_id"}, {Name: "component_id"}, {Name: "batch_index"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "started_time", "completed_time", "total_duration", "attempts", "error_type", "error", "input_size", "output_size", "cache_hit", "update_time"}),
+	}).Create(&runs).Error
+}
+
+// ListComponentRuns lists the component executions of pipeline runs, in the
+// order they started.
+func (r *repository) ListComponentRuns(ctx context.Context, pipelineTriggerIDs []string) ([]*datamodel.ComponentRun, error) {
+	db := r.db.WithContext(ctx)
+
+	runs := []*datamodel.ComponentRun{}
+	if len(pipelineTriggerIDs) == 0 {
+		return runs, nil
+	}
+
+	if result := db.Model(&datamodel.ComponentRun{}).
+		Where("pipeline_trigger_id IN ?", pipelineTriggerIDs).
+		Order("started_time ASC, component_id ASC, batch_index ASC").
+		Find(&runs); result.Error != nil {
+		return nil, result.Error
+	}
+	return runs, nil
+}
+
+// UpsertPipelineTriggerResult stores the result of a trigger. The expired
+// results are removed at the same time.
+func (r *repository) UpsertPipelineTriggerResult(ctx context.Context, result *datamodel.PipelineTriggerResult) error {
+	db := r.db.WithContext(ctx)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if res := tx.Where("expire_time < ?", time.Now()).Delete(&datamodel.PipelineTriggerResult{}); res.Error != nil {
+			return res.Error
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "pipeline_trigger_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"outputs", "metadata", "expire_time", "update_time"}),
+		}).Create(result).Error
+	})
+}
+
+// GetPipelineTriggerResult returns the result of a trigger, unless it
+// expired.
+func (r *repository) GetPipelineTriggerResult(ctx context.Context, pipelineTriggerID string) (*datamodel.PipelineTriggerResult, error) {
+	db := r.db.WithContext(ctx)
+
+	result := &datamodel.PipelineTriggerResult{}
+	if res := db.Model(result).
+		Where("pipeline_trigger_id = ? AND expire_time > ?", pipelineTriggerID, time.Now()).
+		First(result); res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			return nil, errdomain.ErrNotFound
+		}
+		return nil, res.Error
+	}
+	return result, nil
+}
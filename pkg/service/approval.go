@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"go.temporal.io/api/serviceerror"
+
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+	"github.com/instill-ai/pipeline-backend/pkg/worker"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+func (s *service) ListNamespaceApprovals(ctx context.Context, ns resource.Namespace) ([]*recipe.ApprovalRequest, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, err
+	}
+
+	return recipe.ListApprovalRequests(ctx, s.redisClient, ns.NsUID)
+}
+
+func (s *service) GetNamespaceApprovalByID(ctx context.Context, ns resource.Namespace, id string) (*recipe.ApprovalRequest, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, err
+	}
+
+	req, ok, err := recipe.LoadApprovalRequest(ctx, s.redisClient, ns.NsUID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errdomain.ErrNotFound
+	}
+	return req, nil
+}
+
+// ReviewNamespaceApprovalByID approves or rejects a pending approval request.
+// When payload isn't nil, it replaces the reviewed input in the output of the
+// approval component.
+func (s *service) ReviewNamespaceApprovalByID(ctx context.Context, ns resource.Namespace, id string, approved bool, payload map[string]any, comment string) error {
+
+	req, err := s.GetNamespaceApprovalByID(ctx, ns, id)
+	if err != nil {
+		return err
+	}
+
+	decision := worker.ApprovalDecision{
+		BatchIndex:  req.BatchIndex,
+		Approved:    approved,
+		Payload:     payload,
+		ReviewerUID: uuid.FromStringOrNil(resource.GetRequestSingleHeader(ctx, constant.HeaderUserUIDKey)).String(),
+		Comment:     comment,
+	}
+	err = s.temporalClient.SignalWorkflow(ctx, req.WorkflowID, "", worker.ApprovalSignalName(req.ComponentID), decision)
+
+	// The request is removed from the pending list once it's reviewed or the
+	// trigger isn't waiting for it anymore. On any other error it's kept, so
+	// the review can be retried.
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return err
+	}
+	if delErr := recipe.DeleteApprovalRequest(ctx, s.redisClient, ns.NsUID, req.TriggerID, req.ID); delErr != nil {
+		return delErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: trigger isn't running", errdomain.ErrNotFound)
+	}
+	return nil
+}
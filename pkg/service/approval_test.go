@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frankban/quicktest"
+	"github.com/go-redis/redismock/v9"
+	"github.com/gofrs/uuid"
+	"github.com/gojuno/minimock/v3"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
+	"github.com/instill-ai/pipeline-backend/pkg/mock"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+func TestService_ReviewNamespaceApprovalByID(t *testing.T) {
+	c := quicktest.New(t)
+
+	nsUID := uuid.Must(uuid.NewV4())
+	ns := resource.Namespace{NsType: resource.User, NsUID: nsUID}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(constant.HeaderUserUIDKey, nsUID.String()))
+
+	req := &recipe.ApprovalRequest{
+		ID:          "approval",
+		TriggerID:   "trigger",
+		ComponentID: "review",
+		WorkflowID:  "trigger",
+	}
+	b, err := json.Marshal(req)
+	c.Assert(err, quicktest.IsNil)
+	key := fmt.Sprintf("pipeline_approval:%s:trigger:approval", nsUID)
+
+	testcases := []struct {
+		name      string
+		signalErr error
+		deleted   bool
+		wantErr   error
+	}{
+		{name: "ok", deleted: true},
+		{name: "trigger not running", signalErr: serviceerror.NewNotFound("workflow not found"), deleted: true, wantErr: errdomain.ErrNotFound},
+		{name: "signal error", signalErr: errors.New("connection refused")},
+	}
+
+	for _, tc := range testcases {
+		c.Run(tc.name, func(c *quicktest.C) {
+			mc := minimock.NewController(c)
+			temporalClient := mock.NewClientMock(mc)
+			temporalClient.SignalWorkflowMock.Return(tc.signalErr)
+
+			redisClient, redisMock := redismock.NewClientMock()
+			redisMock.ExpectScan(0, fmt.Sprintf("pipeline_approval:%s:*:approval", nsUID), 0).SetVal([]string{key}, 0)
+			redisMock.ExpectGet(key).SetVal(string(b))
+			if tc.deleted {
+				redisMock.ExpectDel(key).SetVal(1)
+			}
+
+			s := &service{redisClient: redisClient, temporalClient: temporalClient}
+			err := s.ReviewNamespaceApprovalByID(ctx, ns, "approval", true, nil, "")
+			switch {
+			case tc.wantErr != nil:
+				c.Check(errors.Is(err, tc.wantErr), quicktest.IsTrue)
+			case tc.signalErr != nil:
+				c.Check(err, quicktest.ErrorIs, tc.signalErr)
+			default:
+				c.Check(err, quicktest.IsNil)
+			}
+
+			// The request is kept when the review couldn't be delivered.
+			c.Check(redisMock.ExpectationsWereMet(), quicktest.IsNil)
+		})
+	}
+}
@@ -185,13 +185,8 @@ func (c *converter) includeComponentDetail(ctx context.Context, ownerPermalink s
 
 func (c *converter) includeIteratorComponentDetail(ctx context.Context, ownerPermalink string, comp *datamodel.Component, useDynamicDef bool) error {
 
-	for _, itComp := range comp.Component {
-		if itComp.Type != datamodel.Iterator {
-			err := c.includeComponentDetail(ctx, ownerPermalink, itComp, useDynamicDef)
-			if err != nil {
-				return err
-			}
-		}
+	if err := c.includeComponentsDetail(ctx, ownerPermalink, comp.Component, useDynamicDef); err != nil {
+		return err
 	}
 
 	dataOutput := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
@@ -199,108 +194,60 @@ func (c *converter) includeIteratorComponentDetail(ctx context.Context, ownerPer
 	dataOutput.Fields["properties"] = structpb.NewStructValue(&structpb.Struct{Fields: make(map[string]*structpb.Value)})
 
 	for k, v := range comp.OutputElements {
-		path := v
-		if strings.HasPrefix(path, "${") && strings.HasSuffix(path, "}") && strings.Count(path, "${") == 1 {
-			// Remove "${" and "}"
-			path = path[2:]
-			path = path[:len(path)-1]
-			path = strings.ReplaceAll(path, " ", "")
-
-			// Find upstream component
-			compID := strings.Split(path, ".")[0]
-			path = path[len(compID):]
-			upstreamCompID := ""
-			for id := range comp.Component {
-				if id == compID {
-					upstreamCompID = id
-				}
+		walk := nestedOutputSchema(comp.Component, v)
+		if walk == nil {
+			continue
+		}
+		s := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		s.Fields["type"] = structpb.NewStringValue("array")
+		if f := walk.GetStructValue().Fields["instillFormat"].GetStringValue(); f != "" {
+			// Limitation: console can not support more then three levels of array.
+			if strings.Count(f, "array:") < 2 {
+				s.Fields["instillFormat"] = structpb.NewStringValue("array:" + f)
 			}
-			if upstreamCompID != "" {
-				nestedComp := comp.Component[upstreamCompID]
-
-				var walk *structpb.Value
-				task := ""
-				input := &structpb.Struct{}
-				output := &structpb.Struct{}
-				if nestedComp.Type != datamodel.Iterator {
-					task = nestedComp.Task
-					if _, ok := nestedComp.Definition.Spec.DataSpecifications[task]; ok {
-						input = nestedComp.Definition.Spec.DataSpecifications[task].Input
-						output = nestedComp.Definition.Spec.DataSpecifications[task].Output
-					}
-				}
-				if task == "" {
-					// Skip schema generation if the task is not set.
-					continue
-				}
-				splits := strings.Split(path, ".")
-
-				if splits[1] == "output" {
-					walk = structpb.NewStructValue(output)
-				} else if splits[1] == "input" {
-					walk = structpb.NewStructValue(input)
-				} else {
-					// Skip schema generation if the configuration is not valid.
-					continue
-				}
-				path = path[len(splits[1])+1:]
-
-				success := true
+		}
+		s.Fields["items"] = structpb.NewStructValue(walk.GetStructValue())
+		dataOutput.Fields["properties"].GetStructValue().Fields[k] = structpb.NewStructValue(s)
+	}
 
-				// Traverse the schema of upstream component
-				for {
-					if len(path) == 0 {
-						break
-					}
+	if comp.ContinueOnError {
+		dataOutput.Fields["properties"].GetStructValue().Fields[datamodel.IteratorErrorsOutput] = structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"type":          structpb.NewStringValue("array"),
+			"title":         structpb.NewStringValue("Errors"),
+			"description":   structpb.NewStringValue("The error message of each element, or null if the element didn't fail."),
+			"instillFormat": structpb.NewStringValue("array:string"),
+			"items":         structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"type": structpb.NewStringValue("string")}}),
+		}})
+	}
 
-					splits := strings.Split(path, ".")
-					curr := splits[1]
+	comp.DataSpecification = &pb.DataSpecification{
+		Output: dataOutput,
+	}
 
-					if strings.Contains(curr, "[") && strings.Contains(curr, "]") {
-						target := strings.Split(curr, "[")[0]
-						if _, ok := walk.GetStructValue().Fields["properties"]; ok {
-							if _, ok := walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]; ok {
-								walk = walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target].GetStructValue().Fields["items"]
-							} else {
-								success = false
-								break
-							}
-						} else {
-							success = false
-							break
-						}
-					} else {
-						target := curr
+	return nil
+}
 
-						if _, ok := walk.GetStructValue().Fields["properties"]; ok {
-							if _, ok := walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]; ok {
-								walk = walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]
-							} else {
-								success = false
-								break
-							}
-						} else {
-							success = false
-							break
-						}
+func (c *converter) includeSwitchComponentDetail(ctx context.Context, ownerPermalink string, comp *datamodel.Component, useDynamicDef bool) error {
 
-					}
+	for _, sc := range comp.Cases {
+		if err := c.includeComponentsDetail(ctx, ownerPermalink, sc.Component, useDynamicDef); err != nil {
+			return err
+		}
+	}
 
-					path = path[len(curr)+1:]
-				}
-				if success {
-					s := &structpb.Struct{Fields: map[string]*structpb.Value{}}
-					s.Fields["type"] = structpb.NewStringValue("array")
-					if f := walk.GetStructValue().Fields["instillFormat"].GetStringValue(); f != "" {
-						// Limitation: console can not support more then three levels of array.
-						if strings.Count(f, "array:") < 2 {
-							s.Fields["instillFormat"] = structpb.NewStringValue("array:" + f)
-						}
-					}
-					s.Fields["items"] = structpb.NewStructValue(walk.GetStructValue())
-					dataOutput.Fields["properties"].GetStructValue().Fields[k] = structpb.NewStructValue(s)
-				}
+	dataOutput := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+	dataOutput.Fields["type"] = structpb.NewStringValue("object")
+	dataOutput.Fields["properties"] = structpb.NewStructValue(&structpb.Struct{Fields: make(map[string]*structpb.Value)})
 
+	// The cases define the same outputs, the schema of an output is taken
+	// from the first case where it can be resolved.
+	for _, sc := range comp.Cases {
+		for k, v := range sc.Output {
+			if _, ok := dataOutput.Fields["properties"].GetStructValue().Fields[k]; ok {
+				continue
+			}
+			if walk := nestedOutputSchema(sc.Component, v); walk != nil {
+				dataOutput.Fields["properties"].GetStructValue().Fields[k] = structpb.NewStructValue(walk.GetStructValue())
 			}
 		}
 	}
@@ -312,14 +259,119 @@ func (c *converter) includeIteratorComponentDetail(ctx context.Context, ownerPer
 	return nil
 }
 
-func (c *converter) includeDetailInRecipe(ctx context.Context, ownerPermalink string, recipe *datamodel.Recipe, useDynamicDef bool) error {
+// nestedOutputSchema returns the schema of the value referenced by an output
+// template of a group of nested components, or nil if the template isn't a
+// single reference to a nested component that can be resolved.
+func nestedOutputSchema(comps datamodel.ComponentMap, template string) *structpb.Value {
+	path := template
+	if !strings.HasPrefix(path, "${") || !strings.HasSuffix(path, "}") || strings.Count(path, "${") != 1 {
+		return nil
+	}
 
-	for _, comp := range recipe.Component {
-		var err error
-		if comp.Type != datamodel.Iterator {
-			err = c.includeComponentDetail(ctx, ownerPermalink, comp, useDynamicDef)
+	// Remove "${" and "}"
+	path = path[2:]
+	path = path[:len(path)-1]
+	path = strings.ReplaceAll(path, " ", "")
+
+	// Find upstream component
+	compID := strings.Split(path, ".")[0]
+	path = path[len(compID):]
+	nestedComp, ok := comps[compID]
+	if !ok {
+		return nil
+	}
+
+	var walk *structpb.Value
+	task := ""
+	input := &structpb.Struct{}
+	output := &structpb.Struct{}
+	switch nestedComp.Type {
+	case datamodel.Iterator, datamodel.Switch:
+		if nestedComp.DataSpecification != nil {
+			output = nestedComp.DataSpecification.Output
+		}
+	case datamodel.SubPipeline, datamodel.Approval:
+		// These components have no definition to read the schema from.
+		return nil
+	default:
+		task = nestedComp.Task
+		if _, ok := nestedComp.Definition.Spec.DataSpecifications[task]; ok {
+			input = nestedComp.Definition.Spec.DataSpecifications[task].Input
+			output = nestedComp.Definition.Spec.DataSpecifications[task].Output
+		}
+		if task == "" {
+			// Skip schema generation if the task is not set.
+			return nil
+		}
+	}
+	splits := strings.Split(path, ".")
+
+	if splits[1] == "output" {
+		walk = structpb.NewStructValue(output)
+	} else if splits[1] == "input" {
+		walk = structpb.NewStructValue(input)
+	} else {
+		// Skip schema generation if the configuration is not valid.
+		return nil
+	}
+	path = path[len(splits[1])+1:]
+
+	// Traverse the schema of upstream component
+	for {
+		if len(path) == 0 {
+			break
+		}
+
+		splits := strings.Split(path, ".")
+		curr := splits[1]
+
+		if strings.Contains(curr, "[") && strings.Contains(curr, "]") {
+			target := strings.Split(curr, "[")[0]
+			if _, ok := walk.GetStructValue().Fields["properties"]; ok {
+				if _, ok := walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]; ok {
+					walk = walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target].GetStructValue().Fields["items"]
+				} else {
+					return nil
+				}
+			} else {
+				return nil
+			}
 		} else {
+			target := curr
+
+			if _, ok := walk.GetStructValue().Fields["properties"]; ok {
+				if _, ok := walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]; ok {
+					walk = walk.GetStructValue().Fields["properties"].GetStructValue().Fields[target]
+				} else {
+					return nil
+				}
+			} else {
+				return nil
+			}
+
+		}
+
+		path = path[len(curr)+1:]
+	}
+	return walk
+}
+
+// includeComponentsDetail includes the definitions of a group of components,
+// and the data specification of its iterators and switches.
+func (c *converter) includeComponentsDetail(ctx context.Context, ownerPermalink string, comps datamodel.ComponentMap, useDynamicDef bool) error {
+
+	for _, comp := range comps {
+		var err error
+		switch comp.Type {
+		case datamodel.Iterator:
 			err = c.includeIteratorComponentDetail(ctx, ownerPermalink, comp, useDynamicDef)
+		case datamodel.Switch:
+			err = c.includeSwitchComponentDetail(ctx, ownerPermalink, comp, useDynamicDef)
+		case datamodel.SubPipeline:
+			// The callee is only resolved when the pipeline is triggered.
+		case datamodel.Approval:
+		default:
+			err = c.includeComponentDetail(ctx, ownerPermalink, comp, useDynamicDef)
 		}
 		if err != nil {
 			return err
@@ -328,6 +380,10 @@ func (c *converter) includeDetailInRecipe(ctx context.Context, ownerPermalink st
 	return nil
 }
 
+func (c *converter) includeDetailInRecipe(ctx context.Context, ownerPermalink string, recipe *datamodel.Recipe, useDynamicDef bool) error {
+	return c.includeComponentsDetail(ctx, ownerPermalink, recipe.Component, useDynamicDef)
+}
+
 // ConvertPipelineToDB converts protobuf data model to db data model
 func (c *converter) ConvertPipelineToDB(ctx context.Context, ns resource.Namespace, pbPipeline *pb.Pipeline) (*datamodel.Pipeline, error) {
 	logger, _ := logger.GetZapLogger(ctx)
@@ -809,16 +865,7 @@ func (c *converter) generatePipelineDataSpec(variables map[string]*datamodel.Var
 	success := true
 	pipelineDataSpec := &pb.DataSpecification{}
 
-	dataInput := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
-	dataInput.Fields["type"] = structpb.NewStringValue("object")
-	dataInput.Fields["properties"] = structpb.NewStructValue(&structpb.Struct{Fields: make(map[string]*structpb.Value)})
-
-	for k, v := range variables {
-		b, _ := json.Marshal(v)
-		p := &structpb.Struct{}
-		_ = protojson.Unmarshal(b, p)
-		dataInput.Fields["properties"].GetStructValue().Fields[k] = structpb.NewStructValue(p)
-	}
+	dataInput := variablesSchema(variables)
 
 	// output
 	dataOutput := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
@@ -853,7 +900,7 @@ func (c *converter) generatePipelineDataSpec(variables map[string]*datamodel.Var
 					comp := compsOrigin[upstreamCompID]
 
 					switch comp.Type {
-					case datamodel.Iterator:
+					case datamodel.Iterator, datamodel.Switch:
 
 						splits := strings.Split(str, ".")
 						if splits[1] == "output" {
@@ -862,6 +909,15 @@ func (c *converter) generatePipelineDataSpec(variables map[string]*datamodel.Var
 							return nil, fmt.Errorf("generate pipeline data spec error")
 						}
 						str = str[len(splits[1])+1:]
+					case datamodel.SubPipeline, datamodel.Approval:
+						// The data specification of the callee and of the
+						// reviewed payload isn't part of the recipe.
+						splits := strings.Split(str, ".")
+						if splits[1] != "output" && splits[1] != "input" {
+							return nil, fmt.Errorf("generate pipeline data spec error")
+						}
+						walk = structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{}})
+						str = str[len(splits[1])+1:]
 					default:
 						task := ""
 						input := &structpb.Struct{}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/instill-ai/pipeline-backend/pkg/acl"
 	"github.com/instill-ai/pipeline-backend/pkg/logger"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
 	"github.com/instill-ai/pipeline-backend/pkg/repository"
 	"github.com/instill-ai/pipeline-backend/pkg/resource"
 
@@ -35,6 +36,7 @@ type Service interface {
 	ValidateNamespacePipelineByID(ctx context.Context, ns resource.Namespace, id string) ([]*pb.PipelineValidationError, error)
 	GetNamespacePipelineLatestReleaseUID(ctx context.Context, ns resource.Namespace, id string) (uuid.UUID, error)
 	CloneNamespacePipeline(ctx context.Context, ns resource.Namespace, id string, target string, description string, sharing *pb.Sharing) (*pb.Pipeline, error)
+	PurgeNamespacePipelineCacheByID(ctx context.Context, ns resource.Namespace, id string) error
 
 	ListPipelinesAdmin(ctx context.Context, pageSize int32, pageToken string, view pb.Pipeline_View, filter filtering.Filter, showDeleted bool) ([]*pb.Pipeline, int32, string, error)
 	GetPipelineByUIDAdmin(ctx context.Context, uid uuid.UUID, view pb.Pipeline_View) (*pb.Pipeline, error)
@@ -54,6 +56,16 @@ type Service interface {
 	UpdateNamespaceSecretByID(ctx context.Context, ns resource.Namespace, id string, updatedSecret *pb.Secret) (*pb.Secret, error)
 	DeleteNamespaceSecretByID(ctx context.Context, ns resource.Namespace, id string) error
 
+	ListNamespaceApprovals(ctx context.Context, ns resource.Namespace) ([]*recipe.ApprovalRequest, error)
+	GetNamespaceApprovalByID(ctx context.Context, ns resource.Namespace, id string) (*recipe.ApprovalRequest, error)
+	ReviewNamespaceApprovalByID(ctx context.Context, ns resource.Namespace, id string, approved bool, payload map[string]any, comment string) error
+
+	ListNamespacePipelineRuns(ctx context.Context, ns resource.Namespace, pipelineID string, pageSize int32, pageToken string, filter string) ([]*PipelineRun, int32, string, error)
+	ListNamespaceRuns(ctx context.Context, ns resource.Namespace, pageSize int32, pageToken string, filter string) ([]*PipelineRun, int32, string, error)
+	GetNamespaceRunByID(ctx context.Context, ns resource.Namespace, pipelineTriggerID string) (*PipelineRun, error)
+	ReplayNamespaceRun(ctx context.Context, ns resource.Namespace, pipelineTriggerID string, releaseID string, returnTraces bool) (*longrunningpb.Operation, error)
+	ResumeNamespaceRun(ctx context.Context, ns resource.Namespace, pipelineTriggerID string, returnTraces bool) (*longrunningpb.Operation, error)
+
 	TriggerNamespacePipelineByID(ctx context.Context, ns resource.Namespace, id string, data []*pb.TriggerData, pipelineTriggerID string, returnTraces bool) ([]*structpb.Struct, *pb.TriggerMetadata, error)
 	TriggerNamespacePipelineByIDWithStream(ctx context.Context, ns resource.Namespace, id string, data []*pb.TriggerData, pipelineTriggerID string, returnTraces bool, stream chan<- TriggerResult) error
 	TriggerAsyncNamespacePipelineByID(ctx context.Context, ns resource.Namespace, id string, data []*pb.TriggerData, pipelineTriggerID string, returnTraces bool) (*longrunningpb.Operation, error)
@@ -64,6 +76,7 @@ type Service interface {
 	TriggerNamespacePipelineReleaseByID(ctx context.Context, ns resource.Namespace, pipelineUID uuid.UUID, id string, data []*pb.TriggerData, pipelineTriggerID string, returnTraces bool) ([]*structpb.Struct, *pb.TriggerMetadata, error)
 	TriggerAsyncNamespacePipelineReleaseByID(ctx context.Context, ns resource.Namespace, pipelineUID uuid.UUID, id string, data []*pb.TriggerData, pipelineTriggerID string, returnTraces bool) (*longrunningpb.Operation, error)
 	GetOperation(ctx context.Context, workflowID string) (*longrunningpb.Operation, error)
+	CancelOperation(ctx context.Context, workflowID string) error
 
 	GetCtxUserNamespace(ctx context.Context) (resource.Namespace, error)
 	GetRscNamespace(ctx context.Context, namespaceID string) (resource.Namespace, error)
@@ -15,13 +15,17 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/gofrs/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 	"go.einride.tech/aip/filtering"
 	"go.einride.tech/aip/ordering"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
+	temporalconverter "go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/temporal"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -153,6 +157,9 @@ func (s *service) CreateNamespacePipeline(ctx context.Context, ns resource.Names
 	if err := s.checkSecret(ctx, dbPipeline.Recipe.Component); err != nil {
 		return nil, err
 	}
+	if err := s.checkRecipeOnSave(dbPipeline.Recipe); err != nil {
+		return nil, err
+	}
 
 	dbPipeline.ShareCode = generateShareCode()
 	if err := s.setSchedulePipeline(ctx, ns, dbPipeline); err != nil {
@@ -348,6 +355,9 @@ func (s *service) UpdateNamespacePipelineByID(ctx context.Context, ns resource.N
 	if err := s.checkSecret(ctx, dbPipeline.Recipe.Component); err != nil {
 		return nil, err
 	}
+	if err := s.checkRecipeOnSave(dbPipeline.Recipe); err != nil {
+		return nil, err
+	}
 
 	if granted, err := s.aclClient.CheckPermission(ctx, "pipeline", dbPipeline.UID, "reader"); err != nil {
 		return nil, err
@@ -436,6 +446,29 @@ func (s *service) UpdateNamespacePipelineByID(ctx context.Context, ns resource.N
 	return pipeline, nil
 }
 
+// PurgeNamespacePipelineCacheByID removes the cached component outputs of a
+// pipeline and its releases.
+func (s *service) PurgeNamespacePipelineCacheByID(ctx context.Context, ns resource.Namespace, id string) error {
+	dbPipeline, err := s.repository.GetNamespacePipelineByID(ctx, ns.Permalink(), id, false, true)
+	if err != nil {
+		return errdomain.ErrNotFound
+	}
+
+	if granted, err := s.aclClient.CheckPermission(ctx, "pipeline", dbPipeline.UID, "reader"); err != nil {
+		return err
+	} else if !granted {
+		return errdomain.ErrNotFound
+	}
+
+	if granted, err := s.aclClient.CheckPermission(ctx, "pipeline", dbPipeline.UID, "admin"); err != nil {
+		return err
+	} else if !granted {
+		return errdomain.ErrUnauthorized
+	}
+
+	return recipe.PurgeCache(ctx, s.redisClient, dbPipeline.UID)
+}
+
 func (s *service) DeleteNamespacePipelineByID(ctx context.Context, ns resource.Namespace, id string) error {
 	ownerPermalink := ns.Permalink()
 
@@ -648,16 +681,15 @@ func (s *service) preTriggerPipeline(ctx context.Context, isAdmin bool, ns resou
 		return nil, ErrExceedMaxBatchSize
 	}
 
+	if err := s.resolveSubPipelines(ctx, r.Component, nil); err != nil {
+		return nil, err
+	}
+
 	var metadata []byte
 
 	instillFormatMap := map[string]string{}
 
-	schStruct := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
-	schStruct.Fields["type"] = structpb.NewStringValue("object")
-	b, _ := json.Marshal(r.Variable)
-	properties := &structpb.Struct{}
-	_ = protojson.Unmarshal(b, properties)
-	schStruct.Fields["properties"] = structpb.NewStructValue(properties)
+	schStruct := variablesSchema(r.Variable)
 	for k, v := range r.Variable {
 		instillFormatMap[k] = v.InstillFormat
 	}
@@ -692,6 +724,28 @@ func (s *service) preTriggerPipeline(ctx context.Context, isAdmin bool, ns resou
 
 	for idx, data := range pipelineData {
 		vars := data.Variable
+		if vars == nil {
+			vars = &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+			data.Variable = vars
+		}
+
+		// Defaults are filled in before validation so they're checked against
+		// the same constraints as the provided values.
+		for k, v := range r.Variable {
+			if v.Default == nil {
+				continue
+			}
+			if _, ok := vars.Fields[k]; ok {
+				continue
+			}
+			val, err := structpb.NewValue(v.Default)
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("inputs/%d/%s: invalid default value", idx, k))
+				continue
+			}
+			vars.Fields[k] = val
+		}
+
 		b, err := protojson.Marshal(vars)
 		if err != nil {
 			errors = append(errors, fmt.Sprintf("inputs[%d]: data error", idx))
@@ -1075,6 +1129,7 @@ func (s *service) triggerPipeline(
 		RetryPolicy: &temporal.RetryPolicy{
 			MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
 		},
+		Memo: map[string]any{worker.MemoPipelineUIDKey: pipelineUID.String()},
 	}
 
 	userUID := uuid.FromStringOrNil(resource.GetRequestSingleHeader(ctx, constant.HeaderUserUIDKey))
@@ -1083,6 +1138,11 @@ func (s *service) triggerPipeline(
 		requesterUID = userUID
 	}
 
+	dryRun, dryRunMocks, err := dryRunParams(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	we, err := s.temporalClient.ExecuteWorkflow(
 		ctx,
 		workflowOptions,
@@ -1103,7 +1163,11 @@ func (s *service) triggerPipeline(
 				PipelineRequesterUID: requesterUID,
 				HeaderAuthorization:  resource.GetRequestSingleHeader(ctx, "authorization"),
 			},
-			Mode: mgmtpb.Mode_MODE_SYNC,
+			Mode:              mgmtpb.Mode_MODE_SYNC,
+			IsolateBatchItems: resource.GetRequestSingleHeader(ctx, constant.HeaderIsolateBatchItemsKey) == "true",
+			DryRun:            dryRun,
+			DryRunMocks:       dryRunMocks,
+			SourceTriggerID:   sourceTriggerID(ctx),
 		})
 	if err != nil {
 		logger.Error(fmt.Sprintf("unable to execute workflow: %s", err.Error()))
@@ -1157,6 +1221,7 @@ func (s *service) triggerPipelineWithStream(
 		RetryPolicy: &temporal.RetryPolicy{
 			MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
 		},
+		Memo: map[string]any{worker.MemoPipelineUIDKey: pipelineUID.String()},
 	}
 
 	userUID := uuid.FromStringOrNil(resource.GetRequestSingleHeader(ctx, constant.HeaderUserUIDKey))
@@ -1165,6 +1230,11 @@ func (s *service) triggerPipelineWithStream(
 		requesterUID = userUID
 	}
 
+	dryRun, dryRunMocks, err := dryRunParams(ctx)
+	if err != nil {
+		return err
+	}
+
 	we, err := s.temporalClient.ExecuteWorkflow(
 		ctx,
 		workflowOptions,
@@ -1185,81 +1255,156 @@ func (s *service) triggerPipelineWithStream(
 				PipelineRequesterUID: requesterUID,
 				HeaderAuthorization:  resource.GetRequestSingleHeader(ctx, "authorization"),
 			},
-			IsStreaming: true,
-			Mode:        mgmtpb.Mode_MODE_SYNC,
+			IsStreaming:       true,
+			Mode:              mgmtpb.Mode_MODE_SYNC,
+			IsolateBatchItems: resource.GetRequestSingleHeader(ctx, constant.HeaderIsolateBatchItemsKey) == "true",
+			DryRun:            dryRun,
+			DryRunMocks:       dryRunMocks,
+			SourceTriggerID:   sourceTriggerID(ctx),
 		})
 	if err != nil {
 		logger.Error(fmt.Sprintf("unable to execute workflow: %s", err.Error()))
 		return err
 	}
 
+	// The workflow publishes its progress in the stream of the trigger. The
+	// stream is read until the workflow is done, then drained.
+	defer recipe.PurgeStream(ctx, s.redisClient, pipelineTriggerID)
+	done := make(chan error, 1)
 	go func() {
-		const interval = 1 * time.Millisecond
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-
-		ctxQ, cancel := context.WithTimeout(context.Background(), time.Second*60)
-		defer cancel()
+		done <- we.Get(ctx, nil)
+	}()
 
-		for { // nolint:gosimple
+	lastID := "0"
+	var wfErr error
+	finished := false
+	readFailures := 0
+	for {
+		block := streamReadTimeout
+		if !finished {
 			select {
-			case <-ticker.C:
-				queryResult, err := s.temporalClient.QueryWorkflow(ctxQ, we.GetID(), we.GetRunID(), "workflowStatusQuery")
-				if err != nil {
-					logger.Error("Error querying workflow status: %v", zap.Error(err))
-					return
-				}
-
-				var status worker.WorkFlowSignal
-				if err := queryResult.Get(&status); err != nil {
-					logger.Error("Error querying workflow Get status: %v", zap.Error(err))
-					return
-				}
+			case wfErr = <-done:
+				finished = true
+			default:
+			}
+		}
+		if finished {
+			block = -1
+		}
 
-				const statusCompleted = "completed" // signals that the workflow has completed
-				const statusStep = "step"           // signals that a component has completed, but does not specify which
-				switch status.Status {
-				case statusStep:
-					path := status.ID
-					data, metadata, err := s.getOutputsAndMetadataStream(ctx, pipelineTriggerID, r, returnTraces, path)
-					if err != nil {
-						logger.Error("could not get outputs and metadata", zap.Error(err))
-						continue
-					}
+		entries, err := recipe.ReadStreamEvents(ctx, s.redisClient, pipelineTriggerID, lastID, block)
+		if err != nil {
+			// The read is retried after a pause, until it keeps failing.
+			readFailures++
+			if readFailures >= maxStreamReadFailures {
+				return fmt.Errorf("reading trigger stream: %w", err)
+			}
+			logger.Warn("could not read the trigger stream", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(streamReadTimeout):
+			}
+			continue
+		}
+		readFailures = 0
 
-					if len(data) < 1 {
-						logger.Error("no data found to send to stream", zap.String("path", path))
-						continue
-					}
+		for _, entry := range entries {
+			lastID = entry.ID
+			result, err := s.streamResult(ctx, pipelineTriggerID, r, returnTraces, len(pipelineData), entry.Event)
+			if err != nil {
+				logger.Error("could not get outputs and metadata", zap.Error(err))
+				continue
+			}
+			if result != nil {
+				stream <- *result
+			}
+		}
 
-					stream <- TriggerResult{
-						Struct:   data,
-						Metadata: metadata,
-					}
-				case statusCompleted:
-					close(stream)
-					return
-				}
+		if !finished {
+			continue
+		}
+		if wfErr != nil {
+			// Note: We categorize all pipeline trigger errors as ErrTriggerFail
+			// and mark the code as 400 InvalidArgument for now.
+			// We should further categorize them into InvalidArgument or
+			// PreconditionFailed or InternalError in the future.
+			err = fmt.Errorf("%w:%w", ErrTriggerFail, wfErr)
+
+			var applicationErr *temporal.ApplicationError
+			if errors.As(err, &applicationErr) && applicationErr.Message() != "" {
+				err = errmsg.AddMessage(err, applicationErr.Message())
 			}
+
+			return err
 		}
-	}()
+		close(stream)
+		return nil
+	}
+}
 
-	if err := we.Get(ctx, nil); err != nil {
-		// Note: We categorize all pipeline trigger errors as ErrTriggerFail
-		// and mark the code as 400 InvalidArgument for now.
-		// We should further categorize them into InvalidArgument or
-		// PreconditionFailed or InternalError in the future.
-		err = fmt.Errorf("%w:%w", ErrTriggerFail, err)
+const (
+	// streamReadTimeout bounds the wait for a stream event, so the end of
+	// the workflow is noticed.
+	streamReadTimeout = 200 * time.Millisecond
+	// maxStreamReadFailures is the number of consecutive failed reads of the
+	// trigger stream after which the streaming is stopped.
+	maxStreamReadFailures = 5
+)
 
-		var applicationErr *temporal.ApplicationError
-		if errors.As(err, &applicationErr) && applicationErr.Message() != "" {
-			err = errmsg.AddMessage(err, applicationErr.Message())
+// streamResult builds the streamed result of a trigger event: the pipeline
+// outputs that reference a completed component, or the progress of an
+// iterator.
+func (s *service) streamResult(ctx context.Context, pipelineTriggerID string, r *datamodel.Recipe, returnTraces bool, batchSize int, ev *recipe.StreamEvent) (*TriggerResult, error) {
+	switch ev.Type {
+	case recipe.StreamEventComponent:
+		data, metadata, err := s.getOutputsAndMetadataStream(ctx, pipelineTriggerID, r, returnTraces, ev.ComponentID)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) < 1 {
+			return nil, fmt.Errorf("no data found to send to stream for component %s", ev.ComponentID)
+		}
+		return &TriggerResult{Struct: data, Metadata: metadata}, nil
+
+	case recipe.StreamEventIteration:
+		// The iterations don't produce pipeline outputs. Their progress is
+		// reported in the trace of the iterator, under the `progress` output
+		// of the batch item.
+		data := make([]*structpb.Struct, batchSize)
+		trace := &pipelinepb.Trace{
+			Statuses: make([]pipelinepb.Trace_Status, batchSize),
+			Outputs:  make([]*structpb.Struct, batchSize),
+		}
+		for idx := range batchSize {
+			data[idx] = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+			trace.Outputs[idx] = &structpb.Struct{}
+		}
+		if ev.BatchIndex < batchSize {
+			progress, err := structpb.NewStruct(map[string]any{
+				"progress": map[string]any{
+					"completed": ev.Completed,
+					"total":     ev.Total,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			trace.Outputs[ev.BatchIndex] = progress
+			if ev.Completed == ev.Total {
+				trace.Statuses[ev.BatchIndex] = pipelinepb.Trace_STATUS_COMPLETED
+			}
 		}
 
-		return err
+		result := &TriggerResult{Struct: data}
+		if returnTraces {
+			result.Metadata = &pipelinepb.TriggerMetadata{
+				Traces: map[string]*pipelinepb.Trace{ev.ComponentID: trace},
+			}
+		}
+		return result, nil
 	}
-
-	return nil
+	return nil, nil
 }
 
 func (s *service) triggerAsyncPipeline(
@@ -1280,6 +1425,15 @@ func (s *service) triggerAsyncPipeline(
 		return nil, err
 	}
 
+	// A resumed trigger starts with the memory of the components that
+	// completed in the failed run.
+	source := getTriggerSource(ctx)
+	if len(source.completedComponents) > 0 {
+		if err := recipe.CopyComponentMemory(ctx, s.redisClient, recipe.RetainedTriggerID(source.pipelineTriggerID), pipelineTriggerID, source.completedComponents); err != nil {
+			return nil, err
+		}
+	}
+
 	logger, _ := logger.GetZapLogger(ctx)
 
 	workflowOptions := client.StartWorkflowOptions{
@@ -1289,6 +1443,7 @@ func (s *service) triggerAsyncPipeline(
 		RetryPolicy: &temporal.RetryPolicy{
 			MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
 		},
+		Memo: map[string]any{worker.MemoPipelineUIDKey: pipelineUID.String()},
 	}
 
 	userUID := uuid.FromStringOrNil(resource.GetRequestSingleHeader(ctx, constant.HeaderUserUIDKey))
@@ -1297,6 +1452,11 @@ func (s *service) triggerAsyncPipeline(
 		requesterUID = userUID
 	}
 
+	dryRun, dryRunMocks, err := dryRunParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	we, err := s.temporalClient.ExecuteWorkflow(
 		ctx,
 		workflowOptions,
@@ -1317,7 +1477,13 @@ func (s *service) triggerAsyncPipeline(
 				PipelineRequesterUID: requesterUID,
 				HeaderAuthorization:  resource.GetRequestSingleHeader(ctx, "authorization"),
 			},
-			Mode: mgmtpb.Mode_MODE_ASYNC,
+			Mode:              mgmtpb.Mode_MODE_ASYNC,
+			IsolateBatchItems: resource.GetRequestSingleHeader(ctx, constant.HeaderIsolateBatchItemsKey) == "true",
+			DryRun:            dryRun,
+			DryRunMocks:       dryRunMocks,
+			SourceTriggerID:   source.pipelineTriggerID,
+
+			CompletedComponents: source.completedComponents,
 		})
 	if err != nil {
 		logger.Error(fmt.Sprintf("unable to execute workflow: %s", err.Error()))
@@ -1334,42 +1500,26 @@ func (s *service) triggerAsyncPipeline(
 }
 
 func (s *service) getOutputsAndMetadata(ctx context.Context, pipelineTriggerID string, r *datamodel.Recipe, returnTraces bool) ([]*structpb.Struct, *pipelinepb.TriggerMetadata, error) {
+	return recipe.GenerateOutputs(ctx, s.redisClient, pipelineTriggerID, r, returnTraces)
+}
 
-	memory, err := recipe.LoadMemoryByTriggerID(ctx, s.redisClient, pipelineTriggerID)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	pipelineOutputs := make([]*structpb.Struct, len(memory))
-
-	for idx := range memory {
-		pipelineOutput := &structpb.Struct{Fields: map[string]*structpb.Value{}}
-		for k, v := range r.Output {
-			o, err := recipe.RenderInput(v.Value, idx, memory[idx])
-			if err != nil {
-				return nil, nil, err
-			}
-			structVal, err := structpb.NewValue(o)
-			if err != nil {
-				return nil, nil, err
-			}
-			pipelineOutput.Fields[k] = structVal
-
-		}
-		pipelineOutputs[idx] = pipelineOutput
+// dryRunParams reads the dry-run headers of a trigger request. The mocked
+// outputs are a JSON object keyed by component ID.
+func dryRunParams(ctx context.Context) (bool, map[string]map[string]any, error) {
+	if resource.GetRequestSingleHeader(ctx, constant.HeaderDryRunKey) != "true" {
+		return false, nil, nil
 	}
 
-	var metadata *pipelinepb.TriggerMetadata
-	if returnTraces {
-		traces, err := recipe.GenerateTraces(r.Component, memory)
-		if err != nil {
-			return nil, nil, err
-		}
-		metadata = &pipelinepb.TriggerMetadata{
-			Traces: traces,
+	mocks := map[string]map[string]any{}
+	if h := resource.GetRequestSingleHeader(ctx, constant.HeaderDryRunMockKey); h != "" {
+		if err := json.Unmarshal([]byte(h), &mocks); err != nil {
+			return false, nil, errmsg.AddMessage(
+				fmt.Errorf("%w: invalid dry-run mock: %s", errdomain.ErrInvalidArgument, err),
+				fmt.Sprintf("The %s header must be a JSON object with the mocked output of each component.", constant.HeaderDryRunMockKey),
+			)
 		}
 	}
-	return pipelineOutputs, metadata, nil
+	return true, mocks, nil
 }
 
 func (s *service) getOutputsAndMetadataStream(ctx context.Context, pipelineTriggerID string, r *datamodel.Recipe, returnTraces bool, path string) ([]*structpb.Struct, *pipelinepb.TriggerMetadata, error) {
@@ -1388,20 +1538,11 @@ func (s *service) getOutputsAndMetadataStream(ctx context.Context, pipelineTrigg
 		pipelineOutput := &structpb.Struct{Fields: map[string]*structpb.Value{}}
 
 		for k, v := range r.Output {
-			input := v.Value[2:]
-			input = input[:len(input)-1]
-			input = strings.TrimSpace(input)
-
-			var val any
-			if strings.Contains(input, path) {
-				if input == recipe.SegSecret+"."+constant.GlobalSecretKey {
-					val = componentbase.SecretKeyword
-				} else {
-					val, err = recipe.TraverseBinding(mem, input)
-					if err != nil {
-						// If the path is not found, we should continue to the next output
-						continue
-					}
+			if slices.Contains(recipe.FindReferenceParent(v.Value), path) {
+				val, err := recipe.RenderInput(v.Value, idx, mem)
+				if err != nil {
+					// If the path is not found, we should continue to the next output
+					continue
 				}
 
 				structVal, err := structpb.NewValue(val)
@@ -1701,6 +1842,88 @@ func (s *service) GetOperation(ctx context.Context, workflowID string) (*longrun
 	return s.getOperationFromWorkflowInfo(ctx, workflowExecutionRes.WorkflowExecutionInfo)
 }
 
+// CancelOperation cancels a running pipeline trigger. The workflow stops its
+// components and child workflows, and purges the trigger memory.
+func (s *service) CancelOperation(ctx context.Context, workflowID string) error {
+	workflowExecutionRes, err := s.temporalClient.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return errdomain.ErrNotFound
+		}
+		return err
+	}
+	info := workflowExecutionRes.WorkflowExecutionInfo
+
+	// The triggers started before the pipeline UID was recorded in the
+	// workflow memo can't be cancelled.
+	payload, ok := info.GetMemo().GetFields()[worker.MemoPipelineUIDKey]
+	if !ok {
+		return errdomain.ErrNotFound
+	}
+	var pipelineUID string
+	if err := temporalconverter.GetDefaultDataConverter().FromPayload(payload, &pipelineUID); err != nil {
+		return err
+	}
+	dbPipeline, err := s.repository.GetPipelineByUID(ctx, uuid.FromStringOrNil(pipelineUID), true, false)
+	if err != nil {
+		return errdomain.ErrNotFound
+	}
+	if _, err := s.checkTriggerPermission(ctx, dbPipeline); err != nil {
+		return err
+	}
+
+	if info.Status != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return errmsg.AddMessage(
+			fmt.Errorf("%w: operation isn't running", errdomain.ErrInvalidArgument),
+			"The operation is already done.",
+		)
+	}
+
+	return s.temporalClient.CancelWorkflow(ctx, workflowID, "")
+}
+
+// getTriggerResult returns the owner, the outputs and the metadata of a
+// completed asynchronous trigger. They're read from the stored result or, if
+// the result wasn't stored, from the trigger memory.
+func (s *service) getTriggerResult(ctx context.Context, pipelineTriggerID string) (string, []*structpb.Struct, *pipelinepb.TriggerMetadata, error) {
+	result, err := s.repository.GetPipelineTriggerResult(ctx, pipelineTriggerID)
+	if err == nil {
+		var outputs []*structpb.Struct
+		if err := json.Unmarshal(result.Outputs, &outputs); err != nil {
+			return "", nil, nil, err
+		}
+		var metadata *pipelinepb.TriggerMetadata
+		if len(result.Metadata) > 0 {
+			metadata = &pipelinepb.TriggerMetadata{}
+			if err := protojson.Unmarshal(result.Metadata, metadata); err != nil {
+				return "", nil, nil, err
+			}
+		}
+		return result.Owner, outputs, metadata, nil
+	}
+	if !errors.Is(err, errdomain.ErrNotFound) {
+		return "", nil, nil, err
+	}
+
+	ownerPermalink := recipe.LoadOwnerPermalink(ctx, s.redisClient, pipelineTriggerID)
+	r, err := recipe.LoadRecipe(ctx, s.redisClient, fmt.Sprintf("%s:%s", pipelineTriggerID, recipe.SegRecipe))
+	if errors.Is(err, redis.Nil) {
+		return "", nil, nil, errmsg.AddMessage(
+			fmt.Errorf("%w: trigger result expired", errdomain.ErrNotFound),
+			"The result of the operation is no longer retained.",
+		)
+	}
+	if err != nil {
+		return "", nil, nil, err
+	}
+	outputs, metadata, err := s.getOutputsAndMetadata(ctx, pipelineTriggerID, r, true)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return ownerPermalink, outputs, metadata, nil
+}
+
 func (s *service) getOperationFromWorkflowInfo(ctx context.Context, workflowExecutionInfo *workflowpb.WorkflowExecutionInfo) (*longrunningpb.Operation, error) {
 	operation := longrunningpb.Operation{}
 
@@ -1708,12 +1931,7 @@ func (s *service) getOperationFromWorkflowInfo(ctx context.Context, workflowExec
 	case enums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
 
 		pipelineTriggerID := workflowExecutionInfo.Execution.WorkflowId
-		ownerPermalink := recipe.LoadOwnerPermalink(ctx, s.redisClient, pipelineTriggerID)
-		r, err := recipe.LoadRecipe(ctx, s.redisClient, fmt.Sprintf("%s:%s", pipelineTriggerID, recipe.SegRecipe))
-		if err != nil {
-			return nil, err
-		}
-		outputs, metadata, err := s.getOutputsAndMetadata(ctx, pipelineTriggerID, r, true)
+		ownerPermalink, outputs, metadata, err := s.getTriggerResult(ctx, pipelineTriggerID)
 		if err != nil {
 			return nil, err
 		}
@@ -1754,7 +1972,47 @@ func (s *service) getOperationFromWorkflowInfo(ctx context.Context, workflowExec
 			}
 		}
 
+	case enums.WORKFLOW_EXECUTION_STATUS_CANCELED:
+		operation = longrunningpb.Operation{
+			Done: true,
+			Result: &longrunningpb.Operation_Error{
+				Error: &rpcStatus.Status{
+					Code:    int32(codes.Canceled),
+					Details: []*anypb.Any{},
+					Message: "The operation was cancelled.",
+				},
+			},
+		}
 	case enums.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		operation = longrunningpb.Operation{
+			Done: false,
+			Result: &longrunningpb.Operation_Response{
+				Response: &anypb.Any{},
+			},
+		}
+
+		// A trigger with pending approval requests is waiting for a
+		// reviewer.
+		reqs, err := recipe.ListTriggerApprovalRequests(ctx, s.redisClient, workflowExecutionInfo.Execution.WorkflowId)
+		if err != nil {
+			return nil, err
+		}
+		if len(reqs) > 0 {
+			approvals := make([]any, len(reqs))
+			for i, req := range reqs {
+				approvals[i] = req.ID
+			}
+			state, err := structpb.NewStruct(map[string]any{
+				"state":     "STATE_WAITING_FOR_APPROVAL",
+				"approvals": approvals,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if operation.Metadata, err = anypb.New(state); err != nil {
+				return nil, err
+			}
+		}
 	case enums.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
 		operation = longrunningpb.Operation{
 			Done: false,
@@ -2,18 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/frankban/quicktest"
 	"github.com/go-redis/redismock/v9"
 	"github.com/gofrs/uuid"
 	"github.com/gojuno/minimock/v3"
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
 	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
 	"github.com/instill-ai/pipeline-backend/pkg/mock"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
 	"github.com/instill-ai/pipeline-backend/pkg/resource"
+	"github.com/instill-ai/pipeline-backend/pkg/worker"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	temporalconverter "go.temporal.io/sdk/converter"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
 	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
 )
 
@@ -100,3 +116,254 @@ func TestService_UpdateNamespacePipelineByID(t *testing.T) {
 	c.Assert(err, quicktest.IsNil)
 	c.Assert(updatedPbPipeline, quicktest.IsNotNil)
 }
+
+func TestDryRunParams(t *testing.T) {
+	c := quicktest.New(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		constant.HeaderDryRunKey, "true",
+		constant.HeaderDryRunMockKey, `{"llm": {"texts": ["mocked"]}}`,
+	))
+	dryRun, mocks, err := dryRunParams(ctx)
+	c.Assert(err, quicktest.IsNil)
+	c.Check(dryRun, quicktest.IsTrue)
+	c.Check(mocks, quicktest.DeepEquals, map[string]map[string]any{"llm": {"texts": []any{"mocked"}}})
+
+	// The mocks are ignored outside of a dry run.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(constant.HeaderDryRunMockKey, "{}"))
+	dryRun, mocks, err = dryRunParams(ctx)
+	c.Assert(err, quicktest.IsNil)
+	c.Check(dryRun, quicktest.IsFalse)
+	c.Check(mocks, quicktest.IsNil)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		constant.HeaderDryRunKey, "true",
+		constant.HeaderDryRunMockKey, `["llm"]`,
+	))
+	_, _, err = dryRunParams(ctx)
+	c.Check(errors.Is(err, errdomain.ErrInvalidArgument), quicktest.IsTrue)
+}
+
+func TestPreTriggerPipeline_RequiredVariable(t *testing.T) {
+	c := quicktest.New(t)
+
+	r := &datamodel.Recipe{Variable: map[string]*datamodel.Variable{
+		"prompt": {InstillFormat: "string", Required: true},
+		"style":  {InstillFormat: "string"},
+	}}
+	data := []*pb.TriggerData{{Variable: &structpb.Struct{Fields: map[string]*structpb.Value{
+		"style": structpb.NewStringValue("formal"),
+	}}}}
+
+	s := &service{}
+	_, err := s.preTriggerPipeline(context.Background(), false, resource.Namespace{}, r, "trigger", data)
+	c.Check(err, quicktest.ErrorMatches, `.*missing properties: 'prompt'`)
+}
+
+func TestParseRunFilter(t *testing.T) {
+	c := quicktest.New(t)
+
+	filter, err := parseRunFilter(`status = "RUN_STATUS_FAILED" AND startedTime > timestamp("2024-08-01T00:00:00Z")`)
+	c.Assert(err, quicktest.IsNil)
+	c.Check(filter.CheckedExpr, quicktest.IsNotNil)
+
+	filter, err = parseRunFilter("")
+	c.Assert(err, quicktest.IsNil)
+	c.Check(filter.CheckedExpr, quicktest.IsNil)
+
+	_, err = parseRunFilter(`owner = "users/admin"`)
+	c.Check(errors.Is(err, errdomain.ErrInvalidArgument), quicktest.IsTrue)
+}
+
+func TestGetTriggerResult(t *testing.T) {
+	c := quicktest.New(t)
+	ctx := context.Background()
+
+	c.Run("stored result", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+		repo.GetPipelineTriggerResultMock.Expect(minimock.AnyContext, "trigger").Return(&datamodel.PipelineTriggerResult{
+			PipelineTriggerID: "trigger",
+			Owner:             "users/uid",
+			Outputs:           []byte(`[{"answer":"42"}]`),
+			Metadata:          []byte(`{"traces":{"llm":{"statuses":["STATUS_COMPLETED"]}}}`),
+		}, nil)
+		s := &service{repository: repo}
+
+		owner, outputs, metadata, err := s.getTriggerResult(ctx, "trigger")
+		c.Assert(err, quicktest.IsNil)
+		c.Check(owner, quicktest.Equals, "users/uid")
+		c.Assert(outputs, quicktest.HasLen, 1)
+		c.Check(outputs[0].AsMap(), quicktest.DeepEquals, map[string]any{"answer": "42"})
+		c.Check(metadata.GetTraces()["llm"].GetStatuses(), quicktest.DeepEquals, []pb.Trace_Status{pb.Trace_STATUS_COMPLETED})
+	})
+
+	c.Run("expired result", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+		repo.GetPipelineTriggerResultMock.Return(nil, errdomain.ErrNotFound)
+		redisClient, redisMock := redismock.NewClientMock()
+		redisMock.ExpectGet("pipeline_trigger:trigger:owner_permalink").RedisNil()
+		redisMock.ExpectGet("pipeline_trigger:trigger:recipe").RedisNil()
+		s := &service{repository: repo, redisClient: redisClient}
+
+		_, _, _, err := s.getTriggerResult(ctx, "trigger")
+		c.Check(errors.Is(err, errdomain.ErrNotFound), quicktest.IsTrue)
+	})
+}
+
+func TestConvertPipelineRun_Data(t *testing.T) {
+	c := quicktest.New(t)
+
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	run := &datamodel.PipelineRun{
+		Inputs:  []byte(`[{"prompt":"hi"}]`),
+		Outputs: []byte(`[{"answer":"hello"}]`),
+	}
+
+	run.DataExpireTime = &future
+	got := convertPipelineRun(run)
+	c.Check(string(got.Inputs), quicktest.Equals, `[{"prompt":"hi"}]`)
+	c.Check(string(got.Outputs), quicktest.Equals, `[{"answer":"hello"}]`)
+
+	// The data is hidden once it expires, even if it isn't cleared yet.
+	run.DataExpireTime = &past
+	got = convertPipelineRun(run)
+	c.Check(got.Inputs, quicktest.IsNil)
+	c.Check(got.Outputs, quicktest.IsNil)
+}
+
+func TestStreamResult_Iteration(t *testing.T) {
+	c := quicktest.New(t)
+
+	s := &service{}
+	ev := &recipe.StreamEvent{
+		Type:        recipe.StreamEventIteration,
+		ComponentID: "iter",
+		BatchIndex:  1,
+		Completed:   2,
+		Total:       3,
+	}
+	result, err := s.streamResult(context.Background(), "trigger", nil, true, 2, ev)
+	c.Assert(err, quicktest.IsNil)
+	c.Check(result.Struct, quicktest.HasLen, 2)
+
+	// The progress is reported in the trace of the iterator.
+	traces := result.Metadata.GetTraces()
+	c.Assert(traces, quicktest.HasLen, 1)
+	trace := traces["iter"]
+	c.Assert(trace, quicktest.IsNotNil)
+	c.Check(trace.Statuses, quicktest.DeepEquals, []pb.Trace_Status{pb.Trace_STATUS_UNSPECIFIED, pb.Trace_STATUS_UNSPECIFIED})
+	c.Check(trace.Outputs[0].AsMap(), quicktest.DeepEquals, map[string]any{})
+	c.Check(trace.Outputs[1].AsMap(), quicktest.DeepEquals, map[string]any{
+		"progress": map[string]any{"completed": float64(2), "total": float64(3)},
+	})
+
+	// The batch item is completed with its last element.
+	ev.Completed = 3
+	result, err = s.streamResult(context.Background(), "trigger", nil, true, 2, ev)
+	c.Assert(err, quicktest.IsNil)
+	c.Check(result.Metadata.GetTraces()["iter"].Statuses[1], quicktest.Equals, pb.Trace_STATUS_COMPLETED)
+}
+
+func TestService_CancelOperation(t *testing.T) {
+	c := quicktest.New(t)
+
+	userUID := uuid.Must(uuid.NewV4())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		constant.HeaderUserUIDKey, userUID.String(),
+		constant.HeaderAuthTypeKey, "user",
+	))
+
+	pipelineUID := uuid.Must(uuid.NewV4())
+	memoPayload, err := temporalconverter.GetDefaultDataConverter().ToPayload(pipelineUID.String())
+	c.Assert(err, quicktest.IsNil)
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{worker.MemoPipelineUIDKey: memoPayload}}
+
+	testcases := []struct {
+		name        string
+		describeErr error
+		memo        *commonpb.Memo
+		status      enums.WorkflowExecutionStatus
+		// The roles the user has on the pipeline.
+		roles     []string
+		cancelled bool
+		wantErr   error
+	}{
+		{
+			name:        "unknown operation",
+			describeErr: serviceerror.NewNotFound("workflow not found"),
+			wantErr:     errdomain.ErrNotFound,
+		},
+		{
+			name:    "no pipeline in memo",
+			status:  enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			wantErr: errdomain.ErrNotFound,
+		},
+		{
+			name:    "pipeline not visible",
+			memo:    memo,
+			status:  enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			wantErr: errdomain.ErrNotFound,
+		},
+		{
+			name:    "no executor permission",
+			memo:    memo,
+			status:  enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			roles:   []string{"reader"},
+			wantErr: errdomain.ErrUnauthorized,
+		},
+		{
+			name:    "operation done",
+			memo:    memo,
+			status:  enums.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			roles:   []string{"reader", "executor"},
+			wantErr: errdomain.ErrInvalidArgument,
+		},
+		{
+			name:      "ok",
+			memo:      memo,
+			status:    enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			roles:     []string{"reader", "executor"},
+			cancelled: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		c.Run(tc.name, func(c *quicktest.C) {
+			mc := minimock.NewController(c)
+			temporalClient := mock.NewClientMock(mc)
+			repo := mock.NewRepositoryMock(mc)
+			aclClient := mock.NewACLClientInterfaceMock(mc)
+
+			if tc.describeErr != nil {
+				temporalClient.DescribeWorkflowExecutionMock.Expect(ctx, "trigger", "").Return(nil, tc.describeErr)
+			} else {
+				temporalClient.DescribeWorkflowExecutionMock.Expect(ctx, "trigger", "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Memo: tc.memo, Status: tc.status},
+				}, nil)
+			}
+			if tc.memo != nil {
+				// The pipeline is looked up from the UID in the memo.
+				repo.GetPipelineByUIDMock.
+					ExpectUidParam2(pipelineUID).
+					Return(&datamodel.Pipeline{BaseDynamic: datamodel.BaseDynamic{UID: pipelineUID}}, nil)
+				aclClient.CheckPermissionMock.Set(func(_ context.Context, _ string, objectUID uuid.UUID, role string) (bool, error) {
+					c.Check(objectUID, quicktest.Equals, pipelineUID)
+					return slices.Contains(tc.roles, role), nil
+				})
+			}
+			if tc.cancelled {
+				temporalClient.CancelWorkflowMock.Expect(ctx, "trigger", "").Return(nil)
+			}
+
+			s := &service{repository: repo, aclClient: aclClient, temporalClient: temporalClient}
+			err := s.CancelOperation(ctx, "trigger")
+			if tc.wantErr != nil {
+				c.Check(errors.Is(err, tc.wantErr), quicktest.IsTrue)
+				return
+			}
+			c.Check(err, quicktest.IsNil)
+		})
+	}
+}
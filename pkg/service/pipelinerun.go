@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.einride.tech/aip/filtering"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+// PipelineRun is the public representation of the record of a pipeline
+// trigger.
+type PipelineRun struct {
+	PipelineTriggerID  string     `json:"pipelineTriggerId"`
+	PipelineID         string     `json:"pipelineId"`
+	PipelineUID        string     `json:"pipelineUid"`
+	PipelineReleaseID  string     `json:"pipelineReleaseId,omitempty"`
+	PipelineReleaseUID string     `json:"pipelineReleaseUid,omitempty"`
+	RequesterUID       string     `json:"requesterUid,omitempty"`
+	TriggerMode        string     `json:"triggerMode"`
+	Status             string     `json:"status"`
+	StartedTime        time.Time  `json:"startedTime"`
+	CompletedTime      *time.Time `json:"completedTime,omitempty"`
+	// TotalDuration is in milliseconds.
+	TotalDuration *int64          `json:"totalDuration,omitempty"`
+	Error         *string         `json:"error,omitempty"`
+	Inputs        json.RawMessage `json:"inputs,omitempty"`
+	Outputs       json.RawMessage `json:"outputs,omitempty"`
+	CreateTime    time.Time       `json:"createTime"`
+	ComponentRuns []*ComponentRun `json:"componentRuns"`
+	// SourcePipelineTriggerID is the run this one was started from, e.g. by
+	// a replay.
+	SourcePipelineTriggerID string `json:"sourcePipelineTriggerId,omitempty"`
+}
+
+// ComponentRun is the public representation of the execution of a component
+// for a batch item of a pipeline run.
+type ComponentRun struct {
+	ComponentID   string     `json:"componentId"`
+	BatchIndex    int        `json:"batchIndex"`
+	Status        string     `json:"status"`
+	StartedTime   time.Time  `json:"startedTime"`
+	CompletedTime *time.Time `json:"completedTime,omitempty"`
+	// TotalDuration is in milliseconds.
+	TotalDuration *int64  `json:"totalDuration,omitempty"`
+	Attempts      int32   `json:"attempts"`
+	ErrorType     *string `json:"errorType,omitempty"`
+	Error         *string `json:"error,omitempty"`
+	InputSize     int64   `json:"inputSize"`
+	OutputSize    int64   `json:"outputSize"`
+	CacheHit      bool    `json:"cacheHit"`
+}
+
+func convertPipelineRun(run *datamodel.PipelineRun) *PipelineRun {
+	r := &PipelineRun{
+		PipelineTriggerID: run.PipelineTriggerID,
+		PipelineID:        run.PipelineID,
+		PipelineUID:       run.PipelineUID.String(),
+		PipelineReleaseID: run.PipelineReleaseID,
+		TriggerMode:       run.TriggerMode,
+		Status:            string(run.Status),
+		StartedTime:       run.StartedTime,
+		CompletedTime:     run.CompletedTime,
+		TotalDuration:     run.TotalDuration,
+		Error:             run.Error,
+		Inputs:            json.RawMessage(run.Inputs),
+		Outputs:           json.RawMessage(run.Outputs),
+		CreateTime:        run.CreateTime,
+		ComponentRuns:     []*ComponentRun{},
+
+		SourcePipelineTriggerID: run.SourcePipelineTriggerID,
+	}
+	if runDataExpired(run) {
+		r.Inputs, r.Outputs = nil, nil
+	}
+	if run.PipelineReleaseUID.Valid {
+		r.PipelineReleaseUID = run.PipelineReleaseUID.UUID.String()
+	}
+	if run.RequesterUID.Valid {
+		r.RequesterUID = run.RequesterUID.UUID.String()
+	}
+	return r
+}
+
+// runDataExpired tells whether the inputs and outputs of a run expired. The
+// expired data is cleared periodically, it can still be in the record.
+func runDataExpired(run *datamodel.PipelineRun) bool {
+	return run.DataExpireTime != nil && run.DataExpireTime.Before(time.Now())
+}
+
+// runFilter is the filter expression of a pipeline run list.
+type runFilter string
+
+func (f runFilter) GetFilter() string {
+	return string(f)
+}
+
+func parseRunFilter(expr string) (filtering.Filter, error) {
+	declarations, err := filtering.NewDeclarations([]filtering.DeclarationOption{
+		filtering.DeclareStandardFunctions(),
+		filtering.DeclareIdent("pipelineTriggerId", filtering.TypeString),
+		filtering.DeclareIdent("pipelineId", filtering.TypeString),
+		filtering.DeclareIdent("pipelineReleaseId", filtering.TypeString),
+		filtering.DeclareIdent("requesterUid", filtering.TypeString),
+		filtering.DeclareIdent("triggerMode", filtering.TypeString),
+		filtering.DeclareIdent("status", filtering.TypeString),
+		filtering.DeclareIdent("startedTime", filtering.TypeTimestamp),
+		filtering.DeclareIdent("completedTime", filtering.TypeTimestamp),
+		filtering.DeclareIdent("createTime", filtering.TypeTimestamp),
+		filtering.DeclareIdent("sourcePipelineTriggerId", filtering.TypeString),
+	}...)
+	if err != nil {
+		return filtering.Filter{}, err
+	}
+
+	filter, err := filtering.ParseFilter(runFilter(expr), declarations)
+	if err != nil {
+		return filtering.Filter{}, fmt.Errorf("%w: %w", errdomain.ErrInvalidArgument, err)
+	}
+	return filter, nil
+}
+
+func convertComponentRun(run *datamodel.ComponentRun) *ComponentRun {
+	return &ComponentRun{
+		ComponentID:   run.ComponentID,
+		BatchIndex:    run.BatchIndex,
+		Status:        string(run.Status),
+		StartedTime:   run.StartedTime,
+		CompletedTime: run.CompletedTime,
+		TotalDuration: run.TotalDuration,
+		Attempts:      run.Attempts,
+		ErrorType:     run.ErrorType,
+		Error:         run.Error,
+		InputSize:     run.InputSize,
+		OutputSize:    run.OutputSize,
+		CacheHit:      run.CacheHit,
+	}
+}
+
+// convertPipelineRuns converts the pipeline runs and attaches their component
+// runs.
+func (s *service) convertPipelineRuns(ctx context.Context, dbRuns []*datamodel.PipelineRun) ([]*PipelineRun, error) {
+	runs := make([]*PipelineRun, len(dbRuns))
+	byTriggerID := make(map[string]*PipelineRun, len(dbRuns))
+	triggerIDs := make([]string, len(dbRuns))
+	for i, run := range dbRuns {
+		runs[i] = convertPipelineRun(run)
+		byTriggerID[run.PipelineTriggerID] = runs[i]
+		triggerIDs[i] = run.PipelineTriggerID
+	}
+
+	compRuns, err := s.repository.ListComponentRuns(ctx, triggerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, compRun := range compRuns {
+		if run, ok := byTriggerID[compRun.PipelineTriggerID]; ok {
+			run.ComponentRuns = append(run.ComponentRuns, convertComponentRun(compRun))
+		}
+	}
+	return runs, nil
+}
+
+// ListNamespacePipelineRuns lists the runs of a pipeline, from the most
+// recent. The runs are visible to the members of the pipeline namespace.
+func (s *service) ListNamespacePipelineRuns(ctx context.Context, ns resource.Namespace, pipelineID string, pageSize int32, pageToken string, filterExpr string) ([]*PipelineRun, int32, string, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, 0, "", err
+	}
+
+	dbPipeline, err := s.repository.GetNamespacePipelineByID(ctx, ns.Permalink(), pipelineID, true, false)
+	if err != nil {
+		return nil, 0, "", errdomain.ErrNotFound
+	}
+
+	filter, err := parseRunFilter(filterExpr)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	dbRuns, totalSize, nextPageToken, err := s.repository.ListPipelineRuns(ctx, dbPipeline.UID, int64(pageSize), pageToken, filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	runs, err := s.convertPipelineRuns(ctx, dbRuns)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return runs, int32(totalSize), nextPageToken, nil
+}
+
+// ListNamespaceRuns lists the runs of the pipelines of a namespace, from the
+// most recent.
+func (s *service) ListNamespaceRuns(ctx context.Context, ns resource.Namespace, pageSize int32, pageToken string, filterExpr string) ([]*PipelineRun, int32, string, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, 0, "", err
+	}
+
+	filter, err := parseRunFilter(filterExpr)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	dbRuns, totalSize, nextPageToken, err := s.repository.ListNamespacePipelineRuns(ctx, ns.Permalink(), int64(pageSize), pageToken, filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	runs, err := s.convertPipelineRuns(ctx, dbRuns)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return runs, int32(totalSize), nextPageToken, nil
+}
+
+// GetNamespaceRunByID returns the run of a trigger of a namespace pipeline,
+// with its component runs.
+func (s *service) GetNamespaceRunByID(ctx context.Context, ns resource.Namespace, pipelineTriggerID string) (*PipelineRun, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, err
+	}
+
+	dbRun, err := s.repository.GetPipelineRunByTriggerID(ctx, pipelineTriggerID)
+	if err != nil {
+		return nil, err
+	}
+	if dbRun.Owner != ns.Permalink() {
+		return nil, errdomain.ErrNotFound
+	}
+
+	runs, err := s.convertPipelineRuns(ctx, []*datamodel.PipelineRun{dbRun})
+	if err != nil {
+		return nil, err
+	}
+
+	return runs[0], nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+	"github.com/instill-ai/x/errmsg"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+	pipelinepb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
+)
+
+// triggerSource is the trigger a run is started from.
+type triggerSource struct {
+	pipelineTriggerID string
+	// completedComponents holds, when a failed trigger is resumed, the
+	// components that aren't executed again.
+	completedComponents []string
+}
+
+// triggerSourceKey is the context key of the trigger a run is started from.
+type triggerSourceKey struct{}
+
+func withTriggerSource(ctx context.Context, source triggerSource) context.Context {
+	return context.WithValue(ctx, triggerSourceKey{}, source)
+}
+
+// getTriggerSource returns the trigger a run is started from. It's empty if
+// it's a new trigger.
+func getTriggerSource(ctx context.Context) triggerSource {
+	source, _ := ctx.Value(triggerSourceKey{}).(triggerSource)
+	return source
+}
+
+// sourceTriggerID returns the trigger a run is started from, or an empty
+// string if it's a new trigger.
+func sourceTriggerID(ctx context.Context) string {
+	return getTriggerSource(ctx).pipelineTriggerID
+}
+
+var errRunInputsExpired = errmsg.AddMessage(
+	fmt.Errorf("%w: run inputs expired", errdomain.ErrNotFound),
+	"The inputs of the run are no longer retained, so it can't be replayed.",
+)
+
+// ReplayNamespaceRun triggers a pipeline again with the inputs of a previous
+// trigger, asynchronously. The pipeline is triggered in its latest version,
+// or in releaseID if it's set. The new run is linked to the replayed one.
+//
+// The inputs are read from the run record, so only the runs whose data is
+// recorded and not expired can be replayed. Dry runs aren't recorded. The
+// secrets of the trigger request aren't recorded, so the replay only uses the
+// namespace secrets.
+func (s *service) ReplayNamespaceRun(ctx context.Context, ns resource.Namespace, pipelineTriggerID string, releaseID string, returnTraces bool) (*longrunningpb.Operation, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, err
+	}
+
+	run, err := s.repository.GetPipelineRunByTriggerID(ctx, pipelineTriggerID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Owner != ns.Permalink() {
+		return nil, errdomain.ErrNotFound
+	}
+	if len(run.Inputs) == 0 || runDataExpired(run) {
+		return nil, errRunInputsExpired
+	}
+
+	var inputs []map[string]any
+	if err := json.Unmarshal(run.Inputs, &inputs); err != nil {
+		return nil, err
+	}
+	data := make([]*pipelinepb.TriggerData, len(inputs))
+	for idx, vars := range inputs {
+		v, err := structpb.NewStruct(vars)
+		if err != nil {
+			return nil, err
+		}
+		data[idx] = &pipelinepb.TriggerData{Variable: v}
+	}
+
+	newTriggerID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	ctx = withTriggerSource(ctx, triggerSource{pipelineTriggerID: pipelineTriggerID})
+
+	if releaseID != "" {
+		return s.TriggerAsyncNamespacePipelineReleaseByID(ctx, ns, run.PipelineUID, releaseID, data, newTriggerID.String(), returnTraces)
+	}
+
+	dbPipeline, err := s.repository.GetPipelineByUID(ctx, run.PipelineUID, true, false)
+	if err != nil {
+		return nil, errdomain.ErrNotFound
+	}
+	return s.TriggerAsyncNamespacePipelineByID(ctx, ns, dbPipeline.ID, data, newTriggerID.String(), returnTraces)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/frankban/quicktest"
+	"github.com/gofrs/uuid"
+	"github.com/gojuno/minimock/v3"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/mock"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+func TestService_ReplayNamespaceRun(t *testing.T) {
+	c := quicktest.New(t)
+
+	nsUID := uuid.Must(uuid.NewV4())
+	ns := resource.Namespace{NsType: resource.User, NsUID: nsUID}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		constant.HeaderUserUIDKey, nsUID.String(),
+		constant.HeaderAuthTypeKey, "user",
+	))
+
+	pipelineUID := uuid.Must(uuid.NewV4())
+	newRun := func() *datamodel.PipelineRun {
+		return &datamodel.PipelineRun{
+			PipelineTriggerID: "trigger",
+			PipelineUID:       pipelineUID,
+			Owner:             ns.Permalink(),
+			Inputs:            []byte(`[{"prompt":"hello"}]`),
+		}
+	}
+
+	c.Run("owner mismatch", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+
+		run := newRun()
+		run.Owner = "users/" + uuid.Must(uuid.NewV4()).String()
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(run, nil)
+
+		s := &service{repository: repo}
+		_, err := s.ReplayNamespaceRun(ctx, ns, "trigger", "", false)
+		c.Check(errors.Is(err, errdomain.ErrNotFound), quicktest.IsTrue)
+	})
+
+	c.Run("expired inputs", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+
+		run := newRun()
+		expireTime := time.Now().Add(-time.Minute)
+		run.DataExpireTime = &expireTime
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(run, nil)
+
+		s := &service{repository: repo}
+		_, err := s.ReplayNamespaceRun(ctx, ns, "trigger", "", false)
+		c.Check(err, quicktest.ErrorIs, errRunInputsExpired)
+	})
+
+	c.Run("inputs not recorded", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+
+		run := newRun()
+		run.Inputs = nil
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(run, nil)
+
+		s := &service{repository: repo}
+		_, err := s.ReplayNamespaceRun(ctx, ns, "trigger", "", false)
+		c.Check(err, quicktest.ErrorIs, errRunInputsExpired)
+	})
+
+	c.Run("release", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+		aclClient := mock.NewACLClientInterfaceMock(mc)
+
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(newRun(), nil)
+		repo.GetPipelineByUIDMock.
+			ExpectUidParam2(pipelineUID).
+			Return(&datamodel.Pipeline{ID: "pipeline", BaseDynamic: datamodel.BaseDynamic{UID: pipelineUID}}, nil)
+		aclClient.CheckPermissionMock.Return(true, nil)
+
+		// The release of the replayed pipeline is triggered.
+		repo.GetNamespacePipelineReleaseByIDMock.
+			ExpectOwnerPermalinkParam2(ns.Permalink()).
+			ExpectPipelineUIDParam3(pipelineUID).
+			ExpectIdParam4("v1").
+			Return(nil, errdomain.ErrNotFound)
+
+		s := &service{repository: repo, aclClient: aclClient}
+		_, err := s.ReplayNamespaceRun(ctx, ns, "trigger", "v1", false)
+		c.Check(errors.Is(err, errdomain.ErrNotFound), quicktest.IsTrue)
+	})
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"github.com/gofrs/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+	"github.com/instill-ai/x/errmsg"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+	pipelinepb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
+)
+
+var errRunMemoryExpired = errmsg.AddMessage(
+	fmt.Errorf("%w: run memory expired", errdomain.ErrInvalidArgument),
+	"The memory of the run is no longer retained, so it can't be resumed. Replay the run instead.",
+)
+
+// ResumeNamespaceRun triggers again a failed run, asynchronously, from its
+// failed component. The memory of the components that completed is copied
+// from the failed run and only the failed components and their downstream
+// components are executed. The run is resumed with the recipe and the inputs
+// of the failed run, and the new run is linked to it.
+//
+// Failed runs can be resumed while their memory is retained. The secrets of
+// the trigger request aren't retained, so the resumed run only uses the
+// namespace secrets.
+func (s *service) ResumeNamespaceRun(ctx context.Context, ns resource.Namespace, pipelineTriggerID string, returnTraces bool) (*longrunningpb.Operation, error) {
+
+	if err := s.checkNamespacePermission(ctx, ns); err != nil {
+		return nil, err
+	}
+
+	run, err := s.repository.GetPipelineRunByTriggerID(ctx, pipelineTriggerID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Owner != ns.Permalink() {
+		return nil, errdomain.ErrNotFound
+	}
+	if run.Status != datamodel.RunStatusFailed {
+		return nil, errmsg.AddMessage(
+			fmt.Errorf("%w: run status is %s", errdomain.ErrInvalidArgument, run.Status),
+			"Only failed runs can be resumed.",
+		)
+	}
+
+	retainedID := recipe.RetainedTriggerID(pipelineTriggerID)
+	r, err := recipe.LoadRecipe(ctx, s.redisClient, fmt.Sprintf("%s:%s", retainedID, recipe.SegRecipe))
+	if errors.Is(err, redis.Nil) {
+		return nil, errRunMemoryExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+	memory, err := recipe.LoadMemoryByTriggerID(ctx, s.redisClient, retainedID)
+	if errors.Is(err, redis.Nil) {
+		return nil, errRunMemoryExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := recipe.ResumableComponents(r.Component, memory)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pipelinepb.TriggerData, len(memory))
+	for idx, m := range memory {
+		v, err := structpb.NewStruct(m.Variable)
+		if err != nil {
+			return nil, err
+		}
+		data[idx] = &pipelinepb.TriggerData{Variable: v}
+	}
+
+	dbPipeline, err := s.repository.GetPipelineByUID(ctx, run.PipelineUID, false, false)
+	if err != nil {
+		return nil, errdomain.ErrNotFound
+	}
+	isAdmin, err := s.checkTriggerPermission(ctx, dbPipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	newTriggerID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	ctx = withTriggerSource(ctx, triggerSource{
+		pipelineTriggerID:   pipelineTriggerID,
+		completedComponents: completed,
+	})
+
+	return s.triggerAsyncPipeline(ctx, ns, r, isAdmin, dbPipeline.ID, dbPipeline.UID, run.PipelineReleaseID, run.PipelineReleaseUID.UUID, data, newTriggerID.String(), returnTraces)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/frankban/quicktest"
+	"github.com/go-redis/redismock/v9"
+	"github.com/gofrs/uuid"
+	"github.com/gojuno/minimock/v3"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/instill-ai/pipeline-backend/pkg/constant"
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/mock"
+	"github.com/instill-ai/pipeline-backend/pkg/resource"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+func TestService_ResumeNamespaceRun(t *testing.T) {
+	c := quicktest.New(t)
+
+	nsUID := uuid.Must(uuid.NewV4())
+	ns := resource.Namespace{NsType: resource.User, NsUID: nsUID}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(constant.HeaderUserUIDKey, nsUID.String()))
+
+	newRun := func() *datamodel.PipelineRun {
+		return &datamodel.PipelineRun{
+			PipelineTriggerID: "trigger",
+			PipelineUID:       uuid.Must(uuid.NewV4()),
+			Owner:             ns.Permalink(),
+			Status:            datamodel.RunStatusFailed,
+		}
+	}
+
+	c.Run("owner mismatch", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+
+		run := newRun()
+		run.Owner = "users/" + uuid.Must(uuid.NewV4()).String()
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(run, nil)
+
+		s := &service{repository: repo}
+		_, err := s.ResumeNamespaceRun(ctx, ns, "trigger", false)
+		c.Check(errors.Is(err, errdomain.ErrNotFound), quicktest.IsTrue)
+	})
+
+	c.Run("run not failed", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+
+		run := newRun()
+		run.Status = datamodel.RunStatusCompleted
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(run, nil)
+
+		s := &service{repository: repo}
+		_, err := s.ResumeNamespaceRun(ctx, ns, "trigger", false)
+		c.Check(errors.Is(err, errdomain.ErrInvalidArgument), quicktest.IsTrue)
+	})
+
+	c.Run("memory expired", func(c *quicktest.C) {
+		mc := minimock.NewController(c)
+		repo := mock.NewRepositoryMock(mc)
+		repo.GetPipelineRunByTriggerIDMock.Expect(ctx, "trigger").Return(newRun(), nil)
+
+		redisClient, redisMock := redismock.NewClientMock()
+		redisMock.ExpectGet("pipeline_trigger:retained:trigger:recipe").RedisNil()
+
+		s := &service{repository: repo, redisClient: redisClient}
+		_, err := s.ResumeNamespaceRun(ctx, ns, "trigger", false)
+		c.Check(err, quicktest.ErrorIs, errRunMemoryExpired)
+		c.Check(redisMock.ExpectationsWereMet(), quicktest.IsNil)
+	})
+}
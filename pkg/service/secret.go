@@ -150,6 +150,15 @@ func (s *service) checkSecret(ctx context.Context, components datamodel.Componen
 			if err != nil {
 				return err
 			}
+		case datamodel.Switch:
+			for _, c := range comp.Cases {
+				if err := s.checkSecret(ctx, c.Component); err != nil {
+					return err
+				}
+			}
+		case datamodel.SubPipeline:
+			// The callee secrets are checked when the callee is saved.
+		case datamodel.Approval:
 		}
 	}
 	return nil
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+	"github.com/instill-ai/x/errmsg"
+
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+)
+
+// subPipelineError builds an invalid argument error for a sub-pipeline
+// component that can't be triggered.
+func subPipelineError(compID, format string, a ...any) error {
+	msg := fmt.Sprintf("Component %s: %s", compID, fmt.Sprintf(format, a...))
+	return errmsg.AddMessage(
+		fmt.Errorf("%w: invalid sub-pipeline: %s", errdomain.ErrInvalidArgument, msg),
+		msg,
+	)
+}
+
+// resolveSubPipelines loads the pipelines called by the sub-pipeline
+// components of a recipe, including the ones nested in iterators, switch
+// cases and in the callees themselves. The requester must be able to
+// trigger every callee. callStack holds the pipelines that are being called,
+// from the outermost one, and is used to reject recursive calls.
+func (s *service) resolveSubPipelines(ctx context.Context, comps datamodel.ComponentMap, callStack []string) error {
+	ids := make([]string, 0, len(comps))
+	for id := range comps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		comp := comps[id]
+		switch comp.Type {
+		case datamodel.Iterator:
+			if err := s.resolveSubPipelines(ctx, comp.Component, callStack); err != nil {
+				return err
+			}
+		case datamodel.Switch:
+			for _, c := range comp.Cases {
+				if err := s.resolveSubPipelines(ctx, c.Component, callStack); err != nil {
+					return err
+				}
+			}
+		case datamodel.SubPipeline:
+			if err := s.resolveSubPipeline(ctx, id, comp, callStack); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *service) resolveSubPipeline(ctx context.Context, id string, comp *datamodel.Component, callStack []string) error {
+	ref, err := recipe.ParsePipelineReference(comp.Pipeline)
+	if err != nil {
+		return subPipelineError(id, "%s", err)
+	}
+
+	calls := append(callStack, ref.String())
+	for _, called := range callStack {
+		if called == ref.String() {
+			return subPipelineError(id, "recursive pipeline call %s", strings.Join(calls, " -> "))
+		}
+	}
+	if len(calls) > recipe.MaxSubPipelineDepth {
+		return subPipelineError(id, "pipeline calls can't be nested more than %d levels deep: %s", recipe.MaxSubPipelineDepth, strings.Join(calls, " -> "))
+	}
+
+	ns, err := s.GetRscNamespace(ctx, ref.NamespaceID)
+	if err != nil {
+		return subPipelineError(id, "namespace %q not found", ref.NamespaceID)
+	}
+	dbPipeline, err := s.repository.GetNamespacePipelineByID(ctx, ns.Permalink(), ref.PipelineID, false, true)
+	if err != nil {
+		return subPipelineError(id, "pipeline %q not found", ref)
+	}
+	if _, err := s.checkTriggerPermission(ctx, dbPipeline); err != nil {
+		return fmt.Errorf("checking permission to trigger %s from component %s: %w", ref, id, err)
+	}
+
+	callee := &datamodel.Callee{
+		OwnerPermalink: ns.Permalink(),
+		OwnerType:      string(ns.NsType),
+		OwnerUID:       ns.NsUID,
+		PipelineID:     dbPipeline.ID,
+		PipelineUID:    dbPipeline.UID,
+		Recipe:         dbPipeline.Recipe,
+	}
+	if ref.ReleaseID != "" {
+		dbRelease, err := s.repository.GetNamespacePipelineReleaseByID(ctx, ns.Permalink(), dbPipeline.UID, ref.ReleaseID, false)
+		if err != nil {
+			return subPipelineError(id, "pipeline release %q not found", ref)
+		}
+		callee.ReleaseID = dbRelease.ID
+		callee.ReleaseUID = dbRelease.UID
+		callee.Recipe = dbRelease.Recipe
+	}
+	if callee.Recipe == nil {
+		return subPipelineError(id, "pipeline %q has no recipe", ref)
+	}
+
+	// The input of the component is the variable section of the callee.
+	input, _ := comp.Input.(map[string]any)
+	for k := range input {
+		if _, ok := callee.Recipe.Variable[k]; !ok {
+			return subPipelineError(id, "pipeline %q doesn't declare the variable %q", ref, k)
+		}
+	}
+	for k, v := range callee.Recipe.Variable {
+		if _, ok := input[k]; !ok && v.Required && v.Default == nil {
+			return subPipelineError(id, "missing input %q, it's required by pipeline %q", k, ref)
+		}
+	}
+
+	if err := s.resolveSubPipelines(ctx, callee.Recipe.Component, calls); err != nil {
+		return err
+	}
+
+	comp.Callee = callee
+	return nil
+}
@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/instill-ai/pipeline-backend/pkg/constant"
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
 	"github.com/instill-ai/pipeline-backend/pkg/resource"
 	mgmtpb "github.com/instill-ai/protogen-go/core/mgmt/v1beta"
 
@@ -88,3 +93,36 @@ func (s *service) GetRscNamespace(ctx context.Context, namespaceID string) (reso
 	}
 	return resource.Namespace{}, fmt.Errorf("namespace error")
 }
+
+// variablesSchema builds the JSON schema of the pipeline variables. The
+// per-variable `required` flag is collected into the object-level `required`
+// list, as JSON Schema expects.
+func variablesSchema(variables map[string]*datamodel.Variable) *structpb.Struct {
+	sch := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+	sch.Fields["type"] = structpb.NewStringValue("object")
+	properties := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+
+	required := []string{}
+	for k, v := range variables {
+		b, _ := json.Marshal(v)
+		p := &structpb.Struct{}
+		_ = protojson.Unmarshal(b, p)
+		delete(p.Fields, "required")
+		properties.Fields[k] = structpb.NewStructValue(p)
+		if v.Required && v.Default == nil {
+			required = append(required, k)
+		}
+	}
+	sch.Fields["properties"] = structpb.NewStructValue(properties)
+
+	if len(required) > 0 {
+		sort.Strings(required)
+		l := &structpb.ListValue{}
+		for _, k := range required {
+			l.Values = append(l.Values, structpb.NewStringValue(k))
+		}
+		sch.Fields["required"] = structpb.NewListValue(l)
+	}
+
+	return sch
+}
@@ -3,14 +3,21 @@ package service
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
 	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+	"github.com/instill-ai/x/errmsg"
 
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
 	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
 )
 
@@ -32,48 +39,989 @@ func checkTask(compID, targetTask string, compSpec *structpb.Struct, compPropert
 	// return nil
 }
 
-func (s *service) checkRecipe(recipePermalink *datamodel.Recipe) ([]*pb.PipelineValidationError, error) {
+// checkConditions parses the condition of every component (including the
+// ones nested in iterators and switch cases) and of every switch case, and
+// reports the syntax errors.
+func checkConditions(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	check := func(condition, location string) {
+		if condition == "" {
+			return
+		}
+		if _, err := recipe.ParseCondition(condition); err != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: location,
+				Error:    err.Error(),
+			})
+		}
+	}
+	for id, comp := range comps {
+		check(comp.Condition, locationPrefix+id+".condition")
+		switch comp.Type {
+		case datamodel.Iterator:
+			checkConditions(comp.Component, locationPrefix+id+".component.", validationErrors)
+		case datamodel.Switch:
+			for idx, c := range comp.Cases {
+				caseLoc := fmt.Sprintf("%s%s.cases.%d", locationPrefix, id, idx)
+				check(c.Condition, caseLoc+".condition")
+				checkConditions(c.Component, caseLoc+".component.", validationErrors)
+			}
+		}
+	}
+}
+
+// checkSwitches verifies the structure of the switch components: they need
+// at least one case, only the last case can omit its condition and every
+// case must define the same outputs.
+func checkSwitches(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		switch comp.Type {
+		case datamodel.Iterator:
+			checkSwitches(comp.Component, loc+".component.", validationErrors)
+			continue
+		case datamodel.Switch:
+		default:
+			if len(comp.Cases) > 0 {
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: loc + ".cases",
+					Error:    "only switches support this option",
+				})
+			}
+			continue
+		}
 
+		if comp.Input != nil || len(comp.Component) > 0 {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: loc,
+				Error:    "a switch doesn't take an input or components, the components are defined in its cases",
+			})
+		}
+		if len(comp.Cases) == 0 {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: loc + ".cases",
+				Error:    "a switch needs at least one case",
+			})
+			continue
+		}
+
+		for idx, c := range comp.Cases {
+			caseLoc := fmt.Sprintf("%s.cases.%d", loc, idx)
+			if c.Condition == "" && idx < len(comp.Cases)-1 {
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: caseLoc + ".condition",
+					Error:    "only the last case can omit its condition, the next cases are unreachable",
+				})
+			}
+			for k := range comp.Cases[0].Output {
+				if _, ok := c.Output[k]; !ok {
+					*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+						Location: caseLoc + ".output",
+						Error:    fmt.Sprintf("missing output %q, every case must define the same outputs", k),
+					})
+				}
+			}
+			for k := range c.Output {
+				if _, ok := comp.Cases[0].Output[k]; !ok {
+					*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+						Location: caseLoc + ".output." + k,
+						Error:    "the first case doesn't define this output, every case must define the same outputs",
+					})
+				}
+			}
+			checkSwitches(c.Component, caseLoc+".component.", validationErrors)
+		}
+	}
+}
+
+// checkSubPipelines verifies the pipeline reference and the input of the
+// sub-pipeline components. The callee is only resolved when the pipeline is
+// triggered.
+func checkSubPipelines(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		for idx, c := range comp.Cases {
+			checkSubPipelines(c.Component, fmt.Sprintf("%s.cases.%d.component.", loc, idx), validationErrors)
+		}
+		switch comp.Type {
+		case datamodel.Iterator:
+			checkSubPipelines(comp.Component, loc+".component.", validationErrors)
+			continue
+		case datamodel.SubPipeline:
+		default:
+			if comp.Pipeline != "" {
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: loc + ".pipeline",
+					Error:    "only sub-pipelines support this option",
+				})
+			}
+			continue
+		}
+
+		if _, err := recipe.ParsePipelineReference(comp.Pipeline); err != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: loc + ".pipeline",
+				Error:    err.Error(),
+			})
+		}
+		if _, ok := comp.Input.(map[string]any); !ok && comp.Input != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: loc + ".input",
+				Error:    "the input of a sub-pipeline must be an object with the variables of the called pipeline",
+			})
+		}
+		if len(comp.Setup) > 0 || len(comp.Component) > 0 {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: loc,
+				Error:    "a sub-pipeline doesn't take a setup or components",
+			})
+		}
+	}
+}
+
+var approvalOutputs = []string{
+	recipe.ApprovalOutputApproved,
+	recipe.ApprovalOutputPayload,
+	recipe.ApprovalOutputReviewer,
+	recipe.ApprovalOutputComment,
+}
+
+// checkApprovals verifies the input and the timeout of the approval
+// components, and that their options aren't set on other components.
+func checkApprovals(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	addErr := func(location, msg string) {
+		*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+			Location: location,
+			Error:    msg,
+		})
+	}
+
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		for idx, c := range comp.Cases {
+			checkApprovals(c.Component, fmt.Sprintf("%s.cases.%d.component.", loc, idx), validationErrors)
+		}
+		if comp.Type == datamodel.Iterator {
+			checkApprovals(comp.Component, loc+".component.", validationErrors)
+		}
+
+		if comp.Type != datamodel.Approval {
+			if comp.ApprovalTimeout != "" {
+				addErr(loc+".approvalTimeout", "only approvals support this option")
+			}
+			if comp.TimeoutAction != "" {
+				addErr(loc+".timeoutAction", "only approvals support this option")
+			}
+			continue
+		}
+
+		if _, ok := comp.Input.(map[string]any); !ok && comp.Input != nil {
+			addErr(loc+".input", "the input of an approval must be an object with the payload to review")
+		}
+		if len(comp.Setup) > 0 || len(comp.Component) > 0 || comp.Task != "" {
+			addErr(loc, "an approval doesn't take a setup, a task or components")
+		}
+		if d := comp.ApprovalTimeout; d != "" {
+			if v, err := time.ParseDuration(d); err != nil || v <= 0 {
+				addErr(loc+".approvalTimeout", fmt.Sprintf("invalid duration %q, expected a positive duration such as 30s or 1m30s", d))
+			}
+		}
+		switch comp.TimeoutAction {
+		case "", datamodel.ApprovalTimeoutApprove, datamodel.ApprovalTimeoutReject, datamodel.ApprovalTimeoutFail:
+		default:
+			addErr(loc+".timeoutAction", fmt.Sprintf("invalid action %q, expected approve, reject or fail", comp.TimeoutAction))
+		}
+		if comp.TimeoutAction != "" && comp.ApprovalTimeout == "" {
+			addErr(loc+".timeoutAction", "the timeout action requires an approval timeout")
+		}
+	}
+}
+
+// checkExecutionPolicies verifies the retry, timeout, on-error and cache
+// policies of the components. Only the regular components support them.
+func checkExecutionPolicies(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	addErr := func(location, msg string) {
+		*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+			Location: location,
+			Error:    msg,
+		})
+	}
+	checkDuration := func(d, location string) {
+		if d == "" {
+			return
+		}
+		if v, err := time.ParseDuration(d); err != nil || v <= 0 {
+			addErr(location, fmt.Sprintf("invalid duration %q, expected a positive duration such as 30s or 1m30s", d))
+		}
+	}
+
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		for idx, c := range comp.Cases {
+			checkExecutionPolicies(c.Component, fmt.Sprintf("%s.cases.%d.component.", loc, idx), validationErrors)
+		}
+		if comp.Type == datamodel.Iterator {
+			checkExecutionPolicies(comp.Component, loc+".component.", validationErrors)
+		}
+
+		switch comp.Type {
+		case datamodel.Iterator, datamodel.Switch, datamodel.SubPipeline, datamodel.Approval:
+			if comp.Retry != nil {
+				addErr(loc+".retry", "only regular components support this option")
+			}
+			if comp.Timeout != nil {
+				addErr(loc+".timeout", "only regular components support this option")
+			}
+			if comp.OnError != nil {
+				addErr(loc+".onError", "only regular components support this option")
+			}
+			if comp.Cache != nil {
+				addErr(loc+".cache", "only regular components support this option")
+			}
+			continue
+		}
+
+		if r := comp.Retry; r != nil {
+			if r.MaxAttempts < 0 {
+				addErr(loc+".retry.maxAttempts", "must be a positive integer")
+			}
+			if r.BackoffCoefficient != 0 && r.BackoffCoefficient < 1 {
+				addErr(loc+".retry.backoffCoefficient", "must be greater than or equal to 1")
+			}
+			checkDuration(r.InitialInterval, loc+".retry.initialInterval")
+		}
+		if t := comp.Timeout; t != nil {
+			checkDuration(t.StartToClose, loc+".timeout.startToClose")
+			checkDuration(t.ScheduleToClose, loc+".timeout.scheduleToClose")
+		}
+		if o := comp.OnError; o != nil {
+			switch o.Action {
+			case datamodel.OnErrorFail, datamodel.OnErrorContinue:
+				if o.Output != nil {
+					addErr(loc+".onError.output", "only the fallback action supports an output")
+				}
+			case datamodel.OnErrorFallback:
+				if o.Output == nil {
+					addErr(loc+".onError.output", "the fallback action requires an output")
+				}
+			default:
+				addErr(loc+".onError.action", fmt.Sprintf("invalid action %q, expected fail, continue or fallback", o.Action))
+			}
+		}
+		if cp := comp.Cache; cp != nil {
+			if cp.TTL == "" {
+				addErr(loc+".cache.ttl", "the cache requires a TTL")
+			}
+			checkDuration(cp.TTL, loc+".cache.ttl")
+			input, _ := comp.Input.(map[string]any)
+			for idx, k := range cp.Key {
+				if _, ok := input[k]; !ok {
+					addErr(fmt.Sprintf("%s.cache.key.%d", loc, idx), fmt.Sprintf("the input has no field %q", k))
+				}
+			}
+		}
+	}
+}
+
+// checkIteratorOptions verifies the execution options of the iterators and
+// that they aren't set on other components.
+func checkIteratorOptions(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		options := map[string]int{"batchSize": comp.BatchSize, "maxConcurrency": comp.MaxConcurrency}
+		for _, k := range []string{"batchSize", "maxConcurrency"} {
+			v := options[k]
+			switch {
+			case v == 0:
+			case comp.Type != datamodel.Iterator:
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: locationPrefix + id + "." + k,
+					Error:    "only iterators support this option",
+				})
+			case v < 0:
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: locationPrefix + id + "." + k,
+					Error:    "must be a positive integer",
+				})
+			}
+		}
+		if comp.Type == datamodel.Iterator {
+			if _, ok := comp.OutputElements[datamodel.IteratorErrorsOutput]; ok && comp.ContinueOnError {
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: locationPrefix + id + ".outputElements." + datamodel.IteratorErrorsOutput,
+					Error:    fmt.Sprintf("%q is reserved for the element errors when the iterator continues on error", datamodel.IteratorErrorsOutput),
+				})
+			}
+			checkIteratorOptions(comp.Component, locationPrefix+id+".component.", validationErrors)
+		}
+		for idx, c := range comp.Cases {
+			checkIteratorOptions(c.Component, fmt.Sprintf("%s%s.cases.%d.component.", locationPrefix, id, idx), validationErrors)
+		}
+	}
+}
+
+// checkIteratorInputs verifies that the input of every iterator is a
+// reference or a range.
+func checkIteratorInputs(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		for idx, c := range comp.Cases {
+			checkIteratorInputs(c.Component, fmt.Sprintf("%s%s.cases.%d.component.", locationPrefix, id, idx), validationErrors)
+		}
+		if comp.Type != datamodel.Iterator {
+			continue
+		}
+		if err := recipe.ValidateIteratorInput(comp.Input); err != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: locationPrefix + id + ".input",
+				Error:    err.Error(),
+			})
+		}
+		checkIteratorInputs(comp.Component, locationPrefix+id+".component.", validationErrors)
+	}
+}
+
+// checkTemplates checks the syntax of the bindings in every string of a
+// structured template.
+func checkTemplates(template any, location string, validationErrors *[]*pb.PipelineValidationError) {
+	switch t := template.(type) {
+	case string:
+		if err := recipe.ValidateTemplate(t); err != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: location,
+				Error:    err.Error(),
+			})
+		}
+	case map[string]any:
+		for k, v := range t {
+			checkTemplates(v, location+"."+k, validationErrors)
+		}
+	case []any:
+		for idx, v := range t {
+			checkTemplates(v, fmt.Sprintf("%s.%d", location, idx), validationErrors)
+		}
+	}
+}
+
+// checkComponentTemplates checks the syntax of the bindings in the input,
+// setup and outputs of every component, including the ones nested in
+// iterators and switch cases.
+func checkComponentTemplates(comps datamodel.ComponentMap, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		checkTemplates(comp.Setup, loc+".setup", validationErrors)
+		if comp.OnError != nil {
+			checkTemplates(comp.OnError.Output, loc+".onError.output", validationErrors)
+		}
+		if comp.Type != datamodel.Iterator {
+			// The iterator inputs are checked by checkIteratorInputs.
+			checkTemplates(comp.Input, loc+".input", validationErrors)
+		} else {
+			for k, v := range comp.OutputElements {
+				checkTemplates(v, loc+".outputElements."+k, validationErrors)
+			}
+			checkComponentTemplates(comp.Component, loc+".component.", validationErrors)
+		}
+		for idx, c := range comp.Cases {
+			caseLoc := fmt.Sprintf("%s.cases.%d", loc, idx)
+			for k, v := range c.Output {
+				checkTemplates(v, caseLoc+".output."+k, validationErrors)
+			}
+			checkComponentTemplates(c.Component, caseLoc+".component.", validationErrors)
+		}
+	}
+}
+
+// checkCycles reports the dependency cycles between components. The location
+// of the error is the first component of the cycle.
+func checkCycles(comps datamodel.ComponentMap, validationErrors *[]*pb.PipelineValidationError) {
+	for _, cycle := range recipe.FindCycles(comps) {
+		location := "component."
+		for _, group := range cycle.Scope {
+			location += group + ".component."
+		}
+		*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+			Location: location + cycle.Path[0],
+			Error:    cycle.Error(),
+		})
+	}
+}
+
+// checkRecipeStructure performs the recipe checks that don't depend on the
+// component definitions. These checks are cheap and are run every time a
+// pipeline is saved.
+func checkRecipeStructure(r *datamodel.Recipe) []*pb.PipelineValidationError {
 	validationErrors := []*pb.PipelineValidationError{}
+	if r == nil {
+		return validationErrors
+	}
 
-	schema := map[string]any{}
+	checkVariables(r.Variable, &validationErrors)
+	checkConditions(r.Component, "component.", &validationErrors)
+	checkIteratorOptions(r.Component, "component.", &validationErrors)
+	checkIteratorInputs(r.Component, "component.", &validationErrors)
+	checkSwitches(r.Component, "component.", &validationErrors)
+	checkSubPipelines(r.Component, "component.", &validationErrors)
+	checkApprovals(r.Component, "component.", &validationErrors)
+	checkExecutionPolicies(r.Component, "component.", &validationErrors)
+	checkComponentTemplates(r.Component, "component.", &validationErrors)
+	checkCycles(r.Component, &validationErrors)
+	for k, o := range r.Output {
+		checkTemplates(o.Value, "output."+k+".value", &validationErrors)
+	}
 
-	_ = json.Unmarshal(recipe.RecipeSchema, &schema)
+	return validationErrors
+}
+
+// checkVariables verifies the constraints declared on the pipeline variables
+// are consistent and that default values satisfy them.
+func checkVariables(variables map[string]*datamodel.Variable, validationErrors *[]*pb.PipelineValidationError) {
+	for k, v := range variables {
+		location := "variable." + k
+		if v.Pattern != "" {
+			if _, err := regexp.Compile(v.Pattern); err != nil {
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+					Location: location + ".pattern",
+					Error:    fmt.Sprintf("invalid pattern: %s", err),
+				})
+				continue
+			}
+		}
+		if v.Minimum != nil && v.Maximum != nil && *v.Minimum > *v.Maximum {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: location + ".minimum",
+				Error:    "minimum is greater than maximum",
+			})
+			continue
+		}
+		if v.Default == nil {
+			continue
+		}
+		if err := validateVariableDefault(variablesSchema(map[string]*datamodel.Variable{k: v}), k, v.Default); err != "" {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: location + ".default",
+				Error:    fmt.Sprintf("default value doesn't satisfy the variable constraints: %s", err),
+			})
+		}
+	}
+}
+
+// validateVariableDefault validates a default value against the schema of
+// its variable and returns the first validation error message, if any.
+func validateVariableDefault(sch *structpb.Struct, key string, value any) string {
+	b, err := protojson.Marshal(sch.Fields["properties"].GetStructValue().Fields[key])
+	if err != nil {
+		return err.Error()
+	}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource("schema.json", bytes.NewReader(b)); err != nil {
+		return err.Error()
+	}
+	compiled, err := c.Compile("schema.json")
+	if err != nil {
+		return err.Error()
+	}
+
+	vb, err := json.Marshal(value)
+	if err != nil {
+		return err.Error()
+	}
+	var instance any
+	dec := json.NewDecoder(bytes.NewReader(vb))
+	dec.UseNumber()
+	if err := dec.Decode(&instance); err != nil {
+		return err.Error()
+	}
+
+	if err := compiled.Validate(instance); err != nil {
+		valErr, ok := err.(*jsonschema.ValidationError)
+		if !ok {
+			return err.Error()
+		}
+		for _, detail := range valErr.BasicOutput().Errors {
+			if detail.Error != "" && !strings.HasPrefix(detail.Error, "doesn't validate with") {
+				return detail.Error
+			}
+		}
+		return valErr.Message
+	}
+	return ""
+}
+
+// bindingScope holds the components a binding can reference. Components
+// nested in an iterator or a switch case can reference the components of the
+// enclosing scopes, and the element of the enclosing iterators.
+type bindingScope struct {
+	comps    map[string]*datamodel.Component
+	elements map[string]bool
+}
 
+func (sc *bindingScope) nested(iteratorID string, iterator *datamodel.Component) *bindingScope {
+	return sc.group(iterator.Component).withElement(iteratorID)
+}
+
+// group returns the scope of a group of nested components.
+func (sc *bindingScope) group(comps datamodel.ComponentMap) *bindingScope {
+	n := &bindingScope{
+		comps:    make(map[string]*datamodel.Component, len(sc.comps)+len(comps)),
+		elements: sc.elements,
+	}
+	for id, comp := range sc.comps {
+		n.comps[id] = comp
+	}
+	for id, comp := range comps {
+		n.comps[id] = comp
+	}
+	return n
+}
+
+// withElement returns a scope where the element of an iterator is available
+// but its nested components aren't.
+func (sc *bindingScope) withElement(iteratorID string) *bindingScope {
+	n := &bindingScope{comps: sc.comps, elements: map[string]bool{iteratorID: true}}
+	for id := range sc.elements {
+		n.elements[id] = true
+	}
+	return n
+}
+
+// bindingChecker resolves the references of a recipe statically, against the
+// declared variables and the data specification of the referenced component
+// tasks.
+type bindingChecker struct {
+	recipe           *datamodel.Recipe
+	dataSpecs        map[string]*pb.DataSpecification
+	validationErrors *[]*pb.PipelineValidationError
+}
+
+// checkBindings reports the unknown component types and the references that
+// can't be resolved in a recipe: undeclared variables, unknown or
+// out-of-scope components, and fields that aren't part of the referenced
+// component's task specification. Secret
+// references aren't resolved, as secrets can be provided when the pipeline
+// is triggered.
+func (s *service) checkBindings(r *datamodel.Recipe) []*pb.PipelineValidationError {
+	if r == nil {
+		return []*pb.PipelineValidationError{}
+	}
+
+	dataSpecs := map[string]*pb.DataSpecification{}
+	typeErrors := []*pb.PipelineValidationError{}
+	s.loadDataSpecs(r.Component, dataSpecs, "component.", &typeErrors)
+
+	validationErrors := append(typeErrors, checkRecipeBindings(r, dataSpecs)...)
+	slices.SortStableFunc(validationErrors, func(a, b *pb.PipelineValidationError) int {
+		return strings.Compare(a.Location, b.Location)
+	})
+	return validationErrors
+}
+
+// checkRecipeBindings resolves the references of a recipe given the data
+// specification of each component, keyed by component ID. The errors are
+// sorted by location.
+func checkRecipeBindings(r *datamodel.Recipe, dataSpecs map[string]*pb.DataSpecification) []*pb.PipelineValidationError {
+	validationErrors := []*pb.PipelineValidationError{}
+	bc := &bindingChecker{
+		recipe:           r,
+		dataSpecs:        dataSpecs,
+		validationErrors: &validationErrors,
+	}
+
+	sc := &bindingScope{comps: map[string]*datamodel.Component{}, elements: map[string]bool{}}
+	for id, comp := range r.Component {
+		sc.comps[id] = comp
+	}
+	bc.checkComponents(r.Component, sc, "component.")
+	for k, o := range r.Output {
+		bc.checkTemplate(o.Value, "output."+k+".value", sc)
+	}
+
+	slices.SortStableFunc(validationErrors, func(a, b *pb.PipelineValidationError) int {
+		return strings.Compare(a.Location, b.Location)
+	})
+	return validationErrors
+}
+
+// loadDataSpecs fetches the data specification of the task of every
+// component, keyed by component ID. The components whose type doesn't match a
+// definition are reported as validation errors and have no data
+// specification.
+func (s *service) loadDataSpecs(comps datamodel.ComponentMap, dataSpecs map[string]*pb.DataSpecification, locationPrefix string, validationErrors *[]*pb.PipelineValidationError) {
+	for id, comp := range comps {
+		switch comp.Type {
+		case datamodel.Iterator:
+			s.loadDataSpecs(comp.Component, dataSpecs, locationPrefix+id+".component.", validationErrors)
+			continue
+		case datamodel.Switch:
+			for idx, c := range comp.Cases {
+				s.loadDataSpecs(c.Component, dataSpecs, fmt.Sprintf("%s%s.cases.%d.component.", locationPrefix, id, idx), validationErrors)
+			}
+			continue
+		case datamodel.SubPipeline, datamodel.Approval:
+			continue
+		}
+		def, err := s.component.GetDefinitionByID(comp.Type, nil, nil)
+		if err != nil {
+			*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+				Location: locationPrefix + id + ".type",
+				Error:    fmt.Sprintf("unknown component type %q", comp.Type),
+			})
+			continue
+		}
+		if spec, ok := def.GetSpec().GetDataSpecifications()[comp.Task]; ok {
+			dataSpecs[id] = spec
+		}
+	}
+}
+
+func (bc *bindingChecker) checkComponents(comps datamodel.ComponentMap, sc *bindingScope, locationPrefix string) {
+	for id, comp := range comps {
+		loc := locationPrefix + id
+		bc.checkTemplate(comp.Input, loc+".input", sc)
+		bc.checkTemplate(comp.Setup, loc+".setup", sc)
+		switch comp.Type {
+		case datamodel.Iterator:
+			// The condition of an iterator filters its elements.
+			bc.checkTemplate(comp.Condition, loc+".condition", sc.withElement(id))
+			nested := sc.nested(id, comp)
+			bc.checkComponents(comp.Component, nested, loc+".component.")
+			for k, v := range comp.OutputElements {
+				bc.checkTemplate(v, loc+".outputElements."+k, nested)
+			}
+		case datamodel.Switch:
+			bc.checkTemplate(comp.Condition, loc+".condition", sc)
+			for idx, c := range comp.Cases {
+				caseLoc := fmt.Sprintf("%s.cases.%d", loc, idx)
+				bc.checkTemplate(c.Condition, caseLoc+".condition", sc)
+				nested := sc.group(c.Component)
+				bc.checkComponents(c.Component, nested, caseLoc+".component.")
+				for k, v := range c.Output {
+					bc.checkTemplate(v, caseLoc+".output."+k, nested)
+				}
+			}
+		default:
+			bc.checkTemplate(comp.Condition, loc+".condition", sc)
+			if comp.OnError != nil {
+				bc.checkTemplate(comp.OnError.Output, loc+".onError.output", sc)
+			}
+		}
+	}
+}
+
+func (bc *bindingChecker) checkTemplate(template any, location string, sc *bindingScope) {
+	switch t := template.(type) {
+	case string:
+		for _, ref := range recipe.FindReferences(t) {
+			if msg := bc.checkReference(ref, sc); msg != "" {
+				*bc.validationErrors = append(*bc.validationErrors, &pb.PipelineValidationError{
+					Location: location,
+					Error:    fmt.Sprintf("invalid reference ${%s}: %s", ref, msg),
+				})
+			}
+		}
+	case map[string]any:
+		for k, v := range t {
+			bc.checkTemplate(v, location+"."+k, sc)
+		}
+	case []any:
+		for idx, v := range t {
+			bc.checkTemplate(v, fmt.Sprintf("%s.%d", location, idx), sc)
+		}
+	}
+}
+
+// referenceSegment is a segment of a reference path, either a field name or
+// an array index.
+type referenceSegment struct {
+	name  string
+	index bool
+}
+
+func splitReference(ref string) []referenceSegment {
+	segs := []referenceSegment{}
+	for _, s := range strings.FieldsFunc(ref, func(r rune) bool { return r == '.' || r == '[' }) {
+		if strings.HasSuffix(s, "]") {
+			name := strings.TrimSuffix(s, "]")
+			if unquoted := strings.Trim(name, `"'`); unquoted != name {
+				segs = append(segs, referenceSegment{name: unquoted})
+				continue
+			}
+			segs = append(segs, referenceSegment{name: name, index: true})
+			continue
+		}
+		segs = append(segs, referenceSegment{name: s})
+	}
+	return segs
+}
+
+// checkReference returns a description of the problem if the reference can't
+// be resolved, or an empty string otherwise.
+func (bc *bindingChecker) checkReference(ref string, sc *bindingScope) string {
+	// References that are JSON literals evaluate to themselves.
+	if json.Valid([]byte(ref)) {
+		return ""
+	}
+
+	segs := splitReference(ref)
+	if len(segs) == 0 {
+		return "empty reference"
+	}
+
+	switch segs[0].name {
+	case recipe.SegVariable:
+		if len(segs) < 2 || strings.HasPrefix(segs[1].name, "__") {
+			return ""
+		}
+		if _, ok := bc.recipe.Variable[segs[1].name]; !ok {
+			return fmt.Sprintf("variable %q is not declared", segs[1].name)
+		}
+		return ""
+	case recipe.SegSecret:
+		if len(segs) != 2 {
+			return "secrets must be referenced as secret.<id>"
+		}
+		return ""
+	}
+
+	id := segs[0].name
+	comp, ok := sc.comps[id]
+	if !ok {
+		if group := bc.nestingGroup(id, bc.recipe.Component); group != "" {
+			return fmt.Sprintf("component %q is nested in %s and can't be referenced from here", id, group)
+		}
+		return fmt.Sprintf("component %q doesn't exist", id)
+	}
+	if len(segs) < 2 {
+		return "a component reference must select its input, output or status"
+	}
+
+	field := segs[1].name
+	rest := segs[2:]
+	switch field {
+	case "status":
+		if len(rest) > 0 && !slices.Contains([]string{"started", "completed", "skipped", "errored"}, rest[0].name) {
+			return fmt.Sprintf("unknown status field %q", rest[0].name)
+		}
+		return ""
+	case "element":
+		if comp.Type != datamodel.Iterator {
+			return fmt.Sprintf("component %q isn't an iterator", id)
+		}
+		if !sc.elements[id] {
+			return fmt.Sprintf("the element of iterator %q is only available to its nested components", id)
+		}
+		return ""
+	case "input", "output":
+	default:
+		return fmt.Sprintf("unknown field %q, expected input, output or status", field)
+	}
+
+	if comp.Type == datamodel.Switch {
+		if field == "output" && len(rest) > 0 && len(comp.Cases) > 0 {
+			if _, ok := comp.Cases[0].Output[rest[0].name]; !ok {
+				return fmt.Sprintf("switch %q has no output %q", id, rest[0].name)
+			}
+		}
+		return ""
+	}
+	if comp.Type == datamodel.SubPipeline {
+		// The variables and outputs of the callee are resolved when the
+		// pipeline is triggered.
+		return ""
+	}
+	if comp.Type == datamodel.Approval {
+		if field == "output" && len(rest) > 0 && !slices.Contains(approvalOutputs, rest[0].name) {
+			return fmt.Sprintf("approval %q has no output %q", id, rest[0].name)
+		}
+		return ""
+	}
+	if comp.Type == datamodel.Iterator {
+		if field == "output" && len(rest) > 0 {
+			if comp.ContinueOnError && rest[0].name == datamodel.IteratorErrorsOutput {
+				return ""
+			}
+			if _, ok := comp.OutputElements[rest[0].name]; !ok {
+				return fmt.Sprintf("iterator %q has no output element %q", id, rest[0].name)
+			}
+		}
+		return ""
+	}
+
+	spec, ok := bc.dataSpecs[id]
+	if !ok {
+		// The task isn't valid, which is reported by the task check.
+		return ""
+	}
+	sch := spec.GetInput()
+	if field == "output" {
+		sch = spec.GetOutput()
+	}
+	return checkSchemaPath(sch, rest, fmt.Sprintf("%s.%s", id, field), comp.Task)
+}
+
+// nestingGroup describes the group a nested component belongs to, or
+// returns an empty string if the component isn't nested.
+func (bc *bindingChecker) nestingGroup(id string, comps datamodel.ComponentMap) string {
+	for _, comp := range comps {
+		switch comp.Type {
+		case datamodel.Iterator:
+			if _, ok := comp.Component[id]; ok {
+				return "an iterator"
+			}
+			if group := bc.nestingGroup(id, comp.Component); group != "" {
+				return group
+			}
+		case datamodel.Switch:
+			for _, c := range comp.Cases {
+				if _, ok := c.Component[id]; ok {
+					return "a switch case"
+				}
+				if group := bc.nestingGroup(id, c.Component); group != "" {
+					return group
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// checkSchemaPath walks a JSON schema along a reference path. The walk stops
+// without error when it reaches a schema that doesn't constrain its
+// properties.
+func checkSchemaPath(sch *structpb.Struct, segs []referenceSegment, path, task string) string {
+	for _, seg := range segs {
+		if seg.index {
+			items := sch.GetFields()["items"].GetStructValue()
+			if items == nil {
+				return ""
+			}
+			sch = items
+			path = fmt.Sprintf("%s[%s]", path, seg.name)
+			continue
+		}
+
+		sub, found, open := schemaProperty(sch, seg.name)
+		if !found {
+			if open {
+				return ""
+			}
+			return fmt.Sprintf("field %q doesn't exist in %s of task %q", seg.name, path, task)
+		}
+		sch = sub
+		path = path + "." + seg.name
+	}
+	return ""
+}
+
+// schemaProperty looks up a property in an object schema, including its
+// combinators. open reports whether the schema accepts properties that
+// aren't declared.
+func schemaProperty(sch *structpb.Struct, name string) (sub *structpb.Struct, found bool, open bool) {
+	fields := sch.GetFields()
+	if _, ok := fields["$ref"]; ok {
+		return nil, false, true
+	}
+
+	props, hasProps := fields["properties"]
+	if hasProps {
+		if p, ok := props.GetStructValue().GetFields()[name]; ok {
+			return p.GetStructValue(), true, false
+		}
+	}
+
+	hasCombinators := false
+	for _, kw := range []string{"allOf", "anyOf", "oneOf"} {
+		l, ok := fields[kw]
+		if !ok {
+			continue
+		}
+		hasCombinators = true
+		for _, branch := range l.GetListValue().GetValues() {
+			sub, found, branchOpen := schemaProperty(branch.GetStructValue(), name)
+			if found {
+				return sub, true, false
+			}
+			open = open || branchOpen
+		}
+	}
+
+	if ap, ok := fields["additionalProperties"]; ok {
+		if _, isBool := ap.GetKind().(*structpb.Value_BoolValue); !isBool || ap.GetBoolValue() {
+			open = true
+		}
+	}
+	if _, ok := fields["patternProperties"]; ok {
+		open = true
+	}
+	if !hasProps && !hasCombinators {
+		switch fields["type"].GetStringValue() {
+		case "string", "number", "integer", "boolean", "null":
+		default:
+			open = true
+		}
+	}
+	return nil, false, open
+}
+
+// checkRecipeOnSave runs the checks that reject a recipe when a pipeline is
+// created or updated.
+func (s *service) checkRecipeOnSave(r *datamodel.Recipe) error {
+	validationErrors := checkRecipeStructure(r)
+	validationErrors = append(validationErrors, s.checkBindings(r)...)
+	if len(validationErrors) > 0 {
+		return newRecipeValidationError(validationErrors)
+	}
+	return nil
+}
+
+// newRecipeValidationError builds an invalid argument error from a list of
+// validation errors.
+func newRecipeValidationError(validationErrors []*pb.PipelineValidationError) error {
+	msgs := make([]string, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Location, e.Error))
+	}
+	msg := strings.Join(msgs, "; ")
+
+	return errmsg.AddMessage(
+		fmt.Errorf("%w: invalid recipe: %s", errdomain.ErrInvalidArgument, msg),
+		fmt.Sprintf("The pipeline recipe is invalid. %s", msg),
+	)
+}
+
+// componentSchemaProperties checks the task of every component and returns
+// the JSON schema properties that validate the components against their
+// task specification. Iterators and switch cases are handled recursively.
+func (s *service) componentSchemaProperties(comps datamodel.ComponentMap, validationErrors *[]*pb.PipelineValidationError) (map[string]any, error) {
 	compProperties := map[string]any{}
 
-	for id, comp := range recipePermalink.Component {
+	for id, comp := range comps {
 		switch comp.Type {
 		default:
 
 			def, err := s.component.GetDefinitionByID(comp.Type, nil, nil)
 			if err != nil {
-				return nil, err
+				// The unknown types are reported by checkBindings.
+				continue
 			}
-			checkTask(id, comp.Task, def.Spec.ComponentSpecification, compProperties, &validationErrors)
+			checkTask(id, comp.Task, def.Spec.ComponentSpecification, compProperties, validationErrors)
+
+		case datamodel.SubPipeline:
+			// Sub-pipelines are checked by checkSubPipelines.
+
+		case datamodel.Approval:
+			// Approvals are checked by checkApprovals.
 
 		case datamodel.Iterator:
-			nestedCompProperties := map[string]any{}
 			nestedValidationErrors := []*pb.PipelineValidationError{}
-			for nestedID, nestedComp := range comp.Component {
-				if nestedComp.Type != datamodel.Iterator {
-					def, err := s.component.GetDefinitionByID(nestedComp.Type, nil, nil)
-					if err != nil {
-						return nil, err
-					}
-					checkTask(nestedID, nestedComp.Task, def.Spec.ComponentSpecification, nestedCompProperties, &nestedValidationErrors)
-				}
-
+			nestedCompProperties, err := s.componentSchemaProperties(comp.Component, &nestedValidationErrors)
+			if err != nil {
+				return nil, err
 			}
 			for _, e := range nestedValidationErrors {
-				validationErrors = append(validationErrors, &pb.PipelineValidationError{
+				*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
 					Location: "component." + id + "." + e.Location,
 					Error:    e.Error,
 				})
-
 			}
 
-			compProperties[id] = map[string]any{}
 			compProperties[id] = map[string]any{
 				"properties": map[string]any{
 					"component": map[string]any{
@@ -82,8 +1030,59 @@ func (s *service) checkRecipe(recipePermalink *datamodel.Recipe) ([]*pb.Pipeline
 				},
 			}
 
+		case datamodel.Switch:
+			// The cases share a schema, which holds the properties of the
+			// components of every case.
+			caseCompProperties := map[string]any{}
+			for idx, c := range comp.Cases {
+				nestedValidationErrors := []*pb.PipelineValidationError{}
+				nestedCompProperties, err := s.componentSchemaProperties(c.Component, &nestedValidationErrors)
+				if err != nil {
+					return nil, err
+				}
+				for _, e := range nestedValidationErrors {
+					*validationErrors = append(*validationErrors, &pb.PipelineValidationError{
+						Location: fmt.Sprintf("component.%s.cases.%d.%s", id, idx, e.Location),
+						Error:    e.Error,
+					})
+				}
+				for nestedID, p := range nestedCompProperties {
+					caseCompProperties[nestedID] = p
+				}
+			}
+
+			compProperties[id] = map[string]any{
+				"properties": map[string]any{
+					"cases": map[string]any{
+						"items": map[string]any{
+							"properties": map[string]any{
+								"component": map[string]any{
+									"properties": caseCompProperties,
+								},
+							},
+						},
+					},
+				},
+			}
 		}
+	}
+
+	return compProperties, nil
+}
 
+func (s *service) checkRecipe(recipePermalink *datamodel.Recipe) ([]*pb.PipelineValidationError, error) {
+
+	validationErrors := checkRecipeStructure(recipePermalink)
+
+	validationErrors = append(validationErrors, s.checkBindings(recipePermalink)...)
+
+	schema := map[string]any{}
+
+	_ = json.Unmarshal(recipe.RecipeSchema, &schema)
+
+	compProperties, err := s.componentSchemaProperties(recipePermalink.Component, &validationErrors)
+	if err != nil {
+		return nil, err
 	}
 
 	schema["properties"].(map[string]any)["component"].(map[string]any)["properties"] = compProperties
@@ -92,7 +1091,7 @@ func (s *service) checkRecipe(recipePermalink *datamodel.Recipe) ([]*pb.Pipeline
 
 	c := jsonschema.NewCompiler()
 
-	err := c.AddResource("schema.json", bytes.NewReader(schemaByte))
+	err = c.AddResource("schema.json", bytes.NewReader(schemaByte))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frankban/quicktest"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/x/errmsg"
+
+	componentstore "github.com/instill-ai/component/store"
+	errdomain "github.com/instill-ai/pipeline-backend/pkg/errors"
+
+	pb "github.com/instill-ai/protogen-go/vdp/pipeline/v1beta"
+)
+
+func TestCheckVariables(t *testing.T) {
+	c := quicktest.New(t)
+
+	minimum, maximum := 1.0, 10.0
+	minLength := 3
+
+	testCases := []struct {
+		name         string
+		variable     *datamodel.Variable
+		wantLocation string
+		wantErr      string
+	}{
+		{
+			name: "ok",
+			variable: &datamodel.Variable{
+				InstillFormat: "string",
+				Default:       "medium",
+				Enum:          []any{"small", "medium", "large"},
+				MinLength:     &minLength,
+			},
+		},
+		{
+			name:         "default not in enum",
+			variable:     &datamodel.Variable{InstillFormat: "string", Default: "huge", Enum: []any{"small", "large"}},
+			wantLocation: "variable.v.default",
+			wantErr:      "default value doesn't satisfy the variable constraints: value must be one of .*",
+		},
+		{
+			name:         "default out of range",
+			variable:     &datamodel.Variable{InstillFormat: "number", Default: 20, Minimum: &minimum, Maximum: &maximum},
+			wantLocation: "variable.v.default",
+			wantErr:      "default value doesn't satisfy the variable constraints: must be <= 10 but found 20",
+		},
+		{
+			name:         "inverted range",
+			variable:     &datamodel.Variable{InstillFormat: "number", Minimum: &maximum, Maximum: &minimum},
+			wantLocation: "variable.v.minimum",
+			wantErr:      "minimum is greater than maximum",
+		},
+		{
+			name:         "invalid pattern",
+			variable:     &datamodel.Variable{InstillFormat: "string", Pattern: "("},
+			wantLocation: "variable.v.pattern",
+			wantErr:      "invalid pattern: .*",
+		},
+	}
+
+	for _, tc := range testCases {
+		c.Run(tc.name, func(c *quicktest.C) {
+			errs := []*pb.PipelineValidationError{}
+			checkVariables(map[string]*datamodel.Variable{"v": tc.variable}, &errs)
+			if tc.wantErr == "" {
+				c.Check(errs, quicktest.HasLen, 0)
+				return
+			}
+			c.Assert(errs, quicktest.HasLen, 1)
+			c.Check(errs[0].Location, quicktest.Equals, tc.wantLocation)
+			c.Check(errs[0].Error, quicktest.Matches, tc.wantErr)
+		})
+	}
+}
+
+func TestVariablesSchema_Required(t *testing.T) {
+	c := quicktest.New(t)
+
+	sch := variablesSchema(map[string]*datamodel.Variable{
+		"a": {InstillFormat: "string", Required: true},
+		"b": {InstillFormat: "string"},
+		"c": {InstillFormat: "string", Required: true, Default: "x"},
+	})
+
+	required := sch.Fields["required"].GetListValue().AsSlice()
+	c.Check(required, quicktest.DeepEquals, []any{"a"})
+	_, hasRequired := sch.Fields["properties"].GetStructValue().Fields["a"].GetStructValue().Fields["required"]
+	c.Check(hasRequired, quicktest.IsFalse)
+}
+
+func TestCheckRecipeBindings(t *testing.T) {
+	c := quicktest.New(t)
+
+	output, err := structpb.NewStruct(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"texts": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"usage": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"tokens": map[string]any{"type": "integer"}},
+			},
+			"data": map[string]any{"type": "object"},
+		},
+	})
+	c.Assert(err, quicktest.IsNil)
+	dataSpecs := map[string]*pb.DataSpecification{
+		"llm":    {Input: &structpb.Struct{}, Output: output},
+		"nested": {Input: &structpb.Struct{}, Output: output},
+	}
+
+	r := &datamodel.Recipe{
+		Variable: map[string]*datamodel.Variable{"prompt": {InstillFormat: "string"}},
+		Component: datamodel.ComponentMap{
+			"llm": {
+				Type:      "openai",
+				Task:      "TASK_TEXT_GENERATION",
+				Condition: "${variable.promt} != null",
+				Input: map[string]any{
+					"prompt": "${variable.prompt}",
+					"list":   []any{"${variable.__PIPELINE_ID}", "${secret.INSTILL_SECRET}"},
+				},
+			},
+			"iter": {
+				Type:  datamodel.Iterator,
+				Input: "${llm.output.texts}",
+				Component: datamodel.ComponentMap{
+					"nested": {
+						Type:  "openai",
+						Task:  "TASK_TEXT_GENERATION",
+						Input: map[string]any{"prompt": "${iter.element} ${llm.output.usage.tokens} ${llm.output.data.anything}"},
+					},
+				},
+				OutputElements: map[string]string{"result": "${nested.output.texts[0]}"},
+			},
+		},
+		Output: map[string]*datamodel.Output{
+			"a": {Value: "${llm.output.text}"},
+			"b": {Value: "${iter.output.result} ${iter.output.results}"},
+			"c": {Value: "${nested.output.texts} ${iter.element} ${unknown.output.x}"},
+			"d": {Value: "${llm.output.usage.tokens.count} ${llm.outputs}"},
+		},
+	}
+
+	errs := checkRecipeBindings(r, dataSpecs)
+	got := make([][2]string, len(errs))
+	for i, e := range errs {
+		got[i] = [2]string{e.Location, e.Error}
+	}
+	c.Check(got, quicktest.DeepEquals, [][2]string{
+		{"component.llm.condition", `invalid reference ${variable.promt}: variable "promt" is not declared`},
+		{"output.a.value", `invalid reference ${llm.output.text}: field "text" doesn't exist in llm.output of task "TASK_TEXT_GENERATION"`},
+		{"output.b.value", `invalid reference ${iter.output.results}: iterator "iter" has no output element "results"`},
+		{"output.c.value", `invalid reference ${nested.output.texts}: component "nested" is nested in an iterator and can't be referenced from here`},
+		{"output.c.value", `invalid reference ${iter.element}: the element of iterator "iter" is only available to its nested components`},
+		{"output.c.value", `invalid reference ${unknown.output.x}: component "unknown" doesn't exist`},
+		{"output.d.value", `invalid reference ${llm.output.usage.tokens.count}: field "count" doesn't exist in llm.output.usage.tokens of task "TASK_TEXT_GENERATION"`},
+		{"output.d.value", `invalid reference ${llm.outputs}: unknown field "outputs", expected input, output or status`},
+	})
+}
+
+func TestCheckRecipeOnSave_UnknownType(t *testing.T) {
+	c := quicktest.New(t)
+
+	// The store has no definition, so every component type is unknown.
+	s := &service{component: &componentstore.Store{}}
+	r := &datamodel.Recipe{
+		Component: datamodel.ComponentMap{
+			"llm": {
+				Type:  "opnai",
+				Task:  "TASK_TEXT_GENERATION",
+				Input: map[string]any{"prompt": "${variable.prompt}"},
+			},
+			"iter": {
+				Type:  datamodel.Iterator,
+				Input: "${llm.output.texts}",
+				Component: datamodel.ComponentMap{
+					"nested": {Type: "unknown", Input: map[string]any{"x": "${iter.element}"}},
+				},
+				OutputElements: map[string]string{"result": "${nested.output.x}"},
+			},
+		},
+	}
+
+	err := s.checkRecipeOnSave(r)
+	c.Assert(errors.Is(err, errdomain.ErrInvalidArgument), quicktest.IsTrue)
+	c.Check(errmsg.Message(err), quicktest.Equals, "The pipeline recipe is invalid. "+
+		`component.iter.component.nested.type: unknown component type "unknown"; `+
+		`component.llm.input.prompt: invalid reference ${variable.prompt}: variable "prompt" is not declared; `+
+		`component.llm.type: unknown component type "opnai"`)
+}
+
+func TestCheckRecipeOnSave_StatusReference(t *testing.T) {
+	c := quicktest.New(t)
+
+	// The approvals don't need a component definition.
+	s := &service{component: &componentstore.Store{}}
+	r := &datamodel.Recipe{
+		Component: datamodel.ComponentMap{
+			"review": {Type: datamodel.Approval, Input: map[string]any{"draft": "text"}},
+			"escalate": {
+				Type:      datamodel.Approval,
+				Condition: "${review.status.errored}",
+				Input:     map[string]any{"draft": "text"},
+			},
+		},
+	}
+	c.Check(s.checkRecipeOnSave(r), quicktest.IsNil)
+
+	r.Component["escalate"].Condition = "${review.status.failed}"
+	err := s.checkRecipeOnSave(r)
+	c.Check(errmsg.Message(err), quicktest.Equals, "The pipeline recipe is invalid. "+
+		`component.escalate.condition: invalid reference ${review.status.failed}: unknown status field "failed"`)
+}
+
+func TestCheckIteratorOptions(t *testing.T) {
+	c := quicktest.New(t)
+
+	comps := datamodel.ComponentMap{
+		"iter": {
+			Type:            datamodel.Iterator,
+			BatchSize:       10,
+			MaxConcurrency:  -1,
+			ContinueOnError: true,
+			OutputElements:  map[string]string{"errors": "${llm.output.texts}"},
+			Component: datamodel.ComponentMap{
+				"llm": {Type: "openai", BatchSize: 2},
+			},
+		},
+	}
+
+	errs := []*pb.PipelineValidationError{}
+	checkIteratorOptions(comps, "component.", &errs)
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Location] = e.Error
+	}
+	c.Check(got, quicktest.DeepEquals, map[string]string{
+		"component.iter.maxConcurrency":          "must be a positive integer",
+		"component.iter.component.llm.batchSize": "only iterators support this option",
+		"component.iter.outputElements.errors":   `"errors" is reserved for the element errors when the iterator continues on error`,
+	})
+}
+
+func TestCheckIteratorInputs(t *testing.T) {
+	c := quicktest.New(t)
+
+	r := &datamodel.Recipe{
+		Variable: map[string]*datamodel.Variable{"n": {InstillFormat: "number"}},
+		Component: datamodel.ComponentMap{
+			"range": {
+				Type:      datamodel.Iterator,
+				Input:     "range(0, ${variable.n})",
+				Condition: "${range.element} > 1",
+			},
+			"list": {Type: datamodel.Iterator, Input: []any{"a", "b"}},
+			"arity": {
+				Type:      datamodel.Iterator,
+				Input:     "range(${variable.n})",
+				Condition: "${arity.element} > ${range.element}",
+			},
+		},
+	}
+
+	got := [][2]string{}
+	for _, e := range checkRecipeStructure(r) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	for _, e := range checkRecipeBindings(r, nil) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	c.Check(got, quicktest.ContentEquals, [][2]string{
+		{"component.arity.input", "range takes 2 or 3 arguments, got 1"},
+		{"component.list.input", "iterator input must be a reference or a range, got list"},
+		{"component.arity.condition", `invalid reference ${range.element}: the element of iterator "range" is only available to its nested components`},
+	})
+}
+
+func TestCheckSwitches(t *testing.T) {
+	c := quicktest.New(t)
+
+	r := &datamodel.Recipe{
+		Variable: map[string]*datamodel.Variable{"lang": {InstillFormat: "string"}},
+		Component: datamodel.ComponentMap{
+			"route": {
+				Type: datamodel.Switch,
+				Cases: []*datamodel.SwitchCase{
+					{
+						Component: datamodel.ComponentMap{"en": {Type: "openai"}},
+						Output:    map[string]string{"text": "${en.output.text}"},
+					},
+					{
+						Condition: `${variable.lang} == "fr"`,
+						Component: datamodel.ComponentMap{"fr": {Type: "openai"}},
+						Output:    map[string]string{"txt": "${en.output.text}"},
+					},
+				},
+			},
+			"empty": {Type: datamodel.Switch},
+			"llm":   {Type: "openai", Input: map[string]any{"text": "${route.output.text} ${route.output.txt}"}},
+		},
+	}
+
+	got := [][2]string{}
+	for _, e := range checkRecipeStructure(r) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	for _, e := range checkRecipeBindings(r, nil) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	c.Check(got, quicktest.ContentEquals, [][2]string{
+		{"component.empty.cases", "a switch needs at least one case"},
+		{"component.route.cases.0.condition", "only the last case can omit its condition, the next cases are unreachable"},
+		{"component.route.cases.1.output", `missing output "text", every case must define the same outputs`},
+		{"component.route.cases.1.output.txt", "the first case doesn't define this output, every case must define the same outputs"},
+		{"component.llm.input.text", `invalid reference ${route.output.txt}: switch "route" has no output "txt"`},
+		{"component.route.cases.1.output.txt", `invalid reference ${en.output.text}: component "en" is nested in a switch case and can't be referenced from here`},
+	})
+}
+
+func TestCheckSubPipelines(t *testing.T) {
+	c := quicktest.New(t)
+
+	r := &datamodel.Recipe{
+		Variable: map[string]*datamodel.Variable{"text": {InstillFormat: "string"}},
+		Component: datamodel.ComponentMap{
+			"summary": {
+				Type:     datamodel.SubPipeline,
+				Pipeline: "acme/summarize@v1.0.0",
+				Input:    map[string]any{"text": "${variable.text}"},
+			},
+			"invalid": {Type: datamodel.SubPipeline, Pipeline: "summarize", Input: "${variable.text}"},
+			"llm": {
+				Type:     "openai",
+				Pipeline: "acme/summarize",
+				Input:    map[string]any{"prompt": "${summary.output.anything} ${variable.txt}"},
+			},
+		},
+	}
+
+	got := [][2]string{}
+	for _, e := range checkRecipeStructure(r) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	for _, e := range checkRecipeBindings(r, nil) {
+		got = append(got, [2]string{e.Location, e.Error})
+	}
+	c.Check(got, quicktest.ContentEquals, [][2]string{
+		{"component.invalid.pipeline", `invalid pipeline reference "summarize", expected namespace/pipeline[@release]`},
+		{"component.invalid.input", "the input of a sub-pipeline must be an object with the variables of the called pipeline"},
+		{"component.llm.pipeline", "only sub-pipelines support this option"},
+		{"component.llm.input.prompt", `invalid reference ${variable.txt}: variable "txt" is not declared`},
+	})
+}
+
+func TestCheckExecutionPolicies(t *testing.T) {
+	c := quicktest.New(t)
+
+	comps := datamodel.ComponentMap{
+		"llm": {
+			Type:    "openai",
+			Retry:   &datamodel.RetryPolicy{MaxAttempts: 5, InitialInterval: "500ms", BackoffCoefficient: 2, NonRetryableErrorTypes: []string{"ComponentActivityError"}},
+			Timeout: &datamodel.TimeoutPolicy{StartToClose: "30s", ScheduleToClose: "5m"},
+		},
+		"json": {
+			Type:    "json",
+			Retry:   &datamodel.RetryPolicy{MaxAttempts: -1, InitialInterval: "soon", BackoffCoefficient: 0.5},
+			Timeout: &datamodel.TimeoutPolicy{StartToClose: "-1s"},
+		},
+		"iter": {
+			Type:    datamodel.Iterator,
+			Timeout: &datamodel.TimeoutPolicy{StartToClose: "1m"},
+			Component: datamodel.ComponentMap{
+				"nested": {Type: "openai", Timeout: &datamodel.TimeoutPolicy{ScheduleToClose: "1d"}},
+			},
+		},
+	}
+
+	errs := []*pb.PipelineValidationError{}
+	checkExecutionPolicies(comps, "component.", &errs)
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Location] = e.Error
+	}
+	c.Check(got, quicktest.DeepEquals, map[string]string{
+		"component.json.retry.maxAttempts":                        "must be a positive integer",
+		"component.json.retry.backoffCoefficient":                 "must be greater than or equal to 1",
+		"component.json.retry.initialInterval":                    `invalid duration "soon", expected a positive duration such as 30s or 1m30s`,
+		"component.json.timeout.startToClose":                     `invalid duration "-1s", expected a positive duration such as 30s or 1m30s`,
+		"component.iter.timeout":                                  "only regular components support this option",
+		"component.iter.component.nested.timeout.scheduleToClose": `invalid duration "1d", expected a positive duration such as 30s or 1m30s`,
+	})
+}
+
+func TestCheckExecutionPolicies_OnError(t *testing.T) {
+	c := quicktest.New(t)
+
+	comps := datamodel.ComponentMap{
+		"continue": {Type: "openai", OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorContinue}},
+		"fallback": {
+			Type:    "openai",
+			OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorFallback, Output: map[string]any{"texts": []any{"${variable.default-text}"}}},
+		},
+		"no-output": {Type: "openai", OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorFallback}},
+		"extra":     {Type: "openai", OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorFail, Output: map[string]any{"texts": []any{}}}},
+		"unknown":   {Type: "openai", OnError: &datamodel.OnErrorPolicy{Action: "retry"}},
+		"switch":    {Type: datamodel.Switch, OnError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorContinue}},
+	}
+
+	errs := []*pb.PipelineValidationError{}
+	checkExecutionPolicies(comps, "component.", &errs)
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Location] = e.Error
+	}
+	c.Check(got, quicktest.DeepEquals, map[string]string{
+		"component.no-output.onError.output": "the fallback action requires an output",
+		"component.extra.onError.output":     "only the fallback action supports an output",
+		"component.unknown.onError.action":   `invalid action "retry", expected fail, continue or fallback`,
+		"component.switch.onError":           "only regular components support this option",
+	})
+}
+
+func TestCheckExecutionPolicies_Cache(t *testing.T) {
+	c := quicktest.New(t)
+
+	comps := datamodel.ComponentMap{
+		"llm": {
+			Type:  "openai",
+			Input: map[string]any{"prompt": "${variable.prompt}"},
+			Cache: &datamodel.CachePolicy{TTL: "24h", Key: []string{"prompt"}},
+		},
+		"no-ttl":  {Type: "openai", Cache: &datamodel.CachePolicy{}},
+		"bad-ttl": {Type: "openai", Cache: &datamodel.CachePolicy{TTL: "1d"}},
+		"bad-key": {
+			Type:  "openai",
+			Input: map[string]any{"prompt": "${variable.prompt}"},
+			Cache: &datamodel.CachePolicy{TTL: "1h", Key: []string{"prompt", "model"}},
+		},
+		"iter": {Type: datamodel.Iterator, Cache: &datamodel.CachePolicy{TTL: "1h"}},
+	}
+
+	errs := []*pb.PipelineValidationError{}
+	checkExecutionPolicies(comps, "component.", &errs)
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Location] = e.Error
+	}
+	c.Check(got, quicktest.DeepEquals, map[string]string{
+		"component.no-ttl.cache.ttl":    "the cache requires a TTL",
+		"component.bad-ttl.cache.ttl":   `invalid duration "1d", expected a positive duration such as 30s or 1m30s`,
+		"component.bad-key.cache.key.1": `the input has no field "model"`,
+		"component.iter.cache":          "only regular components support this option",
+	})
+}
+
+func TestCheckApprovals(t *testing.T) {
+	c := quicktest.New(t)
+
+	comps := datamodel.ComponentMap{
+		"review": {
+			Type:            datamodel.Approval,
+			Input:           map[string]any{"draft": "${llm.output.texts[0]}"},
+			ApprovalTimeout: "24h",
+			TimeoutAction:   datamodel.ApprovalTimeoutReject,
+		},
+		"bad-input":   {Type: datamodel.Approval, Input: "${llm.output}"},
+		"bad-setup":   {Type: datamodel.Approval, Setup: map[string]any{"api-key": "x"}},
+		"bad-timeout": {Type: datamodel.Approval, ApprovalTimeout: "1d"},
+		"bad-action":  {Type: datamodel.Approval, ApprovalTimeout: "1h", TimeoutAction: "ignore"},
+		"no-timeout":  {Type: datamodel.Approval, TimeoutAction: datamodel.ApprovalTimeoutApprove},
+		"llm":         {Type: "openai", ApprovalTimeout: "1h"},
+	}
+
+	errs := []*pb.PipelineValidationError{}
+	checkApprovals(comps, "component.", &errs)
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Location] = e.Error
+	}
+	c.Check(got, quicktest.DeepEquals, map[string]string{
+		"component.bad-input.input":             "the input of an approval must be an object with the payload to review",
+		"component.bad-setup":                   "an approval doesn't take a setup, a task or components",
+		"component.bad-timeout.approvalTimeout": `invalid duration "1d", expected a positive duration such as 30s or 1m30s`,
+		"component.bad-action.timeoutAction":    `invalid action "ignore", expected approve, reject or fail`,
+		"component.no-timeout.timeoutAction":    "the timeout action requires an approval timeout",
+		"component.llm.approvalTimeout":         "only approvals support this option",
+	})
+}
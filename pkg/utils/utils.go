@@ -70,6 +70,9 @@ type PipelineUsageMetricData struct {
 	PipelineTriggerUID  string
 	TriggerTime         string
 	ComputeTimeDuration float64
+	// Cancelled is set, along with an errored status, when the trigger was
+	// cancelled.
+	Cancelled bool
 }
 
 // NewPipelineDataPoint transforms the information of a pipeline trigger into
@@ -94,6 +97,9 @@ func NewPipelineDataPoint(data PipelineUsageMetricData) *write.Point {
 		tags["pipeline_release_id"] = data.PipelineReleaseID
 		tags["pipeline_release_uid"] = data.PipelineReleaseUID
 	}
+	if data.Cancelled {
+		tags["status"] = "STATUS_CANCELLED"
+	}
 
 	fields := map[string]any{
 		"pipeline_trigger_id":   data.PipelineTriggerUID,
@@ -18,15 +18,34 @@ import (
 // TaskQueue is the Temporal task queue name for pipeline-backend
 const TaskQueue = "pipeline-backend"
 
+// MemoPipelineUIDKey is the key of the triggered pipeline UID in the memo of
+// the trigger workflows.
+const MemoPipelineUIDKey = "pipelineUID"
+
 // Worker interface
 type Worker interface {
 	TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPipelineWorkflowParam) error
 	SchedulePipelineWorkflow(ctx workflow.Context, param *SchedulePipelineWorkflowParam) error
 	ComponentActivity(ctx context.Context, param *ComponentActivityParam) (*ComponentActivityParam, error)
+	ComponentErrorActivity(ctx context.Context, param *ComponentErrorActivityParam) error
 	PreIteratorActivity(ctx context.Context, param *PreIteratorActivityParam) (*PreIteratorActivityResult, error)
 	PostIteratorActivity(ctx context.Context, param *PostIteratorActivityParam) error
+	PreSwitchActivity(ctx context.Context, param *PreSwitchActivityParam) (*PreSwitchActivityResult, error)
+	PostSwitchActivity(ctx context.Context, param *PostSwitchActivityParam) error
+	PreSubPipelineActivity(ctx context.Context, param *PreSubPipelineActivityParam) (*PreSubPipelineActivityResult, error)
+	PostSubPipelineActivity(ctx context.Context, param *PostSubPipelineActivityParam) error
+	PublishStreamEventActivity(ctx context.Context, param *PublishStreamEventActivityParam) error
+	PreApprovalActivity(ctx context.Context, param *PreApprovalActivityParam) (*PreApprovalActivityResult, error)
+	PostApprovalActivity(ctx context.Context, param *PostApprovalActivityParam) error
 	IncreasePipelineTriggerCountActivity(context.Context, recipe.SystemVariables) error
+	CreatePipelineRunActivity(ctx context.Context, param *CreatePipelineRunActivityParam) error
+	CompletePipelineRunActivity(ctx context.Context, param *CompletePipelineRunActivityParam) error
+	PurgeMemoryActivity(ctx context.Context, param *PurgeMemoryActivityParam) error
+	RetainMemoryActivity(ctx context.Context, param *RetainMemoryActivityParam) error
+	StoreTriggerResultActivity(ctx context.Context, param *StoreTriggerResultActivityParam) error
 	SchedulePipelineLoaderActivity(ctx context.Context, param *SchedulePipelineLoaderActivityParam) (*SchedulePipelineLoaderActivityResult, error)
+
+	ClearExpiredRunData(ctx context.Context)
 }
 
 // worker represents resources required to run Temporal workflow and activity
@@ -4,6 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/instill-ai/pipeline-backend/config"
 	"github.com/instill-ai/pipeline-backend/pkg/utils"
@@ -24,3 +30,127 @@ func (w *worker) writeNewDataPoint(ctx context.Context, data utils.PipelineUsage
 
 	return nil
 }
+
+// asyncResultRetention returns how long the result of an asynchronous
+// trigger of a namespace is stored.
+func asyncResultRetention(nsUID uuid.UUID) time.Duration {
+	cfg := config.Config.Server.AsyncResult
+	if retention, ok := cfg.NamespaceRetention[nsUID.String()]; ok {
+		return retention
+	}
+	return cfg.Retention
+}
+
+// marshalRunData marshals the inputs or the outputs of a pipeline run. They
+// aren't recorded, and nil is returned, when they exceed the configured size.
+func marshalRunData(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize := config.Config.Server.PipelineRun.MaxDataSize; maxSize > 0 && len(b) > maxSize*1024*1024 {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// jsonSize returns the length of the JSON representation of a component input
+// or output.
+func jsonSize(s *structpb.Struct) int64 {
+	b, err := protojson.Marshal(s)
+	if err != nil {
+		return 0
+	}
+	return int64(len(b))
+}
+
+// heartbeat records the heartbeat of an activity until the returned function
+// is called. The heartbeats let Temporal deliver a cancellation to the
+// activity, through its context.
+func heartbeat(ctx context.Context) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(componentHeartbeatTimeout / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				activity.RecordHeartbeat(ctx)
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// mockValue synthesizes a value that matches a JSON schema, for the dry runs.
+// The default or the first example of the schema is used when there's one,
+// otherwise the value is the zero value of the schema type.
+func mockValue(sch map[string]any) any {
+	if v, ok := sch["default"]; ok {
+		return v
+	}
+	if examples, ok := sch["examples"].([]any); ok && len(examples) > 0 {
+		return examples[0]
+	}
+	if enum, ok := sch["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	if v, ok := sch["const"]; ok {
+		return v
+	}
+	for _, kw := range []string{"anyOf", "oneOf"} {
+		if branches, ok := sch[kw].([]any); ok && len(branches) > 0 {
+			if branch, ok := branches[0].(map[string]any); ok {
+				return mockValue(branch)
+			}
+		}
+	}
+
+	typ, _ := sch["type"].(string)
+	if types, ok := sch["type"].([]any); ok && len(types) > 0 {
+		typ, _ = types[0].(string)
+	}
+	switch typ {
+	case "string":
+		return ""
+	case "number", "integer":
+		return 0
+	case "boolean":
+		return false
+	case "null":
+		return nil
+	case "array":
+		items, ok := sch["items"].(map[string]any)
+		if !ok {
+			return []any{}
+		}
+		return []any{mockValue(items)}
+	}
+
+	obj := map[string]any{}
+	if props, ok := sch["properties"].(map[string]any); ok {
+		for k, p := range props {
+			if p, ok := p.(map[string]any); ok {
+				obj[k] = mockValue(p)
+			}
+		}
+	}
+	if branches, ok := sch["allOf"].([]any); ok {
+		for _, branch := range branches {
+			branch, ok := branch.(map[string]any)
+			if !ok {
+				continue
+			}
+			if v, ok := mockValue(branch).(map[string]any); ok {
+				for k, p := range v {
+					obj[k] = p
+				}
+			}
+		}
+	}
+	return obj
+}
@@ -4,14 +4,16 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go/parser"
+	"sort"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"go.einride.tech/aip/filtering"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 	"go.uber.org/zap"
@@ -26,6 +28,7 @@ import (
 	"github.com/instill-ai/pipeline-backend/pkg/utils"
 	"github.com/instill-ai/x/errmsg"
 
+	componentbase "github.com/instill-ai/component/base"
 	componentstore "github.com/instill-ai/component/store"
 	mgmtpb "github.com/instill-ai/protogen-go/core/mgmt/v1beta"
 )
@@ -35,8 +38,36 @@ type TriggerPipelineWorkflowParam struct {
 	MemoryStorageKey *recipe.BatchMemoryKey
 	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
 	Mode             mgmtpb.Mode
-	IsIterator       bool
-	IsStreaming      bool
+	// IsIterator is set for the child workflows that run nested component
+	// groups (iterations and switch cases), which don't count as triggers.
+	IsIterator  bool
+	IsStreaming bool
+
+	// The workflows that run the iterations of an iterator process a chunk
+	// of its elements. Their memory keys are derived from MemoryKeyPrefix,
+	// the ID of the iteration, instead of the workflow ID, and their batch
+	// items start at BatchOffset in the iteration.
+	MemoryKeyPrefix string
+	BatchOffset     int
+
+	// IsolateBatchItems makes each batch item succeed or fail on its own.
+	// The items that fail are marked as errored in memory instead of
+	// failing the workflow.
+	IsolateBatchItems bool
+
+	// DryRun replaces the component executions with mocked outputs: the
+	// ones in DryRunMocks, keyed by component ID, or outputs synthesized
+	// from the output schema of the component task.
+	DryRun      bool
+	DryRunMocks map[string]map[string]any
+
+	// SourceTriggerID links the run to the trigger it was started from, e.g.
+	// by a replay.
+	SourceTriggerID string
+	// CompletedComponents holds the components that completed in the run a
+	// resumed trigger is started from. Their memory is copied to the trigger
+	// memory, so they aren't executed again.
+	CompletedComponents []string
 }
 
 type SchedulePipelineWorkflowParam struct {
@@ -57,6 +88,7 @@ type SchedulePipelineLoaderActivityResult struct {
 // ComponentActivityParam represents the parameters for TriggerActivity
 type ComponentActivityParam struct {
 	WorkflowID       string
+	BatchOffset      int
 	MemoryStorageKey *recipe.BatchMemoryKey
 	ID               string
 	UpstreamIDs      []string
@@ -66,14 +98,39 @@ type ComponentActivityParam struct {
 	Type             string
 	Task             string
 	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+	// IsolateBatchItems executes the batch items one by one when the batch
+	// execution fails, so only the items that fail are errored.
+	IsolateBatchItems bool
+	// ErroredItems is set in the activity result with the batch items that
+	// failed in isolation.
+	ErroredItems []int
+	Cache        *datamodel.CachePolicy
+	// DryRun skips the execution of the component, which outputs Mock or,
+	// if it's nil, an output synthesized from its task schema.
+	DryRun bool
+	Mock   map[string]any
 }
 
-type PreIteratorActivityParam struct {
+// ComponentErrorActivityParam holds the error of a failed component
+// execution and the policy to apply.
+type ComponentErrorActivityParam struct {
 	WorkflowID       string
+	BatchOffset      int
 	MemoryStorageKey *recipe.BatchMemoryKey
 	ID               string
 	UpstreamIDs      []string
-	Input            string
+	Condition        string
+	Error            string
+	OnError          *datamodel.OnErrorPolicy
+}
+
+type PreIteratorActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	Input            any
+	Condition        string
 	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
 }
 
@@ -85,20 +142,166 @@ type PreIteratorActivityResult struct {
 
 type PostIteratorActivityParam struct {
 	WorkflowID        string
+	BatchOffset       int
 	MemoryStorageKeys []*recipe.BatchMemoryKey
 	ID                string
 	OutputElements    map[string]string
+	ElementErrors     []map[int]string
+	ContinueOnError   bool
 	SystemVariables   recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
 }
 
-var tracer = otel.Tracer("pipeline-backend.temporal.tracer")
+type PreSwitchActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+// PreSwitchActivityResult holds, for each batch item, the index of the
+// matching case or -1 if the switch is skipped, and the child workflow that
+// runs the case.
+type PreSwitchActivityResult struct {
+	Cases             []int
+	ChildWorkflowIDs  []string
+	MemoryStorageKeys []*recipe.BatchMemoryKey
+}
+
+type PostSwitchActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	Cases            []int
+	ChildWorkflowIDs []string
+	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+type PreSubPipelineActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	UpstreamIDs      []string
+	Condition        string
+	Input            any
+	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+// PreSubPipelineActivityResult holds, for each batch item, the child
+// workflow that triggers the callee and its trigger memory. The child
+// workflow ID is empty if the component is skipped.
+type PreSubPipelineActivityResult struct {
+	ChildWorkflowIDs  []string
+	MemoryStorageKeys []*recipe.BatchMemoryKey
+}
+
+type PostSubPipelineActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	ChildWorkflowIDs []string
+	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+// CreatePipelineRunActivityParam holds the trigger whose run is recorded.
+type CreatePipelineRunActivityParam struct {
+	MemoryStorageKey *recipe.BatchMemoryKey
+	SystemVariables  recipe.SystemVariables
+	Mode             mgmtpb.Mode
+	StartedTime      time.Time
+	SourceTriggerID  string
+}
+
+// CompletePipelineRunActivityParam holds the result of a recorded run.
+type CompletePipelineRunActivityParam struct {
+	MemoryStorageKey *recipe.BatchMemoryKey
+	SystemVariables  recipe.SystemVariables
+	StartedTime      time.Time
+	Status           datamodel.RunStatus
+	Error            string
+}
+
+// PurgeMemoryActivityParam holds the trigger whose memory is purged.
+type PurgeMemoryActivityParam struct {
+	WorkflowID string
+	// PipelineTriggerID is set to purge the pending approval requests of the
+	// trigger too.
+	PipelineTriggerID string
+}
+
+// RetainMemoryActivityParam holds the trigger whose memory is retained.
+type RetainMemoryActivityParam struct {
+	WorkflowID string
+}
+
+// StoreTriggerResultActivityParam holds the trigger whose result is stored.
+type StoreTriggerResultActivityParam struct {
+	WorkflowID string
+	Retention  time.Duration
+}
+
+// PublishStreamEventActivityParam holds an event of a streamed trigger.
+type PublishStreamEventActivityParam struct {
+	TriggerID string
+	Event     *recipe.StreamEvent
+}
+
+// PreApprovalActivityParam holds the input of an approval component.
+// ExecutionID is the workflow execution that waits for the reviews.
+type PreApprovalActivityParam struct {
+	WorkflowID       string
+	ExecutionID      string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	UpstreamIDs      []string
+	Condition        string
+	Input            any
+	Timeout          string
+	SystemVariables  recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+// PreApprovalActivityResult holds the approval request of each batch item
+// that waits for a review.
+type PreApprovalActivityResult struct {
+	Requests map[int]string
+}
+
+type PostApprovalActivityParam struct {
+	WorkflowID       string
+	BatchOffset      int
+	MemoryStorageKey *recipe.BatchMemoryKey
+	ID               string
+	Requests         map[int]string
+	// Decisions holds the reviews that were received. The missing ones timed
+	// out.
+	Decisions       map[int]*ApprovalDecision
+	TimeoutAction   string
+	SystemVariables recipe.SystemVariables // TODO: we should store vars directly in trigger memory.
+}
+
+// ApprovalDecision is the review of an approval request. It's sent as a
+// signal to the workflow that waits for it.
+type ApprovalDecision struct {
+	BatchIndex int
+	Approved   bool
+	// Payload replaces the reviewed input when it's set.
+	Payload     map[string]any
+	ReviewerUID string
+	Comment     string
+}
 
-// WorkFlowSignal is used by sChan to signal the status of components in the Workflow.
-type WorkFlowSignal struct {
-	ID     string
-	Status string
+// ApprovalSignalName is the signal that delivers the reviews of an approval
+// component.
+func ApprovalSignalName(compID string) string {
+	return "approval:" + compID
 }
 
+var tracer = otel.Tracer("pipeline-backend.temporal.tracer")
+
 // TriggerPipelineWorkflow is a pipeline trigger workflow definition.
 // The workflow is only responsible for orchestrating the DAG, not processing or reading/writing the data.
 // All data processing should be done in activities.
@@ -112,51 +315,6 @@ func (w *worker) TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPip
 	logger, _ := logger.GetZapLogger(sCtx)
 	logger.Info("TriggerPipelineWorkflow started")
 
-	// Inline function to initialize the channel only if streaming is active
-	initChan := func() chan WorkFlowSignal {
-		if param.IsStreaming {
-			return make(chan WorkFlowSignal, 100) // 100 is probably more than enough
-		}
-		return nil
-	}
-
-	// sChan is used to signal from the Workflow to the QueryHandler if an Activity is completed.
-	// The QueryHandler will be called by the client to get the status of the Workflow in order
-	// to act accordingly e.g. signal partial completion of the Workflow. The buffer size is set to 100 but
-	// can be adjusted based on the expected number of components in the Workflow.
-	var sChan = initChan()
-	const statusStep = "step"
-	const statusCompleted = "completed"
-
-	if param.IsStreaming {
-		logger.Debug("streaming is active")
-		// sChan is used to signal from the Workflow to the QueryHandler if an Activity is completed.
-		// The QueryHandler will be called by the client to get the status of the Workflow in order
-		// act accordingly e.g. signal partial completion of the Workflow. The buffer size is set to 100 but
-		// can be adjusted based on the expected number of components in the Workflow.
-		var sChan2 = make(chan WorkFlowSignal, 100)
-		sChan = sChan2
-
-		// Register query handler for workflow status
-		err := workflow.SetQueryHandler(ctx, "workflowStatusQuery", func() (WorkFlowSignal, error) {
-			select {
-			case msg := <-sChan:
-				if len(msg.Status) == 0 {
-					return WorkFlowSignal{}, nil
-				}
-				return msg, nil
-			case <-time.After(time.Second * 3):
-				return WorkFlowSignal{Status: "timeout"}, nil
-			}
-		})
-		if err != nil {
-			return err
-		}
-
-		sChan <- WorkFlowSignal{Status: "started"}
-		defer close(sChan)
-	}
-
 	var ownerType mgmtpb.OwnerType
 	switch param.SystemVariables.PipelineOwnerType {
 	case resource.Organization:
@@ -191,6 +349,10 @@ func (w *worker) TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPip
 		dataPoint.RequesterType = mgmtpb.OwnerType_OWNER_TYPE_ORGANIZATION
 	}
 
+	// The dry runs don't execute the components, so they aren't counted as
+	// triggers and don't record usage.
+	recordsUsage := !param.DryRun
+
 	ao := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout) * time.Second,
 		RetryPolicy: &temporal.RetryPolicy{
@@ -214,7 +376,12 @@ func (w *worker) TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPip
 		return err
 	}
 
+	// The memory of the workflow is stored under its ID, except for chunks of
+	// an iteration, which share the memory layout of the iteration.
 	workflowID := workflow.GetInfo(ctx).WorkflowExecution.ID
+	if param.MemoryKeyPrefix != "" {
+		workflowID = param.MemoryKeyPrefix
+	}
 
 	for i := range param.BatchSize {
 		if param.MemoryStorageKey.Components[i] == nil {
@@ -222,131 +389,369 @@ func (w *worker) TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPip
 		}
 	}
 
-	// The components in the same group can be executed in parallel
-	for group := range orderedComp {
-		futures := []workflow.Future{}
-		for compID, comp := range orderedComp[group] {
-			upstreamIDs := dag.GetUpstreamCompIDs(compID)
+	// erroredItems holds the batch items that failed in isolation.
+	erroredItems := map[int]bool{}
 
-			switch comp.Type {
-			default:
-				futures = append(futures, workflow.ExecuteActivity(ctx, w.ComponentActivity, &ComponentActivityParam{
-					WorkflowID:       workflowID,
-					ID:               compID,
-					UpstreamIDs:      upstreamIDs,
-					Type:             comp.Type,
-					Task:             comp.Task,
-					Input:            comp.Input.(map[string]any),
-					Setup:            comp.Setup,
-					Condition:        comp.Condition,
-					MemoryStorageKey: param.MemoryStorageKey,
-					SystemVariables:  param.SystemVariables,
-				}))
+	// Each component runs in its own coroutine and starts as soon as its
+	// upstream components complete, so a slow component only holds back its
+	// downstream components. The coroutines are started in topological order
+	// to keep the workflow deterministic.
+	runComponent := func(ctx workflow.Context, compID string, comp *datamodel.Component, upstreamIDs []string) error {
+		var err error
+
+		switch comp.Type {
+		default:
+			var result ComponentActivityParam
+			compCtx := workflow.WithActivityOptions(ctx, componentActivityOptions(ao, comp))
+			if err := workflow.ExecuteActivity(compCtx, w.ComponentActivity, &ComponentActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				UpstreamIDs:      upstreamIDs,
+				Type:             comp.Type,
+				Task:             comp.Task,
+				Input:            comp.Input.(map[string]any),
+				Setup:            comp.Setup,
+				Condition:        comp.Condition,
+				MemoryStorageKey: param.MemoryStorageKey,
+				SystemVariables:  param.SystemVariables,
+				// An on-error policy takes precedence over the isolation of
+				// the batch items.
+				IsolateBatchItems: param.IsolateBatchItems && (comp.OnError == nil || comp.OnError.Action == datamodel.OnErrorFail),
+				Cache:             comp.Cache,
+				DryRun:            param.DryRun,
+				Mock:              param.DryRunMocks[compID],
+			}).Get(ctx, &result); err != nil {
+				if temporal.IsCanceledError(err) {
+					return err
+				}
+				if comp.OnError == nil || comp.OnError.Action == datamodel.OnErrorFail {
+					if recordsUsage {
+						w.writeErrorDataPoint(sCtx, err, span, startTime, &dataPoint)
+					}
 
-			case datamodel.Iterator:
-				//TODO tillknuesting: support intermediate result streaming for Iterator
+					// ComponentActivity is responsible of returning a temporal
+					// application error with the relevant information. Wrapping
+					// the error here prevents the client from accessing the error
+					// message from the activity.
+					return err
+				}
 
-				preIteratorResult := &PreIteratorActivityResult{}
-				if err = workflow.ExecuteActivity(ctx, w.PreIteratorActivity, &PreIteratorActivityParam{
+				// The error is recorded in the component memory and the
+				// pipeline goes on.
+				if err := workflow.ExecuteActivity(ctx, w.ComponentErrorActivity, &ComponentErrorActivityParam{
 					WorkflowID:       workflowID,
+					BatchOffset:      param.BatchOffset,
 					ID:               compID,
 					UpstreamIDs:      upstreamIDs,
-					Input:            comp.Input.(string),
-					SystemVariables:  param.SystemVariables,
+					Condition:        comp.Condition,
+					Error:            errorMessage(err),
+					OnError:          comp.OnError,
 					MemoryStorageKey: param.MemoryStorageKey,
-				}).Get(ctx, &preIteratorResult); err != nil {
+				}).Get(ctx, nil); err != nil {
 					return err
 				}
+			}
+			for _, idx := range result.ErroredItems {
+				erroredItems[idx] = true
+			}
 
-				itFutures := []workflow.Future{}
-				for iter := range param.BatchSize {
-					childWorkflowOptions := workflow.ChildWorkflowOptions{
-						TaskQueue:                TaskQueue,
-						WorkflowID:               preIteratorResult.ChildWorkflowIDs[iter],
-						WorkflowExecutionTimeout: time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout) * time.Second,
-						RetryPolicy: &temporal.RetryPolicy{
-							MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
-						},
-					}
+		case datamodel.Iterator:
+			//TODO tillknuesting: support intermediate result streaming for Iterator
+
+			preIteratorResult := &PreIteratorActivityResult{}
+			if err = workflow.ExecuteActivity(ctx, w.PreIteratorActivity, &PreIteratorActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				Input:            comp.Input,
+				Condition:        comp.Condition,
+				SystemVariables:  param.SystemVariables,
+				MemoryStorageKey: param.MemoryStorageKey,
+			}).Get(ctx, &preIteratorResult); err != nil {
+				return err
+			}
 
-					itFutures = append(itFutures, workflow.ExecuteChildWorkflow(
-						workflow.WithChildOptions(ctx, childWorkflowOptions),
-						"TriggerPipelineWorkflow",
-						&TriggerPipelineWorkflowParam{
-							IsIterator:       true,
-							BatchSize:        preIteratorResult.ElementSize[iter],
-							MemoryStorageKey: preIteratorResult.MemoryStorageKeys[iter],
-							SystemVariables:  param.SystemVariables,
-							Mode:             mgmtpb.Mode_MODE_SYNC,
-						}))
+			elementErrors, err := w.executeIterations(ctx, compID, comp, preIteratorResult, param)
+			if err != nil {
+				logger.Error(fmt.Sprintf("unable to execute iterator workflow: %s", err.Error()))
+				return err
+			}
 
-				}
-				for iter := 0; iter < param.BatchSize; iter++ {
-					err = itFutures[iter].Get(ctx, nil)
-					if err != nil {
-						logger.Error(fmt.Sprintf("unable to execute iterator workflow: %s", err.Error()))
-						return err
-					}
-				}
+			if err = workflow.ExecuteActivity(ctx, w.PostIteratorActivity, &PostIteratorActivityParam{
+				WorkflowID:        workflowID,
+				BatchOffset:       param.BatchOffset,
+				ID:                compID,
+				MemoryStorageKeys: preIteratorResult.MemoryStorageKeys,
+				OutputElements:    comp.OutputElements,
+				ElementErrors:     elementErrors,
+				ContinueOnError:   comp.ContinueOnError,
+				SystemVariables:   param.SystemVariables,
+			}).Get(ctx, nil); err != nil {
+				return err
+			}
 
-				if err = workflow.ExecuteActivity(ctx, w.PostIteratorActivity, &PostIteratorActivityParam{
-					WorkflowID:        workflowID,
-					ID:                compID,
-					MemoryStorageKeys: preIteratorResult.MemoryStorageKeys,
-					OutputElements:    comp.OutputElements,
-					SystemVariables:   param.SystemVariables,
-				}).Get(ctx, nil); err != nil {
-					return err
-				}
+		case datamodel.Switch:
+			preSwitchResult := &PreSwitchActivityResult{}
+			if err = workflow.ExecuteActivity(ctx, w.PreSwitchActivity, &PreSwitchActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				SystemVariables:  param.SystemVariables,
+				MemoryStorageKey: param.MemoryStorageKey,
+			}).Get(ctx, &preSwitchResult); err != nil {
+				return err
 			}
 
-		}
+			if err := w.executeSwitchCases(ctx, preSwitchResult, param); err != nil {
+				logger.Error(fmt.Sprintf("unable to execute switch workflow: %s", err.Error()))
+				return err
+			}
 
-		for idx := range futures {
-			var result ComponentActivityParam
-			err = futures[idx].Get(ctx, &result)
+			if err = workflow.ExecuteActivity(ctx, w.PostSwitchActivity, &PostSwitchActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				MemoryStorageKey: param.MemoryStorageKey,
+				Cases:            preSwitchResult.Cases,
+				ChildWorkflowIDs: preSwitchResult.ChildWorkflowIDs,
+				SystemVariables:  param.SystemVariables,
+			}).Get(ctx, nil); err != nil {
+				return err
+			}
+
+		case datamodel.Approval:
+			preApprovalResult := &PreApprovalActivityResult{}
+			if err = workflow.ExecuteActivity(ctx, w.PreApprovalActivity, &PreApprovalActivityParam{
+				WorkflowID:       workflowID,
+				ExecutionID:      workflow.GetInfo(ctx).WorkflowExecution.ID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				UpstreamIDs:      upstreamIDs,
+				Condition:        comp.Condition,
+				Input:            comp.Input,
+				Timeout:          comp.ApprovalTimeout,
+				SystemVariables:  param.SystemVariables,
+				MemoryStorageKey: param.MemoryStorageKey,
+			}).Get(ctx, &preApprovalResult); err != nil {
+				return err
+			}
+
+			decisions, err := waitForApprovals(ctx, compID, comp, preApprovalResult.Requests)
 			if err != nil {
-				w.writeErrorDataPoint(sCtx, err, span, startTime, &dataPoint)
+				return err
+			}
 
-				// ComponentActivity is responsible of returning a temporal
-				// application error with the relevant information. Wrapping
-				// the error here prevents the client from accessing the error
-				// message from the activity.
+			if err = workflow.ExecuteActivity(ctx, w.PostApprovalActivity, &PostApprovalActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				MemoryStorageKey: param.MemoryStorageKey,
+				Requests:         preApprovalResult.Requests,
+				Decisions:        decisions,
+				TimeoutAction:    comp.TimeoutAction,
+				SystemVariables:  param.SystemVariables,
+			}).Get(ctx, nil); err != nil {
 				return err
 			}
-			if param.IsStreaming {
-				sChan <- WorkFlowSignal{Status: statusStep, ID: result.ID}
+
+		case datamodel.SubPipeline:
+			preSubPipelineResult := &PreSubPipelineActivityResult{}
+			if err = workflow.ExecuteActivity(ctx, w.PreSubPipelineActivity, &PreSubPipelineActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				UpstreamIDs:      upstreamIDs,
+				Condition:        comp.Condition,
+				Input:            comp.Input,
+				SystemVariables:  param.SystemVariables,
+				MemoryStorageKey: param.MemoryStorageKey,
+			}).Get(ctx, &preSubPipelineResult); err != nil {
+				return err
 			}
-		}
 
-		for batchIdx := range param.BatchSize {
-			for compID := range orderedComp[group] {
-				param.MemoryStorageKey.Components[batchIdx][compID] = fmt.Sprintf("%s:%d:%s:%s", workflowID, batchIdx, recipe.SegComponent, compID)
+			if err := w.executeSubPipelines(ctx, comp.Callee, preSubPipelineResult, param); err != nil {
+				logger.Error(fmt.Sprintf("unable to execute sub-pipeline workflow: %s", err.Error()))
+				return err
 			}
-		}
-		if param.IsStreaming {
-			// if we don't sleep, there will be race condition between Redis write and read
-			if err := workflow.Sleep(ctx, time.Millisecond*10); err != nil {
-				logger.Error(fmt.Sprintf(" workflow unable to sleep: %s", err.Error()))
+
+			if err = workflow.ExecuteActivity(ctx, w.PostSubPipelineActivity, &PostSubPipelineActivityParam{
+				WorkflowID:       workflowID,
+				BatchOffset:      param.BatchOffset,
+				ID:               compID,
+				MemoryStorageKey: param.MemoryStorageKey,
+				ChildWorkflowIDs: preSubPipelineResult.ChildWorkflowIDs,
+				SystemVariables:  param.SystemVariables,
+			}).Get(ctx, nil); err != nil {
+				return err
 			}
 		}
+		return nil
+	}
+
+	// The dry runs aren't recorded as pipeline runs.
+	runStartTime := workflow.Now(ctx)
+	if !param.IsIterator && !param.DryRun {
+		if err := workflow.ExecuteActivity(ctx, w.CreatePipelineRunActivity, &CreatePipelineRunActivityParam{
+			MemoryStorageKey: param.MemoryStorageKey,
+			SystemVariables:  param.SystemVariables,
+			Mode:             param.Mode,
+			StartedTime:      runStartTime,
+			SourceTriggerID:  param.SourceTriggerID,
+		}).Get(ctx, nil); err != nil {
+			logger.Warn("recording pipeline run", zap.Error(err))
+		}
+	}
+
+	ctx, cancel := workflow.WithCancel(ctx)
+	defer cancel()
+
+	completed := map[string]bool{}
+	for _, compID := range param.CompletedComponents {
+		completed[compID] = true
+	}
+
+	compDone := map[string]workflow.Future{}
+	selector := workflow.NewSelector(ctx)
+	var firstErr error
+	for _, group := range orderedComp {
+		compIDs := make([]string, 0, len(group))
+		for compID := range group {
+			compIDs = append(compIDs, compID)
+		}
+		sort.Strings(compIDs)
+
+		for _, compID := range compIDs {
+			comp := group[compID]
+			upstreamIDs := dag.GetUpstreamCompIDs(compID)
+			future, settable := workflow.NewFuture(ctx)
+			compDone[compID] = future
+
+			workflow.Go(ctx, func(ctx workflow.Context) {
+				for _, upstreamID := range upstreamIDs {
+					if err := compDone[upstreamID].Get(ctx, nil); err != nil {
+						settable.SetError(err)
+						return
+					}
+				}
+				// The memory of the components completed in a resumed run
+				// is already in the trigger memory.
+				if !completed[compID] {
+					if err := runComponent(ctx, compID, comp, upstreamIDs); err != nil {
+						settable.SetError(err)
+						return
+					}
+				}
+
+				// The downstream components can read the memory of the
+				// component once its key is registered.
+				for batchIdx := range param.BatchSize {
+					param.MemoryStorageKey.Components[batchIdx][compID] = fmt.Sprintf("%s:%d:%s:%s", workflowID, param.BatchOffset+batchIdx, recipe.SegComponent, compID)
+				}
+				if param.IsStreaming {
+					w.publishStreamEvent(ctx, param, &recipe.StreamEvent{
+						Type:        recipe.StreamEventComponent,
+						ComponentID: compID,
+					})
+				}
+				settable.Set(nil, nil)
+			})
+
+			selector.AddFuture(future, func(f workflow.Future) {
+				if err := f.Get(ctx, nil); err != nil && firstErr == nil {
+					firstErr = err
+				}
+			})
+		}
 	}
 
-	if param.IsStreaming {
-		sChan <- WorkFlowSignal{Status: statusCompleted}
+	for range compDone {
+		selector.Select(ctx)
+		if firstErr == nil {
+			continue
+		}
+		if temporal.IsCanceledError(firstErr) && !param.IsIterator {
+			// The trigger was cancelled. The running components and child
+			// workflows received the cancellation too.
+			dataPoint.ComputeTimeDuration = time.Since(startTime).Seconds()
+			dataPoint.Status = mgmtpb.Status_STATUS_ERRORED
+			dataPoint.Cancelled = true
+			if recordsUsage {
+				if err := w.writeNewDataPoint(sCtx, dataPoint); err != nil {
+					logger.Warn(err.Error())
+				}
+			}
+			w.purgeMemory(ctx, &PurgeMemoryActivityParam{
+				WorkflowID:        workflowID,
+				PipelineTriggerID: param.SystemVariables.PipelineTriggerID,
+			})
+		}
+		if !param.IsIterator {
+			status := datamodel.RunStatusFailed
+			if temporal.IsCanceledError(firstErr) {
+				status = datamodel.RunStatusCancelled
+			} else {
+				// The memory is kept so the trigger can be resumed from the
+				// failed component.
+				if err := workflow.ExecuteActivity(ctx, w.RetainMemoryActivity, &RetainMemoryActivityParam{
+					WorkflowID: workflowID,
+				}).Get(ctx, nil); err != nil {
+					logger.Warn("retaining trigger memory", zap.Error(err))
+				}
+			}
+			w.completePipelineRun(ctx, param, runStartTime, status, errorMessage(firstErr))
+
+			// The operation of a failed asynchronous trigger doesn't read
+			// its memory.
+			if status == datamodel.RunStatusFailed && storesResult(ctx, param) {
+				w.purgeMemory(ctx, &PurgeMemoryActivityParam{WorkflowID: workflowID})
+			}
+		}
+		// The components that are still running are cancelled.
+		return firstErr
 	}
 
 	dataPoint.ComputeTimeDuration = time.Since(startTime).Seconds()
 	dataPoint.Status = mgmtpb.Status_STATUS_COMPLETED
 
 	if !param.IsIterator {
+		w.completePipelineRun(ctx, param, runStartTime, datamodel.RunStatusCompleted, "")
+	}
+
+	if !param.IsIterator && recordsUsage {
 		// TODO: we should check whether to collect failed component or not
 		if err := workflow.ExecuteActivity(ctx, w.IncreasePipelineTriggerCountActivity, param.SystemVariables).Get(ctx, nil); err != nil {
 			return fmt.Errorf("updating pipeline trigger count: %w", err)
 		}
 
-		if err := w.writeNewDataPoint(sCtx, dataPoint); err != nil {
-			logger.Warn(err.Error())
+		if !param.IsolateBatchItems {
+			if err := w.writeNewDataPoint(sCtx, dataPoint); err != nil {
+				logger.Warn(err.Error())
+			}
+		} else {
+			// When the batch items are isolated, the usage is recorded per
+			// item with its own status.
+			for idx := range param.BatchSize {
+				itemDataPoint := dataPoint
+				if erroredItems[idx] {
+					itemDataPoint.Status = mgmtpb.Status_STATUS_ERRORED
+				}
+				if err := w.writeNewDataPoint(sCtx, itemDataPoint); err != nil {
+					logger.Warn(err.Error())
+				}
+			}
+		}
+	}
+
+	// The operation of an asynchronous trigger is served from the stored
+	// result, so the memory can be purged. If the result can't be stored, the
+	// operation is still served from the memory.
+	if storesResult(ctx, param) {
+		if err := workflow.ExecuteActivity(ctx, w.StoreTriggerResultActivity, &StoreTriggerResultActivityParam{
+			WorkflowID: workflowID,
+			Retention:  asyncResultRetention(param.SystemVariables.PipelineOwnerUID),
+		}).Get(ctx, nil); err != nil {
+			logger.Warn("storing trigger result", zap.Error(err))
 		}
 	}
 
@@ -355,218 +760,1401 @@ func (w *worker) TriggerPipelineWorkflow(ctx workflow.Context, param *TriggerPip
 	return nil
 }
 
-func (w *worker) ComponentActivity(ctx context.Context, param *ComponentActivityParam) (*ComponentActivityParam, error) {
-	logger, _ := logger.GetZapLogger(ctx)
-	logger.Info("ComponentActivity started")
-
-	batchMemory, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+// waitForApprovals blocks until every approval request of a component is
+// reviewed or its timeout expires. It returns the reviews that were received.
+func waitForApprovals(ctx workflow.Context, compID string, comp *datamodel.Component, requests map[int]string) (map[int]*ApprovalDecision, error) {
+	decisions := make(map[int]*ApprovalDecision, len(requests))
+	if len(requests) == 0 {
+		return decisions, nil
 	}
 
-	compInputs, idxMap, err := w.processInput(batchMemory, param.ID, param.UpstreamIDs, param.Condition, param.Input)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	var timer workflow.Future
+	if d, err := time.ParseDuration(comp.ApprovalTimeout); err == nil && d > 0 {
+		timer = workflow.NewTimer(ctx, d)
 	}
 
-	cons, err := w.processSetup(batchMemory, param.Setup)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	signals := workflow.GetSignalChannel(ctx, ApprovalSignalName(compID))
+	timedOut := false
+	for len(decisions) < len(requests) && !timedOut {
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, _ bool) {
+			decision := &ApprovalDecision{}
+			c.Receive(ctx, decision)
+			// Only the first review of a pending request is kept.
+			if _, ok := requests[decision.BatchIndex]; !ok {
+				return
+			}
+			if _, ok := decisions[decision.BatchIndex]; !ok {
+				decisions[decision.BatchIndex] = decision
+			}
+		})
+		if timer != nil {
+			selector.AddFuture(timer, func(workflow.Future) { timedOut = true })
+		}
+		selector.AddReceive(ctx.Done(), func(workflow.ReceiveChannel, bool) {})
+		selector.Select(ctx)
+
+		if ctx.Err() != nil {
+			return nil, temporal.NewCanceledError()
+		}
 	}
-	sysVars, err := recipe.GenerateSystemVariables(ctx, param.SystemVariables)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	return decisions, nil
+}
+
+// componentHeartbeatTimeout is the time after which a component execution
+// that doesn't heartbeat is considered lost.
+const componentHeartbeatTimeout = time.Minute
+
+// componentActivityOptions applies the retry and timeout policies of a
+// component on top of the default activity options. The durations are
+// validated when the recipe is saved, the invalid ones are ignored.
+func componentActivityOptions(ao workflow.ActivityOptions, comp *datamodel.Component) workflow.ActivityOptions {
+	if t := comp.Timeout; t != nil {
+		if d, err := time.ParseDuration(t.StartToClose); err == nil && d > 0 {
+			ao.StartToCloseTimeout = d
+		}
+		if d, err := time.ParseDuration(t.ScheduleToClose); err == nil && d > 0 {
+			ao.ScheduleToCloseTimeout = d
+		}
 	}
 
-	// Note: we assume that setup in the batch are all the same
-	executionParams := componentstore.ExecutionParams{
-		ComponentID:           param.ID,
-		ComponentDefinitionID: param.Type,
-		SystemVariables:       sysVars,
-		Setup:                 cons[0],
-		Task:                  param.Task,
+	if r := comp.Retry; r != nil {
+		policy := temporal.RetryPolicy{}
+		if ao.RetryPolicy != nil {
+			policy = *ao.RetryPolicy
+		}
+		if r.MaxAttempts > 0 {
+			policy.MaximumAttempts = int32(r.MaxAttempts)
+		}
+		if d, err := time.ParseDuration(r.InitialInterval); err == nil && d > 0 {
+			policy.InitialInterval = d
+		}
+		if r.BackoffCoefficient >= 1 {
+			policy.BackoffCoefficient = r.BackoffCoefficient
+		}
+		if len(r.NonRetryableErrorTypes) > 0 {
+			policy.NonRetryableErrorTypes = r.NonRetryableErrorTypes
+		}
+		ao.RetryPolicy = &policy
 	}
-	execution, err := w.component.CreateExecution(executionParams)
+
+	// The component executions heartbeat so they can be cancelled.
+	ao.HeartbeatTimeout = componentHeartbeatTimeout
+	ao.WaitForCancellation = true
+	return ao
+}
+
+// publishStreamEvent publishes the progress of a streamed trigger. A failure
+// only delays the streamed results until the next event, so it doesn't fail
+// the trigger.
+func (w *worker) publishStreamEvent(ctx workflow.Context, param *TriggerPipelineWorkflowParam, ev *recipe.StreamEvent) {
+	err := workflow.ExecuteActivity(ctx, w.PublishStreamEventActivity, &PublishStreamEventActivityParam{
+		TriggerID: param.SystemVariables.PipelineTriggerID,
+		Event:     ev,
+	}).Get(ctx, nil)
 	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+		workflow.GetLogger(ctx).Warn("publishing stream event", "error", err.Error())
 	}
+}
 
-	compOutputs, err := execution.Execute(ctx, compInputs)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+// purgeMemory removes the memory of a trigger. As the trigger can be
+// cancelled, the activity runs in a disconnected context. A failure to purge
+// the memory doesn't fail the trigger, the memory expires anyway.
+func (w *worker) purgeMemory(ctx workflow.Context, param *PurgeMemoryActivityParam) {
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	if err := workflow.ExecuteActivity(ctx, w.PurgeMemoryActivity, param).Get(ctx, nil); err != nil {
+		workflow.GetLogger(ctx).Warn("purging trigger memory", "error", err.Error())
 	}
+}
 
-	compMem, err := w.processOutput(batchMemory, param.ID, compOutputs, idxMap)
-	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+// storesResult returns whether the result of a trigger is stored when it
+// completes. Only the asynchronous triggers of a request are: the nested and
+// the sub-pipeline workflows return their result to their parent workflow.
+func storesResult(ctx workflow.Context, param *TriggerPipelineWorkflowParam) bool {
+	return param.Mode == mgmtpb.Mode_MODE_ASYNC &&
+		!param.IsIterator &&
+		workflow.GetInfo(ctx).ParentWorkflowExecution == nil &&
+		asyncResultRetention(param.SystemVariables.PipelineOwnerUID) > 0
+}
+
+// completePipelineRun records the end of a trigger. The record is written
+// even if the trigger was cancelled. Like its creation, a failure to record
+// the run doesn't fail the trigger.
+func (w *worker) completePipelineRun(ctx workflow.Context, param *TriggerPipelineWorkflowParam, startTime time.Time, status datamodel.RunStatus, errMsg string) {
+	if param.DryRun {
+		return
 	}
 
-	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, compMem)
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	err := workflow.ExecuteActivity(ctx, w.CompletePipelineRunActivity, &CompletePipelineRunActivityParam{
+		MemoryStorageKey: param.MemoryStorageKey,
+		SystemVariables:  param.SystemVariables,
+		StartedTime:      startTime,
+		Status:           status,
+		Error:            errMsg,
+	}).Get(ctx, nil)
 	if err != nil {
-		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+		workflow.GetLogger(ctx).Warn("recording pipeline run", "error", err.Error())
 	}
+}
 
-	logger.Info("ComponentActivity completed")
+// iterationChunk is a range of elements of an iteration that is processed by
+// a single child workflow.
+type iterationChunk struct {
+	batchIdx   int
+	start, end int
+}
 
-	// the data is logged in temporal hence we should only return data that is needed
-	p := &ComponentActivityParam{
-		WorkflowID: param.WorkflowID,
-		ID:         param.ID, // is used by the caller to identify the component
+// executeIterations runs the iterations of an iterator as child workflows.
+// The elements are split in chunks of the iterator batch size, and at most
+// the iterator max concurrency chunks run at the same time. Chunks are
+// started in order and the workflow waits on a selector, so the execution is
+// deterministic.
+//
+// When the iterator continues on error, a failed chunk is run again one
+// element at a time, so only the failing elements are reported. The error
+// messages are returned by batch item and element index.
+func (w *worker) executeIterations(ctx workflow.Context, iteratorID string, comp *datamodel.Component, preIteratorResult *PreIteratorActivityResult, param *TriggerPipelineWorkflowParam) ([]map[int]string, error) {
+	elementErrors := make([]map[int]string, len(preIteratorResult.ElementSize))
+	queue := []iterationChunk{}
+	for batchIdx, size := range preIteratorResult.ElementSize {
+		elementErrors[batchIdx] = map[int]string{}
+		chunkSize := comp.BatchSize
+		if chunkSize <= 0 || chunkSize > size {
+			chunkSize = size
+		}
+		for start := 0; start < size; start += chunkSize {
+			queue = append(queue, iterationChunk{batchIdx: batchIdx, start: start, end: min(start+chunkSize, size)})
+		}
 	}
-	return p, nil
-}
 
-// TODO: complete iterator
-// PreIteratorActivity generate the trigger memory for each iteration.
-func (w *worker) PreIteratorActivity(ctx context.Context, param *PreIteratorActivityParam) (*PreIteratorActivityResult, error) {
+	selector := workflow.NewSelector(ctx)
+	running := 0
+	var childErr error
 
-	logger, _ := logger.GetZapLogger(ctx)
-	logger.Info("PreIteratorActivity started")
+	// The progress of the iterations is streamed after each chunk.
+	completed := make([]int, len(preIteratorResult.ElementSize))
+	var progress []*recipe.StreamEvent
 
-	m, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
-	if err != nil {
-		return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+	for len(queue) > 0 || running > 0 {
+		if len(queue) == 0 || (comp.MaxConcurrency > 0 && running >= comp.MaxConcurrency) {
+			selector.Select(ctx)
+			if childErr != nil {
+				return nil, childErr
+			}
+			for _, ev := range progress {
+				w.publishStreamEvent(ctx, param, ev)
+			}
+			progress = nil
+			continue
+		}
+
+		chunk := queue[0]
+		queue = queue[1:]
+
+		iterationID := preIteratorResult.ChildWorkflowIDs[chunk.batchIdx]
+		k := preIteratorResult.MemoryStorageKeys[chunk.batchIdx]
+		childWorkflowOptions := workflow.ChildWorkflowOptions{
+			TaskQueue:                TaskQueue,
+			WorkflowID:               fmt.Sprintf("%s:%d-%d", iterationID, chunk.start, chunk.end),
+			WorkflowExecutionTimeout: time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout) * time.Second,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
+			},
+		}
+
+		f := workflow.ExecuteChildWorkflow(
+			workflow.WithChildOptions(ctx, childWorkflowOptions),
+			"TriggerPipelineWorkflow",
+			&TriggerPipelineWorkflowParam{
+				IsIterator: true,
+				BatchSize:  chunk.end - chunk.start,
+				MemoryStorageKey: &recipe.BatchMemoryKey{
+					Components:     k.Components[chunk.start:chunk.end],
+					Variables:      k.Variables[chunk.start:chunk.end],
+					Secrets:        k.Secrets[chunk.start:chunk.end],
+					Recipe:         k.Recipe,
+					OwnerPermalink: k.OwnerPermalink,
+				},
+				SystemVariables: param.SystemVariables,
+				Mode:            mgmtpb.Mode_MODE_SYNC,
+				MemoryKeyPrefix: iterationID,
+				BatchOffset:     chunk.start,
+				DryRun:          param.DryRun,
+				DryRunMocks:     param.DryRunMocks,
+			})
+		running++
+		selector.AddFuture(f, func(f workflow.Future) {
+			running--
+			err := f.Get(ctx, nil)
+			switch {
+			case err == nil:
+			case !comp.ContinueOnError:
+				if childErr == nil {
+					childErr = err
+				}
+				return
+			case chunk.end-chunk.start > 1:
+				for e := chunk.start; e < chunk.end; e++ {
+					queue = append(queue, iterationChunk{batchIdx: chunk.batchIdx, start: e, end: e + 1})
+				}
+				return
+			default:
+				elementErrors[chunk.batchIdx][chunk.start] = errorMessage(err)
+			}
+
+			if param.IsStreaming {
+				completed[chunk.batchIdx] += chunk.end - chunk.start
+				progress = append(progress, &recipe.StreamEvent{
+					Type:        recipe.StreamEventIteration,
+					ComponentID: iteratorID,
+					BatchIndex:  chunk.batchIdx,
+					Completed:   completed[chunk.batchIdx],
+					Total:       preIteratorResult.ElementSize[chunk.batchIdx],
+				})
+			}
+		})
+	}
+
+	return elementErrors, nil
+}
+
+// executeSwitchCases runs the matching case of every batch item as a child
+// workflow with a single batch item. The cases run concurrently.
+func (w *worker) executeSwitchCases(ctx workflow.Context, preSwitchResult *PreSwitchActivityResult, param *TriggerPipelineWorkflowParam) error {
+	params := make([]*TriggerPipelineWorkflowParam, len(preSwitchResult.Cases))
+	for batchIdx, caseIdx := range preSwitchResult.Cases {
+		if caseIdx < 0 {
+			continue
+		}
+		params[batchIdx] = &TriggerPipelineWorkflowParam{
+			IsIterator:       true,
+			BatchSize:        1,
+			MemoryStorageKey: preSwitchResult.MemoryStorageKeys[batchIdx],
+			SystemVariables:  param.SystemVariables,
+			Mode:             mgmtpb.Mode_MODE_SYNC,
+			MemoryKeyPrefix:  preSwitchResult.ChildWorkflowIDs[batchIdx],
+			DryRun:           param.DryRun,
+			DryRunMocks:      param.DryRunMocks,
+		}
+	}
+	return w.executeChildWorkflows(ctx, preSwitchResult.ChildWorkflowIDs, params)
+}
+
+// executeSubPipelines triggers the callee of a sub-pipeline component for
+// every batch item that isn't skipped. Each trigger is a child workflow with
+// a single batch item and the system variables of the callee, so it's
+// accounted as a trigger of the callee.
+func (w *worker) executeSubPipelines(ctx workflow.Context, callee *datamodel.Callee, preSubPipelineResult *PreSubPipelineActivityResult, param *TriggerPipelineWorkflowParam) error {
+	params := make([]*TriggerPipelineWorkflowParam, len(preSubPipelineResult.ChildWorkflowIDs))
+	for batchIdx, childWorkflowID := range preSubPipelineResult.ChildWorkflowIDs {
+		if childWorkflowID == "" {
+			continue
+		}
+
+		sv := param.SystemVariables
+		sv.PipelineTriggerID = childWorkflowID
+		sv.PipelineID = callee.PipelineID
+		sv.PipelineUID = callee.PipelineUID
+		sv.PipelineReleaseID = callee.ReleaseID
+		sv.PipelineReleaseUID = callee.ReleaseUID
+		sv.PipelineRecipe = callee.Recipe
+		sv.PipelineOwnerType = resource.NamespaceType(callee.OwnerType)
+		sv.PipelineOwnerUID = callee.OwnerUID
+
+		params[batchIdx] = &TriggerPipelineWorkflowParam{
+			BatchSize:        1,
+			MemoryStorageKey: preSubPipelineResult.MemoryStorageKeys[batchIdx],
+			SystemVariables:  sv,
+			Mode:             param.Mode,
+			DryRun:           param.DryRun,
+			DryRunMocks:      param.DryRunMocks,
+		}
+	}
+	return w.executeChildWorkflows(ctx, preSubPipelineResult.ChildWorkflowIDs, params)
+}
+
+// executeChildWorkflows runs a TriggerPipelineWorkflow for every non-empty
+// child workflow ID and waits for all of them. The workflows run
+// concurrently.
+func (w *worker) executeChildWorkflows(ctx workflow.Context, childWorkflowIDs []string, params []*TriggerPipelineWorkflowParam) error {
+	futures := []workflow.ChildWorkflowFuture{}
+	for idx, childWorkflowID := range childWorkflowIDs {
+		if childWorkflowID == "" {
+			continue
+		}
+
+		childWorkflowOptions := workflow.ChildWorkflowOptions{
+			TaskQueue:                TaskQueue,
+			WorkflowID:               childWorkflowID,
+			WorkflowExecutionTimeout: time.Duration(config.Config.Server.Workflow.MaxWorkflowTimeout) * time.Second,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: config.Config.Server.Workflow.MaxWorkflowRetry,
+			},
+		}
+
+		futures = append(futures, workflow.ExecuteChildWorkflow(
+			workflow.WithChildOptions(ctx, childWorkflowOptions),
+			"TriggerPipelineWorkflow",
+			params[idx],
+		))
+	}
+
+	for _, f := range futures {
+		if err := f.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errorType returns the type of an application error, which identifies the
+// origin of the error.
+func errorType(err error) string {
+	var applicationErr *temporal.ApplicationError
+	if errors.As(err, &applicationErr) {
+		return applicationErr.Type()
+	}
+	return ""
+}
+
+// errorMessage extracts the end-user message of a workflow or activity error.
+func errorMessage(err error) string {
+	var applicationErr *temporal.ApplicationError
+	if errors.As(err, &applicationErr) && applicationErr.Message() != "" {
+		return applicationErr.Message()
+	}
+	return err.Error()
+}
+
+func (w *worker) ComponentActivity(ctx context.Context, param *ComponentActivityParam) (*ComponentActivityParam, error) {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("ComponentActivity started")
+	startTime := time.Now()
+
+	batchMemory, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	compInputs, idxMap, err := w.processInput(batchMemory, param.ID, param.UpstreamIDs, param.Condition, param.Input)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	cons, err := w.processSetup(batchMemory, param.Setup)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+	sysVars, err := recipe.GenerateSystemVariables(ctx, param.SystemVariables)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	compOutputs := make([]*structpb.Struct, len(compInputs))
+	var erroredItems []int
+	if param.DryRun {
+		// A dry run renders the bindings but doesn't call the component.
+		err = w.mockOutputs(param, compOutputs)
+	} else {
+		erroredItems, err = w.executeComponent(ctx, param, batchMemory, idxMap, sysVars, cons[0], compInputs, compOutputs)
+	}
+	w.recordComponentRuns(ctx, param, batchMemory, idxMap, startTime, compInputs, compOutputs, err)
+	if err != nil {
+		return nil, err
+	}
+
+	compMem, err := w.processOutput(batchMemory, param.ID, compOutputs, idxMap)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, compMem)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	logger.Info("ComponentActivity completed")
+
+	// the data is logged in temporal hence we should only return data that is needed
+	p := &ComponentActivityParam{
+		WorkflowID:   param.WorkflowID,
+		ID:           param.ID, // is used by the caller to identify the component
+		ErroredItems: erroredItems,
+	}
+	return p, nil
+}
+
+// recordComponentRuns records the execution of a component for each batch
+// item it ran for, in the component memory and, unless it's a dry run, in the
+// run history of the trigger. execErr is the error of the execution if it
+// failed. A failure to record the runs doesn't fail the component.
+func (w *worker) recordComponentRuns(
+	ctx context.Context,
+	param *ComponentActivityParam,
+	batchMemory []*recipe.Memory,
+	idxMap map[int]int,
+	startTime time.Time,
+	compInputs []*structpb.Struct,
+	compOutputs []*structpb.Struct,
+	execErr error,
+) {
+	logger, _ := logger.GetZapLogger(ctx)
+
+	endTime := time.Now()
+	duration := endTime.Sub(startTime).Milliseconds()
+	attempts := activity.GetInfo(ctx).Attempt
+	compPath := recipe.ComponentPath(param.SystemVariables.PipelineTriggerID, param.WorkflowID, param.ID)
+
+	dbRuns := make([]*datamodel.ComponentRun, 0, len(compInputs))
+	for idx, compInput := range compInputs {
+		compMem := batchMemory[idxMap[idx]].Component[param.ID]
+		run := &recipe.ComponentRun{
+			StartTime: startTime,
+			EndTime:   endTime,
+			Attempts:  attempts,
+			InputSize: jsonSize(compInput),
+		}
+		status := datamodel.RunStatusCompleted
+		switch {
+		case execErr != nil:
+			run.ErrorType = errorType(execErr)
+			run.Error = errorMessage(execErr)
+			status = datamodel.RunStatusFailed
+			if ctx.Err() != nil {
+				status = datamodel.RunStatusCancelled
+			}
+		case compMem.Status.Errored:
+			// The item failed in isolation.
+			run.ErrorType = componentActivityErrorType
+			run.Error = compMem.Error
+			status = datamodel.RunStatusFailed
+		default:
+			run.OutputSize = jsonSize(compOutputs[idx])
+		}
+		compMem.Run = run
+
+		dbRun := &datamodel.ComponentRun{
+			PipelineTriggerID: param.SystemVariables.PipelineTriggerID,
+			ComponentID:       compPath,
+			BatchIndex:        param.BatchOffset + idxMap[idx],
+			Status:            status,
+			StartedTime:       startTime,
+			CompletedTime:     &endTime,
+			TotalDuration:     &duration,
+			Attempts:          attempts,
+			InputSize:         run.InputSize,
+			OutputSize:        run.OutputSize,
+			CacheHit:          compMem.Cache != nil && compMem.Cache.Hit,
+		}
+		if run.Error != "" {
+			dbRun.ErrorType = &run.ErrorType
+			dbRun.Error = &run.Error
+		}
+		dbRuns = append(dbRuns, dbRun)
+	}
+
+	if param.DryRun {
+		return
+	}
+
+	// The context may be cancelled, the runs are recorded regardless.
+	if err := w.repository.UpsertComponentRuns(context.WithoutCancel(ctx), dbRuns); err != nil {
+		logger.Warn("recording component runs", zap.String("component", param.ID), zap.Error(err))
+	}
+}
+
+// executeComponent executes a component on the inputs without a cached
+// output and sets its outputs in compOutputs. It returns the batch items that
+// failed in isolation.
+func (w *worker) executeComponent(
+	ctx context.Context,
+	param *ComponentActivityParam,
+	batchMemory []*recipe.Memory,
+	idxMap map[int]int,
+	sysVars map[string]any,
+	setup *structpb.Struct,
+	compInputs []*structpb.Struct,
+	compOutputs []*structpb.Struct,
+) ([]int, error) {
+	logger, _ := logger.GetZapLogger(ctx)
+
+	// Note: we assume that setup in the batch are all the same
+	executionParams := componentstore.ExecutionParams{
+		ComponentID:           param.ID,
+		ComponentDefinitionID: param.Type,
+		SystemVariables:       sysVars,
+		Setup:                 setup,
+		Task:                  param.Task,
+	}
+	execution, err := w.component.CreateExecution(executionParams)
+	if err != nil {
+		return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+	}
+
+	// The execution stops when the trigger is cancelled.
+	stopHeartbeat := heartbeat(ctx)
+	defer stopHeartbeat()
+
+	// The inputs with a cached output aren't executed.
+	var cacheHashes []string
+	if param.Cache != nil {
+		cacheHashes, err = w.loadCachedOutputs(ctx, param, setup, compInputs, compOutputs)
+		if err != nil {
+			return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+		}
+		for idx, hash := range cacheHashes {
+			batchMemory[idxMap[idx]].Component[param.ID].Cache = &recipe.CacheStatus{
+				Key: hash,
+				Hit: compOutputs[idx] != nil,
+			}
+		}
+	}
+
+	var execInputs []*structpb.Struct
+	var execIdx []int
+	for idx, compOutput := range compOutputs {
+		if compOutput == nil {
+			execInputs = append(execInputs, compInputs[idx])
+			execIdx = append(execIdx, idx)
+		}
+	}
+
+	var erroredItems []int
+	if len(execInputs) > 0 {
+		execOutputs, err := execution.Execute(ctx, execInputs)
+		var itemErrs []error
+		if err != nil {
+			if !param.IsolateBatchItems {
+				return nil, componentActivityError(err, componentActivityErrorType, param.ID)
+			}
+			execOutputs, itemErrs = executeIsolated(ctx, execution, execInputs, err)
+		}
+
+		for i, idx := range execIdx {
+			if itemErrs != nil && itemErrs[i] != nil {
+				m := batchMemory[idxMap[idx]].Component[param.ID]
+				m.Status.Errored = true
+				m.Error = errorMessage(componentActivityError(itemErrs[i], componentActivityErrorType, param.ID))
+				erroredItems = append(erroredItems, idxMap[idx])
+				continue
+			}
+			compOutputs[idx] = execOutputs[i]
+
+			if param.Cache == nil {
+				continue
+			}
+			if err := w.writeCachedOutput(ctx, param, cacheHashes[idx], execOutputs[i]); err != nil {
+				// The output is still valid, only the next executions miss
+				// the cache.
+				logger.Warn("caching component output", zap.String("component", param.ID), zap.Error(err))
+			}
+		}
+	}
+	return erroredItems, nil
+}
+
+// mockOutputs sets the output of a component in a dry run for every input.
+// The output is the mock of the trigger request or, if there's none, an
+// output synthesized from the output schema of the task.
+func (w *worker) mockOutputs(param *ComponentActivityParam, compOutputs []*structpb.Struct) error {
+	output := param.Mock
+	if output == nil {
+		def, err := w.component.GetDefinitionByID(param.Type, nil, nil)
+		if err != nil {
+			return componentActivityError(err, componentActivityErrorType, param.ID)
+		}
+		spec, ok := def.GetSpec().GetDataSpecifications()[param.Task]
+		if !ok {
+			err := fmt.Errorf("task %q has no data specification to mock its output", param.Task)
+			return componentActivityError(err, componentActivityErrorType, param.ID)
+		}
+		output, _ = mockValue(spec.GetOutput().AsMap()).(map[string]any)
+	}
+
+	for idx := range compOutputs {
+		compOutput, err := structpb.NewStruct(output)
+		if err != nil {
+			return componentActivityError(err, componentActivityErrorType, param.ID)
+		}
+		compOutputs[idx] = compOutput
+	}
+	return nil
+}
+
+// loadCachedOutputs sets the cached outputs of the component inputs in
+// compOutputs and returns the cache hash of each input.
+func (w *worker) loadCachedOutputs(ctx context.Context, param *ComponentActivityParam, setup *structpb.Struct, compInputs []*structpb.Struct, compOutputs []*structpb.Struct) ([]string, error) {
+	def, err := w.component.GetDefinitionByID(param.Type, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(compInputs))
+	for idx, compInput := range compInputs {
+		hash, err := recipe.CacheEntry{
+			DefinitionID:      param.Type,
+			DefinitionVersion: def.GetVersion(),
+			Task:              param.Task,
+			Setup:             setup.AsMap(),
+			Input:             compInput.AsMap(),
+		}.Hash(param.Cache.Key)
+		if err != nil {
+			return nil, err
+		}
+		hashes[idx] = hash
+
+		output, ok, err := recipe.LoadCachedOutput(ctx, w.redisClient, param.SystemVariables.PipelineUID, hash)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if compOutputs[idx], err = structpb.NewStruct(output); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+func (w *worker) writeCachedOutput(ctx context.Context, param *ComponentActivityParam, hash string, output *structpb.Struct) error {
+	// The TTL is validated when the recipe is saved.
+	ttl, err := time.ParseDuration(param.Cache.TTL)
+	if err != nil || ttl <= 0 {
+		return nil
+	}
+	return recipe.WriteCachedOutput(ctx, w.redisClient, param.SystemVariables.PipelineUID, hash, output.AsMap(), ttl)
+}
+
+// executeIsolated executes the inputs of a failed batch execution one by one.
+// It returns the output of each input, or its error when it fails.
+func executeIsolated(ctx context.Context, execution *componentbase.ExecutionWrapper, compInputs []*structpb.Struct, batchErr error) ([]*structpb.Struct, []error) {
+	compOutputs := make([]*structpb.Struct, len(compInputs))
+	errs := make([]error, len(compInputs))
+	if len(compInputs) == 1 {
+		// The batch execution already failed for the only input.
+		errs[0] = batchErr
+		return compOutputs, errs
+	}
+
+	for idx, compInput := range compInputs {
+		outputs, err := execution.Execute(ctx, []*structpb.Struct{compInput})
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
+		compOutputs[idx] = outputs[0]
+	}
+	return compOutputs, errs
+}
+
+// ComponentErrorActivity applies the on-error policy of a component whose
+// execution failed. The batch items the component ran for are marked as
+// errored and, with a fallback policy, receive the fallback output.
+func (w *worker) ComponentErrorActivity(ctx context.Context, param *ComponentErrorActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("ComponentErrorActivity started")
+
+	batchMemory, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return componentActivityError(err, componentErrorActivityErrorType, param.ID)
+	}
+
+	if err := w.processStatus(batchMemory, param.ID, param.UpstreamIDs, param.Condition); err != nil {
+		return componentActivityError(err, componentErrorActivityErrorType, param.ID)
+	}
+
+	compMem := make([]*recipe.ComponentMemory, len(batchMemory))
+	for idx, m := range batchMemory {
+		compMem[idx] = m.Component[param.ID]
+		if !compMem[idx].Status.Started {
+			continue
+		}
+		compMem[idx].Status.Errored = true
+		compMem[idx].Error = param.Error
+
+		if param.OnError.Action != datamodel.OnErrorFallback {
+			continue
+		}
+		output, err := recipe.RenderInput(param.OnError.Output, idx, m)
+		if err != nil {
+			return componentActivityError(fmt.Errorf("rendering fallback output: %w", err), componentErrorActivityErrorType, param.ID)
+		}
+		*compMem[idx].Output = output.(map[string]any)
+		compMem[idx].Status.Completed = true
+	}
+
+	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, compMem)
+	if err != nil {
+		return componentActivityError(err, componentErrorActivityErrorType, param.ID)
+	}
+
+	logger.Info("ComponentErrorActivity completed")
+	return nil
+}
+
+// PublishStreamEventActivity appends an event to the stream of a trigger.
+func (w *worker) PublishStreamEventActivity(ctx context.Context, param *PublishStreamEventActivityParam) error {
+	if err := recipe.PublishStreamEvent(ctx, w.redisClient, param.TriggerID, param.Event); err != nil {
+		return temporal.NewApplicationErrorWithCause("publishing stream event", publishStreamEventActivityErrorType, err)
+	}
+	return nil
+}
+
+// PreApprovalActivity renders the input of an approval component and requests
+// a review for each batch item.
+func (w *worker) PreApprovalActivity(ctx context.Context, param *PreApprovalActivityParam) (*PreApprovalActivityResult, error) {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PreApprovalActivity started")
+
+	m, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return nil, componentActivityError(err, preApprovalActivityErrorType, param.ID)
+	}
+
+	input := param.Input
+	if input == nil {
+		input = map[string]any{}
+	}
+	_, idxMap, err := w.processInput(m, param.ID, param.UpstreamIDs, param.Condition, input)
+	if err != nil {
+		return nil, componentActivityError(err, preApprovalActivityErrorType, param.ID)
+	}
+
+	now := time.Now()
+	var expireTime *time.Time
+	if d, err := time.ParseDuration(param.Timeout); err == nil && d > 0 {
+		t := now.Add(d)
+		expireTime = &t
+	}
+
+	result := &PreApprovalActivityResult{Requests: map[int]string{}}
+	for _, idx := range idxMap {
+		req := &recipe.ApprovalRequest{
+			ID:          approvalID(param, idx),
+			TriggerID:   param.SystemVariables.PipelineTriggerID,
+			PipelineUID: param.SystemVariables.PipelineUID.String(),
+			PipelineID:  param.SystemVariables.PipelineID,
+			ComponentID: param.ID,
+			WorkflowID:  param.ExecutionID,
+			BatchIndex:  idx,
+			Payload:     *m[idx].Component[param.ID].Input,
+			CreateTime:  now,
+			ExpireTime:  expireTime,
+		}
+		if err := recipe.WriteApprovalRequest(ctx, w.redisClient, param.SystemVariables.PipelineOwnerUID, req); err != nil {
+			return nil, componentActivityError(err, preApprovalActivityErrorType, param.ID)
+		}
+		result.Requests[idx] = req.ID
+	}
+
+	compMem := make([]*recipe.ComponentMemory, len(m))
+	for idx := range m {
+		compMem[idx] = m[idx].Component[param.ID]
+	}
+	if err := recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, compMem); err != nil {
+		return nil, componentActivityError(err, preApprovalActivityErrorType, param.ID)
+	}
+
+	logger.Info("PreApprovalActivity completed")
+	return result, nil
+}
+
+// approvalID returns the ID of the approval request of a batch item. It's
+// derived from the item, so a retry of the activity overwrites the requests
+// it already wrote instead of leaving orphans behind.
+func approvalID(param *PreApprovalActivityParam, idx int) string {
+	name := fmt.Sprintf("%s:%s:%s:%d", param.SystemVariables.PipelineTriggerID, param.WorkflowID, param.ID, param.BatchOffset+idx)
+	return uuid.NewV5(uuid.NamespaceOID, name).String()
+}
+
+// PostApprovalActivity removes the approval requests of a component and
+// writes the reviews as its output. The requests that timed out follow the
+// timeout action of the component.
+func (w *worker) PostApprovalActivity(ctx context.Context, param *PostApprovalActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PostApprovalActivity started")
+
+	timedOut := false
+	for idx, approvalID := range param.Requests {
+		err := recipe.DeleteApprovalRequest(ctx, w.redisClient, param.SystemVariables.PipelineOwnerUID, param.SystemVariables.PipelineTriggerID, approvalID)
+		if err != nil {
+			return componentActivityError(err, postApprovalActivityErrorType, param.ID)
+		}
+
+		decision, ok := param.Decisions[idx]
+		if !ok {
+			switch param.TimeoutAction {
+			case datamodel.ApprovalTimeoutApprove, datamodel.ApprovalTimeoutReject:
+				decision = &ApprovalDecision{Approved: param.TimeoutAction == datamodel.ApprovalTimeoutApprove}
+			default:
+				timedOut = true
+				continue
+			}
+		}
+
+		compMem, err := recipe.LoadComponentMemory(ctx, w.redisClient, param.WorkflowID, param.BatchOffset+idx, param.ID)
+		if err != nil {
+			return componentActivityError(err, postApprovalActivityErrorType, param.ID)
+		}
+		payload := decision.Payload
+		if payload == nil {
+			payload = *compMem.Input
+		}
+		compMem.Output = &recipe.ComponentIO{
+			recipe.ApprovalOutputApproved: decision.Approved,
+			recipe.ApprovalOutputPayload:  payload,
+			recipe.ApprovalOutputReviewer: decision.ReviewerUID,
+			recipe.ApprovalOutputComment:  decision.Comment,
+		}
+		compMem.Status.Completed = true
+
+		err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset+idx, []*recipe.ComponentMemory{compMem})
+		if err != nil {
+			return componentActivityError(err, postApprovalActivityErrorType, param.ID)
+		}
+	}
+
+	if timedOut {
+		// Retrying won't bring the reviews back.
+		msg := fmt.Sprintf("Component %s failed to execute. The approval timed out.", param.ID)
+		return temporal.NewNonRetryableApplicationError(msg, postApprovalActivityErrorType, nil)
+	}
+
+	logger.Info("PostApprovalActivity completed")
+	return nil
+}
+
+// TODO: complete iterator
+// PreIteratorActivity generate the trigger memory for each iteration.
+func (w *worker) PreIteratorActivity(ctx context.Context, param *PreIteratorActivityParam) (*PreIteratorActivityResult, error) {
+
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PreIteratorActivity started")
+
+	m, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
 	}
 	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKey.Recipe)
 	if err != nil {
 		return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
 	}
 
-	iteratorRecipe := &datamodel.Recipe{
-		Component: r.Component[param.ID].Component,
-	}
+	iteratorRecipe := &datamodel.Recipe{
+		Component: r.Component[param.ID].Component,
+	}
+
+	result := &PreIteratorActivityResult{
+		MemoryStorageKeys: make([]*recipe.BatchMemoryKey, len(m)),
+		ElementSize:       make([]int, len(m)),
+	}
+	recipeKey := fmt.Sprintf("%s:%s:%s", param.WorkflowID, param.ID, recipe.SegRecipe)
+	err = recipe.WriteRecipe(ctx, w.redisClient, recipeKey, iteratorRecipe)
+	if err != nil {
+		return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+	}
+
+	batchSize := len(m)
+	childWorkflowIDs := make([]string, batchSize)
+	for iter := range batchSize {
+		childWorkflowIDs[iter] = recipe.IterationWorkflowID(param.WorkflowID, param.BatchOffset+iter, param.ID)
+	}
+
+	for iter := range m {
+
+		elements, err := recipe.IterationElements(param.Input, m[iter])
+		if err != nil {
+			return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+		}
+		// The condition of an iterator filters its elements, the filtered
+		// out elements don't start any iteration.
+		elements, err = recipe.FilterElements(param.ID, param.Condition, elements, m[iter])
+		if err != nil {
+			return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+		}
+
+		elems := make([]*recipe.ComponentMemory, len(elements))
+		for elemIdx := range elements {
+			elems[elemIdx] = &recipe.ComponentMemory{
+				Element: elements[elemIdx],
+			}
+		}
+		elementSize := len(elems)
+		result.ElementSize[iter] = elementSize
+
+		err = recipe.WriteComponentMemory(ctx, w.redisClient, childWorkflowIDs[iter], param.ID, 0, elems)
+		if err != nil {
+			return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+		}
+
+		varKeys := make([]string, elementSize)
+		for e := range elementSize {
+			varKeys[e] = param.MemoryStorageKey.Variables[iter]
+		}
+		secretKeys := make([]string, elementSize)
+		for e := range elementSize {
+			secretKeys[e] = param.MemoryStorageKey.Secrets[iter]
+		}
+		// The iterations inherit the memory of every component available to
+		// the iterator, so components nested at any depth can reference the
+		// components and elements of the enclosing scopes.
+		compKeys := make([]map[string]string, elementSize)
+		for e := range elementSize {
+			compKeys[e] = make(map[string]string)
+			for id, key := range param.MemoryStorageKey.Components[iter] {
+				compKeys[e][id] = key
+			}
+		}
+
+		for e := range elementSize {
+
+			compKeys[e][param.ID] = fmt.Sprintf("%s:%d:%s:%s", childWorkflowIDs[iter], e, recipe.SegComponent, param.ID)
+		}
+
+		k := &recipe.BatchMemoryKey{
+			Components:     compKeys,
+			Variables:      varKeys,
+			Secrets:        secretKeys,
+			Recipe:         recipeKey,
+			OwnerPermalink: param.MemoryStorageKey.OwnerPermalink,
+		}
+		result.MemoryStorageKeys[iter] = k
+
+	}
+	result.ChildWorkflowIDs = childWorkflowIDs
+	logger.Info("PreIteratorActivity completed")
+	return result, nil
+}
+
+// PostIteratorActivity merges the trigger memory from each iteration.
+func (w *worker) PostIteratorActivity(ctx context.Context, param *PostIteratorActivityParam) error {
+
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PostIteratorActivity started")
+
+	// recipes for all iteration are the same
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKeys[0].Recipe)
+	if err != nil {
+		return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+	}
+
+	iterComp := []*recipe.ComponentMemory{}
+	for iter := range param.MemoryStorageKeys {
+		iterationID := recipe.IterationWorkflowID(param.WorkflowID, param.BatchOffset+iter, param.ID)
+		elementErrors := map[int]string{}
+		if iter < len(param.ElementErrors) && param.ElementErrors[iter] != nil {
+			elementErrors = param.ElementErrors[iter]
+		}
+
+		// The memory of the nested components isn't complete for the failed
+		// elements, only their element memory is loaded.
+		k := param.MemoryStorageKeys[iter]
+		for e := range len(k.Variables) {
+			if _, failed := elementErrors[e]; failed {
+				continue
+			}
+			for compID := range r.Component {
+				k.Components[e][compID] = fmt.Sprintf("%s:%d:%s:%s", iterationID, e, recipe.SegComponent, compID)
+			}
+		}
+
+		m, err := recipe.LoadMemory(ctx, w.redisClient, k)
+		if err != nil {
+			return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+		}
+
+		output := recipe.ComponentIO{}
+		for k := range param.OutputElements {
+			output[k] = make([]any, len(m))
+		}
+		errs := make([]any, len(m))
+		elems := make([]*recipe.ComponentMemory, len(m))
+
+		for elemIdx := range len(m) {
+			elem := &recipe.ComponentMemory{
+				Status: &recipe.ComponentStatus{Started: true},
+			}
+			if elemMem, ok := m[elemIdx].Component[param.ID]; ok {
+				elem.Element = elemMem.Element
+			}
+			elems[elemIdx] = elem
+
+			if msg, failed := elementErrors[elemIdx]; failed {
+				elem.Status.Errored = true
+				elem.Error = msg
+				errs[elemIdx] = msg
+				continue
+			}
+
+			elemVals := map[string]any{}
+			for k, v := range param.OutputElements {
+				elemVal, err := recipe.RenderInput(v, elemIdx, m[elemIdx])
+				if err != nil {
+					if !param.ContinueOnError {
+						return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+					}
+					elem.Status.Errored = true
+					elem.Error = err.Error()
+					errs[elemIdx] = err.Error()
+					break
+				}
+				elemVals[k] = elemVal
+			}
+			if elem.Status.Errored {
+				continue
+			}
+			for k, v := range elemVals {
+				output[k].([]any)[elemIdx] = v
+			}
+
+			// An element is skipped when none of the nested components ran.
+			skipped := len(r.Component) > 0
+			for compID := range r.Component {
+				if compMem, ok := m[elemIdx].Component[compID]; !ok || compMem.Status == nil || !compMem.Status.Skipped {
+					skipped = false
+					break
+				}
+			}
+			elem.Status.Skipped = skipped
+			elem.Status.Completed = !skipped
+		}
+		if param.ContinueOnError {
+			output[datamodel.IteratorErrorsOutput] = errs
+		}
+
+		err = recipe.WriteComponentMemory(ctx, w.redisClient, iterationID, param.ID, 0, elems)
+		if err != nil {
+			return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+		}
+
+		iterComp = append(iterComp, &recipe.ComponentMemory{
+			Output: &output,
+			Status: &recipe.ComponentStatus{
+				Started:   true,
+				Completed: true,
+			},
+		})
+	}
+	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, iterComp)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to execute workflow: %s", err.Error()))
+		return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+	}
+
+	logger.Info("PostIteratorActivity completed")
+	return nil
+}
+
+// PreSwitchActivity evaluates the cases of a switch for each batch item and
+// generates the trigger memory of the matching cases.
+func (w *worker) PreSwitchActivity(ctx context.Context, param *PreSwitchActivityParam) (*PreSwitchActivityResult, error) {
+
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PreSwitchActivity started")
+
+	m, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return nil, componentActivityError(err, preSwitchActivityErrorType, param.ID)
+	}
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKey.Recipe)
+	if err != nil {
+		return nil, componentActivityError(err, preSwitchActivityErrorType, param.ID)
+	}
+	comp := r.Component[param.ID]
+
+	recipeKeys := make([]string, len(comp.Cases))
+	for caseIdx, c := range comp.Cases {
+		recipeKeys[caseIdx] = recipe.SwitchCaseRecipeKey(param.WorkflowID, param.ID, caseIdx)
+		err = recipe.WriteRecipe(ctx, w.redisClient, recipeKeys[caseIdx], &datamodel.Recipe{Component: c.Component})
+		if err != nil {
+			return nil, componentActivityError(err, preSwitchActivityErrorType, param.ID)
+		}
+	}
+
+	result := &PreSwitchActivityResult{
+		Cases:             make([]int, len(m)),
+		ChildWorkflowIDs:  make([]string, len(m)),
+		MemoryStorageKeys: make([]*recipe.BatchMemoryKey, len(m)),
+	}
+	compMem := make([]*recipe.ComponentMemory, len(m))
+	for idx := range m {
+		caseIdx, err := matchSwitchCase(comp, m[idx])
+		if err != nil {
+			return nil, componentActivityError(err, preSwitchActivityErrorType, param.ID)
+		}
+		result.Cases[idx] = caseIdx
+
+		compMem[idx] = &recipe.ComponentMemory{
+			Input:  &recipe.ComponentIO{},
+			Output: &recipe.ComponentIO{},
+			Status: &recipe.ComponentStatus{},
+		}
+		if caseIdx < 0 {
+			compMem[idx].Status.Skipped = true
+			continue
+		}
+		compMem[idx].Status.Started = true
+		(*compMem[idx].Input)["case"] = caseIdx
+
+		// The case inherits the memory of every component available to the
+		// switch.
+		compKeys := make(map[string]string, len(param.MemoryStorageKey.Components[idx]))
+		for id, key := range param.MemoryStorageKey.Components[idx] {
+			compKeys[id] = key
+		}
+		result.ChildWorkflowIDs[idx] = recipe.SwitchWorkflowID(param.WorkflowID, param.BatchOffset+idx, param.ID)
+		result.MemoryStorageKeys[idx] = &recipe.BatchMemoryKey{
+			Components:     []map[string]string{compKeys},
+			Variables:      []string{param.MemoryStorageKey.Variables[idx]},
+			Secrets:        []string{param.MemoryStorageKey.Secrets[idx]},
+			Recipe:         recipeKeys[caseIdx],
+			OwnerPermalink: param.MemoryStorageKey.OwnerPermalink,
+		}
+	}
+
+	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, compMem)
+	if err != nil {
+		return nil, componentActivityError(err, preSwitchActivityErrorType, param.ID)
+	}
+
+	logger.Info("PreSwitchActivity completed")
+	return result, nil
+}
+
+// matchSwitchCase returns the index of the first case of a switch whose
+// condition is true, or -1 if the switch is skipped.
+func matchSwitchCase(comp *datamodel.Component, memory *recipe.Memory) (int, error) {
+	if comp.Condition != "" {
+		ok, err := recipe.EvalCondition(comp.Condition, memory)
+		if err != nil || !ok {
+			return -1, err
+		}
+	}
+	for caseIdx, c := range comp.Cases {
+		if c.Condition == "" {
+			return caseIdx, nil
+		}
+		ok, err := recipe.EvalCondition(c.Condition, memory)
+		if err != nil {
+			return -1, fmt.Errorf("evaluating case %d: %w", caseIdx, err)
+		}
+		if ok {
+			return caseIdx, nil
+		}
+	}
+	return -1, nil
+}
+
+// PostSwitchActivity renders the output of the matching case of each batch
+// item into the output of the switch.
+func (w *worker) PostSwitchActivity(ctx context.Context, param *PostSwitchActivityParam) error {
 
-	result := &PreIteratorActivityResult{
-		MemoryStorageKeys: make([]*recipe.BatchMemoryKey, len(m)),
-		ElementSize:       make([]int, len(m)),
-	}
-	recipeKey := fmt.Sprintf("%s:%s:%s", param.WorkflowID, param.ID, recipe.SegRecipe)
-	err = recipe.WriteRecipe(ctx, w.redisClient, recipeKey, iteratorRecipe)
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PostSwitchActivity started")
+
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKey.Recipe)
 	if err != nil {
-		return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+		return componentActivityError(err, postSwitchActivityErrorType, param.ID)
 	}
+	comp := r.Component[param.ID]
 
-	batchSize := len(m)
-	childWorkflowIDs := make([]string, batchSize)
-	for iter := range batchSize {
-		childWorkflowIDs[iter] = fmt.Sprintf("%s:%d:%s:%s:%s", param.WorkflowID, iter, recipe.SegComponent, param.ID, recipe.SegIteration)
-	}
+	for idx, caseIdx := range param.Cases {
+		if caseIdx < 0 {
+			continue
+		}
+		c := comp.Cases[caseIdx]
 
-	for iter := range m {
+		compIDs := make([]string, 0, len(c.Component))
+		for compID := range c.Component {
+			compIDs = append(compIDs, compID)
+		}
+		m, err := recipe.LoadSwitchMemory(ctx, w.redisClient, param.ChildWorkflowIDs[idx], compIDs)
+		if err != nil {
+			return componentActivityError(err, postSwitchActivityErrorType, param.ID)
+		}
 
-		input, err := recipe.RenderInput(param.Input, iter, m[iter])
+		// The outputs of the case can reference the components available to
+		// the switch.
+		parent, err := recipe.LoadMemory(ctx, w.redisClient, &recipe.BatchMemoryKey{
+			Components:     []map[string]string{param.MemoryStorageKey.Components[idx]},
+			Variables:      []string{param.MemoryStorageKey.Variables[idx]},
+			Secrets:        []string{param.MemoryStorageKey.Secrets[idx]},
+			Recipe:         param.MemoryStorageKey.Recipe,
+			OwnerPermalink: param.MemoryStorageKey.OwnerPermalink,
+		})
 		if err != nil {
-			return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+			return componentActivityError(err, postSwitchActivityErrorType, param.ID)
+		}
+		for compID, compMem := range m.Component {
+			parent[0].Component[compID] = compMem
 		}
 
-		elems := make([]*recipe.ComponentMemory, len(input.([]any)))
-		for elemIdx := range input.([]any) {
-			elems[elemIdx] = &recipe.ComponentMemory{
-				Element: input.([]any)[elemIdx],
+		output := recipe.ComponentIO{}
+		for k, v := range c.Output {
+			val, err := recipe.RenderInput(v, 0, parent[0])
+			if err != nil {
+				return componentActivityError(err, postSwitchActivityErrorType, param.ID)
 			}
-
+			output[k] = val
 		}
-		elementSize := len(elems)
-		result.ElementSize[iter] = elementSize
 
-		err = recipe.WriteComponentMemory(ctx, w.redisClient, childWorkflowIDs[iter], param.ID, elems)
+		err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset+idx, []*recipe.ComponentMemory{{
+			Input:  &recipe.ComponentIO{"case": caseIdx},
+			Output: &output,
+			Status: &recipe.ComponentStatus{
+				Started:   true,
+				Completed: true,
+			},
+		}})
 		if err != nil {
-			return nil, componentActivityError(err, preIteratorActivityErrorType, param.ID)
+			return componentActivityError(err, postSwitchActivityErrorType, param.ID)
 		}
+	}
 
-		varKeys := make([]string, elementSize)
-		for e := range elementSize {
-			varKeys[e] = param.MemoryStorageKey.Variables[iter]
-		}
-		secretKeys := make([]string, elementSize)
-		for e := range elementSize {
-			secretKeys[e] = param.MemoryStorageKey.Secrets[iter]
+	logger.Info("PostSwitchActivity completed")
+	return nil
+}
+
+// PreSubPipelineActivity maps the input of a sub-pipeline component onto
+// the variables of the callee and generates the trigger memory of the callee
+// for each batch item.
+func (w *worker) PreSubPipelineActivity(ctx context.Context, param *PreSubPipelineActivityParam) (*PreSubPipelineActivityResult, error) {
+
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PreSubPipelineActivity started")
+
+	m, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+	if err != nil {
+		return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
+	}
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKey.Recipe)
+	if err != nil {
+		return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
+	}
+	callee := r.Component[param.ID].Callee
+	if callee == nil || callee.Recipe == nil {
+		return nil, componentActivityError(fmt.Errorf("the pipeline called by component %s wasn't resolved", param.ID), preSubPipelineActivityErrorType, param.ID)
+	}
+
+	input := param.Input
+	if input == nil {
+		input = map[string]any{}
+	}
+	_, idxMap, err := w.processInput(m, param.ID, param.UpstreamIDs, param.Condition, input)
+	if err != nil {
+		return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
+	}
+
+	// The callee runs with the secrets of its namespace.
+	secrets := recipe.SecretMemory{}
+	pt := ""
+	for {
+		var nsSecrets []*datamodel.Secret
+		nsSecrets, _, pt, err = w.repository.ListNamespaceSecrets(ctx, callee.OwnerPermalink, 100, pt, filtering.Filter{})
+		if err != nil {
+			return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
 		}
-		compKeys := make([]map[string]string, elementSize)
-		for e := range elementSize {
-			compKeys[e] = make(map[string]string)
-			for _, id := range param.UpstreamIDs {
-				compKeys[e][id] = param.MemoryStorageKey.Components[iter][id]
+		for _, nsSecret := range nsSecrets {
+			if nsSecret.Value != nil {
+				secrets[nsSecret.ID] = *nsSecret.Value
 			}
 		}
+		if pt == "" {
+			break
+		}
+	}
 
-		for e := range elementSize {
-
-			compKeys[e][param.ID] = fmt.Sprintf("%s:%d:%s:%s", childWorkflowIDs[iter], e, recipe.SegComponent, param.ID)
+	result := &PreSubPipelineActivityResult{
+		ChildWorkflowIDs:  make([]string, len(m)),
+		MemoryStorageKeys: make([]*recipe.BatchMemoryKey, len(m)),
+	}
+	for _, idx := range idxMap {
+		variables := recipe.VariableMemory{}
+		for k, v := range *m[idx].Component[param.ID].Input {
+			variables[k] = v
+		}
+		for k, v := range callee.Recipe.Variable {
+			if _, ok := variables[k]; !ok && v.Default != nil {
+				variables[k] = v.Default
+			}
 		}
 
-		k := &recipe.BatchMemoryKey{
-			Components:     compKeys,
-			Variables:      varKeys,
-			Secrets:        secretKeys,
-			Recipe:         recipeKey,
-			OwnerPermalink: param.MemoryStorageKey.OwnerPermalink,
+		childWorkflowID := recipe.SubPipelineWorkflowID(param.WorkflowID, param.BatchOffset+idx, param.ID)
+		key, err := recipe.Write(ctx, w.redisClient, childWorkflowID, callee.Recipe, []*recipe.Memory{{
+			Variable: variables,
+			Secret:   secrets,
+		}}, callee.OwnerPermalink)
+		if err != nil {
+			return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
 		}
-		result.MemoryStorageKeys[iter] = k
+		result.ChildWorkflowIDs[idx] = childWorkflowID
+		result.MemoryStorageKeys[idx] = key
+	}
 
+	compMem := make([]*recipe.ComponentMemory, len(m))
+	for idx := range m {
+		compMem[idx] = m[idx].Component[param.ID]
 	}
-	result.ChildWorkflowIDs = childWorkflowIDs
-	logger.Info("PreIteratorActivity completed")
+	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset, compMem)
+	if err != nil {
+		return nil, componentActivityError(err, preSubPipelineActivityErrorType, param.ID)
+	}
+
+	logger.Info("PreSubPipelineActivity completed")
 	return result, nil
 }
 
-// PostIteratorActivity merges the trigger memory from each iteration.
-func (w *worker) PostIteratorActivity(ctx context.Context, param *PostIteratorActivityParam) error {
+// PostSubPipelineActivity renders the output section of the callee of each
+// batch item into the output of the sub-pipeline component.
+func (w *worker) PostSubPipelineActivity(ctx context.Context, param *PostSubPipelineActivityParam) error {
 
 	logger, _ := logger.GetZapLogger(ctx)
-	logger.Info("PostIteratorActivity started")
+	logger.Info("PostSubPipelineActivity started")
 
-	// recipes for all iteration are the same
-	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKeys[0].Recipe)
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, param.MemoryStorageKey.Recipe)
 	if err != nil {
-		return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+		return componentActivityError(err, postSubPipelineActivityErrorType, param.ID)
 	}
+	callee := r.Component[param.ID].Callee
 
-	iterComp := []*recipe.ComponentMemory{}
-	for iter := range param.MemoryStorageKeys {
-
-		k := param.MemoryStorageKeys[iter]
-		for e := range len(k.Variables) {
-			for compID := range r.Component {
-				k.Components[e][compID] = fmt.Sprintf("%s:%d:%s:%s:%s:%d:%s:%s", param.WorkflowID, iter, recipe.SegComponent, param.ID, recipe.SegIteration, e, recipe.SegComponent, compID)
-			}
+	for idx, childWorkflowID := range param.ChildWorkflowIDs {
+		if childWorkflowID == "" {
+			continue
 		}
 
-		m, err := recipe.LoadMemory(ctx, w.redisClient, k)
+		m, err := recipe.LoadMemoryByTriggerID(ctx, w.redisClient, childWorkflowID)
 		if err != nil {
-			return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+			return componentActivityError(err, postSubPipelineActivityErrorType, param.ID)
+		}
+		if len(m) != 1 {
+			return componentActivityError(fmt.Errorf("the memory of the pipeline called by component %s is missing", param.ID), postSubPipelineActivityErrorType, param.ID)
 		}
 
 		output := recipe.ComponentIO{}
-		for k, v := range param.OutputElements {
-			elemVals := []any{}
-
-			for elemIdx := range len(m) {
-				elemVal, err := recipe.RenderInput(v, elemIdx, m[elemIdx])
-				if err != nil {
-					return componentActivityError(err, postIteratorActivityErrorType, param.ID)
-				}
-				elemVals = append(elemVals, elemVal)
-
+		for k, o := range callee.Recipe.Output {
+			val, err := recipe.RenderInput(o.Value, 0, m[0])
+			if err != nil {
+				return componentActivityError(err, postSubPipelineActivityErrorType, param.ID)
 			}
-			output[k] = elemVals
+			output[k] = val
 		}
 
-		iterComp = append(iterComp, &recipe.ComponentMemory{
-			Output: &output,
-			Status: &recipe.ComponentStatus{ // TODO: use real status
-				Started:   true,
-				Completed: true,
-			},
-		})
-	}
-	err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, iterComp)
-	if err != nil {
-		logger.Error(fmt.Sprintf("unable to execute workflow: %s", err.Error()))
-		return componentActivityError(err, postIteratorActivityErrorType, param.ID)
+		compMem, err := recipe.LoadComponentMemory(ctx, w.redisClient, param.WorkflowID, param.BatchOffset+idx, param.ID)
+		if err != nil {
+			return componentActivityError(err, postSubPipelineActivityErrorType, param.ID)
+		}
+		compMem.Output = &output
+		compMem.Status.Completed = true
+
+		err = recipe.WriteComponentMemory(ctx, w.redisClient, param.WorkflowID, param.ID, param.BatchOffset+idx, []*recipe.ComponentMemory{compMem})
+		if err != nil {
+			return componentActivityError(err, postSubPipelineActivityErrorType, param.ID)
+		}
 	}
 
-	logger.Info("PostIteratorActivity completed")
+	logger.Info("PostSubPipelineActivity completed")
 	return nil
 }
 
@@ -583,10 +2171,227 @@ func (w *worker) IncreasePipelineTriggerCountActivity(ctx context.Context, sv re
 	return nil
 }
 
-func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamIDs []string, condition string, input any) ([]*structpb.Struct, map[int]int, error) {
-	var compInputs []*structpb.Struct
-	idxMap := map[int]int{}
+// CreatePipelineRunActivity records the start of a pipeline trigger.
+func (w *worker) CreatePipelineRunActivity(ctx context.Context, param *CreatePipelineRunActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("CreatePipelineRunActivity started")
+
+	sv := param.SystemVariables
+	run := &datamodel.PipelineRun{
+		PipelineTriggerID:  sv.PipelineTriggerID,
+		PipelineUID:        sv.PipelineUID,
+		PipelineID:         sv.PipelineID,
+		PipelineReleaseUID: uuid.NullUUID{UUID: sv.PipelineReleaseUID, Valid: !sv.PipelineReleaseUID.IsNil()},
+		PipelineReleaseID:  sv.PipelineReleaseID,
+		Owner:              resource.Namespace{NsType: sv.PipelineOwnerType, NsUID: sv.PipelineOwnerUID}.Permalink(),
+		RequesterUID:       uuid.NullUUID{UUID: sv.PipelineRequesterUID, Valid: !sv.PipelineRequesterUID.IsNil()},
+		TriggerMode:        param.Mode.String(),
+		Status:             datamodel.RunStatusProcessing,
+		StartedTime:        param.StartedTime,
+
+		SourcePipelineTriggerID: param.SourceTriggerID,
+	}
+
+	if config.Config.Server.PipelineRun.RecordData {
+		batchMemory, err := recipe.LoadMemory(ctx, w.redisClient, param.MemoryStorageKey)
+		if err != nil {
+			return temporal.NewApplicationErrorWithCause("loading run inputs", createPipelineRunActivityErrorType, err)
+		}
+
+		inputs := make([]recipe.VariableMemory, len(batchMemory))
+		for idx, m := range batchMemory {
+			inputs[idx] = m.Variable
+		}
+		if run.Inputs, err = marshalRunData(inputs); err != nil {
+			return temporal.NewApplicationErrorWithCause("marshalling run inputs", createPipelineRunActivityErrorType, err)
+		}
+		if retention := config.Config.Server.PipelineRun.DataRetention; retention > 0 {
+			expireTime := param.StartedTime.Add(retention)
+			run.DataExpireTime = &expireTime
+		}
+	}
+
+	if err := w.repository.UpsertPipelineRun(ctx, run); err != nil {
+		return temporal.NewApplicationErrorWithCause("creating pipeline run", createPipelineRunActivityErrorType, err)
+	}
+
+	logger.Info("CreatePipelineRunActivity completed")
+	return nil
+}
+
+// CompletePipelineRunActivity records the end of a pipeline trigger and, on
+// success, its outputs.
+func (w *worker) CompletePipelineRunActivity(ctx context.Context, param *CompletePipelineRunActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("CompletePipelineRunActivity started")
+
+	now := time.Now()
+	duration := now.Sub(param.StartedTime).Milliseconds()
+	run := &datamodel.PipelineRun{
+		Status:        param.Status,
+		CompletedTime: &now,
+		TotalDuration: &duration,
+	}
+	if param.Error != "" {
+		run.Error = &param.Error
+	}
+
+	if config.Config.Server.PipelineRun.RecordData && param.Status == datamodel.RunStatusCompleted {
+		outputs, err := w.renderRunOutputs(ctx, param.MemoryStorageKey)
+		if err != nil {
+			return temporal.NewApplicationErrorWithCause("rendering run outputs", completePipelineRunActivityErrorType, err)
+		}
+		if run.Outputs, err = marshalRunData(outputs); err != nil {
+			return temporal.NewApplicationErrorWithCause("marshalling run outputs", completePipelineRunActivityErrorType, err)
+		}
+	}
+
+	if err := w.repository.UpdatePipelineRun(ctx, param.SystemVariables.PipelineTriggerID, run); err != nil {
+		return temporal.NewApplicationErrorWithCause("updating pipeline run", completePipelineRunActivityErrorType, err)
+	}
+
+	logger.Info("CompletePipelineRunActivity completed")
+	return nil
+}
+
+// PurgeMemoryActivity removes the memory of a trigger and, if requested, its
+// pending approval requests.
+func (w *worker) PurgeMemoryActivity(ctx context.Context, param *PurgeMemoryActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("PurgeMemoryActivity started")
+
+	recipe.Purge(ctx, w.redisClient, param.WorkflowID)
+	if param.PipelineTriggerID != "" {
+		recipe.PurgeApprovalRequests(ctx, w.redisClient, param.PipelineTriggerID)
+	}
+
+	logger.Info("PurgeMemoryActivity completed")
+	return nil
+}
+
+// The expired run data is cleared every runDataCleanupInterval, at most
+// runDataCleanupBatchSize runs at a time.
+const (
+	runDataCleanupInterval  = time.Hour
+	runDataCleanupBatchSize = 1000
+)
+
+// ClearExpiredRunData periodically removes the expired inputs and outputs of
+// the pipeline runs, in batches, until the context is done.
+func (w *worker) ClearExpiredRunData(ctx context.Context) {
+	logger, _ := logger.GetZapLogger(ctx)
+
+	ticker := time.NewTicker(runDataCleanupInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			cleared, err := w.repository.ClearExpiredPipelineRunData(ctx, runDataCleanupBatchSize)
+			if err != nil {
+				logger.Warn("clearing expired run data", zap.Error(err))
+				break
+			}
+			if cleared < runDataCleanupBatchSize {
+				break
+			}
+		}
 
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RetainMemoryActivity keeps the memory of a failed trigger for the resume
+// retention period, so it can be resumed after the memory is purged. The
+// memory isn't kept if the retention period isn't set.
+func (w *worker) RetainMemoryActivity(ctx context.Context, param *RetainMemoryActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("RetainMemoryActivity started")
+
+	retention := config.Config.Server.Resume.Retention
+	if retention <= 0 {
+		logger.Info("RetainMemoryActivity skipped")
+		return nil
+	}
+
+	if err := recipe.RetainMemory(ctx, w.redisClient, param.WorkflowID, retention); err != nil {
+		return temporal.NewApplicationErrorWithCause("retaining trigger memory", retainMemoryActivityErrorType, err)
+	}
+
+	logger.Info("RetainMemoryActivity completed")
+	return nil
+}
+
+// StoreTriggerResultActivity stores the outputs and the traces of a completed
+// asynchronous trigger and purges its memory.
+func (w *worker) StoreTriggerResultActivity(ctx context.Context, param *StoreTriggerResultActivityParam) error {
+	logger, _ := logger.GetZapLogger(ctx)
+	logger.Info("StoreTriggerResultActivity started")
+
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, fmt.Sprintf("%s:%s", param.WorkflowID, recipe.SegRecipe))
+	if err != nil {
+		return temporal.NewApplicationErrorWithCause("loading trigger recipe", storeTriggerResultActivityErrorType, err)
+	}
+	outputs, metadata, err := recipe.GenerateOutputs(ctx, w.redisClient, param.WorkflowID, r, true)
+	if err != nil {
+		return temporal.NewApplicationErrorWithCause("generating trigger outputs", storeTriggerResultActivityErrorType, err)
+	}
+
+	result := &datamodel.PipelineTriggerResult{
+		PipelineTriggerID: param.WorkflowID,
+		Owner:             recipe.LoadOwnerPermalink(ctx, w.redisClient, param.WorkflowID),
+		ExpireTime:        time.Now().Add(param.Retention),
+	}
+	if result.Outputs, err = json.Marshal(outputs); err != nil {
+		return temporal.NewApplicationErrorWithCause("marshalling trigger outputs", storeTriggerResultActivityErrorType, err)
+	}
+	if metadata != nil {
+		if result.Metadata, err = protojson.Marshal(metadata); err != nil {
+			return temporal.NewApplicationErrorWithCause("marshalling trigger metadata", storeTriggerResultActivityErrorType, err)
+		}
+	}
+	if err := w.repository.UpsertPipelineTriggerResult(ctx, result); err != nil {
+		return temporal.NewApplicationErrorWithCause("storing trigger result", storeTriggerResultActivityErrorType, err)
+	}
+
+	recipe.Purge(ctx, w.redisClient, param.WorkflowID)
+
+	logger.Info("StoreTriggerResultActivity completed")
+	return nil
+}
+
+// renderRunOutputs renders the pipeline outputs of each batch item. The
+// outputs that reference a component without output, e.g. because it failed
+// with a continue policy, are missing.
+func (w *worker) renderRunOutputs(ctx context.Context, key *recipe.BatchMemoryKey) ([]map[string]any, error) {
+	r, err := recipe.LoadRecipe(ctx, w.redisClient, key.Recipe)
+	if err != nil {
+		return nil, err
+	}
+	batchMemory, err := recipe.LoadMemory(ctx, w.redisClient, key)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]map[string]any, len(batchMemory))
+	for idx, m := range batchMemory {
+		outputs[idx] = map[string]any{}
+		for k, v := range r.Output {
+			if o, err := recipe.RenderInput(v.Value, idx, m); err == nil {
+				outputs[idx][k] = o
+			}
+		}
+	}
+	return outputs, nil
+}
+
+// processStatus initializes the memory of a component and decides, for each
+// batch item, whether it starts or is skipped. A component is skipped when
+// its condition is false or when an upstream component was skipped or
+// errored without output.
+func (w *worker) processStatus(batchMemory []*recipe.Memory, id string, UpstreamIDs []string, condition string) error {
 	for idx := range batchMemory {
 
 		batchMemory[idx].Component[id] = &recipe.ComponentMemory{
@@ -596,7 +2401,8 @@ func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamI
 		}
 
 		for _, upstreamID := range UpstreamIDs {
-			if batchMemory[idx].Component[upstreamID].Status.Skipped {
+			upstreamStatus := batchMemory[idx].Component[upstreamID].Status
+			if upstreamStatus.Skipped || (upstreamStatus.Errored && !upstreamStatus.Completed) {
 				batchMemory[idx].Component[id].Status.Skipped = true
 				break
 			}
@@ -604,29 +2410,11 @@ func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamI
 
 		if !batchMemory[idx].Component[id].Status.Skipped {
 			if condition != "" {
-
-				// TODO: these code should be refactored and shared some common functions with RenderInput
-				condStr := condition
-				var varMapping map[string]string
-				condStr, _, varMapping = recipe.SanitizeCondition(condStr)
-
-				expr, err := parser.ParseExpr(condStr)
-				if err != nil {
-					return nil, nil, err
-				}
-
-				condMemory := map[string]any{}
-
-				for k, v := range batchMemory[idx].Component {
-					condMemory[varMapping[k]] = v
-				}
-				condMemory[varMapping["variable"]] = batchMemory[idx].Variable
-
-				cond, err := recipe.EvalCondition(expr, condMemory)
+				cond, err := recipe.EvalCondition(condition, batchMemory[idx])
 				if err != nil {
-					return nil, nil, err
+					return err
 				}
-				if cond == false {
+				if !cond {
 					batchMemory[idx].Component[id].Status.Skipped = true
 				} else {
 					batchMemory[idx].Component[id].Status.Started = true
@@ -635,7 +2423,19 @@ func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamI
 				batchMemory[idx].Component[id].Status.Started = true
 			}
 		}
+	}
+	return nil
+}
 
+func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamIDs []string, condition string, input any) ([]*structpb.Struct, map[int]int, error) {
+	var compInputs []*structpb.Struct
+	idxMap := map[int]int{}
+
+	if err := w.processStatus(batchMemory, id, UpstreamIDs, condition); err != nil {
+		return nil, nil, err
+	}
+
+	for idx := range batchMemory {
 		if batchMemory[idx].Component[id].Status.Started {
 
 			var compInputTemplateJSON []byte
@@ -679,6 +2479,10 @@ func (w *worker) processInput(batchMemory []*recipe.Memory, id string, UpstreamI
 func (w *worker) processOutput(batchMemory []*recipe.Memory, id string, compOutputs []*structpb.Struct, idxMap map[int]int) ([]*recipe.ComponentMemory, error) {
 
 	for idx := range compOutputs {
+		if compOutputs[idx] == nil {
+			// The item failed in isolation.
+			continue
+		}
 
 		outputJSON, err := protojson.Marshal(compOutputs[idx])
 		if err != nil {
@@ -764,9 +2568,22 @@ func componentActivityError(err error, errType, componentID string) error {
 // business domain (e.g. VendorError (non billable), InputDataError (billable),
 // etc.).
 const (
-	componentActivityErrorType    = "ComponentActivityError"
-	preIteratorActivityErrorType  = "PreIteratorActivityError"
-	postIteratorActivityErrorType = "PostIteratorActivityError"
+	componentActivityErrorType           = "ComponentActivityError"
+	componentErrorActivityErrorType      = "ComponentErrorActivityError"
+	createPipelineRunActivityErrorType   = "CreatePipelineRunActivityError"
+	completePipelineRunActivityErrorType = "CompletePipelineRunActivityError"
+	retainMemoryActivityErrorType        = "RetainMemoryActivityError"
+	storeTriggerResultActivityErrorType  = "StoreTriggerResultActivityError"
+	preApprovalActivityErrorType         = "PreApprovalActivityError"
+	publishStreamEventActivityErrorType  = "PublishStreamEventActivityError"
+	postApprovalActivityErrorType        = "PostApprovalActivityError"
+	preIteratorActivityErrorType         = "PreIteratorActivityError"
+	postIteratorActivityErrorType        = "PostIteratorActivityError"
+	preSwitchActivityErrorType           = "PreSwitchActivityError"
+	postSwitchActivityErrorType          = "PostSwitchActivityError"
+
+	preSubPipelineActivityErrorType  = "PreSubPipelineActivityError"
+	postSubPipelineActivityErrorType = "PostSubPipelineActivityError"
 )
 
 // EndUserErrorDetails provides a structured way to add an end-user error
@@ -0,0 +1,628 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/instill-ai/pipeline-backend/config"
+	"github.com/instill-ai/pipeline-backend/pkg/datamodel"
+	"github.com/instill-ai/pipeline-backend/pkg/recipe"
+)
+
+// writeAPI counts the usage data points that are written.
+type writeAPI struct {
+	api.WriteAPI
+	points int
+}
+
+func (w *writeAPI) WritePoint(*write.Point) {
+	w.points++
+}
+
+// newWorkflowTest returns a worker whose trigger memory holds the recipe,
+// and a test environment where the run records and the trigger count are
+// mocked.
+func newWorkflowTest(c *qt.C, r *datamodel.Recipe) (*worker, *testsuite.TestWorkflowEnvironment) {
+	cfg := config.Config.Server
+	c.Cleanup(func() { config.Config.Server = cfg })
+	config.Config.Server.Workflow.MaxWorkflowTimeout = 60
+
+	b, err := json.Marshal(r)
+	c.Assert(err, qt.IsNil)
+	redisClient, redisMock := redismock.NewClientMock()
+	redisMock.ExpectGet("pipeline_trigger:trigger:recipe").SetVal(string(b))
+	w := &worker{redisClient: redisClient, influxDBWriteClient: &writeAPI{}}
+
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartWorkflowOptions(client.StartWorkflowOptions{ID: "trigger"})
+	env.OnActivity(w.CreatePipelineRunActivity, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(w.IncreasePipelineTriggerCountActivity, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(w.RetainMemoryActivity, mock.Anything, mock.Anything).Return(nil)
+	return w, env
+}
+
+func newTriggerParam(batchSize int) *TriggerPipelineWorkflowParam {
+	return &TriggerPipelineWorkflowParam{
+		BatchSize: batchSize,
+		MemoryStorageKey: &recipe.BatchMemoryKey{
+			Recipe:     "trigger:recipe",
+			Components: make([]map[string]string, batchSize),
+		},
+		SystemVariables: recipe.SystemVariables{PipelineTriggerID: "trigger"},
+	}
+}
+
+// componentID matches the activity params of a component.
+func componentID(id string) any {
+	return mock.MatchedBy(func(p *ComponentActivityParam) bool { return p.ID == id })
+}
+
+// componentRecorder records the components that complete, in order, and the
+// memory of the upstream components they read.
+type componentRecorder struct {
+	mu       sync.Mutex
+	done     []string
+	upstream map[string][]string
+}
+
+func (r *componentRecorder) complete(_ context.Context, p *ComponentActivityParam) (*ComponentActivityParam, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.done = append(r.done, p.ID)
+	if r.upstream == nil {
+		r.upstream = map[string][]string{}
+	}
+	ids := []string{}
+	for id := range p.MemoryStorageKey.Components[0] {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	r.upstream[p.ID] = ids
+	return &ComponentActivityParam{}, nil
+}
+
+func TestTriggerPipelineWorkflow_Scheduling(t *testing.T) {
+	c := qt.New(t)
+
+	component := func(input string) *datamodel.Component {
+		return &datamodel.Component{Type: "openai", Task: "TASK_TEXT_GENERATION", Input: map[string]any{"x": input}}
+	}
+
+	c.Run("independent branches", func(c *qt.C) {
+		r := &datamodel.Recipe{Component: datamodel.ComponentMap{
+			"slow": component(""),
+			"fast": component(""),
+			"next": component("${fast.output.x}"),
+		}}
+		w, env := newWorkflowTest(c, r)
+
+		rec := &componentRecorder{}
+		env.OnActivity(w.ComponentActivity, mock.Anything, componentID("slow")).After(time.Hour).Return(rec.complete)
+		env.OnActivity(w.ComponentActivity, mock.Anything, mock.Anything).Return(rec.complete)
+		env.OnActivity(w.CompletePipelineRunActivity, mock.Anything, mock.Anything).Return(nil)
+
+		env.ExecuteWorkflow(w.TriggerPipelineWorkflow, newTriggerParam(1))
+		c.Assert(env.GetWorkflowError(), qt.IsNil)
+
+		// The downstream component of fast doesn't wait for slow, which is in
+		// the same layer of the DAG as fast.
+		c.Check(rec.done, qt.DeepEquals, []string{"fast", "next", "slow"})
+		c.Check(rec.upstream["next"], qt.DeepEquals, []string{"fast"})
+	})
+
+	c.Run("upstream error", func(c *qt.C) {
+		r := &datamodel.Recipe{Component: datamodel.ComponentMap{
+			"a": component(""),
+			"b": component("${a.output.x}"),
+			"c": component("${b.output.x}"),
+		}}
+		w, env := newWorkflowTest(c, r)
+
+		rec := &componentRecorder{}
+		env.OnActivity(w.ComponentActivity, mock.Anything, componentID("a")).Return(nil, temporal.NewNonRetryableApplicationError("boom", componentActivityErrorType, nil))
+		env.OnActivity(w.ComponentActivity, mock.Anything, mock.Anything).Return(rec.complete)
+		var run *CompletePipelineRunActivityParam
+		env.OnActivity(w.CompletePipelineRunActivity, mock.Anything, mock.Anything).Return(func(_ context.Context, p *CompletePipelineRunActivityParam) error {
+			run = p
+			return nil
+		})
+
+		env.ExecuteWorkflow(w.TriggerPipelineWorkflow, newTriggerParam(1))
+		c.Assert(env.GetWorkflowError(), qt.ErrorMatches, ".*boom.*")
+
+		// The downstream components of the failed component don't run.
+		c.Check(rec.done, qt.HasLen, 0)
+		c.Assert(run, qt.IsNotNil)
+		c.Check(run.Status, qt.Equals, datamodel.RunStatusFailed)
+
+		// The memory of the failed trigger is kept so it can be resumed.
+		env.AssertCalled(c, "RetainMemoryActivity", mock.Anything, &RetainMemoryActivityParam{WorkflowID: "trigger"})
+	})
+
+	c.Run("linear recipe", func(c *qt.C) {
+		r := &datamodel.Recipe{Component: datamodel.ComponentMap{
+			"a": component(""),
+			"b": component("${a.output.x}"),
+			"c": component("${b.output.x}"),
+		}}
+		w, env := newWorkflowTest(c, r)
+
+		rec := &componentRecorder{}
+		env.OnActivity(w.ComponentActivity, mock.Anything, mock.Anything).Return(rec.complete)
+		var run *CompletePipelineRunActivityParam
+		env.OnActivity(w.CompletePipelineRunActivity, mock.Anything, mock.Anything).Return(func(_ context.Context, p *CompletePipelineRunActivityParam) error {
+			run = p
+			return nil
+		})
+
+		env.ExecuteWorkflow(w.TriggerPipelineWorkflow, newTriggerParam(1))
+		c.Assert(env.GetWorkflowError(), qt.IsNil)
+
+		// The components run in sequence, each one reading the memory of the
+		// previous ones, and the trigger memory holds every component.
+		c.Check(rec.done, qt.DeepEquals, []string{"a", "b", "c"})
+		c.Check(rec.upstream, qt.DeepEquals, map[string][]string{
+			"a": {},
+			"b": {"a"},
+			"c": {"a", "b"},
+		})
+		c.Assert(run, qt.IsNotNil)
+		c.Check(run.Status, qt.Equals, datamodel.RunStatusCompleted)
+		c.Check(run.MemoryStorageKey.Components, qt.DeepEquals, []map[string]string{{
+			"a": "trigger:0:component:a",
+			"b": "trigger:0:component:b",
+			"c": "trigger:0:component:c",
+		}})
+	})
+}
+
+func TestTriggerPipelineWorkflow_Cancel(t *testing.T) {
+	c := qt.New(t)
+
+	r := &datamodel.Recipe{Component: datamodel.ComponentMap{
+		"slow": {Type: "openai", Task: "TASK_TEXT_GENERATION", Input: map[string]any{}},
+		"fast": {Type: "openai", Task: "TASK_TEXT_GENERATION", Input: map[string]any{}},
+	}}
+	w, env := newWorkflowTest(c, r)
+
+	rec := &componentRecorder{}
+	env.OnActivity(w.ComponentActivity, mock.Anything, componentID("slow")).After(time.Hour).Return(rec.complete)
+	env.OnActivity(w.ComponentActivity, mock.Anything, mock.Anything).Return(rec.complete)
+	var run *CompletePipelineRunActivityParam
+	env.OnActivity(w.CompletePipelineRunActivity, mock.Anything, mock.Anything).Return(func(_ context.Context, p *CompletePipelineRunActivityParam) error {
+		run = p
+		return nil
+	})
+	var purged *PurgeMemoryActivityParam
+	env.OnActivity(w.PurgeMemoryActivity, mock.Anything, mock.Anything).Return(func(_ context.Context, p *PurgeMemoryActivityParam) error {
+		purged = p
+		return nil
+	})
+
+	env.RegisterDelayedCallback(env.CancelWorkflow, time.Minute)
+	env.ExecuteWorkflow(w.TriggerPipelineWorkflow, newTriggerParam(1))
+	c.Assert(env.IsWorkflowCompleted(), qt.IsTrue)
+	c.Check(temporal.IsCanceledError(env.GetWorkflowError()), qt.IsTrue)
+
+	// The running component is cancelled with the trigger.
+	c.Check(rec.done, qt.DeepEquals, []string{"fast"})
+
+	c.Assert(run, qt.IsNotNil)
+	c.Check(run.Status, qt.Equals, datamodel.RunStatusCancelled)
+	c.Check(purged, qt.DeepEquals, &PurgeMemoryActivityParam{WorkflowID: "trigger", PipelineTriggerID: "trigger"})
+}
+
+func TestTriggerPipelineWorkflow_DryRun(t *testing.T) {
+	c := qt.New(t)
+
+	r := &datamodel.Recipe{
+		Component: datamodel.ComponentMap{"llm": {Type: "openai", Task: "TASK_TEXT_GENERATION", Input: map[string]any{}}},
+	}
+
+	testcases := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "trigger"},
+		{name: "dry run", dryRun: true},
+	}
+
+	for _, tc := range testcases {
+		c.Run(tc.name, func(c *qt.C) {
+			w, env := newWorkflowTest(c, r)
+			env.OnActivity(w.ComponentActivity, mock.Anything, mock.Anything).Return(&ComponentActivityParam{}, nil)
+			env.OnActivity(w.CompletePipelineRunActivity, mock.Anything, mock.Anything).Return(nil)
+
+			param := newTriggerParam(1)
+			param.DryRun = tc.dryRun
+			env.ExecuteWorkflow(w.TriggerPipelineWorkflow, param)
+			c.Assert(env.IsWorkflowCompleted(), qt.IsTrue)
+			c.Assert(env.GetWorkflowError(), qt.IsNil)
+
+			// The dry runs aren't counted as triggers, don't record usage
+			// and aren't recorded as pipeline runs.
+			points := w.influxDBWriteClient.(*writeAPI).points
+			if tc.dryRun {
+				env.AssertNotCalled(c, "IncreasePipelineTriggerCountActivity", mock.Anything, mock.Anything)
+				env.AssertNotCalled(c, "CreatePipelineRunActivity", mock.Anything, mock.Anything)
+				env.AssertNotCalled(c, "CompletePipelineRunActivity", mock.Anything, mock.Anything)
+				c.Check(points, qt.Equals, 0)
+			} else {
+				env.AssertNumberOfCalls(c, "IncreasePipelineTriggerCountActivity", 1)
+				env.AssertNumberOfCalls(c, "CreatePipelineRunActivity", 1)
+				env.AssertNumberOfCalls(c, "CompletePipelineRunActivity", 1)
+				c.Check(points, qt.Equals, 2)
+			}
+		})
+	}
+}
+
+func TestExecuteIterations(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.Config.Server
+	c.Cleanup(func() { config.Config.Server = cfg })
+	config.Config.Server.Workflow.MaxWorkflowTimeout = 3600
+	config.Config.Server.Workflow.MaxWorkflowRetry = 1
+
+	// newPreIteratorResult returns the result of the pre-iterator activity for
+	// a batch whose items have the given number of elements.
+	newPreIteratorResult := func(elementSizes ...int) *PreIteratorActivityResult {
+		r := &PreIteratorActivityResult{ElementSize: elementSizes}
+		for batchIdx, size := range elementSizes {
+			r.ChildWorkflowIDs = append(r.ChildWorkflowIDs, fmt.Sprintf("trigger:%d:component:iter:iteration", batchIdx))
+			k := &recipe.BatchMemoryKey{
+				Components: make([]map[string]string, size),
+				Variables:  make([]string, size),
+				Secrets:    make([]string, size),
+			}
+			r.MemoryStorageKeys = append(r.MemoryStorageKeys, k)
+		}
+		return r
+	}
+
+	// iterations records the chunks of elements that are executed and the
+	// maximum number of concurrent chunks.
+	type iterations struct {
+		chunks         []string
+		running        int
+		maxConcurrency int
+	}
+
+	// execute runs the iterations, which take a minute per chunk. The chunks
+	// that hold a failed element fail.
+	execute := func(c *qt.C, comp *datamodel.Component, pre *PreIteratorActivityResult, failed int) (*iterations, []map[int]string, error) {
+		w := &worker{}
+		var s testsuite.WorkflowTestSuite
+		env := s.NewTestWorkflowEnvironment()
+
+		it := &iterations{}
+		env.RegisterWorkflow(w.TriggerPipelineWorkflow)
+		env.OnWorkflow(w.TriggerPipelineWorkflow, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, p *TriggerPipelineWorkflowParam) error {
+			c.Check(p.IsIterator, qt.IsTrue)
+			it.chunks = append(it.chunks, fmt.Sprintf("%s:%d-%d", p.MemoryKeyPrefix, p.BatchOffset, p.BatchOffset+p.BatchSize))
+			it.running++
+			it.maxConcurrency = max(it.maxConcurrency, it.running)
+			defer func() { it.running-- }()
+
+			if err := workflow.Sleep(ctx, time.Minute); err != nil {
+				return err
+			}
+			if p.BatchOffset <= failed && failed < p.BatchOffset+p.BatchSize {
+				return temporal.NewNonRetryableApplicationError("boom", componentActivityErrorType, nil)
+			}
+			return nil
+		})
+
+		var elementErrors []map[int]string
+		env.ExecuteWorkflow(func(ctx workflow.Context) error {
+			var err error
+			elementErrors, err = w.executeIterations(ctx, "iter", comp, pre, &TriggerPipelineWorkflowParam{})
+			return err
+		})
+		c.Assert(env.IsWorkflowCompleted(), qt.IsTrue)
+		return it, elementErrors, env.GetWorkflowError()
+	}
+
+	c.Run("batch size", func(c *qt.C) {
+		it, elementErrors, err := execute(c, &datamodel.Component{BatchSize: 2}, newPreIteratorResult(5, 1), -1)
+		c.Assert(err, qt.IsNil)
+
+		// The elements of each batch item are split in chunks of the batch
+		// size, the last one holding the remaining elements.
+		c.Check(it.chunks, qt.ContentEquals, []string{
+			"trigger:0:component:iter:iteration:0-2",
+			"trigger:0:component:iter:iteration:2-4",
+			"trigger:0:component:iter:iteration:4-5",
+			"trigger:1:component:iter:iteration:0-1",
+		})
+		c.Check(it.maxConcurrency, qt.Equals, 4)
+		c.Check(elementErrors, qt.DeepEquals, []map[int]string{{}, {}})
+	})
+
+	c.Run("max concurrency", func(c *qt.C) {
+		it, _, err := execute(c, &datamodel.Component{BatchSize: 1, MaxConcurrency: 2}, newPreIteratorResult(5), -1)
+		c.Assert(err, qt.IsNil)
+
+		// Every element is executed, with no more than two chunks at a time.
+		c.Check(it.chunks, qt.HasLen, 5)
+		c.Check(it.maxConcurrency, qt.Equals, 2)
+	})
+
+	c.Run("error", func(c *qt.C) {
+		_, _, err := execute(c, &datamodel.Component{BatchSize: 2}, newPreIteratorResult(4), 1)
+		c.Check(err, qt.ErrorMatches, ".*boom.*")
+	})
+
+	c.Run("continue on error", func(c *qt.C) {
+		it, elementErrors, err := execute(c, &datamodel.Component{BatchSize: 2, ContinueOnError: true}, newPreIteratorResult(4), 1)
+		c.Assert(err, qt.IsNil)
+
+		// The failed chunk is executed again element by element, so only the
+		// failed element is reported.
+		c.Check(it.chunks, qt.ContentEquals, []string{
+			"trigger:0:component:iter:iteration:0-2",
+			"trigger:0:component:iter:iteration:2-4",
+			"trigger:0:component:iter:iteration:0-1",
+			"trigger:0:component:iter:iteration:1-2",
+		})
+		c.Check(elementErrors, qt.HasLen, 1)
+		c.Check(elementErrors[0], qt.HasLen, 1)
+		c.Check(elementErrors[0][1], qt.Matches, ".*boom.*")
+	})
+}
+
+func TestComponentActivityOptions(t *testing.T) {
+	c := qt.New(t)
+
+	defaultPolicy := &temporal.RetryPolicy{MaximumAttempts: 3}
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		RetryPolicy:         defaultPolicy,
+	}
+
+	testcases := []struct {
+		name string
+		comp *datamodel.Component
+		want workflow.ActivityOptions
+	}{
+		{
+			name: "default",
+			comp: &datamodel.Component{},
+			want: workflow.ActivityOptions{
+				StartToCloseTimeout: time.Hour,
+				RetryPolicy:         defaultPolicy,
+			},
+		},
+		{
+			name: "timeouts",
+			comp: &datamodel.Component{Timeout: &datamodel.TimeoutPolicy{
+				StartToClose:    "30s",
+				ScheduleToClose: "5m",
+			}},
+			want: workflow.ActivityOptions{
+				StartToCloseTimeout:    30 * time.Second,
+				ScheduleToCloseTimeout: 5 * time.Minute,
+				RetryPolicy:            defaultPolicy,
+			},
+		},
+		{
+			name: "invalid timeouts",
+			comp: &datamodel.Component{Timeout: &datamodel.TimeoutPolicy{
+				StartToClose:    "soon",
+				ScheduleToClose: "-1m",
+			}},
+			want: workflow.ActivityOptions{
+				StartToCloseTimeout: time.Hour,
+				RetryPolicy:         defaultPolicy,
+			},
+		},
+		{
+			name: "retry",
+			comp: &datamodel.Component{Retry: &datamodel.RetryPolicy{
+				MaxAttempts:            5,
+				InitialInterval:        "2s",
+				BackoffCoefficient:     1.5,
+				NonRetryableErrorTypes: []string{"ComponentActivityError"},
+			}},
+			want: workflow.ActivityOptions{
+				StartToCloseTimeout: time.Hour,
+				RetryPolicy: &temporal.RetryPolicy{
+					MaximumAttempts:        5,
+					InitialInterval:        2 * time.Second,
+					BackoffCoefficient:     1.5,
+					NonRetryableErrorTypes: []string{"ComponentActivityError"},
+				},
+			},
+		},
+		{
+			name: "partial retry",
+			comp: &datamodel.Component{Retry: &datamodel.RetryPolicy{
+				InitialInterval:    "1s",
+				BackoffCoefficient: 0.5,
+			}},
+			want: workflow.ActivityOptions{
+				StartToCloseTimeout: time.Hour,
+				RetryPolicy: &temporal.RetryPolicy{
+					MaximumAttempts: 3,
+					InitialInterval: time.Second,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		c.Run(tc.name, func(c *qt.C) {
+			got := componentActivityOptions(ao, tc.comp)
+
+			// The component executions always heartbeat.
+			tc.want.HeartbeatTimeout = componentHeartbeatTimeout
+			tc.want.WaitForCancellation = true
+			c.Check(got, qt.DeepEquals, tc.want)
+		})
+	}
+
+	// The default policy isn't modified by the overrides.
+	c.Check(defaultPolicy, qt.DeepEquals, &temporal.RetryPolicy{MaximumAttempts: 3})
+}
+
+func TestComponentErrorActivity(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.Config.Server
+	c.Cleanup(func() { config.Config.Server = cfg })
+	config.Config.Server.Workflow.MaxWorkflowTimeout = 60
+
+	upstream := []*recipe.ComponentMemory{
+		{Output: &recipe.ComponentIO{"x": "hello"}, Status: &recipe.ComponentStatus{Started: true, Completed: true}},
+		{Status: &recipe.ComponentStatus{Skipped: true}},
+	}
+
+	testcases := []struct {
+		name    string
+		onError *datamodel.OnErrorPolicy
+		// The memory of the errored component in the first batch item.
+		want *recipe.ComponentMemory
+	}{
+		{
+			name:    "continue",
+			onError: &datamodel.OnErrorPolicy{Action: datamodel.OnErrorContinue},
+			want: &recipe.ComponentMemory{
+				Input:  &recipe.ComponentIO{},
+				Output: &recipe.ComponentIO{},
+				Status: &recipe.ComponentStatus{Started: true, Errored: true},
+				Error:  "boom",
+			},
+		},
+		{
+			name: "fallback",
+			onError: &datamodel.OnErrorPolicy{
+				Action: datamodel.OnErrorFallback,
+				Output: map[string]any{"text": "${a.output.x}, ${variable.name}"},
+			},
+			// The fallback output is rendered from the memory of the batch
+			// item, and the component is still reported as errored.
+			want: &recipe.ComponentMemory{
+				Input:  &recipe.ComponentIO{},
+				Output: &recipe.ComponentIO{"text": "hello, Ada"},
+				Status: &recipe.ComponentStatus{Started: true, Completed: true, Errored: true},
+				Error:  "boom",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		c.Run(tc.name, func(c *qt.C) {
+			redisClient, redisMock := redismock.NewClientMock()
+			key := &recipe.BatchMemoryKey{
+				Variables:  []string{"trigger:0:variable", "trigger:1:variable"},
+				Secrets:    []string{"trigger:0:secret", "trigger:1:secret"},
+				Components: []map[string]string{{"a": "trigger:0:component:a"}, {"a": "trigger:1:component:a"}},
+			}
+			for idx := range 2 {
+				redisMock.ExpectGet(fmt.Sprintf("pipeline_trigger:trigger:%d:variable", idx)).SetVal(`{"name":"Ada"}`)
+				redisMock.ExpectGet(fmt.Sprintf("pipeline_trigger:trigger:%d:secret", idx)).SetVal(`{}`)
+				b, err := json.Marshal(upstream[idx])
+				c.Assert(err, qt.IsNil)
+				redisMock.ExpectGet(fmt.Sprintf("pipeline_trigger:trigger:%d:component:a", idx)).SetVal(string(b))
+			}
+
+			// The component is skipped in the batch item where its upstream
+			// component was skipped.
+			written := []*recipe.ComponentMemory{tc.want, {
+				Input:  &recipe.ComponentIO{},
+				Output: &recipe.ComponentIO{},
+				Status: &recipe.ComponentStatus{Skipped: true},
+			}}
+			for idx, m := range written {
+				b, err := json.Marshal(m)
+				c.Assert(err, qt.IsNil)
+				redisMock.ExpectSet(fmt.Sprintf("pipeline_trigger:trigger:%d:component:b", idx), b, time.Minute).SetVal("OK")
+			}
+
+			w := &worker{redisClient: redisClient}
+			err := w.ComponentErrorActivity(context.Background(), &ComponentErrorActivityParam{
+				WorkflowID:       "trigger",
+				ID:               "b",
+				UpstreamIDs:      []string{"a"},
+				Error:            "boom",
+				OnError:          tc.onError,
+				MemoryStorageKey: key,
+			})
+			c.Assert(err, qt.IsNil)
+			c.Check(redisMock.ExpectationsWereMet(), qt.IsNil)
+		})
+	}
+}
+
+func TestRetainMemoryActivity(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.Config.Server
+	c.Cleanup(func() { config.Config.Server = cfg })
+
+	c.Run("no retention", func(c *qt.C) {
+		config.Config.Server.Resume.Retention = 0
+		redisClient, redisMock := redismock.NewClientMock()
+
+		// The memory isn't copied.
+		w := &worker{redisClient: redisClient}
+		err := w.RetainMemoryActivity(context.Background(), &RetainMemoryActivityParam{WorkflowID: "trigger"})
+		c.Assert(err, qt.IsNil)
+		c.Check(redisMock.ExpectationsWereMet(), qt.IsNil)
+	})
+
+	c.Run("retention", func(c *qt.C) {
+		config.Config.Server.Resume.Retention = time.Hour
+		redisClient, redisMock := redismock.NewClientMock()
+		redisMock.ExpectScan(0, "pipeline_trigger:trigger:*", 0).SetVal([]string{"pipeline_trigger:trigger:0:variable"}, 0)
+		redisMock.ExpectGet("pipeline_trigger:trigger:0:variable").SetVal(`{"name":"Ada"}`)
+		redisMock.ExpectSet("pipeline_trigger:"+recipe.RetainedTriggerID("trigger")+":0:variable", []byte(`{"name":"Ada"}`), time.Hour).SetVal("OK")
+
+		w := &worker{redisClient: redisClient}
+		err := w.RetainMemoryActivity(context.Background(), &RetainMemoryActivityParam{WorkflowID: "trigger"})
+		c.Assert(err, qt.IsNil)
+		c.Check(redisMock.ExpectationsWereMet(), qt.IsNil)
+	})
+}
+
+func TestApprovalID(t *testing.T) {
+	c := qt.New(t)
+
+	param := &PreApprovalActivityParam{
+		WorkflowID:      "trigger:0:component:iter:iteration",
+		ID:              "review",
+		BatchOffset:     2,
+		SystemVariables: recipe.SystemVariables{PipelineTriggerID: "trigger"},
+	}
+	id := approvalID(param, 1)
+
+	// A retry of the activity writes the same requests.
+	c.Check(approvalID(param, 1), qt.Equals, id)
+
+	// The ID depends on the index of the item in the batch, not in the
+	// iteration chunk.
+	c.Check(approvalID(param, 0), qt.Not(qt.Equals), id)
+	other := *param
+	other.BatchOffset = 0
+	c.Check(approvalID(&other, 3), qt.Equals, id)
+	c.Check(approvalID(&other, 1), qt.Not(qt.Equals), id)
+}